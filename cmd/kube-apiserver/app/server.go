@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"regexp"
@@ -38,14 +39,26 @@ import (
 	"k8s.io/kubernetes/pkg/api/meta"
 	apiutil "k8s.io/kubernetes/pkg/api/util"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/apiserver/audit"
 	"k8s.io/kubernetes/pkg/capabilities"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/cloudprovider"
 	"k8s.io/kubernetes/pkg/master"
 	"k8s.io/kubernetes/pkg/master/ports"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	clusterroleetcd "k8s.io/kubernetes/pkg/registry/clusterrole/etcd"
+	"k8s.io/kubernetes/pkg/registry/clusterrolebinding"
+	clusterrolebindingetcd "k8s.io/kubernetes/pkg/registry/clusterrolebinding/etcd"
+	"k8s.io/kubernetes/pkg/registry/role"
+	roleetcd "k8s.io/kubernetes/pkg/registry/role/etcd"
+	"k8s.io/kubernetes/pkg/registry/rolebinding"
+	rolebindingetcd "k8s.io/kubernetes/pkg/registry/rolebinding/etcd"
 	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/storage/encryption"
+	"k8s.io/kubernetes/pkg/storage/kv"
 	"k8s.io/kubernetes/pkg/tools"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 	forked "k8s.io/kubernetes/third_party/forked/coreos/go-etcd/etcd"
 
 	"github.com/coreos/go-etcd/etcd"
@@ -59,6 +72,10 @@ const (
 	ReadWriteTimeout = time.Minute * 60
 	//TODO: This can be tightened up. It still matches objects named watch or proxy.
 	defaultLongRunningRequestRE = "(/|^)((watch|proxy)(/|$)|(logs?|portforward|exec|attach)/?$)"
+
+	// Values of --storage-backend
+	storageBackendEtcd2  = "etcd2"
+	storageBackendMemory = "memory"
 )
 
 // APIServer runs a kubernetes api server.
@@ -89,10 +106,19 @@ type APIServer struct {
 	ServiceAccountKeyFile      string
 	ServiceAccountLookup       bool
 	KeystoneURL                string
+	TokenWebhookConfigFile     string
+	TokenWebhookCacheTTL       time.Duration
 	AuthorizationMode          string
 	AuthorizationPolicyFile    string
+	AuthorizationRBACSuperUser string
+	AuthzWebhookConfigFile     string
+	AuthzWebhookAllowTTL       time.Duration
+	AuthzWebhookDenyTTL        time.Duration
 	AdmissionControl           string
 	AdmissionControlConfigFile string
+	StorageBackend             string
+	EncryptResources           []string
+	EncryptionKeyFile          string
 	EtcdServerList             []string
 	EtcdConfigFile             string
 	EtcdPathPrefix             string
@@ -108,11 +134,17 @@ type APIServer struct {
 	EnableProfiling            bool
 	EnableWatchCache           bool
 	MaxRequestsInFlight        int
+	RequestPriorityConfigFile  string
 	MinRequestTimeout          int
 	LongRunningRequestRE       string
 	SSHUser                    string
 	SSHKeyfile                 string
 	MaxConnectionBytesPerSec   int64
+	AuditLogPath               string
+	AuditLogMaxSize            int
+	AuditLogMaxBackups         int
+	APIDelegates               util.ConfigurationMap
+	APIDelegateTLSConfig       client.TLSClientConfig
 }
 
 // NewAPIServer creates a new APIServer object with default parameters
@@ -125,16 +157,23 @@ func NewAPIServer() *APIServer {
 		APIPrefix:              "/api",
 		APIGroupPrefix:         "/apis",
 		EventTTL:               1 * time.Hour,
+		TokenWebhookCacheTTL:   2 * time.Minute,
 		AuthorizationMode:      "AlwaysAllow",
+		AuthzWebhookAllowTTL:   5 * time.Minute,
+		AuthzWebhookDenyTTL:    30 * time.Second,
 		AdmissionControl:       "AlwaysAdmit",
+		StorageBackend:         storageBackendEtcd2,
 		EtcdPathPrefix:         master.DefaultEtcdPathPrefix,
 		EnableLogsSupport:      true,
 		MasterServiceNamespace: api.NamespaceDefault,
 		ClusterName:            "kubernetes",
 		CertDirectory:          "/var/run/kubernetes",
 		StorageVersions:        latest.AllPreferredGroupVersions(),
+		AuditLogMaxSize:        100,
+		AuditLogMaxBackups:     10,
 
 		RuntimeConfig: make(util.ConfigurationMap),
+		APIDelegates:  make(util.ConfigurationMap),
 		KubeletConfig: client.KubeletConfig{
 			Port:        ports.KubeletPort,
 			EnableHttps: true,
@@ -205,10 +244,19 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.ServiceAccountKeyFile, "service-account-key-file", s.ServiceAccountKeyFile, "File containing PEM-encoded x509 RSA private or public key, used to verify ServiceAccount tokens. If unspecified, --tls-private-key-file is used.")
 	fs.BoolVar(&s.ServiceAccountLookup, "service-account-lookup", s.ServiceAccountLookup, "If true, validate ServiceAccount tokens exist in etcd as part of authentication.")
 	fs.StringVar(&s.KeystoneURL, "experimental-keystone-url", s.KeystoneURL, "If passed, activates the keystone authentication plugin")
+	fs.StringVar(&s.TokenWebhookConfigFile, "authentication-token-webhook-config-file", s.TokenWebhookConfigFile, "File with webhook configuration for token authentication in kubeconfig format. The API server will query the remote service to determine authentication for bearer tokens.")
+	fs.DurationVar(&s.TokenWebhookCacheTTL, "authentication-token-webhook-cache-ttl", s.TokenWebhookCacheTTL, "The duration to cache responses from the webhook token authenticator.")
 	fs.StringVar(&s.AuthorizationMode, "authorization-mode", s.AuthorizationMode, "Ordered list of plug-ins to do authorization on secure port. Comma-delimited list of: "+strings.Join(apiserver.AuthorizationModeChoices, ","))
 	fs.StringVar(&s.AuthorizationPolicyFile, "authorization-policy-file", s.AuthorizationPolicyFile, "File with authorization policy in csv format, used with --authorization-mode=ABAC, on the secure port.")
+	fs.StringVar(&s.AuthorizationRBACSuperUser, "authorization-rbac-super-user", s.AuthorizationRBACSuperUser, "If specified, a username which avoids RBAC authorization checks, used with --authorization-mode=RBAC to create the initial roles and bindings.")
+	fs.StringVar(&s.AuthzWebhookConfigFile, "authorization-webhook-config-file", s.AuthzWebhookConfigFile, "File with webhook configuration in kubeconfig format, used with --authorization-mode=Webhook. The API server will query the remote service to determine access on the secure port.")
+	fs.DurationVar(&s.AuthzWebhookAllowTTL, "authorization-webhook-cache-authorized-ttl", s.AuthzWebhookAllowTTL, "The duration to cache 'authorized' responses from the webhook authorizer.")
+	fs.DurationVar(&s.AuthzWebhookDenyTTL, "authorization-webhook-cache-unauthorized-ttl", s.AuthzWebhookDenyTTL, "The duration to cache 'unauthorized' responses from the webhook authorizer.")
 	fs.StringVar(&s.AdmissionControl, "admission-control", s.AdmissionControl, "Ordered list of plug-ins to do admission control of resources into cluster. Comma-delimited list of: "+strings.Join(admission.GetPlugins(), ", "))
 	fs.StringVar(&s.AdmissionControlConfigFile, "admission-control-config-file", s.AdmissionControlConfigFile, "File with admission control configuration.")
+	fs.StringVar(&s.StorageBackend, "storage-backend", s.StorageBackend, "The storage backend for persistence. Options: 'etcd2' (default), 'memory'. The memory backend is transactional, supports compare-and-swap across keys and is lost when the apiserver exits; --etcd-prefix still applies to it.")
+	fs.StringSliceVar(&s.EncryptResources, "encrypt-resources", s.EncryptResources, "List of resources, comma separated, which are encrypted with --encryption-key-file before they are written to etcd, e.g. secrets.")
+	fs.StringVar(&s.EncryptionKeyFile, "encryption-key-file", s.EncryptionKeyFile, "File with AES keys, one <name>:<base64 encoded key> per line, used with --encrypt-resources. Objects are encrypted with the first key and decrypted with any of them.")
 	fs.StringSliceVar(&s.EtcdServerList, "etcd-servers", s.EtcdServerList, "List of etcd servers to watch (http://ip:port), comma separated. Mutually exclusive with -etcd-config")
 	fs.StringVar(&s.EtcdConfigFile, "etcd-config", s.EtcdConfigFile, "The config file for the etcd client. Mutually exclusive with -etcd-servers.")
 	fs.StringVar(&s.EtcdPathPrefix, "etcd-prefix", s.EtcdPathPrefix, "The prefix for all resource paths in etcd.")
@@ -227,12 +275,20 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	// TODO: enable cache in integration tests.
 	fs.BoolVar(&s.EnableWatchCache, "watch-cache", true, "Enable watch caching in the apiserver")
 	fs.StringVar(&s.ExternalHost, "external-hostname", "", "The hostname to use when generating externalized URLs for this master (e.g. Swagger API Docs.)")
-	fs.IntVar(&s.MaxRequestsInFlight, "max-requests-inflight", 400, "The maximum number of requests in flight at a given time.  When the server exceeds this, it rejects requests.  Zero for no limit.")
+	fs.IntVar(&s.MaxRequestsInFlight, "max-requests-inflight", 400, "The maximum number of requests in flight at a given time which match no bucket of --request-priority-config-file.  When the server exceeds this, it rejects requests.  Unless it is zero, requests being authenticated are also limited to the capacity of all buckets together.  Zero for no limit.")
+	fs.StringVar(&s.RequestPriorityConfigFile, "request-priority-config-file", s.RequestPriorityConfigFile, "File with priority buckets, one JSON object per line, which limit the requests in flight per user, group and request kind (read, mutating or watch) on the secure port.")
 	fs.IntVar(&s.MinRequestTimeout, "min-request-timeout", 1800, "An optional field indicating the minimum number of seconds a handler must keep a request open before timing it out. Currently only honored by the watch request handler, which picks a randomized value above this number as the connection timeout, to spread out load.")
-	fs.StringVar(&s.LongRunningRequestRE, "long-running-request-regexp", defaultLongRunningRequestRE, "A regular expression matching long running requests which should be excluded from maximum inflight request handling.")
+	fs.StringVar(&s.LongRunningRequestRE, "long-running-request-regexp", defaultLongRunningRequestRE, "A regular expression matching long running requests which should be excluded from maximum inflight request handling.  Watches may still be limited by --request-priority-config-file.")
 	fs.StringVar(&s.SSHUser, "ssh-user", "", "If non-empty, use secure SSH proxy to the nodes, using this user name")
 	fs.StringVar(&s.SSHKeyfile, "ssh-keyfile", "", "If non-empty, use secure SSH proxy to the nodes, using this user keyfile")
 	fs.Int64Var(&s.MaxConnectionBytesPerSec, "max-connection-bytes-per-sec", 0, "If non-zero, throttle each user connection to this number of bytes/sec.  Currently only applies to long-running requests")
+	fs.StringVar(&s.AuditLogPath, "audit-log-path", s.AuditLogPath, "If set, all requests coming to the apiserver will be recorded to this file as JSON audit events, one per line.")
+	fs.IntVar(&s.AuditLogMaxSize, "audit-log-maxsize", s.AuditLogMaxSize, "The maximum size in megabytes of the audit log file before it gets rotated. Zero disables rotation.")
+	fs.IntVar(&s.AuditLogMaxBackups, "audit-log-maxbackup", s.AuditLogMaxBackups, "The maximum number of rotated audit log files to retain.")
+	fs.Var(&s.APIDelegates, "api-delegates", "A set of <group>/<version>=<url> pairs. Requests for each API group version are proxied to the API server at the url, and the group is listed at /apis.")
+	fs.StringVar(&s.APIDelegateTLSConfig.CertFile, "api-delegate-client-certificate", s.APIDelegateTLSConfig.CertFile, "Path to a client cert file for TLS connections to the API servers of --api-delegates.")
+	fs.StringVar(&s.APIDelegateTLSConfig.KeyFile, "api-delegate-client-key", s.APIDelegateTLSConfig.KeyFile, "Path to a client key file for TLS connections to the API servers of --api-delegates.")
+	fs.StringVar(&s.APIDelegateTLSConfig.CAFile, "api-delegate-certificate-authority", s.APIDelegateTLSConfig.CAFile, "Path to a cert. file for the certificate authority of the API servers of --api-delegates.")
 	// Kubelet related flags:
 	fs.BoolVar(&s.KubeletConfig.EnableHttps, "kubelet-https", s.KubeletConfig.EnableHttps, "Use https for kubelet connections")
 	fs.UintVar(&s.KubeletConfig.Port, "kubelet-port", s.KubeletConfig.Port, "Kubelet port")
@@ -253,7 +309,7 @@ func (s *APIServer) verifyClusterIPFlags() {
 	}
 }
 
-func newEtcd(etcdConfigFile string, etcdServerList []string, interfacesFunc meta.VersionInterfacesFunc, storageVersion, pathPrefix string) (etcdStorage storage.Interface, err error) {
+func newEtcd(etcdConfigFile string, etcdServerList []string, interfacesFunc meta.VersionInterfacesFunc, storageVersion, pathPrefix string, transformer storage.ValueTransformer) (etcdStorage storage.Interface, err error) {
 	if storageVersion == "" {
 		return etcdStorage, fmt.Errorf("storageVersion is required to create a etcd storage")
 	}
@@ -275,10 +331,40 @@ func newEtcd(etcdConfigFile string, etcdServerList []string, interfacesFunc meta
 		etcdClient.SetTransport(transport)
 		client = etcdClient
 	}
-	etcdStorage, err = master.NewEtcdStorage(client, interfacesFunc, storageVersion, pathPrefix)
+	etcdStorage, err = master.NewTransformingEtcdStorage(client, interfacesFunc, storageVersion, pathPrefix, transformer)
 	return etcdStorage, err
 }
 
+// newStorage returns the storage for a single API group, kept in kvClient if it
+// is set or in etcd otherwise.
+func (s *APIServer) newStorage(kvClient kv.KV, interfacesFunc meta.VersionInterfacesFunc, storageVersion string) (storage.Interface, error) {
+	if kvClient == nil {
+		return newEtcd(s.EtcdConfigFile, s.EtcdServerList, interfacesFunc, storageVersion, s.EtcdPathPrefix, nil)
+	}
+	if storageVersion == "" {
+		return nil, fmt.Errorf("storageVersion is required to create a storage")
+	}
+	return master.NewKVStorage(kvClient, interfacesFunc, storageVersion, s.EtcdPathPrefix)
+}
+
+// groupForResource returns the API group which serves resource.
+func groupForResource(resource string, enableExp bool) (*latest.GroupMeta, error) {
+	groups := []string{""}
+	if enableExp {
+		groups = append(groups, "experimental")
+	}
+	for _, name := range groups {
+		group, err := latest.Group(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, err := group.RESTMapper.VersionAndKindForResource(resource); err == nil {
+			return group, nil
+		}
+	}
+	return nil, fmt.Errorf("no enabled API group serves resource %q", resource)
+}
+
 // convert to a map between group and groupVersions.
 func generateStorageVersionMap(legacyVersion string, storageVersions string) map[string]string {
 	storageVersionMap := map[string]string{}
@@ -306,8 +392,14 @@ func (s *APIServer) Run(_ []string) error {
 		s.AdvertiseAddress = s.BindAddress
 	}
 
-	if (s.EtcdConfigFile != "" && len(s.EtcdServerList) != 0) || (s.EtcdConfigFile == "" && len(s.EtcdServerList) == 0) {
-		glog.Fatalf("specify either --etcd-servers or --etcd-config")
+	switch s.StorageBackend {
+	case storageBackendEtcd2:
+		if (s.EtcdConfigFile != "" && len(s.EtcdServerList) != 0) || (s.EtcdConfigFile == "" && len(s.EtcdServerList) == 0) {
+			glog.Fatalf("specify either --etcd-servers or --etcd-config")
+		}
+	case storageBackendMemory:
+	default:
+		glog.Fatalf("unknown --storage-backend %q, must be one of %q or %q", s.StorageBackend, storageBackendEtcd2, storageBackendMemory)
 	}
 
 	capabilities.Initialize(capabilities.Capabilities{
@@ -355,6 +447,15 @@ func (s *APIServer) Run(_ []string) error {
 	// This takes preference over api/all, if specified.
 	enableExp := s.getRuntimeConfigValue("experimental/v1alpha1", false)
 
+	apiDelegateTLSConfig, err := client.TLSConfigFor(&client.Config{TLSClientConfig: s.APIDelegateTLSConfig})
+	if err != nil {
+		glog.Fatalf("Invalid TLS configuration for delegated apis: %v", err)
+	}
+	var apiDelegateTransport http.RoundTripper
+	if apiDelegateTLSConfig != nil {
+		apiDelegateTransport = &http.Transport{TLSClientConfig: apiDelegateTLSConfig}
+	}
+
 	clientConfig := &client.Config{
 		Host:    net.JoinHostPort(s.InsecureBindAddress.String(), strconv.Itoa(s.InsecurePort)),
 		Version: s.DeprecatedStorageVersion,
@@ -373,7 +474,12 @@ func (s *APIServer) Run(_ []string) error {
 	if _, found := storageVersions[legacyV1Group.Group]; !found {
 		glog.Fatalf("Couldn't find the storage version for group: %q in storageVersions: %v", legacyV1Group.Group, storageVersions)
 	}
-	etcdStorage, err := newEtcd(s.EtcdConfigFile, s.EtcdServerList, legacyV1Group.InterfacesFor, storageVersions[legacyV1Group.Group], s.EtcdPathPrefix)
+	// the legacy and experimental storages share a single keyspace
+	var kvClient kv.KV
+	if s.StorageBackend == storageBackendMemory {
+		kvClient = kv.NewMemory()
+	}
+	etcdStorage, err := s.newStorage(kvClient, legacyV1Group.InterfacesFor, storageVersions[legacyV1Group.Group])
 	if err != nil {
 		glog.Fatalf("Invalid storage version or misconfigured etcd: %v", err)
 	}
@@ -387,12 +493,37 @@ func (s *APIServer) Run(_ []string) error {
 		if _, found := storageVersions[expGroup.Group]; !found {
 			glog.Fatalf("Couldn't find the storage version for group: %q in storageVersions: %v", expGroup.Group, storageVersions)
 		}
-		expEtcdStorage, err = newEtcd(s.EtcdConfigFile, s.EtcdServerList, expGroup.InterfacesFor, storageVersions[expGroup.Group], s.EtcdPathPrefix)
+		expEtcdStorage, err = s.newStorage(kvClient, expGroup.InterfacesFor, storageVersions[expGroup.Group])
 		if err != nil {
 			glog.Fatalf("Invalid experimental storage version or misconfigured etcd: %v", err)
 		}
 	}
 
+	resourceStorage := map[string]storage.Interface{}
+	if len(s.EncryptResources) > 0 {
+		if kvClient != nil {
+			glog.Fatalf("--encrypt-resources requires the %q storage backend", storageBackendEtcd2)
+		}
+		keys, err := encryption.LoadKeys(s.EncryptionKeyFile)
+		if err != nil {
+			glog.Fatalf("Invalid encryption key file: %v", err)
+		}
+		transformer, err := encryption.NewAESGCMTransformer(keys)
+		if err != nil {
+			glog.Fatalf("Invalid encryption keys: %v", err)
+		}
+		for _, resource := range s.EncryptResources {
+			group, err := groupForResource(resource, enableExp)
+			if err != nil {
+				glog.Fatalf("Unable to encrypt %s: %v", resource, err)
+			}
+			resourceStorage[resource], err = newEtcd(s.EtcdConfigFile, s.EtcdServerList, group.InterfacesFor, storageVersions[group.Group], s.EtcdPathPrefix, transformer)
+			if err != nil {
+				glog.Fatalf("Invalid storage version or misconfigured etcd for %s: %v", resource, err)
+			}
+		}
+	}
+
 	n := s.ServiceClusterIPRange
 
 	// Default to the private server key for service account token signing
@@ -415,6 +546,8 @@ func (s *APIServer) Run(_ []string) error {
 		ServiceAccountLookup:  s.ServiceAccountLookup,
 		Storage:               etcdStorage,
 		KeystoneURL:           s.KeystoneURL,
+		WebhookConfigFile:     s.TokenWebhookConfigFile,
+		WebhookCacheTTL:       s.TokenWebhookCacheTTL,
 	})
 
 	if err != nil {
@@ -422,11 +555,55 @@ func (s *APIServer) Run(_ []string) error {
 	}
 
 	authorizationModeNames := strings.Split(s.AuthorizationMode, ",")
-	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(authorizationModeNames, s.AuthorizationPolicyFile)
+	authorizationConfig := apiserver.AuthorizationConfig{
+		PolicyFile:    s.AuthorizationPolicyFile,
+		RBACSuperUser: s.AuthorizationRBACSuperUser,
+
+		WebhookConfigFile:           s.AuthzWebhookConfigFile,
+		WebhookCacheAuthorizedTTL:   s.AuthzWebhookAllowTTL,
+		WebhookCacheUnauthorizedTTL: s.AuthzWebhookDenyTTL,
+	}
+	if expEtcdStorage != nil {
+		authorizationConfig.RBACRoleRegistry = role.NewRegistry(roleetcd.NewREST(expEtcdStorage))
+		authorizationConfig.RBACRoleBindingRegistry = rolebinding.NewRegistry(rolebindingetcd.NewREST(expEtcdStorage))
+		authorizationConfig.RBACClusterRoleRegistry = clusterrole.NewRegistry(clusterroleetcd.NewREST(expEtcdStorage))
+		authorizationConfig.RBACClusterRoleBindingRegistry = clusterrolebinding.NewRegistry(clusterrolebindingetcd.NewREST(expEtcdStorage))
+	}
+	authorizer, err := apiserver.NewAuthorizerFromAuthorizationConfig(authorizationModeNames, authorizationConfig)
 	if err != nil {
 		glog.Fatalf("Invalid Authorization Config: %v", err)
 	}
 
+	var auditBackend audit.Backend
+	if len(s.AuditLogPath) > 0 {
+		auditBackend, err = audit.NewFileBackend(s.AuditLogPath, int64(s.AuditLogMaxSize)*1024*1024, s.AuditLogMaxBackups)
+		if err != nil {
+			glog.Fatalf("Invalid Audit Config: %v", err)
+		}
+	}
+
+	apiDelegates := map[string]*url.URL{}
+	for groupVersion, location := range s.APIDelegates {
+		u, err := url.Parse(location)
+		if err != nil {
+			glog.Fatalf("Invalid location %q for delegated api %s: %v", location, groupVersion, err)
+		}
+		apiDelegates[groupVersion] = u
+	}
+
+	priorityBuckets := []apiserver.PriorityBucket{}
+	if len(s.RequestPriorityConfigFile) > 0 {
+		priorityBuckets, err = apiserver.LoadPriorityBuckets(s.RequestPriorityConfigFile)
+		if err != nil {
+			glog.Fatalf("Invalid request priority config file: %v", err)
+		}
+	}
+	longRunningRE := regexp.MustCompile(s.LongRunningRequestRE)
+	requestLimiter, err := apiserver.NewPriorityLimiter(priorityBuckets, s.MaxRequestsInFlight, longRunningRE)
+	if err != nil {
+		glog.Fatalf("Invalid request priority config: %v", err)
+	}
+
 	admissionControlPluginNames := strings.Split(s.AdmissionControl, ",")
 	admissionController := admission.NewFromPlugins(client, admissionControlPluginNames, s.AdmissionControlConfigFile)
 
@@ -462,6 +639,7 @@ func (s *APIServer) Run(_ []string) error {
 	config := &master.Config{
 		DatabaseStorage:    etcdStorage,
 		ExpDatabaseStorage: expEtcdStorage,
+		ResourceStorage:    resourceStorage,
 		StorageVersions:    storageVersions,
 
 		EventTTL:               s.EventTTL,
@@ -482,7 +660,12 @@ func (s *APIServer) Run(_ []string) error {
 		Authenticator:          authenticator,
 		SupportsBasicAuth:      len(s.BasicAuthFile) > 0,
 		Authorizer:             authorizer,
+		AuthorizationRBAC:      sets.NewString(authorizationModeNames...).Has(apiserver.ModeRBAC),
 		AdmissionControl:       admissionController,
+		AuditBackend:           auditBackend,
+		RequestLimiter:         requestLimiter,
+		APIDelegates:           apiDelegates,
+		APIDelegateTransport:   apiDelegateTransport,
 		DisableV1:              disableV1,
 		EnableExp:              enableExp,
 		MasterServiceNamespace: s.MasterServiceNamespace,
@@ -505,14 +688,8 @@ func (s *APIServer) Run(_ []string) error {
 
 	// See the flag commentary to understand our assumptions when opening the read-only and read-write ports.
 
-	var sem chan bool
-	if s.MaxRequestsInFlight > 0 {
-		sem = make(chan bool, s.MaxRequestsInFlight)
-	}
-
-	longRunningRE := regexp.MustCompile(s.LongRunningRequestRE)
 	longRunningTimeout := func(req *http.Request) (<-chan time.Time, string) {
-		// TODO unify this with apiserver.MaxInFlightLimit
+		// TODO unify this with apiserver.PriorityLimiter
 		if longRunningRE.MatchString(req.URL.Path) || req.URL.Query().Get("watch") == "true" {
 			return nil, ""
 		}
@@ -523,7 +700,7 @@ func (s *APIServer) Run(_ []string) error {
 		handler := apiserver.TimeoutHandler(m.Handler, longRunningTimeout)
 		secureServer := &http.Server{
 			Addr:           secureLocation,
-			Handler:        apiserver.MaxInFlightLimit(sem, longRunningRE, apiserver.RecoverPanics(handler)),
+			Handler:        apiserver.RecoverPanics(handler),
 			MaxHeaderBytes: 1 << 20,
 			TLSConfig: &tls.Config{
 				// Change default from SSLv3 to TLSv1.0 (because of POODLE vulnerability)
@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kube-reencrypt rewrites the objects of encrypted resources in etcd with the
+// current encryption key, for example after a key has been rotated or after a
+// resource has been added to the apiserver's --encrypt-resources.
+package main
+
+import (
+	"log"
+	"path"
+	"runtime"
+
+	"github.com/coreos/go-etcd/etcd"
+	"k8s.io/kubernetes/pkg/storage/encryption"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/tools"
+	"k8s.io/kubernetes/pkg/util"
+
+	flag "github.com/spf13/pflag"
+)
+
+var (
+	etcdServerList    = flag.StringSlice("etcd-servers", []string{}, "List of etcd servers (http://ip:port), comma separated. Mutually exclusive with --etcd-config")
+	etcdConfigFile    = flag.String("etcd-config", "", "The config file for the etcd client. Mutually exclusive with --etcd-servers.")
+	etcdPathPrefix    = flag.String("etcd-prefix", "/registry", "The prefix for all resource paths in etcd, as given to the apiserver.")
+	encryptResources  = flag.StringSlice("encrypt-resources", []string{"secrets"}, "List of resources, comma separated, to rewrite.")
+	encryptionKeyFile = flag.String("encryption-key-file", "", "File with AES keys, as given to the apiserver. Objects are rewritten with the first key.")
+)
+
+func main() {
+	runtime.GOMAXPROCS(runtime.NumCPU())
+	flag.CommandLine.SetNormalizeFunc(util.WordSepNormalizeFunc)
+	flag.Parse()
+
+	if (*etcdConfigFile != "") == (len(*etcdServerList) != 0) {
+		log.Fatalf("Specify exactly one of --etcd-servers or --etcd-config")
+	}
+	keys, err := encryption.LoadKeys(*encryptionKeyFile)
+	if err != nil {
+		log.Fatalf("Couldn't load encryption keys: %v", err)
+	}
+	transformer, err := encryption.NewAESGCMTransformer(keys)
+	if err != nil {
+		log.Fatalf("Invalid encryption keys: %v", err)
+	}
+
+	var client tools.EtcdClient
+	if *etcdConfigFile != "" {
+		client, err = etcd.NewClientFromFile(*etcdConfigFile)
+		if err != nil {
+			log.Fatalf("Couldn't create etcd client: %v", err)
+		}
+	} else {
+		client = etcd.NewClient(*etcdServerList)
+	}
+
+	for _, resource := range *encryptResources {
+		// Objects of a resource are stored below a directory named after it.
+		rewritten, err := etcdstorage.RewriteStaleValues(client, path.Join("/", *etcdPathPrefix, resource), transformer)
+		if err != nil {
+			log.Fatalf("Failed to rewrite %s after %d objects: %v", resource, rewritten, err)
+		}
+		log.Printf("Rewrote %d %s", rewritten, resource)
+	}
+}
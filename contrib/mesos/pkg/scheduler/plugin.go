@@ -302,6 +302,12 @@ func (k *kubeScheduler) Schedule(pod *api.Pod, unused algorithm.NodeLister) (str
 	}
 }
 
+// Preempt implements the Scheduler interface of Kubernetes. Pods are never
+// preempted to make room for others on Mesos slaves.
+func (k *kubeScheduler) Preempt(pod *api.Pod, unused algorithm.NodeLister, scheduleErr error) (string, []*api.Pod, error) {
+	return "", nil, nil
+}
+
 // Call ScheduleFunc and subtract some resources, returning the name of the machine the task is scheduled on
 func (k *kubeScheduler) doSchedule(task *podtask.T, err error) (string, error) {
 	var offer offers.Perishable
@@ -227,7 +227,8 @@ func TestExampleObjectSchemas(t *testing.T) {
 			"daemon": &experimental.DaemonSet{},
 		},
 		"../examples": {
-			"scheduler-policy-config": &schedulerapi.Policy{},
+			"scheduler-policy-config":               &schedulerapi.Policy{},
+			"scheduler-policy-config-with-extender": &schedulerapi.Policy{},
 		},
 		"../examples/rbd/secret": {
 			"ceph-secret": &api.Secret{},
@@ -386,7 +387,7 @@ func TestExampleObjectSchemas(t *testing.T) {
 				t.Logf("skipping : %s/%s\n", path, name)
 				return
 			}
-			if name == "scheduler-policy-config" {
+			if name == "scheduler-policy-config" || name == "scheduler-policy-config-with-extender" {
 				if err := schedulerapilatest.Codec.DecodeInto(data, expectedType); err != nil {
 					t.Errorf("%s did not decode correctly: %v\n%s", path, err, string(data))
 					return
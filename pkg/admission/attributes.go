@@ -76,3 +76,21 @@ func (record *attributesRecord) GetObject() runtime.Object {
 func (record *attributesRecord) GetUserInfo() user.Info {
 	return record.userInfo
 }
+
+func (record *attributesRecord) IsDryRun() bool {
+	return false
+}
+
+// dryRunAttributes marks the request described by the embedded Attributes as a dry run.
+type dryRunAttributes struct {
+	Attributes
+}
+
+// WithDryRun returns attributes describing the same request as a, marked as a dry run.
+func WithDryRun(a Attributes) Attributes {
+	return dryRunAttributes{a}
+}
+
+func (dryRunAttributes) IsDryRun() bool {
+	return true
+}
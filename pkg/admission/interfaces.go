@@ -44,6 +44,9 @@ type Attributes interface {
 	GetKind() string
 	// GetUserInfo is information about the requesting user
 	GetUserInfo() user.Info
+	// IsDryRun is true if the request will not be persisted. Admission controllers
+	// must not have side effects for a dry run request.
+	IsDryRun() bool
 }
 
 // Interface is an abstract, pluggable interface for Admission Control decisions.
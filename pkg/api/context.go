@@ -37,6 +37,9 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// dryRunKey is the context key marking a request that must not be persisted.
+const dryRunKey key = 2
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -101,3 +104,15 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// WithDryRun returns a copy of parent marking the request as a dry run. Storage
+// honoring a dry run performs all checks of a request but does not persist it.
+func WithDryRun(parent Context) Context {
+	return WithValue(parent, dryRunKey, true)
+}
+
+// IsDryRun returns true if the ctx marks the request as a dry run
+func IsDryRun(ctx Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey).(bool)
+	return dryRun
+}
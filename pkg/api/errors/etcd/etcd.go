@@ -17,7 +17,10 @@ limitations under the License.
 package etcd
 
 import (
+	"fmt"
+
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/storage"
 	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
 )
 
@@ -25,18 +28,29 @@ import (
 // operation into the appropriate API error.
 func InterpretGetError(err error, kind, name string) error {
 	switch {
-	case etcdstorage.IsEtcdNotFound(err):
+	case etcdstorage.IsEtcdNotFound(err), storage.IsNotFound(err):
 		return errors.NewNotFound(kind, name)
 	default:
 		return err
 	}
 }
 
+// InterpretListError converts a generic etcd error on a list
+// operation into the appropriate API error.
+func InterpretListError(err error, kind string) error {
+	switch {
+	case storage.IsInvalidContinue(err):
+		return errors.NewBadRequest(fmt.Sprintf("the continue token for the list of %s is not valid", kind))
+	default:
+		return err
+	}
+}
+
 // InterpretCreateError converts a generic etcd error on a create
 // operation into the appropriate API error.
 func InterpretCreateError(err error, kind, name string) error {
 	switch {
-	case etcdstorage.IsEtcdNodeExist(err):
+	case etcdstorage.IsEtcdNodeExist(err), storage.IsNodeExist(err):
 		return errors.NewAlreadyExists(kind, name)
 	default:
 		return err
@@ -47,7 +61,7 @@ func InterpretCreateError(err error, kind, name string) error {
 // operation into the appropriate API error.
 func InterpretUpdateError(err error, kind, name string) error {
 	switch {
-	case etcdstorage.IsEtcdTestFailed(err), etcdstorage.IsEtcdNodeExist(err):
+	case etcdstorage.IsEtcdTestFailed(err), etcdstorage.IsEtcdNodeExist(err), storage.IsTestFailed(err), storage.IsNodeExist(err):
 		return errors.NewConflict(kind, name, err)
 	default:
 		return err
@@ -58,7 +72,7 @@ func InterpretUpdateError(err error, kind, name string) error {
 // operation into the appropriate API error.
 func InterpretDeleteError(err error, kind, name string) error {
 	switch {
-	case etcdstorage.IsEtcdNotFound(err):
+	case etcdstorage.IsEtcdNotFound(err), storage.IsNotFound(err):
 		return errors.NewNotFound(kind, name)
 	default:
 		return err
@@ -20,6 +20,7 @@ import (
 	"crypto/md5"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -248,3 +249,113 @@ func ParseRFC3339(s string, nowFn func() unversioned.Time) (unversioned.Time, er
 	}
 	return unversioned.Time{t}, nil
 }
+
+// NodeSelectorRequirementsAsSelector converts the requirements of a node selector term
+// to a label selector, which the labels of a node match if they satisfy every requirement.
+func NodeSelectorRequirementsAsSelector(nsm []NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.LabelSelector{}
+	for _, expr := range nsm {
+		var op labels.Operator
+		switch expr.Operator {
+		case NodeSelectorOpIn:
+			op = labels.InOperator
+		case NodeSelectorOpNotIn:
+			op = labels.NotInOperator
+		case NodeSelectorOpExists:
+			op = labels.ExistsOperator
+		case NodeSelectorOpDoesNotExist:
+			op = labels.DoesNotExistOperator
+		case NodeSelectorOpGt:
+			op = labels.GreaterThanOperator
+		case NodeSelectorOpLt:
+			op = labels.LessThanOperator
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", expr.Operator)
+		}
+		r, err := labels.NewRequirement(expr.Key, op, sets.NewString(expr.Values...))
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, *r)
+	}
+	return selector, nil
+}
+
+// LabelSelectorAsSelector converts a label selector to a labels.Selector. A nil selector
+// matches no labels, and an empty one matches every set of labels.
+func LabelSelectorAsSelector(ps *LabelSelector) (labels.Selector, error) {
+	if ps == nil {
+		return labels.Nothing(), nil
+	}
+	if len(ps.MatchLabels)+len(ps.MatchExpressions) == 0 {
+		return labels.Everything(), nil
+	}
+	selector := labels.LabelSelector{}
+	for k, v := range ps.MatchLabels {
+		r, err := labels.NewRequirement(k, labels.InOperator, sets.NewString(v))
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, *r)
+	}
+	for _, expr := range ps.MatchExpressions {
+		var op labels.Operator
+		switch expr.Operator {
+		case LabelSelectorOpIn:
+			op = labels.InOperator
+		case LabelSelectorOpNotIn:
+			op = labels.NotInOperator
+		case LabelSelectorOpExists:
+			op = labels.ExistsOperator
+		case LabelSelectorOpDoesNotExist:
+			op = labels.DoesNotExistOperator
+		default:
+			return nil, fmt.Errorf("%q is not a valid label selector operator", expr.Operator)
+		}
+		r, err := labels.NewRequirement(expr.Key, op, sets.NewString(expr.Values...))
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, *r)
+	}
+	sort.Sort(labels.ByKey(selector))
+	return selector, nil
+}
+
+// ToleratesTaint checks whether the toleration matches the taint. A toleration with an empty
+// key and the Exists operator matches every taint, and one with an empty effect matches
+// taints with any effect.
+func (t *Toleration) ToleratesTaint(taint *Taint) bool {
+	if len(t.Effect) > 0 && t.Effect != taint.Effect {
+		return false
+	}
+	if len(t.Key) > 0 && t.Key != taint.Key {
+		return false
+	}
+	switch t.Operator {
+	case TolerationOpExists:
+		return true
+	case "", TolerationOpEqual:
+		return len(t.Key) > 0 && t.Value == taint.Value
+	}
+	return false
+}
+
+// TaintToleratedByTolerations checks whether any of the tolerations matches the taint.
+func TaintToleratedByTolerations(taint *Taint, tolerations []Toleration) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPodPriority returns the priority of the pod, or DefaultPriorityWhenNoDefaultClassExists
+// if it was never resolved.
+func GetPodPriority(pod *Pod) int {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	return DefaultPriorityWhenNoDefaultClassExists
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/labels"
 
 	"speter.net/go/exp/math/dec/inf"
 )
@@ -175,3 +176,133 @@ func TestRemoveDuplicateAccessModes(t *testing.T) {
 		t.Errorf("Expected 2 distinct modes in set but found %v", len(modes))
 	}
 }
+
+func TestNodeSelectorRequirementsAsSelector(t *testing.T) {
+	matchExpressions := []NodeSelectorRequirement{
+		{Key: "disktype", Operator: NodeSelectorOpIn, Values: []string{"ssd", "nvme"}},
+		{Key: "zone", Operator: NodeSelectorOpNotIn, Values: []string{"us-east1-a"}},
+		{Key: "gpu", Operator: NodeSelectorOpExists},
+		{Key: "retiring", Operator: NodeSelectorOpDoesNotExist},
+		{Key: "cores", Operator: NodeSelectorOpGt, Values: []string{"8"}},
+		{Key: "generation", Operator: NodeSelectorOpLt, Values: []string{"4"}},
+	}
+	selector, err := NodeSelectorRequirementsAsSelector(matchExpressions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		nodeLabels map[string]string
+		matches    bool
+	}{
+		{map[string]string{"disktype": "ssd", "zone": "us-east1-b", "gpu": "", "cores": "16", "generation": "3"}, true},
+		{map[string]string{"disktype": "nvme", "gpu": "k80", "cores": "9", "generation": "1"}, true},
+		{map[string]string{"disktype": "hdd", "gpu": "", "cores": "16", "generation": "3"}, false},
+		{map[string]string{"disktype": "ssd", "zone": "us-east1-a", "gpu": "", "cores": "16", "generation": "3"}, false},
+		{map[string]string{"disktype": "ssd", "cores": "16", "generation": "3"}, false},
+		{map[string]string{"disktype": "ssd", "gpu": "", "retiring": "true", "cores": "16", "generation": "3"}, false},
+		{map[string]string{"disktype": "ssd", "gpu": "", "cores": "8", "generation": "3"}, false},
+		{map[string]string{"disktype": "ssd", "gpu": "", "cores": "16", "generation": "4"}, false},
+		{map[string]string{"disktype": "ssd", "gpu": "", "cores": "many", "generation": "3"}, false},
+	}
+	for i, test := range tests {
+		if matches := selector.Matches(labels.Set(test.nodeLabels)); matches != test.matches {
+			t.Errorf("%d: expected %v for %v, got %v", i, test.matches, test.nodeLabels, matches)
+		}
+	}
+
+	if _, err := NodeSelectorRequirementsAsSelector([]NodeSelectorRequirement{{Key: "a", Operator: "Equals", Values: []string{"b"}}}); err == nil {
+		t.Errorf("expected an error for an unknown operator")
+	}
+	if _, err := NodeSelectorRequirementsAsSelector([]NodeSelectorRequirement{{Key: "a", Operator: NodeSelectorOpGt, Values: []string{"b"}}}); err == nil {
+		t.Errorf("expected an error for a non-integer Gt value")
+	}
+}
+
+func TestLabelSelectorAsSelector(t *testing.T) {
+	tests := []struct {
+		selector *LabelSelector
+		labels   map[string]string
+		matches  bool
+	}{
+		{nil, map[string]string{"app": "web"}, false},
+		{&LabelSelector{}, map[string]string{"app": "web"}, true},
+		{&LabelSelector{MatchLabels: map[string]string{"app": "web"}}, map[string]string{"app": "web", "tier": "frontend"}, true},
+		{&LabelSelector{MatchLabels: map[string]string{"app": "web"}}, map[string]string{"app": "db"}, false},
+		{
+			&LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+				MatchExpressions: []LabelSelectorRequirement{
+					{Key: "tier", Operator: LabelSelectorOpIn, Values: []string{"frontend", "backend"}},
+					{Key: "canary", Operator: LabelSelectorOpDoesNotExist},
+				},
+			},
+			map[string]string{"app": "web", "tier": "backend"},
+			true,
+		},
+		{
+			&LabelSelector{
+				MatchExpressions: []LabelSelectorRequirement{
+					{Key: "tier", Operator: LabelSelectorOpNotIn, Values: []string{"frontend"}},
+					{Key: "app", Operator: LabelSelectorOpExists},
+				},
+			},
+			map[string]string{"app": "web", "tier": "frontend"},
+			false,
+		},
+	}
+	for i, test := range tests {
+		selector, err := LabelSelectorAsSelector(test.selector)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if matches := selector.Matches(labels.Set(test.labels)); matches != test.matches {
+			t.Errorf("%d: expected %v for %v, got %v", i, test.matches, test.labels, matches)
+		}
+	}
+
+	if _, err := LabelSelectorAsSelector(&LabelSelector{MatchExpressions: []LabelSelectorRequirement{{Key: "a", Operator: "Gt", Values: []string{"1"}}}}); err == nil {
+		t.Errorf("expected an error for an unknown operator")
+	}
+}
+
+func TestToleratesTaint(t *testing.T) {
+	taint := Taint{Key: "dedicated", Value: "team-a", Effect: TaintEffectNoSchedule}
+	tests := []struct {
+		toleration Toleration
+		tolerates  bool
+	}{
+		{Toleration{Key: "dedicated", Value: "team-a", Effect: TaintEffectNoSchedule}, true},
+		{Toleration{Key: "dedicated", Operator: TolerationOpEqual, Value: "team-a"}, true},
+		{Toleration{Key: "dedicated", Operator: TolerationOpExists}, true},
+		{Toleration{Operator: TolerationOpExists}, true},
+		{Toleration{Key: "dedicated", Value: "team-b", Effect: TaintEffectNoSchedule}, false},
+		{Toleration{Key: "dedicated", Value: "team-a", Effect: TaintEffectPreferNoSchedule}, false},
+		{Toleration{Key: "gpu", Operator: TolerationOpExists}, false},
+		{Toleration{Value: "team-a"}, false},
+		{Toleration{Key: "dedicated", Operator: "In", Value: "team-a"}, false},
+	}
+	for i, test := range tests {
+		if tolerates := test.toleration.ToleratesTaint(&taint); tolerates != test.tolerates {
+			t.Errorf("%d: expected %v for %+v, got %v", i, test.tolerates, test.toleration, tolerates)
+		}
+	}
+
+	tolerations := []Toleration{{Key: "gpu", Operator: TolerationOpExists}, {Key: "dedicated", Value: "team-a"}}
+	if !TaintToleratedByTolerations(&taint, tolerations) {
+		t.Errorf("expected %+v to be tolerated by %+v", taint, tolerations)
+	}
+	if TaintToleratedByTolerations(&taint, tolerations[:1]) {
+		t.Errorf("expected %+v not to be tolerated by %+v", taint, tolerations[:1])
+	}
+}
+
+func TestGetPodPriority(t *testing.T) {
+	priority := 100
+	if p := GetPodPriority(&Pod{}); p != DefaultPriorityWhenNoDefaultClassExists {
+		t.Errorf("expected the default priority for a pod without a priority, got %d", p)
+	}
+	if p := GetPodPriority(&Pod{Spec: PodSpec{Priority: &priority}}); p != priority {
+		t.Errorf("expected %d, got %d", priority, p)
+	}
+}
@@ -53,6 +53,10 @@ func init() {
 		&ServiceAccountList{},
 		&Secret{},
 		&SecretList{},
+		&ConfigMap{},
+		&ConfigMapList{},
+		&PriorityClass{},
+		&PriorityClassList{},
 		&PersistentVolume{},
 		&PersistentVolumeList{},
 		&PersistentVolumeClaim{},
@@ -100,6 +104,10 @@ func (*ServiceAccount) IsAnAPIObject()            {}
 func (*ServiceAccountList) IsAnAPIObject()        {}
 func (*Secret) IsAnAPIObject()                    {}
 func (*SecretList) IsAnAPIObject()                {}
+func (*ConfigMap) IsAnAPIObject()                 {}
+func (*ConfigMapList) IsAnAPIObject()             {}
+func (*PriorityClass) IsAnAPIObject()             {}
+func (*PriorityClassList) IsAnAPIObject()         {}
 func (*PersistentVolume) IsAnAPIObject()          {}
 func (*PersistentVolumeList) IsAnAPIObject()      {}
 func (*PersistentVolumeClaim) IsAnAPIObject()     {}
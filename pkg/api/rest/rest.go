@@ -65,6 +65,23 @@ type Lister interface {
 	List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error)
 }
 
+// PagedLister is an object that can return the resources of a list a page at a time.
+type PagedLister interface {
+	// ListPage selects at most options.Limit resources in the storage which match options'
+	// selectors, resuming after the previous page identified by options.Continue. The
+	// continue token of the returned list is set if more resources may remain. A
+	// list which sets options.ResourceVersion may instead be served from a cache at
+	// least as recent as that version, in a single page.
+	ListPage(ctx api.Context, options *api.ListOptions) (runtime.Object, error)
+}
+
+// DryRunner is an object whose create, update and delete operations honor api.IsDryRun,
+// performing every check of the operation without persisting its result.
+type DryRunner interface {
+	// SupportsDryRun returns true if dry run requests can be served.
+	SupportsDryRun() bool
+}
+
 // Getter is an object that can retrieve a named RESTful resource.
 type Getter interface {
 	// Get finds a resource in the storage by name and returns it.
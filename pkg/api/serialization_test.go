@@ -29,6 +29,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/testapi"
 	apitesting "k8s.io/kubernetes/pkg/api/testing"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 
@@ -158,6 +159,27 @@ func doRoundTripTest(kind string, t *testing.T) {
 	}
 }
 
+func TestProtobufRoundTripTypes(t *testing.T) {
+	version := testapi.Default.Version()
+	codec := protobuf.NewCodec(api.Scheme, version)
+	externalTypes := api.Scheme.KnownTypes(version)
+	for kind := range api.Scheme.KnownTypes("") {
+		if _, ok := externalTypes[kind]; !ok || nonRoundTrippableTypes.Has(kind) {
+			continue
+		}
+		if sets.NewString(nonRoundTrippableTypesByVersion[kind]...).Has(version) {
+			continue
+		}
+		for i := 0; i < *fuzzIters; i++ {
+			item, err := api.Scheme.New("", kind)
+			if err != nil {
+				t.Fatalf("Couldn't make a %v? %v", kind, err)
+			}
+			roundTrip(t, codec, fuzzInternalObject(t, version, item, rand.Int63()))
+		}
+	}
+}
+
 func TestEncode_Ptr(t *testing.T) {
 	grace := int64(30)
 	pod := &api.Pod{
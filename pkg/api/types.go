@@ -138,8 +138,38 @@ type ObjectMeta struct {
 	// objects.  Annotation keys have the same formatting restrictions as Label keys. See the
 	// comments on Labels for details.
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// OwnerReferences is the list of objects this object depends on. Once every owner
+	// listed here has been deleted, the object is deleted by the garbage collector.
+	// Owners must be in the same namespace as the object.
+	OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+
+	// Finalizers must be empty before the object is removed from storage. Deleting an
+	// object with finalizers only sets its DeletionTimestamp; each controller responsible
+	// for a finalizer removes it from the list once its cleanup is done, and the update
+	// which removes the last one deletes the object.
+	Finalizers []string `json:"finalizers,omitempty"`
 }
 
+// OwnerReference contains enough information to identify an owning object.
+// The owner must be in the same namespace as the dependent, so there is no
+// namespace field.
+type OwnerReference struct {
+	// API version of the owner.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the owner.
+	Kind string `json:"kind"`
+	// Name of the owner.
+	Name string `json:"name"`
+	// UID of the owner.
+	UID types.UID `json:"uid"`
+}
+
+// OrphanDependentsAnnotation is set to "true" on an owner which is deleted
+// without its dependents. Once the owner is gone, the garbage collector removes
+// it from the ownerReferences of its dependents instead of deleting them.
+const OrphanDependentsAnnotation = "kubernetes.io/orphan-dependents"
+
 const (
 	// NamespaceDefault means the object is in the default namespace which is applied when not specified by clients
 	NamespaceDefault string = "default"
@@ -208,6 +238,8 @@ type VolumeSource struct {
 	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI,omitempty"`
 	// FC represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.
 	FC *FCVolumeSource `json:"fc,omitempty"`
+	// ConfigMap represents a configMap that should populate this volume
+	ConfigMap *ConfigMapVolumeSource `json:"configMap,omitempty"`
 }
 
 // Similar to VolumeSource but meant for the administrator who creates PVs.
@@ -512,6 +544,14 @@ type SecretVolumeSource struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// volume as files using the keys in the Data field as the file names.
+type ConfigMapVolumeSource struct {
+	LocalObjectReference `json:",inline"`
+}
+
 // NFSVolumeSource represents an NFS Mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server
@@ -606,6 +646,14 @@ type DownwardAPIVolumeFile struct {
 	FieldRef ObjectFieldSelector `json:"fieldRef"`
 }
 
+// ConfigMapKeySelector selects a key from a ConfigMap.
+type ConfigMapKeySelector struct {
+	// The ConfigMap to select from.
+	LocalObjectReference `json:",inline"`
+	// The key to select.
+	Key string `json:"key"`
+}
+
 // ContainerPort represents a network port in a single container
 type ContainerPort struct {
 	// Optional: If specified, this must be an IANA_SVC_NAME  Each named port
@@ -651,8 +699,10 @@ type EnvVar struct {
 
 // EnvVarSource represents a source for the value of an EnvVar.
 type EnvVarSource struct {
-	// Required: Selects a field of the pod; only name and namespace are supported.
-	FieldRef *ObjectFieldSelector `json:"fieldRef"`
+	// Selects a field of the pod; only name and namespace are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+	// Selects a key of a ConfigMap.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
 }
 
 // ObjectFieldSelector selects an APIVersioned field of an object.
@@ -946,6 +996,162 @@ const (
 	DNSDefault DNSPolicy = "Default"
 )
 
+// NodeSelectorOperator is the relationship of a node label to the values of a NodeSelectorRequirement.
+type NodeSelectorOperator string
+
+const (
+	NodeSelectorOpIn           NodeSelectorOperator = "In"
+	NodeSelectorOpNotIn        NodeSelectorOperator = "NotIn"
+	NodeSelectorOpExists       NodeSelectorOperator = "Exists"
+	NodeSelectorOpDoesNotExist NodeSelectorOperator = "DoesNotExist"
+	NodeSelectorOpGt           NodeSelectorOperator = "Gt"
+	NodeSelectorOpLt           NodeSelectorOperator = "Lt"
+)
+
+// NodeSelectorRequirement is a requirement on the value of a node label.
+type NodeSelectorRequirement struct {
+	// The label key that the requirement applies to.
+	Key string `json:"key"`
+	// Represents the key's relationship to the values: In, NotIn, Exists, DoesNotExist, Gt or Lt.
+	Operator NodeSelectorOperator `json:"operator"`
+	// The values of the requirement. Must be non-empty for In and NotIn, empty for Exists and
+	// DoesNotExist, and hold a single integer for Gt and Lt.
+	Values []string `json:"values,omitempty"`
+}
+
+// NodeSelectorTerm is a set of requirements which a node's labels must all satisfy.
+type NodeSelectorTerm struct {
+	// Required. The requirements of the term.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions"`
+}
+
+// NodeSelector selects the nodes whose labels satisfy any of its terms.
+type NodeSelector struct {
+	// Required. The terms of the selector.
+	NodeSelectorTerms []NodeSelectorTerm `json:"nodeSelectorTerms"`
+}
+
+// PreferredSchedulingTerm is a node selector term which the scheduler prefers nodes to satisfy.
+type PreferredSchedulingTerm struct {
+	// The weight of the term, in the range 1-100.
+	Weight int `json:"weight"`
+	// The node selector term which preferred nodes satisfy.
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// NodeAffinity describes the nodes a pod may be and prefers to be scheduled onto.
+type NodeAffinity struct {
+	// The pod is only scheduled onto nodes matching this selector. Pods already running on a
+	// node which stops matching it are not evicted.
+	RequiredDuringSchedulingIgnoredDuringExecution *NodeSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes which match these terms, favoring the nodes for which the sum
+	// of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []PreferredSchedulingTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// LabelSelectorOperator is the relationship of a label to the values of a LabelSelectorRequirement.
+type LabelSelectorOperator string
+
+const (
+	LabelSelectorOpIn           LabelSelectorOperator = "In"
+	LabelSelectorOpNotIn        LabelSelectorOperator = "NotIn"
+	LabelSelectorOpExists       LabelSelectorOperator = "Exists"
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
+)
+
+// LabelSelectorRequirement is a requirement on the value of a label.
+type LabelSelectorRequirement struct {
+	// The label key that the requirement applies to.
+	Key string `json:"key"`
+	// Represents the key's relationship to the values: In, NotIn, Exists or DoesNotExist.
+	Operator LabelSelectorOperator `json:"operator"`
+	// The values of the requirement. Must be non-empty for In and NotIn, and empty for Exists
+	// and DoesNotExist.
+	Values []string `json:"values,omitempty"`
+}
+
+// LabelSelector selects the objects whose labels satisfy both MatchLabels and MatchExpressions.
+// An empty selector selects every object.
+type LabelSelector struct {
+	// The labels which objects must have, with the same values.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	// The requirements which the labels of objects must all satisfy.
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// PodAffinityTerm selects the pods which a pod should be, or should not be, co-located with.
+// Two pods are co-located if they run on nodes with the same value of the TopologyKey label.
+type PodAffinityTerm struct {
+	// A label query over pods.
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+	// The namespaces of the pods selected by LabelSelector. If empty, the namespace of the pod
+	// the term belongs to.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// The node label whose value defines the topology domain pods are co-located in, for example
+	// kubernetes.io/hostname for the same node, or a zone label for the same zone.
+	TopologyKey string `json:"topologyKey"`
+}
+
+// WeightedPodAffinityTerm is a pod affinity term which the scheduler prefers nodes to satisfy.
+type WeightedPodAffinityTerm struct {
+	// The weight of the term, in the range 1-100.
+	Weight int `json:"weight"`
+	// The pod affinity term which preferred nodes satisfy.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm"`
+}
+
+// PodAffinity describes the pods a pod must be or prefers to be co-located with.
+type PodAffinity struct {
+	// The pod is only scheduled onto nodes co-located with pods matching every one of these
+	// terms. Running pods are not evicted if the terms stop being satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes co-located with pods matching these terms, favoring the nodes
+	// for which the sum of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PodAntiAffinity describes the pods a pod must not be or prefers not to be co-located with.
+type PodAntiAffinity struct {
+	// The pod is only scheduled onto nodes not co-located with any pod matching any of these
+	// terms. Running pods are not evicted if the terms stop being satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes not co-located with pods matching these terms, avoiding the
+	// nodes for which the sum of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// Affinity holds the scheduling constraints of a pod.
+type Affinity struct {
+	// Describes the nodes the pod may be and prefers to be scheduled onto.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty"`
+	// Describes the pods the pod must be or prefers to be co-located with.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
+	// Describes the pods the pod must not be or prefers not to be co-located with.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+}
+
+// TolerationOperator is the relationship between the key and value of a toleration and of a taint.
+type TolerationOperator string
+
+const (
+	TolerationOpExists TolerationOperator = "Exists"
+	TolerationOpEqual  TolerationOperator = "Equal"
+)
+
+// Toleration allows a pod to be scheduled onto the nodes with the taints it matches.
+type Toleration struct {
+	// The taint key that the toleration applies to.
+	Key string `json:"key,omitempty"`
+	// Represents the key's relationship to the value: Exists or Equal. Defaults to Equal.
+	// Exists matches every taint with the key, whatever its value.
+	Operator TolerationOperator `json:"operator,omitempty"`
+	// The taint value the toleration matches. Must be empty if the operator is Exists.
+	Value string `json:"value,omitempty"`
+	// The taint effect the toleration matches: NoSchedule or PreferNoSchedule. If empty, the
+	// toleration matches taints with any effect.
+	Effect TaintEffect `json:"effect,omitempty"`
+}
+
 // PodSpec is a description of a pod
 type PodSpec struct {
 	Volumes []Volume `json:"volumes"`
@@ -966,6 +1172,16 @@ type PodSpec struct {
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Affinity holds the scheduling constraints of the pod, beyond those of NodeSelector.
+	Affinity *Affinity `json:"affinity,omitempty"`
+	// The tolerations of the pod, which allow it to be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+	// PriorityClassName is the name of the PriorityClass the priority of the pod is resolved from.
+	// If empty, the priority of the global default PriorityClass is used, or zero if there is none.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Priority is the priority of the pod, resolved from PriorityClassName when the pod is created.
+	// Pods with a higher priority are scheduled first, and may preempt pods with a lower priority.
+	Priority *int `json:"priority,omitempty"`
 
 	// ServiceAccountName is the name of the ServiceAccount to use to run this pod
 	// The pod will be allowed to use secrets referenced by the ServiceAccount
@@ -1008,6 +1224,10 @@ type PodStatus struct {
 	// This is before the Kubelet pulled the container image(s) for the pod.
 	StartTime *unversioned.Time `json:"startTime,omitempty"`
 
+	// NominatedNodeName is set when the scheduler preempted pods on a node to make room
+	// for this pod, which is expected to be scheduled onto that node once they are gone.
+	NominatedNodeName string `json:"nominatedNodeName,omitempty"`
+
 	// The list has one entry per container in the manifest. Each entry is
 	// currently the output of `docker inspect`. This output format is *not*
 	// final and should not be relied upon.
@@ -1366,6 +1586,29 @@ type NodeSpec struct {
 
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
 	Unschedulable bool `json:"unschedulable,omitempty"`
+
+	// The taints of the node, which repel the pods that do not tolerate them.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// TaintEffect is the effect a taint has on the pods that do not tolerate it.
+type TaintEffect string
+
+const (
+	// Pods that do not tolerate the taint are not scheduled onto the node.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// The scheduler tries not to schedule pods that do not tolerate the taint onto the node.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+)
+
+// Taint marks a node so that the pods which do not tolerate it are kept off the node.
+type Taint struct {
+	// The taint key.
+	Key string `json:"key"`
+	// The taint value.
+	Value string `json:"value,omitempty"`
+	// The effect of the taint on the pods that do not tolerate it: NoSchedule or PreferNoSchedule.
+	Effect TaintEffect `json:"effect"`
 }
 
 // DaemonEndpoint contains information about a single Daemon endpoint.
@@ -1588,8 +1831,14 @@ type ListOptions struct {
 	FieldSelector fields.Selector
 	// If true, watch for changes to this list
 	Watch bool
-	// The resource version to watch (no effect on list yet)
+	// The resource version to watch, or the resource version a list must be at least as recent as
 	ResourceVersion string
+	// The maximum number of items to return from a list, or zero to return every item
+	Limit int64
+	// The continue token of the previous page of a limited list
+	Continue string
+	// If true, a watch may send bookmark events carrying the latest resource version
+	AllowWatchBookmarks bool
 }
 
 // PodLogOptions is the query options for a Pod's logs REST call
@@ -1932,6 +2181,60 @@ type SecretList struct {
 	Items []Secret `json:"items"`
 }
 
+// ConfigMap holds configuration data for components or applications to consume.
+type ConfigMap struct {
+	unversioned.TypeMeta `json:",inline"`
+	ObjectMeta           `json:"metadata,omitempty"`
+
+	// Data contains the configuration data. Each key must be a valid
+	// DNS_SUBDOMAIN or leading dot followed by valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// MaxConfigMapSize is the maximum total size, in bytes, of the values in a ConfigMap.
+const MaxConfigMapSize = 1 * 1024 * 1024
+
+// ConfigMapList is a resource containing a list of ConfigMap objects.
+type ConfigMapList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ConfigMaps.
+	Items []ConfigMap `json:"items"`
+}
+
+const (
+	// HighestUserDefinablePriority is the highest priority a PriorityClass may have.
+	HighestUserDefinablePriority = 1000000000
+	// DefaultPriorityWhenNoDefaultClassExists is the priority of pods without a PriorityClassName
+	// when there is no global default PriorityClass.
+	DefaultPriorityWhenNoDefaultClassExists = 0
+)
+
+// PriorityClass maps a priority class name to the integer priority of the pods in that class.
+type PriorityClass struct {
+	unversioned.TypeMeta `json:",inline"`
+	ObjectMeta           `json:"metadata,omitempty"`
+
+	// Value is the priority of the pods that name this class. The higher the value,
+	// the higher the priority.
+	Value int `json:"value"`
+	// GlobalDefault marks the class whose value is used for pods without a PriorityClassName.
+	// At most one PriorityClass may be the global default.
+	GlobalDefault bool `json:"globalDefault,omitempty"`
+	// Description describes when the class should be used.
+	Description string `json:"description,omitempty"`
+}
+
+// PriorityClassList is a resource containing a list of PriorityClass objects.
+type PriorityClassList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PriorityClasses.
+	Items []PriorityClass `json:"items"`
+}
+
 // These constants are for remote command execution and port forwarding and are
 // used by both the client side and server side components.
 //
@@ -1970,8 +2273,17 @@ const (
 	JSONPatchType           PatchType = "application/json-patch+json"
 	MergePatchType          PatchType = "application/merge-patch+json"
 	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+	// ApplyPatchType carries the complete desired configuration of an object. It is
+	// reconciled with the current object by a three-way strategic merge against the
+	// configuration recorded by the previous apply in LastAppliedConfigAnnotation.
+	ApplyPatchType PatchType = "application/apply-patch+json"
 )
 
+// LastAppliedConfigAnnotation is the annotation in which the server records the
+// configuration most recently applied to an object with an ApplyPatchType patch.
+// Fields it contains are owned by the applier, all others by other writers.
+const LastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
 // Type and constants for component health validation.
 type ComponentConditionType string
 
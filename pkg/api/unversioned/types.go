@@ -50,6 +50,13 @@ type ListMeta struct {
 	// Read-only.
 	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#concurrency-control-and-consistency
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Continue is set if the list was limited and more items may remain. Pass it
+	// unmodified as the continue parameter of the same list to retrieve the next page.
+	// Every page of a list reports the resourceVersion of the first page.
+	// Populated by the system.
+	// Read-only.
+	Continue string `json:"continue,omitempty"`
 }
 
 // Status is a return value for calls that don't return other objects.
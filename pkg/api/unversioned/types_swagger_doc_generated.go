@@ -31,6 +31,7 @@ var map_ListMeta = map[string]string{
 	"":                "ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.",
 	"selfLink":        "SelfLink is a URL representing this object. Populated by the system. Read-only.",
 	"resourceVersion": "String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#concurrency-control-and-consistency",
+	"continue":        "Continue is set if the list was limited and more items may remain. Pass it unmodified as the continue parameter of the same list to retrieve the next page. Every page of a list reports the resourceVersion of the first page. Populated by the system. Read-only.",
 }
 
 func (ListMeta) SwaggerDoc() map[string]string {
@@ -41,6 +41,41 @@ func convert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolu
 	return autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource(in, out, s)
 }
 
+func autoconvert_api_Affinity_To_v1_Affinity(in *api.Affinity, out *Affinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Affinity))(in)
+	}
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(NodeAffinity)
+		if err := convert_api_NodeAffinity_To_v1_NodeAffinity(in.NodeAffinity, out.NodeAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(PodAffinity)
+		if err := convert_api_PodAffinity_To_v1_PodAffinity(in.PodAffinity, out.PodAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(PodAntiAffinity)
+		if err := convert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in.PodAntiAffinity, out.PodAntiAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
+func convert_api_Affinity_To_v1_Affinity(in *api.Affinity, out *Affinity, s conversion.Scope) error {
+	return autoconvert_api_Affinity_To_v1_Affinity(in, out, s)
+}
+
 func autoconvert_api_Binding_To_v1_Binding(in *api.Binding, out *Binding, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Binding))(in)
@@ -201,6 +236,87 @@ func convert_api_ComponentStatusList_To_v1_ComponentStatusList(in *api.Component
 	return autoconvert_api_ComponentStatusList_To_v1_ComponentStatusList(in, out, s)
 }
 
+func autoconvert_api_ConfigMap_To_v1_ConfigMap(in *api.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMap))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Data != nil {
+		out.Data = make(map[string]string)
+		for key, val := range in.Data {
+			out.Data[key] = val
+		}
+	} else {
+		out.Data = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMap_To_v1_ConfigMap(in *api.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	return autoconvert_api_ConfigMap_To_v1_ConfigMap(in, out, s)
+}
+
+func autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_api_ConfigMapList_To_v1_ConfigMapList(in *api.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ConfigMap, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ConfigMap_To_v1_ConfigMap(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ConfigMapList_To_v1_ConfigMapList(in *api.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapList_To_v1_ConfigMapList(in, out, s)
+}
+
+func autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_api_Container_To_v1_Container(in *api.Container, out *Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Container))(in)
@@ -665,6 +781,14 @@ func autoconvert_api_EnvVarSource_To_v1_EnvVarSource(in *api.EnvVarSource, out *
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(ConfigMapKeySelector)
+		if err := convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
 	return nil
 }
 
@@ -912,6 +1036,56 @@ func convert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in *api.ISCSIVolumeSo
 	return autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_api_LabelSelector_To_v1_LabelSelector(in *api.LabelSelector, out *LabelSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.LabelSelector))(in)
+	}
+	if in.MatchLabels != nil {
+		out.MatchLabels = make(map[string]string)
+		for key, val := range in.MatchLabels {
+			out.MatchLabels[key] = val
+		}
+	} else {
+		out.MatchLabels = nil
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]LabelSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_api_LabelSelector_To_v1_LabelSelector(in *api.LabelSelector, out *LabelSelector, s conversion.Scope) error {
+	return autoconvert_api_LabelSelector_To_v1_LabelSelector(in, out, s)
+}
+
+func autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in *api.LabelSelectorRequirement, out *LabelSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.LabelSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = LabelSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in *api.LabelSelectorRequirement, out *LabelSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in, out, s)
+}
+
 func autoconvert_api_Lifecycle_To_v1_Lifecycle(in *api.Lifecycle, out *Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Lifecycle))(in)
@@ -1114,6 +1288,9 @@ func autoconvert_api_ListOptions_To_v1_ListOptions(in *api.ListOptions, out *Lis
 	}
 	out.Watch = in.Watch
 	out.ResourceVersion = in.ResourceVersion
+	out.Limit = in.Limit
+	out.Continue = in.Continue
+	out.AllowWatchBookmarks = in.AllowWatchBookmarks
 	return nil
 }
 
@@ -1298,6 +1475,35 @@ func convert_api_NodeAddress_To_v1_NodeAddress(in *api.NodeAddress, out *NodeAdd
 	return autoconvert_api_NodeAddress_To_v1_NodeAddress(in, out, s)
 }
 
+func autoconvert_api_NodeAffinity_To_v1_NodeAffinity(in *api.NodeAffinity, out *NodeAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(NodeSelector)
+		if err := convert_api_NodeSelector_To_v1_NodeSelector(in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, s); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_NodeAffinity_To_v1_NodeAffinity(in *api.NodeAffinity, out *NodeAffinity, s conversion.Scope) error {
+	return autoconvert_api_NodeAffinity_To_v1_NodeAffinity(in, out, s)
+}
+
 func autoconvert_api_NodeCondition_To_v1_NodeCondition(in *api.NodeCondition, out *NodeCondition, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.NodeCondition))(in)
@@ -1360,6 +1566,69 @@ func convert_api_NodeList_To_v1_NodeList(in *api.NodeList, out *NodeList, s conv
 	return autoconvert_api_NodeList_To_v1_NodeList(in, out, s)
 }
 
+func autoconvert_api_NodeSelector_To_v1_NodeSelector(in *api.NodeSelector, out *NodeSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelector))(in)
+	}
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(&in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelector_To_v1_NodeSelector(in *api.NodeSelector, out *NodeSelector, s conversion.Scope) error {
+	return autoconvert_api_NodeSelector_To_v1_NodeSelector(in, out, s)
+}
+
+func autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in *api.NodeSelectorRequirement, out *NodeSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = NodeSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in *api.NodeSelectorRequirement, out *NodeSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in, out, s)
+}
+
+func autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in *api.NodeSelectorTerm, out *NodeSelectorTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelectorTerm))(in)
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in *api.NodeSelectorTerm, out *NodeSelectorTerm, s conversion.Scope) error {
+	return autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in, out, s)
+}
+
 func autoconvert_api_NodeSpec_To_v1_NodeSpec(in *api.NodeSpec, out *NodeSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.NodeSpec))(in)
@@ -1368,6 +1637,16 @@ func autoconvert_api_NodeSpec_To_v1_NodeSpec(in *api.NodeSpec, out *NodeSpec, s
 	out.ExternalID = in.ExternalID
 	out.ProviderID = in.ProviderID
 	out.Unschedulable = in.Unschedulable
+	if in.Taints != nil {
+		out.Taints = make([]Taint, len(in.Taints))
+		for i := range in.Taints {
+			if err := convert_api_Taint_To_v1_Taint(&in.Taints[i], &out.Taints[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Taints = nil
+	}
 	return nil
 }
 
@@ -1500,6 +1779,24 @@ func autoconvert_api_ObjectMeta_To_v1_ObjectMeta(in *api.ObjectMeta, out *Object
 	} else {
 		out.Annotations = nil
 	}
+	if in.OwnerReferences != nil {
+		out.OwnerReferences = make([]OwnerReference, len(in.OwnerReferences))
+		for i := range in.OwnerReferences {
+			if err := convert_api_OwnerReference_To_v1_OwnerReference(&in.OwnerReferences[i], &out.OwnerReferences[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OwnerReferences = nil
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]string, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = in.Finalizers[i]
+		}
+	} else {
+		out.Finalizers = nil
+	}
 	return nil
 }
 
@@ -1525,6 +1822,21 @@ func convert_api_ObjectReference_To_v1_ObjectReference(in *api.ObjectReference,
 	return autoconvert_api_ObjectReference_To_v1_ObjectReference(in, out, s)
 }
 
+func autoconvert_api_OwnerReference_To_v1_OwnerReference(in *api.OwnerReference, out *OwnerReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.OwnerReference))(in)
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.UID = in.UID
+	return nil
+}
+
+func convert_api_OwnerReference_To_v1_OwnerReference(in *api.OwnerReference, out *OwnerReference, s conversion.Scope) error {
+	return autoconvert_api_OwnerReference_To_v1_OwnerReference(in, out, s)
+}
+
 func autoconvert_api_PersistentVolume_To_v1_PersistentVolume(in *api.PersistentVolume, out *PersistentVolume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.PersistentVolume))(in)
@@ -1864,6 +2176,96 @@ func convert_api_Pod_To_v1_Pod(in *api.Pod, out *Pod, s conversion.Scope) error
 	return autoconvert_api_Pod_To_v1_Pod(in, out, s)
 }
 
+func autoconvert_api_PodAffinity_To_v1_PodAffinity(in *api.PodAffinity, out *PodAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_PodAffinity_To_v1_PodAffinity(in *api.PodAffinity, out *PodAffinity, s conversion.Scope) error {
+	return autoconvert_api_PodAffinity_To_v1_PodAffinity(in, out, s)
+}
+
+func autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in *api.PodAffinityTerm, out *PodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAffinityTerm))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = new(LabelSelector)
+		if err := convert_api_LabelSelector_To_v1_LabelSelector(in.LabelSelector, out.LabelSelector, s); err != nil {
+			return err
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in *api.PodAffinityTerm, out *PodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in, out, s)
+}
+
+func autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in *api.PodAntiAffinity, out *PodAntiAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAntiAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in *api.PodAntiAffinity, out *PodAntiAffinity, s conversion.Scope) error {
+	return autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in, out, s)
+}
+
 func autoconvert_api_PodAttachOptions_To_v1_PodAttachOptions(in *api.PodAttachOptions, out *PodAttachOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.PodAttachOptions))(in)
@@ -2062,6 +2464,31 @@ func autoconvert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *PodSpec, s conv
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(Affinity)
+		if err := convert_api_Affinity_To_v1_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_api_Toleration_To_v1_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.Priority != nil {
+		out.Priority = new(int)
+		*out.Priority = *in.Priority
+	} else {
+		out.Priority = nil
+	}
 	out.ServiceAccountName = in.ServiceAccountName
 	out.NodeName = in.NodeName
 	out.HostNetwork = in.HostNetwork
@@ -2106,6 +2533,7 @@ func autoconvert_api_PodStatus_To_v1_PodStatus(in *api.PodStatus, out *PodStatus
 	} else {
 		out.StartTime = nil
 	}
+	out.NominatedNodeName = in.NominatedNodeName
 	if in.ContainerStatuses != nil {
 		out.ContainerStatuses = make([]ContainerStatus, len(in.ContainerStatuses))
 		for i := range in.ContainerStatuses {
@@ -2207,6 +2635,68 @@ func convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(in *api.PodTemplateSpec,
 	return autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec(in, out, s)
 }
 
+func autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in *api.PreferredSchedulingTerm, out *PreferredSchedulingTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PreferredSchedulingTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(&in.Preference, &out.Preference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in *api.PreferredSchedulingTerm, out *PreferredSchedulingTerm, s conversion.Scope) error {
+	return autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in, out, s)
+}
+
+func autoconvert_api_PriorityClass_To_v1_PriorityClass(in *api.PriorityClass, out *PriorityClass, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PriorityClass))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.Description = in.Description
+	return nil
+}
+
+func convert_api_PriorityClass_To_v1_PriorityClass(in *api.PriorityClass, out *PriorityClass, s conversion.Scope) error {
+	return autoconvert_api_PriorityClass_To_v1_PriorityClass(in, out, s)
+}
+
+func autoconvert_api_PriorityClassList_To_v1_PriorityClassList(in *api.PriorityClassList, out *PriorityClassList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PriorityClassList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]PriorityClass, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_PriorityClass_To_v1_PriorityClass(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_PriorityClassList_To_v1_PriorityClassList(in *api.PriorityClassList, out *PriorityClassList, s conversion.Scope) error {
+	return autoconvert_api_PriorityClassList_To_v1_PriorityClassList(in, out, s)
+}
+
 func autoconvert_api_Probe_To_v1_Probe(in *api.Probe, out *Probe, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Probe))(in)
@@ -2847,6 +3337,35 @@ func convert_api_TCPSocketAction_To_v1_TCPSocketAction(in *api.TCPSocketAction,
 	return autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_api_Taint_To_v1_Taint(in *api.Taint, out *Taint, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Taint))(in)
+	}
+	out.Key = in.Key
+	out.Value = in.Value
+	out.Effect = TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_api_Taint_To_v1_Taint(in *api.Taint, out *Taint, s conversion.Scope) error {
+	return autoconvert_api_Taint_To_v1_Taint(in, out, s)
+}
+
+func autoconvert_api_Toleration_To_v1_Toleration(in *api.Toleration, out *Toleration, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Toleration))(in)
+	}
+	out.Key = in.Key
+	out.Operator = TolerationOperator(in.Operator)
+	out.Value = in.Value
+	out.Effect = TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_api_Toleration_To_v1_Toleration(in *api.Toleration, out *Toleration, s conversion.Scope) error {
+	return autoconvert_api_Toleration_To_v1_Toleration(in, out, s)
+}
+
 func autoconvert_api_Volume_To_v1_Volume(in *api.Volume, out *Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Volume))(in)
@@ -3000,6 +3519,14 @@ func autoconvert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(ConfigMapVolumeSource)
+		if err := convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -3007,6 +3534,21 @@ func convert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *Volu
 	return autoconvert_api_VolumeSource_To_v1_VolumeSource(in, out, s)
 }
 
+func autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in *api.WeightedPodAffinityTerm, out *WeightedPodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.WeightedPodAffinityTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.PodAffinityTerm, &out.PodAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in *api.WeightedPodAffinityTerm, out *WeightedPodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in, out, s)
+}
+
 func autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource(in *AWSElasticBlockStoreVolumeSource, out *api.AWSElasticBlockStoreVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*AWSElasticBlockStoreVolumeSource))(in)
@@ -3022,6 +3564,41 @@ func convert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolu
 	return autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_Affinity_To_api_Affinity(in *Affinity, out *api.Affinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Affinity))(in)
+	}
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(api.NodeAffinity)
+		if err := convert_v1_NodeAffinity_To_api_NodeAffinity(in.NodeAffinity, out.NodeAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(api.PodAffinity)
+		if err := convert_v1_PodAffinity_To_api_PodAffinity(in.PodAffinity, out.PodAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(api.PodAntiAffinity)
+		if err := convert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in.PodAntiAffinity, out.PodAntiAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
+func convert_v1_Affinity_To_api_Affinity(in *Affinity, out *api.Affinity, s conversion.Scope) error {
+	return autoconvert_v1_Affinity_To_api_Affinity(in, out, s)
+}
+
 func autoconvert_v1_Binding_To_api_Binding(in *Binding, out *api.Binding, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Binding))(in)
@@ -3151,13 +3728,80 @@ func autoconvert_v1_ComponentStatus_To_api_ComponentStatus(in *ComponentStatus,
 	return nil
 }
 
-func convert_v1_ComponentStatus_To_api_ComponentStatus(in *ComponentStatus, out *api.ComponentStatus, s conversion.Scope) error {
-	return autoconvert_v1_ComponentStatus_To_api_ComponentStatus(in, out, s)
+func convert_v1_ComponentStatus_To_api_ComponentStatus(in *ComponentStatus, out *api.ComponentStatus, s conversion.Scope) error {
+	return autoconvert_v1_ComponentStatus_To_api_ComponentStatus(in, out, s)
+}
+
+func autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in *ComponentStatusList, out *api.ComponentStatusList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ComponentStatusList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ComponentStatus, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ComponentStatus_To_api_ComponentStatus(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ComponentStatusList_To_api_ComponentStatusList(in *ComponentStatusList, out *api.ComponentStatusList, s conversion.Scope) error {
+	return autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in, out, s)
+}
+
+func autoconvert_v1_ConfigMap_To_api_ConfigMap(in *ConfigMap, out *api.ConfigMap, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMap))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Data != nil {
+		out.Data = make(map[string]string)
+		for key, val := range in.Data {
+			out.Data[key] = val
+		}
+	} else {
+		out.Data = nil
+	}
+	return nil
+}
+
+func convert_v1_ConfigMap_To_api_ConfigMap(in *ConfigMap, out *api.ConfigMap, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMap_To_api_ConfigMap(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMapKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in, out, s)
 }
 
-func autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in *ComponentStatusList, out *api.ComponentStatusList, s conversion.Scope) error {
+func autoconvert_v1_ConfigMapList_To_api_ConfigMapList(in *ConfigMapList, out *api.ConfigMapList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*ComponentStatusList))(in)
+		defaulting.(func(*ConfigMapList))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -3166,9 +3810,9 @@ func autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in *Component
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]api.ComponentStatus, len(in.Items))
+		out.Items = make([]api.ConfigMap, len(in.Items))
 		for i := range in.Items {
-			if err := convert_v1_ComponentStatus_To_api_ComponentStatus(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_v1_ConfigMap_To_api_ConfigMap(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -3178,8 +3822,22 @@ func autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in *Component
 	return nil
 }
 
-func convert_v1_ComponentStatusList_To_api_ComponentStatusList(in *ComponentStatusList, out *api.ComponentStatusList, s conversion.Scope) error {
-	return autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList(in, out, s)
+func convert_v1_ConfigMapList_To_api_ConfigMapList(in *ConfigMapList, out *api.ConfigMapList, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapList_To_api_ConfigMapList(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ConfigMapVolumeSource))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in, out, s)
 }
 
 func autoconvert_v1_Container_To_api_Container(in *Container, out *api.Container, s conversion.Scope) error {
@@ -3646,6 +4304,14 @@ func autoconvert_v1_EnvVarSource_To_api_EnvVarSource(in *EnvVarSource, out *api.
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
 	return nil
 }
 
@@ -3893,6 +4559,56 @@ func convert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in *ISCSIVolumeSource
 	return autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_LabelSelector_To_api_LabelSelector(in *LabelSelector, out *api.LabelSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*LabelSelector))(in)
+	}
+	if in.MatchLabels != nil {
+		out.MatchLabels = make(map[string]string)
+		for key, val := range in.MatchLabels {
+			out.MatchLabels[key] = val
+		}
+	} else {
+		out.MatchLabels = nil
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.LabelSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_v1_LabelSelector_To_api_LabelSelector(in *LabelSelector, out *api.LabelSelector, s conversion.Scope) error {
+	return autoconvert_v1_LabelSelector_To_api_LabelSelector(in, out, s)
+}
+
+func autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in *LabelSelectorRequirement, out *api.LabelSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*LabelSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.LabelSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in *LabelSelectorRequirement, out *api.LabelSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in, out, s)
+}
+
 func autoconvert_v1_Lifecycle_To_api_Lifecycle(in *Lifecycle, out *api.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Lifecycle))(in)
@@ -4095,6 +4811,9 @@ func autoconvert_v1_ListOptions_To_api_ListOptions(in *ListOptions, out *api.Lis
 	}
 	out.Watch = in.Watch
 	out.ResourceVersion = in.ResourceVersion
+	out.Limit = in.Limit
+	out.Continue = in.Continue
+	out.AllowWatchBookmarks = in.AllowWatchBookmarks
 	return nil
 }
 
@@ -4279,6 +4998,35 @@ func convert_v1_NodeAddress_To_api_NodeAddress(in *NodeAddress, out *api.NodeAdd
 	return autoconvert_v1_NodeAddress_To_api_NodeAddress(in, out, s)
 }
 
+func autoconvert_v1_NodeAffinity_To_api_NodeAffinity(in *NodeAffinity, out *api.NodeAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*NodeAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(api.NodeSelector)
+		if err := convert_v1_NodeSelector_To_api_NodeSelector(in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, s); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeAffinity_To_api_NodeAffinity(in *NodeAffinity, out *api.NodeAffinity, s conversion.Scope) error {
+	return autoconvert_v1_NodeAffinity_To_api_NodeAffinity(in, out, s)
+}
+
 func autoconvert_v1_NodeCondition_To_api_NodeCondition(in *NodeCondition, out *api.NodeCondition, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*NodeCondition))(in)
@@ -4341,6 +5089,69 @@ func convert_v1_NodeList_To_api_NodeList(in *NodeList, out *api.NodeList, s conv
 	return autoconvert_v1_NodeList_To_api_NodeList(in, out, s)
 }
 
+func autoconvert_v1_NodeSelector_To_api_NodeSelector(in *NodeSelector, out *api.NodeSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*NodeSelector))(in)
+	}
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]api.NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(&in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelector_To_api_NodeSelector(in *NodeSelector, out *api.NodeSelector, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelector_To_api_NodeSelector(in, out, s)
+}
+
+func autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in *NodeSelectorRequirement, out *api.NodeSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*NodeSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.NodeSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in *NodeSelectorRequirement, out *api.NodeSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in, out, s)
+}
+
+func autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in *NodeSelectorTerm, out *api.NodeSelectorTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*NodeSelectorTerm))(in)
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in *NodeSelectorTerm, out *api.NodeSelectorTerm, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in, out, s)
+}
+
 func autoconvert_v1_NodeSpec_To_api_NodeSpec(in *NodeSpec, out *api.NodeSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*NodeSpec))(in)
@@ -4349,6 +5160,16 @@ func autoconvert_v1_NodeSpec_To_api_NodeSpec(in *NodeSpec, out *api.NodeSpec, s
 	out.ExternalID = in.ExternalID
 	out.ProviderID = in.ProviderID
 	out.Unschedulable = in.Unschedulable
+	if in.Taints != nil {
+		out.Taints = make([]api.Taint, len(in.Taints))
+		for i := range in.Taints {
+			if err := convert_v1_Taint_To_api_Taint(&in.Taints[i], &out.Taints[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Taints = nil
+	}
 	return nil
 }
 
@@ -4481,6 +5302,24 @@ func autoconvert_v1_ObjectMeta_To_api_ObjectMeta(in *ObjectMeta, out *api.Object
 	} else {
 		out.Annotations = nil
 	}
+	if in.OwnerReferences != nil {
+		out.OwnerReferences = make([]api.OwnerReference, len(in.OwnerReferences))
+		for i := range in.OwnerReferences {
+			if err := convert_v1_OwnerReference_To_api_OwnerReference(&in.OwnerReferences[i], &out.OwnerReferences[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OwnerReferences = nil
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]string, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = in.Finalizers[i]
+		}
+	} else {
+		out.Finalizers = nil
+	}
 	return nil
 }
 
@@ -4506,6 +5345,21 @@ func convert_v1_ObjectReference_To_api_ObjectReference(in *ObjectReference, out
 	return autoconvert_v1_ObjectReference_To_api_ObjectReference(in, out, s)
 }
 
+func autoconvert_v1_OwnerReference_To_api_OwnerReference(in *OwnerReference, out *api.OwnerReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*OwnerReference))(in)
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.UID = in.UID
+	return nil
+}
+
+func convert_v1_OwnerReference_To_api_OwnerReference(in *OwnerReference, out *api.OwnerReference, s conversion.Scope) error {
+	return autoconvert_v1_OwnerReference_To_api_OwnerReference(in, out, s)
+}
+
 func autoconvert_v1_PersistentVolume_To_api_PersistentVolume(in *PersistentVolume, out *api.PersistentVolume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*PersistentVolume))(in)
@@ -4845,6 +5699,96 @@ func convert_v1_Pod_To_api_Pod(in *Pod, out *api.Pod, s conversion.Scope) error
 	return autoconvert_v1_Pod_To_api_Pod(in, out, s)
 }
 
+func autoconvert_v1_PodAffinity_To_api_PodAffinity(in *PodAffinity, out *api.PodAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_PodAffinity_To_api_PodAffinity(in *PodAffinity, out *api.PodAffinity, s conversion.Scope) error {
+	return autoconvert_v1_PodAffinity_To_api_PodAffinity(in, out, s)
+}
+
+func autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in *PodAffinityTerm, out *api.PodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodAffinityTerm))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = new(api.LabelSelector)
+		if err := convert_v1_LabelSelector_To_api_LabelSelector(in.LabelSelector, out.LabelSelector, s); err != nil {
+			return err
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in *PodAffinityTerm, out *api.PodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in, out, s)
+}
+
+func autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in *PodAntiAffinity, out *api.PodAntiAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PodAntiAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in *PodAntiAffinity, out *api.PodAntiAffinity, s conversion.Scope) error {
+	return autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in, out, s)
+}
+
 func autoconvert_v1_PodAttachOptions_To_api_PodAttachOptions(in *PodAttachOptions, out *api.PodAttachOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*PodAttachOptions))(in)
@@ -5043,6 +5987,31 @@ func autoconvert_v1_PodSpec_To_api_PodSpec(in *PodSpec, out *api.PodSpec, s conv
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := convert_v1_Affinity_To_api_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_v1_Toleration_To_api_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.Priority != nil {
+		out.Priority = new(int)
+		*out.Priority = *in.Priority
+	} else {
+		out.Priority = nil
+	}
 	out.ServiceAccountName = in.ServiceAccountName
 	// in.DeprecatedServiceAccount has no peer in out
 	out.NodeName = in.NodeName
@@ -5088,6 +6057,7 @@ func autoconvert_v1_PodStatus_To_api_PodStatus(in *PodStatus, out *api.PodStatus
 	} else {
 		out.StartTime = nil
 	}
+	out.NominatedNodeName = in.NominatedNodeName
 	if in.ContainerStatuses != nil {
 		out.ContainerStatuses = make([]api.ContainerStatus, len(in.ContainerStatuses))
 		for i := range in.ContainerStatuses {
@@ -5189,6 +6159,68 @@ func convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(in *PodTemplateSpec, out
 	return autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec(in, out, s)
 }
 
+func autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in *PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PreferredSchedulingTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(&in.Preference, &out.Preference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in *PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, s conversion.Scope) error {
+	return autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in, out, s)
+}
+
+func autoconvert_v1_PriorityClass_To_api_PriorityClass(in *PriorityClass, out *api.PriorityClass, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PriorityClass))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.Description = in.Description
+	return nil
+}
+
+func convert_v1_PriorityClass_To_api_PriorityClass(in *PriorityClass, out *api.PriorityClass, s conversion.Scope) error {
+	return autoconvert_v1_PriorityClass_To_api_PriorityClass(in, out, s)
+}
+
+func autoconvert_v1_PriorityClassList_To_api_PriorityClassList(in *PriorityClassList, out *api.PriorityClassList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PriorityClassList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.PriorityClass, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_PriorityClass_To_api_PriorityClass(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_PriorityClassList_To_api_PriorityClassList(in *PriorityClassList, out *api.PriorityClassList, s conversion.Scope) error {
+	return autoconvert_v1_PriorityClassList_To_api_PriorityClassList(in, out, s)
+}
+
 func autoconvert_v1_Probe_To_api_Probe(in *Probe, out *api.Probe, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Probe))(in)
@@ -5828,6 +6860,35 @@ func convert_v1_TCPSocketAction_To_api_TCPSocketAction(in *TCPSocketAction, out
 	return autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_v1_Taint_To_api_Taint(in *Taint, out *api.Taint, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Taint))(in)
+	}
+	out.Key = in.Key
+	out.Value = in.Value
+	out.Effect = api.TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_v1_Taint_To_api_Taint(in *Taint, out *api.Taint, s conversion.Scope) error {
+	return autoconvert_v1_Taint_To_api_Taint(in, out, s)
+}
+
+func autoconvert_v1_Toleration_To_api_Toleration(in *Toleration, out *api.Toleration, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Toleration))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.TolerationOperator(in.Operator)
+	out.Value = in.Value
+	out.Effect = api.TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_v1_Toleration_To_api_Toleration(in *Toleration, out *api.Toleration, s conversion.Scope) error {
+	return autoconvert_v1_Toleration_To_api_Toleration(in, out, s)
+}
+
 func autoconvert_v1_Volume_To_api_Volume(in *Volume, out *api.Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Volume))(in)
@@ -5981,6 +7042,14 @@ func autoconvert_v1_VolumeSource_To_api_VolumeSource(in *VolumeSource, out *api.
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -5988,9 +7057,25 @@ func convert_v1_VolumeSource_To_api_VolumeSource(in *VolumeSource, out *api.Volu
 	return autoconvert_v1_VolumeSource_To_api_VolumeSource(in, out, s)
 }
 
+func autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in *WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*WeightedPodAffinityTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.PodAffinityTerm, &out.PodAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in *WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in, out, s)
+}
+
 func init() {
 	err := api.Scheme.AddGeneratedConversionFuncs(
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
+		autoconvert_api_Affinity_To_v1_Affinity,
 		autoconvert_api_Binding_To_v1_Binding,
 		autoconvert_api_Capabilities_To_v1_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
@@ -5998,6 +7083,10 @@ func init() {
 		autoconvert_api_ComponentCondition_To_v1_ComponentCondition,
 		autoconvert_api_ComponentStatusList_To_v1_ComponentStatusList,
 		autoconvert_api_ComponentStatus_To_v1_ComponentStatus,
+		autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
+		autoconvert_api_ConfigMapList_To_v1_ConfigMapList,
+		autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
+		autoconvert_api_ConfigMap_To_v1_ConfigMap,
 		autoconvert_api_ContainerPort_To_v1_ContainerPort,
 		autoconvert_api_ContainerStateRunning_To_v1_ContainerStateRunning,
 		autoconvert_api_ContainerStateTerminated_To_v1_ContainerStateTerminated,
@@ -6029,6 +7118,8 @@ func init() {
 		autoconvert_api_Handler_To_v1_Handler,
 		autoconvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
 		autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource,
+		autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement,
+		autoconvert_api_LabelSelector_To_v1_LabelSelector,
 		autoconvert_api_Lifecycle_To_v1_Lifecycle,
 		autoconvert_api_LimitRangeItem_To_v1_LimitRangeItem,
 		autoconvert_api_LimitRangeList_To_v1_LimitRangeList,
@@ -6045,9 +7136,13 @@ func init() {
 		autoconvert_api_NamespaceStatus_To_v1_NamespaceStatus,
 		autoconvert_api_Namespace_To_v1_Namespace,
 		autoconvert_api_NodeAddress_To_v1_NodeAddress,
+		autoconvert_api_NodeAffinity_To_v1_NodeAffinity,
 		autoconvert_api_NodeCondition_To_v1_NodeCondition,
 		autoconvert_api_NodeDaemonEndpoints_To_v1_NodeDaemonEndpoints,
 		autoconvert_api_NodeList_To_v1_NodeList,
+		autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement,
+		autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm,
+		autoconvert_api_NodeSelector_To_v1_NodeSelector,
 		autoconvert_api_NodeSpec_To_v1_NodeSpec,
 		autoconvert_api_NodeStatus_To_v1_NodeStatus,
 		autoconvert_api_NodeSystemInfo_To_v1_NodeSystemInfo,
@@ -6055,6 +7150,7 @@ func init() {
 		autoconvert_api_ObjectFieldSelector_To_v1_ObjectFieldSelector,
 		autoconvert_api_ObjectMeta_To_v1_ObjectMeta,
 		autoconvert_api_ObjectReference_To_v1_ObjectReference,
+		autoconvert_api_OwnerReference_To_v1_OwnerReference,
 		autoconvert_api_PersistentVolumeClaimList_To_v1_PersistentVolumeClaimList,
 		autoconvert_api_PersistentVolumeClaimSpec_To_v1_PersistentVolumeClaimSpec,
 		autoconvert_api_PersistentVolumeClaimStatus_To_v1_PersistentVolumeClaimStatus,
@@ -6065,6 +7161,9 @@ func init() {
 		autoconvert_api_PersistentVolumeSpec_To_v1_PersistentVolumeSpec,
 		autoconvert_api_PersistentVolumeStatus_To_v1_PersistentVolumeStatus,
 		autoconvert_api_PersistentVolume_To_v1_PersistentVolume,
+		autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm,
+		autoconvert_api_PodAffinity_To_v1_PodAffinity,
+		autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity,
 		autoconvert_api_PodAttachOptions_To_v1_PodAttachOptions,
 		autoconvert_api_PodCondition_To_v1_PodCondition,
 		autoconvert_api_PodExecOptions_To_v1_PodExecOptions,
@@ -6078,6 +7177,9 @@ func init() {
 		autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
 		autoconvert_api_PodTemplate_To_v1_PodTemplate,
 		autoconvert_api_Pod_To_v1_Pod,
+		autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm,
+		autoconvert_api_PriorityClassList_To_v1_PriorityClassList,
+		autoconvert_api_PriorityClass_To_v1_PriorityClass,
 		autoconvert_api_Probe_To_v1_Probe,
 		autoconvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoconvert_api_RangeAllocation_To_v1_RangeAllocation,
@@ -6104,10 +7206,14 @@ func init() {
 		autoconvert_api_ServiceStatus_To_v1_ServiceStatus,
 		autoconvert_api_Service_To_v1_Service,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
+		autoconvert_api_Taint_To_v1_Taint,
+		autoconvert_api_Toleration_To_v1_Toleration,
 		autoconvert_api_VolumeMount_To_v1_VolumeMount,
 		autoconvert_api_VolumeSource_To_v1_VolumeSource,
 		autoconvert_api_Volume_To_v1_Volume,
+		autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm,
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
+		autoconvert_v1_Affinity_To_api_Affinity,
 		autoconvert_v1_Binding_To_api_Binding,
 		autoconvert_v1_Capabilities_To_api_Capabilities,
 		autoconvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
@@ -6115,6 +7221,10 @@ func init() {
 		autoconvert_v1_ComponentCondition_To_api_ComponentCondition,
 		autoconvert_v1_ComponentStatusList_To_api_ComponentStatusList,
 		autoconvert_v1_ComponentStatus_To_api_ComponentStatus,
+		autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
+		autoconvert_v1_ConfigMapList_To_api_ConfigMapList,
+		autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
+		autoconvert_v1_ConfigMap_To_api_ConfigMap,
 		autoconvert_v1_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1_ContainerStateRunning_To_api_ContainerStateRunning,
 		autoconvert_v1_ContainerStateTerminated_To_api_ContainerStateTerminated,
@@ -6146,6 +7256,8 @@ func init() {
 		autoconvert_v1_Handler_To_api_Handler,
 		autoconvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource,
+		autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement,
+		autoconvert_v1_LabelSelector_To_api_LabelSelector,
 		autoconvert_v1_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1_LimitRangeItem_To_api_LimitRangeItem,
 		autoconvert_v1_LimitRangeList_To_api_LimitRangeList,
@@ -6162,9 +7274,13 @@ func init() {
 		autoconvert_v1_NamespaceStatus_To_api_NamespaceStatus,
 		autoconvert_v1_Namespace_To_api_Namespace,
 		autoconvert_v1_NodeAddress_To_api_NodeAddress,
+		autoconvert_v1_NodeAffinity_To_api_NodeAffinity,
 		autoconvert_v1_NodeCondition_To_api_NodeCondition,
 		autoconvert_v1_NodeDaemonEndpoints_To_api_NodeDaemonEndpoints,
 		autoconvert_v1_NodeList_To_api_NodeList,
+		autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement,
+		autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm,
+		autoconvert_v1_NodeSelector_To_api_NodeSelector,
 		autoconvert_v1_NodeSpec_To_api_NodeSpec,
 		autoconvert_v1_NodeStatus_To_api_NodeStatus,
 		autoconvert_v1_NodeSystemInfo_To_api_NodeSystemInfo,
@@ -6172,6 +7288,7 @@ func init() {
 		autoconvert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector,
 		autoconvert_v1_ObjectMeta_To_api_ObjectMeta,
 		autoconvert_v1_ObjectReference_To_api_ObjectReference,
+		autoconvert_v1_OwnerReference_To_api_OwnerReference,
 		autoconvert_v1_PersistentVolumeClaimList_To_api_PersistentVolumeClaimList,
 		autoconvert_v1_PersistentVolumeClaimSpec_To_api_PersistentVolumeClaimSpec,
 		autoconvert_v1_PersistentVolumeClaimStatus_To_api_PersistentVolumeClaimStatus,
@@ -6182,6 +7299,9 @@ func init() {
 		autoconvert_v1_PersistentVolumeSpec_To_api_PersistentVolumeSpec,
 		autoconvert_v1_PersistentVolumeStatus_To_api_PersistentVolumeStatus,
 		autoconvert_v1_PersistentVolume_To_api_PersistentVolume,
+		autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm,
+		autoconvert_v1_PodAffinity_To_api_PodAffinity,
+		autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity,
 		autoconvert_v1_PodAttachOptions_To_api_PodAttachOptions,
 		autoconvert_v1_PodCondition_To_api_PodCondition,
 		autoconvert_v1_PodExecOptions_To_api_PodExecOptions,
@@ -6195,6 +7315,9 @@ func init() {
 		autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoconvert_v1_PodTemplate_To_api_PodTemplate,
 		autoconvert_v1_Pod_To_api_Pod,
+		autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm,
+		autoconvert_v1_PriorityClassList_To_api_PriorityClassList,
+		autoconvert_v1_PriorityClass_To_api_PriorityClass,
 		autoconvert_v1_Probe_To_api_Probe,
 		autoconvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoconvert_v1_RangeAllocation_To_api_RangeAllocation,
@@ -6221,9 +7344,12 @@ func init() {
 		autoconvert_v1_ServiceStatus_To_api_ServiceStatus,
 		autoconvert_v1_Service_To_api_Service,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
+		autoconvert_v1_Taint_To_api_Taint,
+		autoconvert_v1_Toleration_To_api_Toleration,
 		autoconvert_v1_VolumeMount_To_api_VolumeMount,
 		autoconvert_v1_VolumeSource_To_api_VolumeSource,
 		autoconvert_v1_Volume_To_api_Volume,
+		autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
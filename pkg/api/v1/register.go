@@ -66,6 +66,10 @@ func addKnownTypes() {
 		&NamespaceList{},
 		&Secret{},
 		&SecretList{},
+		&ConfigMap{},
+		&ConfigMapList{},
+		&PriorityClass{},
+		&PriorityClassList{},
 		&ServiceAccount{},
 		&ServiceAccountList{},
 		&PersistentVolume{},
@@ -113,6 +117,10 @@ func (*Namespace) IsAnAPIObject()                 {}
 func (*NamespaceList) IsAnAPIObject()             {}
 func (*Secret) IsAnAPIObject()                    {}
 func (*SecretList) IsAnAPIObject()                {}
+func (*ConfigMap) IsAnAPIObject()                 {}
+func (*ConfigMapList) IsAnAPIObject()             {}
+func (*PriorityClass) IsAnAPIObject()             {}
+func (*PriorityClassList) IsAnAPIObject()         {}
 func (*ServiceAccount) IsAnAPIObject()            {}
 func (*ServiceAccountList) IsAnAPIObject()        {}
 func (*PersistentVolume) IsAnAPIObject()          {}
@@ -177,6 +177,34 @@ type ObjectMeta struct {
 	// queryable and should be preserved when modifying objects.
 	// More info: http://releases.k8s.io/HEAD/docs/user-guide/annotations.md
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// List of objects depended on by this object. If ALL objects in the list have
+	// been deleted, this object will be garbage collected. Owners must be in the
+	// same namespace as this object.
+	OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+
+	// Must be empty before the object is deleted from the registry. Each entry
+	// is an identifier for the responsible component that will remove the entry
+	// from the list. If the deletionTimestamp of the object is non-nil, entries
+	// in this list can only be removed.
+	Finalizers []string `json:"finalizers,omitempty"`
+}
+
+// OwnerReference contains enough information to let you identify an owning
+// object. Currently, an owning object must be in the same namespace, so there
+// is no namespace field.
+type OwnerReference struct {
+	// API version of the referent.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referent.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Name of the referent.
+	// More info: http://releases.k8s.io/HEAD/docs/user-guide/identifiers.md#names
+	Name string `json:"name"`
+	// UID of the referent.
+	// More info: http://releases.k8s.io/HEAD/docs/user-guide/identifiers.md#uids
+	UID types.UID `json:"uid"`
 }
 
 const (
@@ -254,6 +282,8 @@ type VolumeSource struct {
 	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI,omitempty"`
 	// FC represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.
 	FC *FCVolumeSource `json:"fc,omitempty"`
+	// ConfigMap represents a configMap that should populate this volume
+	ConfigMap *ConfigMapVolumeSource `json:"configMap,omitempty"`
 }
 
 // PersistentVolumeClaimVolumeSource references the user's PVC in the same namespace.
@@ -673,6 +703,14 @@ type SecretVolumeSource struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a volume.
+//
+// The contents of the target ConfigMap's Data field will be presented in a
+// volume as files using the keys in the Data field as the file names.
+type ConfigMapVolumeSource struct {
+	LocalObjectReference `json:",inline"`
+}
+
 // NFSVolumeSource represents an NFS mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server.
@@ -781,7 +819,17 @@ type EnvVar struct {
 // EnvVarSource represents a source for the value of an EnvVar.
 type EnvVarSource struct {
 	// Selects a field of the pod. Only name and namespace are supported.
-	FieldRef *ObjectFieldSelector `json:"fieldRef"`
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+	// Selects a key of a ConfigMap.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key from a ConfigMap.
+type ConfigMapKeySelector struct {
+	// The ConfigMap to select from.
+	LocalObjectReference `json:",inline"`
+	// The key to select.
+	Key string `json:"key"`
 }
 
 // ObjectFieldSelector selects an APIVersioned field of an object.
@@ -1169,6 +1217,164 @@ const (
 	DefaultTerminationGracePeriodSeconds = 30
 )
 
+// A node selector operator is the relationship of a node label to the values of a node selector requirement.
+type NodeSelectorOperator string
+
+const (
+	NodeSelectorOpIn           NodeSelectorOperator = "In"
+	NodeSelectorOpNotIn        NodeSelectorOperator = "NotIn"
+	NodeSelectorOpExists       NodeSelectorOperator = "Exists"
+	NodeSelectorOpDoesNotExist NodeSelectorOperator = "DoesNotExist"
+	NodeSelectorOpGt           NodeSelectorOperator = "Gt"
+	NodeSelectorOpLt           NodeSelectorOperator = "Lt"
+)
+
+// A node selector requirement is a requirement on the value of a node label.
+type NodeSelectorRequirement struct {
+	// The label key that the requirement applies to.
+	Key string `json:"key"`
+	// Represents the key's relationship to the values.
+	// Valid operators are In, NotIn, Exists, DoesNotExist, Gt and Lt.
+	Operator NodeSelectorOperator `json:"operator"`
+	// The values of the requirement. Must be non-empty for In and NotIn, empty for Exists and
+	// DoesNotExist, and hold a single integer for Gt and Lt.
+	Values []string `json:"values,omitempty"`
+}
+
+// A node selector term is a set of requirements which the labels of a node must all satisfy.
+type NodeSelectorTerm struct {
+	// Required. The requirements of the term.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions"`
+}
+
+// A node selector selects the nodes whose labels satisfy any of its terms.
+type NodeSelector struct {
+	// Required. The terms of the selector.
+	NodeSelectorTerms []NodeSelectorTerm `json:"nodeSelectorTerms"`
+}
+
+// A preferred scheduling term is a node selector term which the scheduler prefers nodes to satisfy.
+type PreferredSchedulingTerm struct {
+	// The weight of the term, in the range 1-100.
+	Weight int `json:"weight"`
+	// The node selector term which preferred nodes satisfy.
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// Node affinity describes the nodes a pod may be and prefers to be scheduled onto.
+type NodeAffinity struct {
+	// The pod is only scheduled onto nodes matching this selector. Pods already running on a
+	// node which stops matching it are not evicted.
+	RequiredDuringSchedulingIgnoredDuringExecution *NodeSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes which match these terms, favoring the nodes for which the sum
+	// of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []PreferredSchedulingTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// A label selector operator is the relationship of a label to the values of a label selector requirement.
+type LabelSelectorOperator string
+
+const (
+	LabelSelectorOpIn           LabelSelectorOperator = "In"
+	LabelSelectorOpNotIn        LabelSelectorOperator = "NotIn"
+	LabelSelectorOpExists       LabelSelectorOperator = "Exists"
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
+)
+
+// A label selector requirement is a requirement on the value of a label.
+type LabelSelectorRequirement struct {
+	// The label key that the requirement applies to.
+	Key string `json:"key"`
+	// Represents the key's relationship to the values.
+	// Valid operators are In, NotIn, Exists and DoesNotExist.
+	Operator LabelSelectorOperator `json:"operator"`
+	// The values of the requirement. Must be non-empty for In and NotIn, and empty for Exists
+	// and DoesNotExist.
+	Values []string `json:"values,omitempty"`
+}
+
+// A label selector selects the objects whose labels satisfy both matchLabels and matchExpressions.
+// An empty selector selects every object.
+type LabelSelector struct {
+	// The labels which selected objects must have, with the same values.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	// The requirements which the labels of objects must all satisfy.
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// A pod affinity term selects the pods which a pod should be, or should not be, co-located with.
+// Two pods are co-located if they run on nodes with the same value of the topologyKey label.
+type PodAffinityTerm struct {
+	// A label query over pods.
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+	// The namespaces of the pods selected by labelSelector. If empty, the namespace of the pod
+	// the term belongs to.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// The node label whose value defines the topology domain pods are co-located in, for example
+	// kubernetes.io/hostname for the same node, or a zone label for the same zone.
+	TopologyKey string `json:"topologyKey"`
+}
+
+// A weighted pod affinity term is a pod affinity term which the scheduler prefers nodes to satisfy.
+type WeightedPodAffinityTerm struct {
+	// The weight of the term, in the range 1-100.
+	Weight int `json:"weight"`
+	// The pod affinity term which preferred nodes satisfy.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm"`
+}
+
+// Pod affinity describes the pods a pod must be or prefers to be co-located with.
+type PodAffinity struct {
+	// The pod is only scheduled onto nodes co-located with pods matching every one of these
+	// terms. Running pods are not evicted if the terms stop being satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes co-located with pods matching these terms, favoring the nodes
+	// for which the sum of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// Pod anti-affinity describes the pods a pod must not be or prefers not to be co-located with.
+type PodAntiAffinity struct {
+	// The pod is only scheduled onto nodes not co-located with any pod matching any of these
+	// terms. Running pods are not evicted if the terms stop being satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []PodAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// The scheduler prefers nodes not co-located with pods matching these terms, avoiding the
+	// nodes for which the sum of the weights of the matching terms is largest.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPodAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// Affinity holds the scheduling constraints of a pod.
+type Affinity struct {
+	// Describes the nodes the pod may be and prefers to be scheduled onto.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty"`
+	// Describes the pods the pod must be or prefers to be co-located with.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
+	// Describes the pods the pod must not be or prefers not to be co-located with.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+}
+
+// TolerationOperator is the relationship between the key and value of a toleration and of a taint.
+type TolerationOperator string
+
+const (
+	TolerationOpExists TolerationOperator = "Exists"
+	TolerationOpEqual  TolerationOperator = "Equal"
+)
+
+// Toleration allows a pod to be scheduled onto the nodes with the taints it matches.
+type Toleration struct {
+	// The taint key that the toleration applies to.
+	Key string `json:"key,omitempty"`
+	// Represents the key's relationship to the value: Exists or Equal. Defaults to Equal.
+	// Exists matches every taint with the key, whatever its value.
+	Operator TolerationOperator `json:"operator,omitempty"`
+	// The taint value the toleration matches. Must be empty if the operator is Exists.
+	Value string `json:"value,omitempty"`
+	// The taint effect the toleration matches: NoSchedule or PreferNoSchedule. If empty, the
+	// toleration matches taints with any effect.
+	Effect TaintEffect `json:"effect,omitempty"`
+}
+
 // PodSpec is a description of a pod.
 type PodSpec struct {
 	// List of volumes that can be mounted by containers belonging to the pod.
@@ -1205,6 +1411,18 @@ type PodSpec struct {
 	// Selector which must match a node's labels for the pod to be scheduled on that node.
 	// More info: http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// If specified, the pod's scheduling constraints, beyond those of nodeSelector.
+	// More info: http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md
+	Affinity *Affinity `json:"affinity,omitempty"`
+	// The tolerations of the pod, which allow it to be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+	// If specified, the name of the PriorityClass the priority of the pod is resolved from.
+	// If empty, the priority of the global default PriorityClass is used, or zero if there is none.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// The priority of the pod, resolved from priorityClassName by the Priority admission controller
+	// when the pod is created. Pods with a higher priority are scheduled first, and may preempt
+	// pods with a lower priority.
+	Priority *int `json:"priority,omitempty"`
 
 	// ServiceAccountName is the name of the ServiceAccount to use to run this pod.
 	// More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md
@@ -1259,6 +1477,11 @@ type PodStatus struct {
 	// This is before the Kubelet pulled the container image(s) for the pod.
 	StartTime *unversioned.Time `json:"startTime,omitempty"`
 
+	// The name of the node the scheduler preempted pods on to make room for this pod.
+	// The pod is expected to be scheduled onto that node once the preempted pods are gone,
+	// but may still be scheduled elsewhere.
+	NominatedNodeName string `json:"nominatedNodeName,omitempty"`
+
 	// The list has one entry per container in the manifest. Each entry is currently the output
 	// of `docker inspect`.
 	// More info: http://releases.k8s.io/HEAD/docs/user-guide/pod-states.md#container-statuses
@@ -1730,6 +1953,28 @@ type NodeSpec struct {
 	// Unschedulable controls node schedulability of new pods. By default, node is schedulable.
 	// More info: http://releases.k8s.io/HEAD/docs/admin/node.md#manual-node-administration"`
 	Unschedulable bool `json:"unschedulable,omitempty"`
+	// The taints of the node, which repel the pods that do not tolerate them.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// TaintEffect is the effect a taint has on the pods that do not tolerate it.
+type TaintEffect string
+
+const (
+	// Pods that do not tolerate the taint are not scheduled onto the node.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// The scheduler tries not to schedule pods that do not tolerate the taint onto the node.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+)
+
+// Taint marks a node so that the pods which do not tolerate it are kept off the node.
+type Taint struct {
+	// The taint key.
+	Key string `json:"key"`
+	// The taint value.
+	Value string `json:"value,omitempty"`
+	// The effect of the taint on the pods that do not tolerate it: NoSchedule or PreferNoSchedule.
+	Effect TaintEffect `json:"effect"`
 }
 
 // DaemonEndpoint contains information about a single Daemon endpoint.
@@ -1984,7 +2229,20 @@ type ListOptions struct {
 	Watch bool `json:"watch,omitempty"`
 	// When specified with a watch call, shows changes that occur after that particular version of a resource.
 	// Defaults to changes from the beginning of history.
+	// When specified with a list call, the list may be served from a cache at least as recent
+	// as that version of a resource, and "0" allows any version. Defaults to the latest version.
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+	// The maximum number of items to return from a list. If more items remain, the
+	// continue token of the returned list retrieves the next page.
+	// Defaults to returning every item. Not every resource supports limiting its lists.
+	Limit int64 `json:"limit,omitempty"`
+	// The continue token of the previous page of a limited list, to retrieve the next page.
+	// The other parameters must be unchanged from the request for the previous page.
+	Continue string `json:"continue,omitempty"`
+	// When specified with a watch call, the server may periodically send BOOKMARK events
+	// whose object only carries the latest resourceVersion, from which the watch can be resumed.
+	// Defaults to false. Clients must ignore the rest of the object of a bookmark.
+	AllowWatchBookmarks bool `json:"allowWatchBookmarks,omitempty"`
 }
 
 // PodLogOptions is the query options for a Pod's logs REST call.
@@ -2382,6 +2640,55 @@ type SecretList struct {
 	Items []Secret `json:"items"`
 }
 
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Data contains the configuration data.
+	// Each key must be a valid DNS_SUBDOMAIN with an optional leading dot.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// ConfigMapList is a resource containing a list of ConfigMap objects.
+type ConfigMapList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ConfigMaps.
+	Items []ConfigMap `json:"items"`
+}
+
+// PriorityClass maps a priority class name to the integer priority of the pods in that class.
+type PriorityClass struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// The priority of the pods that name this class. The higher the value, the higher the priority.
+	// Must not be greater than 1000000000.
+	Value int `json:"value"`
+	// If true, the value of this class is used for pods without a priorityClassName.
+	// At most one PriorityClass may be the global default.
+	GlobalDefault bool `json:"globalDefault,omitempty"`
+	// A description of when this class should be used.
+	Description string `json:"description,omitempty"`
+}
+
+// PriorityClassList is a resource containing a list of PriorityClass objects.
+type PriorityClassList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PriorityClasses.
+	Items []PriorityClass `json:"items"`
+}
+
 // Type and constants for component health validation.
 type ComponentConditionType string
 
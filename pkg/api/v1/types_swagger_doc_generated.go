@@ -39,6 +39,17 @@ func (AWSElasticBlockStoreVolumeSource) SwaggerDoc() map[string]string {
 	return map_AWSElasticBlockStoreVolumeSource
 }
 
+var map_Affinity = map[string]string{
+	"":                "Affinity holds the scheduling constraints of a pod.",
+	"nodeAffinity":    "Describes the nodes the pod may be and prefers to be scheduled onto.",
+	"podAffinity":     "Describes the pods the pod must be or prefers to be co-located with.",
+	"podAntiAffinity": "Describes the pods the pod must not be or prefers not to be co-located with.",
+}
+
+func (Affinity) SwaggerDoc() map[string]string {
+	return map_Affinity
+}
+
 var map_Binding = map[string]string{
 	"":         "Binding ties one object to another. For example, a pod is bound to a node by a scheduler.",
 	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
@@ -115,6 +126,43 @@ func (ComponentStatusList) SwaggerDoc() map[string]string {
 	return map_ComponentStatusList
 }
 
+var map_ConfigMap = map[string]string{
+	"":         "ConfigMap holds configuration data for pods to consume.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"data":     "Data contains the configuration data. Each key must be a valid DNS_SUBDOMAIN with an optional leading dot.",
+}
+
+func (ConfigMap) SwaggerDoc() map[string]string {
+	return map_ConfigMap
+}
+
+var map_ConfigMapKeySelector = map[string]string{
+	"":    "ConfigMapKeySelector selects a key from a ConfigMap.",
+	"key": "The key to select.",
+}
+
+func (ConfigMapKeySelector) SwaggerDoc() map[string]string {
+	return map_ConfigMapKeySelector
+}
+
+var map_ConfigMapList = map[string]string{
+	"":         "ConfigMapList is a resource containing a list of ConfigMap objects.",
+	"metadata": "More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of ConfigMaps.",
+}
+
+func (ConfigMapList) SwaggerDoc() map[string]string {
+	return map_ConfigMapList
+}
+
+var map_ConfigMapVolumeSource = map[string]string{
+	"": "ConfigMapVolumeSource adapts a ConfigMap into a volume.\n\nThe contents of the target ConfigMap's Data field will be presented in a volume as files using the keys in the Data field as the file names.",
+}
+
+func (ConfigMapVolumeSource) SwaggerDoc() map[string]string {
+	return map_ConfigMapVolumeSource
+}
+
 var map_Container = map[string]string{
 	"":                       "A single application container that you want to run within a pod.",
 	"name":                   "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated.",
@@ -324,8 +372,9 @@ func (EnvVar) SwaggerDoc() map[string]string {
 }
 
 var map_EnvVarSource = map[string]string{
-	"":         "EnvVarSource represents a source for the value of an EnvVar.",
-	"fieldRef": "Selects a field of the pod. Only name and namespace are supported.",
+	"":                "EnvVarSource represents a source for the value of an EnvVar.",
+	"fieldRef":        "Selects a field of the pod. Only name and namespace are supported.",
+	"configMapKeyRef": "Selects a key of a ConfigMap.",
 }
 
 func (EnvVarSource) SwaggerDoc() map[string]string {
@@ -467,6 +516,27 @@ func (ISCSIVolumeSource) SwaggerDoc() map[string]string {
 	return map_ISCSIVolumeSource
 }
 
+var map_LabelSelector = map[string]string{
+	"":                 "A label selector selects the objects whose labels satisfy both matchLabels and matchExpressions. An empty selector selects every object.",
+	"matchLabels":      "The labels which selected objects must have, with the same values.",
+	"matchExpressions": "The requirements which the labels of objects must all satisfy.",
+}
+
+func (LabelSelector) SwaggerDoc() map[string]string {
+	return map_LabelSelector
+}
+
+var map_LabelSelectorRequirement = map[string]string{
+	"":         "A label selector requirement is a requirement on the value of a label.",
+	"key":      "The label key that the requirement applies to.",
+	"operator": "Represents the key's relationship to the values. Valid operators are In, NotIn, Exists and DoesNotExist.",
+	"values":   "The values of the requirement. Must be non-empty for In and NotIn, and empty for Exists and DoesNotExist.",
+}
+
+func (LabelSelectorRequirement) SwaggerDoc() map[string]string {
+	return map_LabelSelectorRequirement
+}
+
 var map_Lifecycle = map[string]string{
 	"":          "Lifecycle describes actions that the management system should take in response to container lifecycle events. For the PostStart and PreStop lifecycle handlers, management of the container blocks until the action is complete, unless the container process fails, in which case the handler is aborted.",
 	"postStart": "PostStart is called immediately after a container is created. If the handler fails, the container is terminated and restarted according to its restart policy. Other management of the container blocks until the hook completes. More info: http://releases.k8s.io/HEAD/docs/user-guide/container-environment.md#hook-details",
@@ -531,11 +601,14 @@ func (List) SwaggerDoc() map[string]string {
 }
 
 var map_ListOptions = map[string]string{
-	"":                "ListOptions is the query options to a standard REST list call.",
-	"labelSelector":   "A selector to restrict the list of returned objects by their labels. Defaults to everything.",
-	"fieldSelector":   "A selector to restrict the list of returned objects by their fields. Defaults to everything.",
-	"watch":           "Watch for changes to the described resources and return them as a stream of add, update, and remove notifications. Specify resourceVersion.",
-	"resourceVersion": "When specified with a watch call, shows changes that occur after that particular version of a resource. Defaults to changes from the beginning of history.",
+	"":                    "ListOptions is the query options to a standard REST list call.",
+	"labelSelector":       "A selector to restrict the list of returned objects by their labels. Defaults to everything.",
+	"fieldSelector":       "A selector to restrict the list of returned objects by their fields. Defaults to everything.",
+	"watch":               "Watch for changes to the described resources and return them as a stream of add, update, and remove notifications. Specify resourceVersion.",
+	"resourceVersion":     "When specified with a watch call, shows changes that occur after that particular version of a resource. Defaults to changes from the beginning of history. When specified with a list call, the list may be served from a cache at least as recent as that version of a resource, and \"0\" allows any version. Defaults to the latest version.",
+	"limit":               "The maximum number of items to return from a list. If more items remain, the continue token of the returned list retrieves the next page. Defaults to returning every item. Not every resource supports limiting its lists.",
+	"continue":            "The continue token of the previous page of a limited list, to retrieve the next page. The other parameters must be unchanged from the request for the previous page.",
+	"allowWatchBookmarks": "When specified with a watch call, the server may periodically send BOOKMARK events whose object only carries the latest resourceVersion, from which the watch can be resumed. Defaults to false. Clients must ignore the rest of the object of a bookmark.",
 }
 
 func (ListOptions) SwaggerDoc() map[string]string {
@@ -641,6 +714,16 @@ func (NodeAddress) SwaggerDoc() map[string]string {
 	return map_NodeAddress
 }
 
+var map_NodeAffinity = map[string]string{
+	"": "Node affinity describes the nodes a pod may be and prefers to be scheduled onto.",
+	"requiredDuringSchedulingIgnoredDuringExecution":  "The pod is only scheduled onto nodes matching this selector. Pods already running on a node which stops matching it are not evicted.",
+	"preferredDuringSchedulingIgnoredDuringExecution": "The scheduler prefers nodes which match these terms, favoring the nodes for which the sum of the weights of the matching terms is largest.",
+}
+
+func (NodeAffinity) SwaggerDoc() map[string]string {
+	return map_NodeAffinity
+}
+
 var map_NodeCondition = map[string]string{
 	"":                   "NodeCondition contains condition infromation for a node.",
 	"type":               "Type of node condition, currently only Ready.",
@@ -674,12 +757,42 @@ func (NodeList) SwaggerDoc() map[string]string {
 	return map_NodeList
 }
 
+var map_NodeSelector = map[string]string{
+	"":                  "A node selector selects the nodes whose labels satisfy any of its terms.",
+	"nodeSelectorTerms": "Required. The terms of the selector.",
+}
+
+func (NodeSelector) SwaggerDoc() map[string]string {
+	return map_NodeSelector
+}
+
+var map_NodeSelectorRequirement = map[string]string{
+	"":         "A node selector requirement is a requirement on the value of a node label.",
+	"key":      "The label key that the requirement applies to.",
+	"operator": "Represents the key's relationship to the values. Valid operators are In, NotIn, Exists, DoesNotExist, Gt and Lt.",
+	"values":   "The values of the requirement. Must be non-empty for In and NotIn, empty for Exists and DoesNotExist, and hold a single integer for Gt and Lt.",
+}
+
+func (NodeSelectorRequirement) SwaggerDoc() map[string]string {
+	return map_NodeSelectorRequirement
+}
+
+var map_NodeSelectorTerm = map[string]string{
+	"":                 "A node selector term is a set of requirements which the labels of a node must all satisfy.",
+	"matchExpressions": "Required. The requirements of the term.",
+}
+
+func (NodeSelectorTerm) SwaggerDoc() map[string]string {
+	return map_NodeSelectorTerm
+}
+
 var map_NodeSpec = map[string]string{
 	"":              "NodeSpec describes the attributes that a node is created with.",
 	"podCIDR":       "PodCIDR represents the pod IP range assigned to the node.",
 	"externalID":    "External ID of the node assigned by some machine database (e.g. a cloud provider). Deprecated.",
 	"providerID":    "ID of the node assigned by the cloud provider in the format: <ProviderName>://<ProviderSpecificNodeID>",
 	"unschedulable": "Unschedulable controls node schedulability of new pods. By default, node is schedulable. More info: http://releases.k8s.io/HEAD/docs/admin/node.md#manual-node-administration\"`",
+	"taints":        "The taints of the node, which repel the pods that do not tolerate them.",
 }
 
 func (NodeSpec) SwaggerDoc() map[string]string {
@@ -740,6 +853,8 @@ var map_ObjectMeta = map[string]string{
 	"deletionGracePeriodSeconds": "Number of seconds allowed for this object to gracefully terminate before it will be removed from the system. Only set when deletionTimestamp is also set. May only be shortened. Read-only.",
 	"labels":                     "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://releases.k8s.io/HEAD/docs/user-guide/labels.md",
 	"annotations":                "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://releases.k8s.io/HEAD/docs/user-guide/annotations.md",
+	"ownerReferences":            "List of objects depended on by this object. If ALL objects in the list have been deleted, this object will be garbage collected. Owners must be in the same namespace as this object.",
+	"finalizers":                 "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. If the deletionTimestamp of the object is non-nil, entries in this list can only be removed.",
 }
 
 func (ObjectMeta) SwaggerDoc() map[string]string {
@@ -761,6 +876,18 @@ func (ObjectReference) SwaggerDoc() map[string]string {
 	return map_ObjectReference
 }
 
+var map_OwnerReference = map[string]string{
+	"":           "OwnerReference contains enough information to let you identify an owning object. Currently, an owning object must be in the same namespace, so there is no namespace field.",
+	"apiVersion": "API version of the referent.",
+	"kind":       "Kind of the referent. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds",
+	"name":       "Name of the referent. More info: http://releases.k8s.io/HEAD/docs/user-guide/identifiers.md#names",
+	"uid":        "UID of the referent. More info: http://releases.k8s.io/HEAD/docs/user-guide/identifiers.md#uids",
+}
+
+func (OwnerReference) SwaggerDoc() map[string]string {
+	return map_OwnerReference
+}
+
 var map_PersistentVolume = map[string]string{
 	"":         "PersistentVolume (PV) is a storage resource provisioned by an administrator. It is analogous to a node. More info: http://releases.k8s.io/HEAD/docs/user-guide/persistent-volumes.md",
 	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
@@ -887,6 +1014,37 @@ func (Pod) SwaggerDoc() map[string]string {
 	return map_Pod
 }
 
+var map_PodAffinity = map[string]string{
+	"": "Pod affinity describes the pods a pod must be or prefers to be co-located with.",
+	"requiredDuringSchedulingIgnoredDuringExecution":  "The pod is only scheduled onto nodes co-located with pods matching every one of these terms. Running pods are not evicted if the terms stop being satisfied.",
+	"preferredDuringSchedulingIgnoredDuringExecution": "The scheduler prefers nodes co-located with pods matching these terms, favoring the nodes for which the sum of the weights of the matching terms is largest.",
+}
+
+func (PodAffinity) SwaggerDoc() map[string]string {
+	return map_PodAffinity
+}
+
+var map_PodAffinityTerm = map[string]string{
+	"":              "A pod affinity term selects the pods which a pod should be, or should not be, co-located with. Two pods are co-located if they run on nodes with the same value of the topologyKey label.",
+	"labelSelector": "A label query over pods.",
+	"namespaces":    "The namespaces of the pods selected by labelSelector. If empty, the namespace of the pod the term belongs to.",
+	"topologyKey":   "The node label whose value defines the topology domain pods are co-located in, for example kubernetes.io/hostname for the same node, or a zone label for the same zone.",
+}
+
+func (PodAffinityTerm) SwaggerDoc() map[string]string {
+	return map_PodAffinityTerm
+}
+
+var map_PodAntiAffinity = map[string]string{
+	"": "Pod anti-affinity describes the pods a pod must not be or prefers not to be co-located with.",
+	"requiredDuringSchedulingIgnoredDuringExecution":  "The pod is only scheduled onto nodes not co-located with any pod matching any of these terms. Running pods are not evicted if the terms stop being satisfied.",
+	"preferredDuringSchedulingIgnoredDuringExecution": "The scheduler prefers nodes not co-located with pods matching these terms, avoiding the nodes for which the sum of the weights of the matching terms is largest.",
+}
+
+func (PodAntiAffinity) SwaggerDoc() map[string]string {
+	return map_PodAntiAffinity
+}
+
 var map_PodAttachOptions = map[string]string{
 	"":          "PodAttachOptions is the query options to a Pod's remote attach call.",
 	"stdin":     "Stdin if true, redirects the standard input stream of the pod for this call. Defaults to false.",
@@ -972,6 +1130,10 @@ var map_PodSpec = map[string]string{
 	"activeDeadlineSeconds":         "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer.",
 	"dnsPolicy":                     "Set DNS policy for containers within the pod. One of 'ClusterFirst' or 'Default'. Defaults to \"ClusterFirst\".",
 	"nodeSelector":                  "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md",
+	"affinity":                      "If specified, the pod's scheduling constraints, beyond those of nodeSelector. More info: http://releases.k8s.io/HEAD/docs/user-guide/node-selection/README.md",
+	"tolerations":                   "The tolerations of the pod, which allow it to be scheduled onto nodes with matching taints.",
+	"priorityClassName":             "If specified, the name of the PriorityClass the priority of the pod is resolved from. If empty, the priority of the global default PriorityClass is used, or zero if there is none.",
+	"priority":                      "The priority of the pod, resolved from priorityClassName by the Priority admission controller when the pod is created. Pods with a higher priority are scheduled first, and may preempt pods with a lower priority.",
 	"serviceAccountName":            "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md",
 	"serviceAccount":                "DeprecatedServiceAccount is a depreciated alias for ServiceAccountName. Deprecated: Use serviceAccountName instead.",
 	"nodeName":                      "NodeName is a request to schedule this pod onto a specific node. If it is non-empty, the scheduler simply schedules this pod onto that node, assuming that it fits resource requirements.",
@@ -994,6 +1156,7 @@ var map_PodStatus = map[string]string{
 	"hostIP":            "IP address of the host to which the pod is assigned. Empty if not yet scheduled.",
 	"podIP":             "IP address allocated to the pod. Routable at least within the cluster. Empty if not yet allocated.",
 	"startTime":         "RFC 3339 date and time at which the object was acknowledged by the Kubelet. This is before the Kubelet pulled the container image(s) for the pod.",
+	"nominatedNodeName": "The name of the node the scheduler preempted pods on to make room for this pod. The pod is expected to be scheduled onto that node once the preempted pods are gone, but may still be scheduled elsewhere.",
 	"containerStatuses": "The list has one entry per container in the manifest. Each entry is currently the output of `docker inspect`. More info: http://releases.k8s.io/HEAD/docs/user-guide/pod-states.md#container-statuses",
 }
 
@@ -1041,6 +1204,38 @@ func (PodTemplateSpec) SwaggerDoc() map[string]string {
 	return map_PodTemplateSpec
 }
 
+var map_PreferredSchedulingTerm = map[string]string{
+	"":           "A preferred scheduling term is a node selector term which the scheduler prefers nodes to satisfy.",
+	"weight":     "The weight of the term, in the range 1-100.",
+	"preference": "The node selector term which preferred nodes satisfy.",
+}
+
+func (PreferredSchedulingTerm) SwaggerDoc() map[string]string {
+	return map_PreferredSchedulingTerm
+}
+
+var map_PriorityClass = map[string]string{
+	"":              "PriorityClass maps a priority class name to the integer priority of the pods in that class.",
+	"metadata":      "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"value":         "The priority of the pods that name this class. The higher the value, the higher the priority. Must not be greater than 1000000000.",
+	"globalDefault": "If true, the value of this class is used for pods without a priorityClassName. At most one PriorityClass may be the global default.",
+	"description":   "A description of when this class should be used.",
+}
+
+func (PriorityClass) SwaggerDoc() map[string]string {
+	return map_PriorityClass
+}
+
+var map_PriorityClassList = map[string]string{
+	"":         "PriorityClassList is a resource containing a list of PriorityClass objects.",
+	"metadata": "More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of PriorityClasses.",
+}
+
+func (PriorityClassList) SwaggerDoc() map[string]string {
+	return map_PriorityClassList
+}
+
 var map_Probe = map[string]string{
 	"": "Probe describes a liveness probe to be examined to the container.",
 	"initialDelaySeconds": "Number of seconds after the container has started before liveness probes are initiated. More info: http://releases.k8s.io/HEAD/docs/user-guide/pod-states.md#container-probes",
@@ -1323,6 +1518,29 @@ func (TCPSocketAction) SwaggerDoc() map[string]string {
 	return map_TCPSocketAction
 }
 
+var map_Taint = map[string]string{
+	"":       "Taint marks a node so that the pods which do not tolerate it are kept off the node.",
+	"key":    "The taint key.",
+	"value":  "The taint value.",
+	"effect": "The effect of the taint on the pods that do not tolerate it: NoSchedule or PreferNoSchedule.",
+}
+
+func (Taint) SwaggerDoc() map[string]string {
+	return map_Taint
+}
+
+var map_Toleration = map[string]string{
+	"":         "Toleration allows a pod to be scheduled onto the nodes with the taints it matches.",
+	"key":      "The taint key that the toleration applies to.",
+	"operator": "Represents the key's relationship to the value: Exists or Equal. Defaults to Equal. Exists matches every taint with the key, whatever its value.",
+	"value":    "The taint value the toleration matches. Must be empty if the operator is Exists.",
+	"effect":   "The taint effect the toleration matches: NoSchedule or PreferNoSchedule. If empty, the toleration matches taints with any effect.",
+}
+
+func (Toleration) SwaggerDoc() map[string]string {
+	return map_Toleration
+}
+
 var map_Volume = map[string]string{
 	"":     "Volume represents a named volume in a pod that may be accessed by any container in the pod.",
 	"name": "Volume's name. Must be a DNS_LABEL and unique within the pod. More info: http://releases.k8s.io/HEAD/docs/user-guide/identifiers.md#names",
@@ -1360,10 +1578,21 @@ var map_VolumeSource = map[string]string{
 	"cephfs":      "CephFS represents a Ceph FS mount on the host that shares a pod's lifetime",
 	"downwardAPI": "DownwardAPI represents downward API about the pod that should populate this volume",
 	"fc":          "FC represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod.",
+	"configMap":   "ConfigMap represents a configMap that should populate this volume",
 }
 
 func (VolumeSource) SwaggerDoc() map[string]string {
 	return map_VolumeSource
 }
 
+var map_WeightedPodAffinityTerm = map[string]string{
+	"":                "A weighted pod affinity term is a pod affinity term which the scheduler prefers nodes to satisfy.",
+	"weight":          "The weight of the term, in the range 1-100.",
+	"podAffinityTerm": "The pod affinity term which preferred nodes satisfy.",
+}
+
+func (WeightedPodAffinityTerm) SwaggerDoc() map[string]string {
+	return map_WeightedPodAffinityTerm
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
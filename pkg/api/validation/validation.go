@@ -24,12 +24,14 @@ import (
 	"path"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/capabilities"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util"
 	errs "k8s.io/kubernetes/pkg/util/fielderrors"
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -164,6 +166,20 @@ func ValidateSecretName(name string, prefix bool) (bool, string) {
 	return NameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateConfigMapName can be used to check whether the given config map name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateConfigMapName(name string, prefix bool) (bool, string) {
+	return NameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePriorityClassName can be used to check whether the given priority class name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePriorityClassName(name string, prefix bool) (bool, string) {
+	return NameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceAccountName can be used to check whether the given service account name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -265,7 +281,45 @@ func ValidateObjectMeta(meta *api.ObjectMeta, requiresNamespace bool, nameFn Val
 	}
 	allErrs = append(allErrs, ValidateLabels(meta.Labels, "labels")...)
 	allErrs = append(allErrs, ValidateAnnotations(meta.Annotations, "annotations")...)
+	allErrs = append(allErrs, validateOwnerReferences(meta.OwnerReferences, meta.UID).Prefix("ownerReferences")...)
+	allErrs = append(allErrs, validateFinalizers(meta.Finalizers, "finalizers")...)
+
+	return allErrs
+}
 
+// validateFinalizers checks that every finalizer is a qualified name.
+func validateFinalizers(finalizers []string, field string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, finalizer := range finalizers {
+		if !validation.IsQualifiedName(finalizer) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("%s[%d]", field, i), finalizer, qualifiedNameErrorMsg))
+		}
+	}
+	return allErrs
+}
+
+// validateOwnerReferences checks that every owner reference fully identifies an
+// object, and that the object does not list itself as an owner.
+func validateOwnerReferences(ownerReferences []api.OwnerReference, uid types.UID) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, ref := range ownerReferences {
+		refErrs := errs.ValidationErrorList{}
+		if len(ref.APIVersion) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("apiVersion"))
+		}
+		if len(ref.Kind) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("kind"))
+		}
+		if len(ref.Name) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("name"))
+		}
+		if len(ref.UID) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("uid"))
+		} else if len(uid) != 0 && ref.UID == uid {
+			refErrs = append(refErrs, errs.NewFieldInvalid("uid", ref.UID, "an object cannot be its own owner"))
+		}
+		allErrs = append(allErrs, refErrs.PrefixIndex(i)...)
+	}
 	return allErrs
 }
 
@@ -290,6 +344,13 @@ func ValidateObjectMetaUpdate(new, old *api.ObjectMeta) errs.ValidationErrorList
 	if old.DeletionGracePeriodSeconds != nil && new.DeletionGracePeriodSeconds == nil {
 		new.DeletionGracePeriodSeconds = old.DeletionGracePeriodSeconds
 	}
+	// only deletion may mark an object for deletion
+	if old.DeletionTimestamp.IsZero() && !new.DeletionTimestamp.IsZero() {
+		allErrs = append(allErrs, errs.NewFieldInvalid("deletionTimestamp", new.DeletionTimestamp, "field is immutable; may only be set via deletion"))
+	}
+	if old.DeletionGracePeriodSeconds == nil && new.DeletionGracePeriodSeconds != nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("deletionGracePeriodSeconds", new.DeletionGracePeriodSeconds, "field is immutable; may only be set via deletion"))
+	}
 	if new.DeletionGracePeriodSeconds != nil && old.DeletionGracePeriodSeconds != nil && *new.DeletionGracePeriodSeconds != *old.DeletionGracePeriodSeconds {
 		allErrs = append(allErrs, errs.NewFieldInvalid("deletionGracePeriodSeconds", new.DeletionGracePeriodSeconds, "field is immutable; may only be changed via deletion"))
 	}
@@ -314,6 +375,19 @@ func ValidateObjectMetaUpdate(new, old *api.ObjectMeta) errs.ValidationErrorList
 
 	allErrs = append(allErrs, ValidateLabels(new.Labels, "labels")...)
 	allErrs = append(allErrs, ValidateAnnotations(new.Annotations, "annotations")...)
+	allErrs = append(allErrs, validateOwnerReferences(new.OwnerReferences, new.UID).Prefix("ownerReferences")...)
+	allErrs = append(allErrs, validateFinalizers(new.Finalizers, "finalizers")...)
+	// once an object is being deleted, finalizers may only be removed
+	if old.DeletionTimestamp != nil {
+		oldFinalizers := sets.NewString(old.Finalizers...)
+		for _, finalizer := range new.Finalizers {
+			if !oldFinalizers.Has(finalizer) {
+				forbiddenErr := errs.NewFieldForbidden("finalizers", finalizer)
+				forbiddenErr.Detail = "finalizers may not be added once the object is being deleted"
+				allErrs = append(allErrs, forbiddenErr)
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -403,6 +477,10 @@ func validateSource(source *api.VolumeSource) errs.ValidationErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateFCVolumeSource(source.FC).Prefix("fc")...)
 	}
+	if source.ConfigMap != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateConfigMapVolumeSource(source.ConfigMap).Prefix("configMap")...)
+	}
 	if numVolumes != 1 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("", source, "exactly 1 volume type is required"))
 	}
@@ -498,6 +576,14 @@ func validateSecretVolumeSource(secretSource *api.SecretVolumeSource) errs.Valid
 	return allErrs
 }
 
+func validateConfigMapVolumeSource(configMapSource *api.ConfigMapVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if configMapSource.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	}
+	return allErrs
+}
+
 func validatePersistentClaimVolumeSource(claim *api.PersistentVolumeClaimVolumeSource) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	if claim.ClaimName == "" {
@@ -788,11 +874,20 @@ func validateEnvVarValueFrom(ev api.EnvVar) errs.ValidationErrorList {
 
 	numSources := 0
 
-	switch {
-	case ev.ValueFrom.FieldRef != nil:
+	if ev.ValueFrom.FieldRef != nil {
 		numSources++
 		allErrs = append(allErrs, validateObjectFieldSelector(ev.ValueFrom.FieldRef, &validFieldPathExpressionsEnv).Prefix("fieldRef")...)
 	}
+	if ev.ValueFrom.ConfigMapKeyRef != nil {
+		numSources++
+		allErrs = append(allErrs, validateConfigMapKeySelector(ev.ValueFrom.ConfigMapKeyRef).Prefix("configMapKeyRef")...)
+	}
+
+	if numSources == 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", "", "must specify one of: `fieldRef` or `configMapKeyRef`"))
+	} else if numSources > 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", "", "may not have more than one field specified at a time"))
+	}
 
 	if ev.Value != "" && numSources != 0 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("", "", "sources cannot be specified when value is not empty"))
@@ -820,6 +915,21 @@ func validateObjectFieldSelector(fs *api.ObjectFieldSelector, expressions *sets.
 	return allErrs
 }
 
+func validateConfigMapKeySelector(s *api.ConfigMapKeySelector) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if len(s.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	}
+	if len(s.Key) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("key"))
+	} else if !IsSecretKey(s.Key) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("key", s.Key, fmt.Sprintf("must have at most %d characters and match regex %s", validation.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+	}
+
+	return allErrs
+}
+
 func validateVolumeMounts(mounts []api.VolumeMount, volumes sets.String) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 
@@ -1066,6 +1176,213 @@ func validateImagePullSecrets(imagePullSecrets []api.LocalObjectReference) errs.
 	return allErrors
 }
 
+var supportedNodeSelectorOperators = sets.NewString(
+	string(api.NodeSelectorOpIn),
+	string(api.NodeSelectorOpNotIn),
+	string(api.NodeSelectorOpExists),
+	string(api.NodeSelectorOpDoesNotExist),
+	string(api.NodeSelectorOpGt),
+	string(api.NodeSelectorOpLt),
+)
+
+func validateNodeSelectorRequirement(req api.NodeSelectorRequirement) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateLabelName(req.Key, "key")...)
+	switch req.Operator {
+	case api.NodeSelectorOpIn, api.NodeSelectorOpNotIn:
+		if len(req.Values) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired("values"))
+		}
+	case api.NodeSelectorOpExists, api.NodeSelectorOpDoesNotExist:
+		if len(req.Values) > 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", req.Values, "must be empty for the Exists and DoesNotExist operators"))
+		}
+	case api.NodeSelectorOpGt, api.NodeSelectorOpLt:
+		if len(req.Values) != 1 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", req.Values, "must hold a single value for the Gt and Lt operators"))
+		} else if _, err := strconv.ParseInt(req.Values[0], 10, 64); err != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", req.Values, "must be an integer for the Gt and Lt operators"))
+		}
+	default:
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("operator", req.Operator, supportedNodeSelectorOperators.List()))
+	}
+	for i, value := range req.Values {
+		if !validation.IsValidLabelValue(value) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("values[%d]", i), value, labelValueErrorMsg))
+		}
+	}
+	return allErrs
+}
+
+func validateNodeSelectorTerm(term api.NodeSelectorTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(term.MatchExpressions) == 0 {
+		return append(allErrs, errs.NewFieldRequired("matchExpressions"))
+	}
+	for i, req := range term.MatchExpressions {
+		allErrs = append(allErrs, validateNodeSelectorRequirement(req).PrefixIndex(i).Prefix("matchExpressions")...)
+	}
+	return allErrs
+}
+
+func validateNodeSelector(selector *api.NodeSelector) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(selector.NodeSelectorTerms) == 0 {
+		return append(allErrs, errs.NewFieldRequired("nodeSelectorTerms"))
+	}
+	for i, term := range selector.NodeSelectorTerms {
+		allErrs = append(allErrs, validateNodeSelectorTerm(term).PrefixIndex(i).Prefix("nodeSelectorTerms")...)
+	}
+	return allErrs
+}
+
+func validateNodeAffinity(affinity *api.NodeAffinity) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if affinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		allErrs = append(allErrs, validateNodeSelector(affinity.RequiredDuringSchedulingIgnoredDuringExecution).Prefix("requiredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	for i, term := range affinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		termErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			termErrs = append(termErrs, errs.NewFieldInvalid("weight", term.Weight, "must be in the range 1-100"))
+		}
+		termErrs = append(termErrs, validateNodeSelectorTerm(term.Preference).Prefix("preference")...)
+		allErrs = append(allErrs, termErrs.PrefixIndex(i).Prefix("preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	return allErrs
+}
+
+var supportedLabelSelectorOperators = sets.NewString(
+	string(api.LabelSelectorOpIn),
+	string(api.LabelSelectorOpNotIn),
+	string(api.LabelSelectorOpExists),
+	string(api.LabelSelectorOpDoesNotExist),
+)
+
+func validateLabelSelectorRequirement(req api.LabelSelectorRequirement) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateLabelName(req.Key, "key")...)
+	switch req.Operator {
+	case api.LabelSelectorOpIn, api.LabelSelectorOpNotIn:
+		if len(req.Values) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired("values"))
+		}
+	case api.LabelSelectorOpExists, api.LabelSelectorOpDoesNotExist:
+		if len(req.Values) > 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("values", req.Values, "must be empty for the Exists and DoesNotExist operators"))
+		}
+	default:
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("operator", req.Operator, supportedLabelSelectorOperators.List()))
+	}
+	for i, value := range req.Values {
+		if !validation.IsValidLabelValue(value) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("values[%d]", i), value, labelValueErrorMsg))
+		}
+	}
+	return allErrs
+}
+
+func validateLabelSelector(selector *api.LabelSelector) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateLabels(selector.MatchLabels, "matchLabels")...)
+	for i, req := range selector.MatchExpressions {
+		allErrs = append(allErrs, validateLabelSelectorRequirement(req).PrefixIndex(i).Prefix("matchExpressions")...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerm(term api.PodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if term.LabelSelector != nil {
+		allErrs = append(allErrs, validateLabelSelector(term.LabelSelector).Prefix("labelSelector")...)
+	}
+	for i, namespace := range term.Namespaces {
+		if ok, msg := ValidateNamespaceName(namespace, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("namespaces[%d]", i), namespace, msg))
+		}
+	}
+	if len(term.TopologyKey) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("topologyKey"))
+	} else {
+		allErrs = append(allErrs, ValidateLabelName(term.TopologyKey, "topologyKey")...)
+	}
+	return allErrs
+}
+
+func validatePodAffinityTerms(required []api.PodAffinityTerm, preferred []api.WeightedPodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, term := range required {
+		allErrs = append(allErrs, validatePodAffinityTerm(term).PrefixIndex(i).Prefix("requiredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	for i, term := range preferred {
+		termErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			termErrs = append(termErrs, errs.NewFieldInvalid("weight", term.Weight, "must be in the range 1-100"))
+		}
+		termErrs = append(termErrs, validatePodAffinityTerm(term.PodAffinityTerm).Prefix("podAffinityTerm")...)
+		allErrs = append(allErrs, termErrs.PrefixIndex(i).Prefix("preferredDuringSchedulingIgnoredDuringExecution")...)
+	}
+	return allErrs
+}
+
+func validateAffinity(affinity *api.Affinity) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if affinity.NodeAffinity != nil {
+		allErrs = append(allErrs, validateNodeAffinity(affinity.NodeAffinity).Prefix("nodeAffinity")...)
+	}
+	if affinity.PodAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAffinity")...)
+	}
+	if affinity.PodAntiAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).Prefix("podAntiAffinity")...)
+	}
+	return allErrs
+}
+
+var supportedTaintEffects = sets.NewString(string(api.TaintEffectNoSchedule), string(api.TaintEffectPreferNoSchedule))
+
+func validateTaintEffect(effect api.TaintEffect, allowEmpty bool) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(effect) == 0 {
+		if !allowEmpty {
+			allErrs = append(allErrs, errs.NewFieldRequired("effect"))
+		}
+	} else if !supportedTaintEffects.Has(string(effect)) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("effect", effect, supportedTaintEffects.List()))
+	}
+	return allErrs
+}
+
+var supportedTolerationOperators = sets.NewString(string(api.TolerationOpExists), string(api.TolerationOpEqual))
+
+func validateTolerations(tolerations []api.Toleration) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, toleration := range tolerations {
+		tolerationErrs := errs.ValidationErrorList{}
+		if len(toleration.Key) > 0 {
+			tolerationErrs = append(tolerationErrs, ValidateLabelName(toleration.Key, "key")...)
+		}
+		switch toleration.Operator {
+		case "", api.TolerationOpEqual:
+			if len(toleration.Key) == 0 {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldRequired("key"))
+			}
+			if !validation.IsValidLabelValue(toleration.Value) {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("value", toleration.Value, labelValueErrorMsg))
+			}
+		case api.TolerationOpExists:
+			if len(toleration.Value) > 0 {
+				tolerationErrs = append(tolerationErrs, errs.NewFieldInvalid("value", toleration.Value, "must be empty for the Exists operator"))
+			}
+		default:
+			tolerationErrs = append(tolerationErrs, errs.NewFieldValueNotSupported("operator", toleration.Operator, supportedTolerationOperators.List()))
+		}
+		tolerationErrs = append(tolerationErrs, validateTaintEffect(toleration.Effect, true)...)
+		allErrs = append(allErrs, tolerationErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
 // ValidatePod tests if required fields in the pod are set.
 func ValidatePod(pod *api.Pod) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1088,6 +1405,10 @@ func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
 	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy).Prefix("restartPolicy")...)
 	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy).Prefix("dnsPolicy")...)
 	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
+	if spec.Affinity != nil {
+		allErrs = append(allErrs, validateAffinity(spec.Affinity).Prefix("affinity")...)
+	}
+	allErrs = append(allErrs, validateTolerations(spec.Tolerations).Prefix("tolerations")...)
 	allErrs = append(allErrs, validateHostNetwork(spec.HostNetwork, spec.Containers).Prefix("hostNetwork")...)
 	allErrs = append(allErrs, validateImagePullSecrets(spec.ImagePullSecrets).Prefix("imagePullSecrets")...)
 	if len(spec.ServiceAccountName) > 0 {
@@ -1095,6 +1416,11 @@ func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
 			allErrs = append(allErrs, errs.NewFieldInvalid("serviceAccountName", spec.ServiceAccountName, msg))
 		}
 	}
+	if len(spec.PriorityClassName) > 0 {
+		if ok, msg := ValidatePriorityClassName(spec.PriorityClassName, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("priorityClassName", spec.PriorityClassName, msg))
+		}
+	}
 
 	if spec.ActiveDeadlineSeconds != nil {
 		if *spec.ActiveDeadlineSeconds <= 0 {
@@ -1144,6 +1470,11 @@ func ValidatePodStatusUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
 	if newPod.Spec.NodeName != oldPod.Spec.NodeName {
 		allErrs = append(allErrs, errs.NewFieldInvalid("status.nodeName", newPod.Spec.NodeName, "pod nodename cannot be changed directly"))
 	}
+	if len(newPod.Status.NominatedNodeName) > 0 {
+		if ok, msg := ValidateNodeName(newPod.Status.NominatedNodeName, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("status.nominatedNodeName", newPod.Status.NominatedNodeName, msg))
+		}
+	}
 
 	// For status update we ignore changes to pod spec.
 	newPod.Spec = oldPod.Spec
@@ -1390,6 +1721,31 @@ func ValidateReadOnlyPersistentDisks(volumes []api.Volume) errs.ValidationErrorL
 	return allErrs
 }
 
+func validateTaints(taints []api.Taint) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	seen := map[api.Taint]bool{}
+	for i, taint := range taints {
+		taintErrs := errs.ValidationErrorList{}
+		if len(taint.Key) == 0 {
+			taintErrs = append(taintErrs, errs.NewFieldRequired("key"))
+		} else {
+			taintErrs = append(taintErrs, ValidateLabelName(taint.Key, "key")...)
+		}
+		if !validation.IsValidLabelValue(taint.Value) {
+			taintErrs = append(taintErrs, errs.NewFieldInvalid("value", taint.Value, labelValueErrorMsg))
+		}
+		taintErrs = append(taintErrs, validateTaintEffect(taint.Effect, false)...)
+		// a node has at most one taint per key and effect
+		keyEffect := api.Taint{Key: taint.Key, Effect: taint.Effect}
+		if seen[keyEffect] {
+			taintErrs = append(taintErrs, errs.NewFieldDuplicate("key", taint.Key))
+		}
+		seen[keyEffect] = true
+		allErrs = append(allErrs, taintErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
 // ValidateNode tests if required fields in the node are set.
 func ValidateNode(node *api.Node) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -1401,6 +1757,7 @@ func ValidateNode(node *api.Node) errs.ValidationErrorList {
 	if len(node.Spec.ExternalID) == 0 {
 		allErrs = append(allErrs, errs.NewFieldRequired("spec.ExternalID"))
 	}
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
 
 	// TODO(rjnagal): Ignore PodCIDR till its completely implemented.
 	return allErrs
@@ -1417,6 +1774,8 @@ func ValidateNodeUpdate(oldNode *api.Node, node *api.Node) errs.ValidationErrorL
 	// 	allErrs = append(allErrs, errs.NewFieldInvalid("status", node.Status, "status must be empty"))
 	// }
 
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
+
 	// Validte no duplicate addresses in node status.
 	addresses := make(map[api.NodeAddress]bool)
 	for _, address := range node.Status.Addresses {
@@ -1435,6 +1794,8 @@ func ValidateNodeUpdate(oldNode *api.Node, node *api.Node) errs.ValidationErrorL
 	oldNode.Spec.PodCIDR = node.Spec.PodCIDR
 	// Allow users to unschedule node
 	oldNode.Spec.Unschedulable = node.Spec.Unschedulable
+	// Allow users to taint the node
+	oldNode.Spec.Taints = node.Spec.Taints
 	// Clear status
 	oldNode.Status = node.Status
 
@@ -1663,6 +2024,57 @@ func ValidateSecretUpdate(oldSecret, newSecret *api.Secret) errs.ValidationError
 	return allErrs
 }
 
+// ValidateConfigMap tests whether required fields in the ConfigMap are set.
+func ValidateConfigMap(cfg *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&cfg.ObjectMeta, true, ValidateConfigMapName).Prefix("metadata")...)
+
+	totalSize := 0
+	for key, value := range cfg.Data {
+		if !IsSecretKey(key) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", key), key, fmt.Sprintf("must have at most %d characters and match regex %s", validation.DNS1123SubdomainMaxLength, SecretKeyFmt)))
+		}
+
+		totalSize += len(value)
+	}
+
+	if totalSize > api.MaxConfigMapSize {
+		allErrs = append(allErrs, errs.NewFieldForbidden("data", "Maximum config map size exceeded"))
+	}
+
+	return allErrs
+}
+
+// ValidateConfigMapUpdate tests if required fields in the ConfigMap are set.
+func ValidateConfigMapUpdate(oldCfg, newCfg *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newCfg.ObjectMeta, &oldCfg.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateConfigMap(newCfg)...)
+	return allErrs
+}
+
+// ValidatePriorityClass tests whether required fields in the PriorityClass are set.
+func ValidatePriorityClass(pc *api.PriorityClass) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pc.ObjectMeta, false, ValidatePriorityClassName).Prefix("metadata")...)
+	if pc.Value > api.HighestUserDefinablePriority {
+		allErrs = append(allErrs, errs.NewFieldInvalid("value", pc.Value, fmt.Sprintf("must not be greater than %d", api.HighestUserDefinablePriority)))
+	}
+	return allErrs
+}
+
+// ValidatePriorityClassUpdate tests if required fields in the PriorityClass are set,
+// and that its value is not changed.
+func ValidatePriorityClassUpdate(oldPC, newPC *api.PriorityClass) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newPC.ObjectMeta, &oldPC.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePriorityClass(newPC)...)
+	if newPC.Value != oldPC.Value {
+		allErrs = append(allErrs, errs.NewFieldInvalid("value", newPC.Value, "field is immutable"))
+	}
+	return allErrs
+}
+
 func validateBasicResource(quantity resource.Quantity) errs.ValidationErrorList {
 	if quantity.Value() < 0 {
 		return errs.ValidationErrorList{errs.NewFieldInvalid("", quantity.Value(), "must be a valid resource quantity")}
@@ -1795,7 +2207,13 @@ func ValidateNamespaceUpdate(newNamespace *api.Namespace, oldNamespace *api.Name
 // that cannot be changed.
 func ValidateNamespaceStatusUpdate(newNamespace, oldNamespace *api.Namespace) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
-	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newNamespace.ObjectMeta, &oldNamespace.ObjectMeta).Prefix("metadata")...)
+	// the first deletion of a namespace marks it for deletion along with its
+	// terminating phase through a status update
+	oldMeta := oldNamespace.ObjectMeta
+	if oldMeta.DeletionTimestamp.IsZero() {
+		oldMeta.DeletionTimestamp = newNamespace.DeletionTimestamp
+	}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&newNamespace.ObjectMeta, &oldMeta).Prefix("metadata")...)
 	newNamespace.Spec = oldNamespace.Spec
 	if newNamespace.DeletionTimestamp.IsZero() {
 		if newNamespace.Status.Phase != api.NamespaceActive {
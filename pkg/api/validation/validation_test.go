@@ -115,6 +115,107 @@ func TestValidateObjectMetaTrimsTrailingSlash(t *testing.T) {
 	}
 }
 
+func TestValidateObjectMetaOwnerReferences(t *testing.T) {
+	validRef := api.OwnerReference{APIVersion: "v1", Kind: "ReplicationController", Name: "rc", UID: "rc-uid"}
+	successCases := [][]api.OwnerReference{
+		nil,
+		{validRef},
+		{validRef, {APIVersion: "experimental/v1alpha1", Kind: "Deployment", Name: "d", UID: "d-uid"}},
+	}
+	for i, refs := range successCases {
+		meta := &api.ObjectMeta{Name: "test", Namespace: "default", UID: "pod-uid", OwnerReferences: refs}
+		if errs := ValidateObjectMeta(meta, true, NameIsDNSSubdomain); len(errs) != 0 {
+			t.Errorf("case[%d]: unexpected errors: %v", i, errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		refs  []api.OwnerReference
+		field string
+	}{
+		"missing apiVersion": {[]api.OwnerReference{{Kind: "ReplicationController", Name: "rc", UID: "rc-uid"}}, "ownerReferences[0].apiVersion"},
+		"missing kind":       {[]api.OwnerReference{{APIVersion: "v1", Name: "rc", UID: "rc-uid"}}, "ownerReferences[0].kind"},
+		"missing name":       {[]api.OwnerReference{validRef, {APIVersion: "v1", Kind: "ReplicationController", UID: "rc-uid"}}, "ownerReferences[1].name"},
+		"missing uid":        {[]api.OwnerReference{{APIVersion: "v1", Kind: "ReplicationController", Name: "rc"}}, "ownerReferences[0].uid"},
+		"self reference":     {[]api.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "test", UID: "pod-uid"}}, "ownerReferences[0].uid"},
+	}
+	for k, v := range errorCases {
+		meta := &api.ObjectMeta{Name: "test", Namespace: "default", UID: "pod-uid", OwnerReferences: v.refs}
+		errs := ValidateObjectMeta(meta, true, NameIsDNSSubdomain)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", k, errs)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.field {
+			t.Errorf("%s: expected error on field %q, got %q", k, v.field, field)
+		}
+	}
+}
+
+func TestValidateObjectMetaFinalizers(t *testing.T) {
+	meta := &api.ObjectMeta{Name: "test", Namespace: "default", Finalizers: []string{"kubernetes.io/pv-protection", "example.com/cleanup"}}
+	if errs := ValidateObjectMeta(meta, true, NameIsDNSSubdomain); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	meta.Finalizers = []string{"not a qualified name"}
+	if errs := ValidateObjectMeta(meta, true, NameIsDNSSubdomain); len(errs) != 1 || errs[0].(*errors.ValidationError).Field != "finalizers[0]" {
+		t.Errorf("expected one error on finalizers[0], got %v", errs)
+	}
+}
+
+func TestValidateObjectMetaUpdateFinalizers(t *testing.T) {
+	now := unversioned.Now()
+	tests := map[string]struct {
+		old, new []string
+		deleting bool
+		valid    bool
+	}{
+		"add while live":         {old: []string{"a.io/x"}, new: []string{"a.io/x", "b.io/y"}, valid: true},
+		"remove while deleting":  {old: []string{"a.io/x", "b.io/y"}, new: []string{"b.io/y"}, deleting: true, valid: true},
+		"clear while deleting":   {old: []string{"a.io/x"}, new: nil, deleting: true, valid: true},
+		"add while deleting":     {old: []string{"a.io/x"}, new: []string{"a.io/x", "b.io/y"}, deleting: true},
+		"replace while deleting": {old: []string{"a.io/x"}, new: []string{"b.io/y"}, deleting: true},
+	}
+	for k, v := range tests {
+		old := &api.ObjectMeta{Name: "test", ResourceVersion: "1", Finalizers: v.old}
+		new := &api.ObjectMeta{Name: "test", ResourceVersion: "1", Finalizers: v.new}
+		if v.deleting {
+			old.DeletionTimestamp = &now
+		}
+		errs := ValidateObjectMetaUpdate(new, old)
+		if v.valid && len(errs) != 0 {
+			t.Errorf("%s: unexpected errors: %v", k, errs)
+		}
+		if !v.valid && len(errs) == 0 {
+			t.Errorf("%s: expected errors", k)
+		}
+	}
+}
+
+func TestValidateObjectMetaUpdateDeletionFields(t *testing.T) {
+	now := unversioned.Now()
+	period := int64(0)
+	old := &api.ObjectMeta{Name: "test", ResourceVersion: "1"}
+	new := &api.ObjectMeta{Name: "test", ResourceVersion: "1", DeletionTimestamp: &now}
+	if errs := ValidateObjectMetaUpdate(new, old); len(errs) != 1 || errs[0].(*errors.ValidationError).Field != "deletionTimestamp" {
+		t.Errorf("expected one error on deletionTimestamp, got %v", errs)
+	}
+	new = &api.ObjectMeta{Name: "test", ResourceVersion: "1", DeletionGracePeriodSeconds: &period}
+	if errs := ValidateObjectMetaUpdate(new, old); len(errs) != 1 || errs[0].(*errors.ValidationError).Field != "deletionGracePeriodSeconds" {
+		t.Errorf("expected one error on deletionGracePeriodSeconds, got %v", errs)
+	}
+
+	// fields set by deletion are carried over and may not be changed
+	old = &api.ObjectMeta{Name: "test", ResourceVersion: "1", DeletionTimestamp: &now, DeletionGracePeriodSeconds: &period}
+	new = &api.ObjectMeta{Name: "test", ResourceVersion: "1"}
+	if errs := ValidateObjectMetaUpdate(new, old); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if new.DeletionTimestamp != old.DeletionTimestamp || new.DeletionGracePeriodSeconds != old.DeletionGracePeriodSeconds {
+		t.Errorf("expected deletion fields to be preserved, got %#v", new)
+	}
+}
+
 func TestValidateLabels(t *testing.T) {
 	successCases := []map[string]string{
 		{"simple": "bar"},
@@ -488,12 +589,13 @@ func TestValidateVolumes(t *testing.T) {
 				FieldPath:  "metadata.labels"}},
 		}}}},
 		{Name: "fc", VolumeSource: api.VolumeSource{FC: &api.FCVolumeSource{[]string{"some_wwn"}, &lun, "ext4", false}}},
+		{Name: "configmap", VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{LocalObjectReference: api.LocalObjectReference{Name: "my-config"}}}},
 	}
 	names, errs := validateVolumes(successCase)
 	if len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
 	}
-	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret", "iscsidisk", "cinder", "cephfs", "fc") {
+	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret", "iscsidisk", "cinder", "cephfs", "fc", "configmap") {
 		t.Errorf("wrong names result: %v", names)
 	}
 	emptyVS := api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}
@@ -531,6 +633,7 @@ func TestValidateVolumes(t *testing.T) {
 	}}
 	zeroWWN := api.VolumeSource{FC: &api.FCVolumeSource{[]string{}, &lun, "ext4", false}}
 	emptyLun := api.VolumeSource{FC: &api.FCVolumeSource{[]string{"wwn"}, nil, "ext4", false}}
+	emptyConfigMap := api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{}}
 	errorCases := map[string]struct {
 		V []api.Volume
 		T errors.ValidationErrorType
@@ -555,6 +658,7 @@ func TestValidateVolumes(t *testing.T) {
 		"dot dot first level dirent ": {[]api.Volume{{Name: "dotdotdirfilename", VolumeSource: dotDotFirstLevelDirent}}, errors.ValidationErrorTypeInvalid, "[0].source.downwardApi.path", "must not start with \"..\"."},
 		"empty wwn":                   {[]api.Volume{{Name: "badimage", VolumeSource: zeroWWN}}, errors.ValidationErrorTypeRequired, "[0].source.fc.targetWWNs", ""},
 		"empty lun":                   {[]api.Volume{{Name: "badimage", VolumeSource: emptyLun}}, errors.ValidationErrorTypeRequired, "[0].source.fc.lun", ""},
+		"empty configmap name":        {[]api.Volume{{Name: "badconfig", VolumeSource: emptyConfigMap}}, errors.ValidationErrorTypeRequired, "[0].source.configMap.name", ""},
 	}
 	for k, v := range errorCases {
 		_, errs := validateVolumes(v.V)
@@ -651,6 +755,15 @@ func TestValidateEnv(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "abc",
+			ValueFrom: &api.EnvVarSource{
+				ConfigMapKeyRef: &api.ConfigMapKeySelector{
+					LocalObjectReference: api.LocalObjectReference{Name: "some-config-map"},
+					Key:                  "some-key",
+				},
+			},
+		},
 	}
 	if errs := validateEnv(successCase); len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -761,6 +874,68 @@ func TestValidateEnv(t *testing.T) {
 			}},
 			expectedError: "[0].valueFrom.fieldRef.fieldPath: unsupported value 'status.phase', Details: supported values: metadata.name, metadata.namespace, status.podIP",
 		},
+		{
+			name: "no source",
+			envs: []api.EnvVar{{
+				Name:      "abc",
+				ValueFrom: &api.EnvVarSource{},
+			}},
+			expectedError: "[0].valueFrom: invalid value '', Details: must specify one of: `fieldRef` or `configMapKeyRef`",
+		},
+		{
+			name: "fieldRef and configMapKeyRef specified",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					FieldRef: &api.ObjectFieldSelector{
+						APIVersion: testapi.Default.Version(),
+						FieldPath:  "metadata.name",
+					},
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						LocalObjectReference: api.LocalObjectReference{Name: "some-config-map"},
+						Key:                  "some-key",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom: invalid value '', Details: may not have more than one field specified at a time",
+		},
+		{
+			name: "missing name on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						Key: "some-key",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.name: required value",
+		},
+		{
+			name: "missing key on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						LocalObjectReference: api.LocalObjectReference{Name: "some-config-map"},
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.key: required value",
+		},
+		{
+			name: "invalid key on ConfigMapKeySelector",
+			envs: []api.EnvVar{{
+				Name: "abc",
+				ValueFrom: &api.EnvVarSource{
+					ConfigMapKeyRef: &api.ConfigMapKeySelector{
+						LocalObjectReference: api.LocalObjectReference{Name: "some-config-map"},
+						Key:                  "a key",
+					},
+				},
+			}},
+			expectedError: "[0].valueFrom.configMapKeyRef.key: invalid value 'a key', Details: must have at most 253 characters and match regex \\.?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*",
+		},
 	}
 	for _, tc := range errorCases {
 		if errs := validateEnv(tc.envs); len(errs) == 0 {
@@ -1248,6 +1423,12 @@ func TestValidatePodSpec(t *testing.T) {
 			ActiveDeadlineSeconds: &activeDeadlineSeconds,
 			ServiceAccountName:    "acct",
 		},
+		{ // Populate PriorityClassName.
+			Containers:        []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:     api.RestartPolicyAlways,
+			DNSPolicy:         api.DNSClusterFirst,
+			PriorityClassName: "valid.priority-class",
+		},
 		{ // Populate HostNetwork.
 			Containers: []api.Container{
 				{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent", Ports: []api.ContainerPort{
@@ -1308,6 +1489,12 @@ func TestValidatePodSpec(t *testing.T) {
 			DNSPolicy:          api.DNSClusterFirst,
 			ServiceAccountName: "invalidName",
 		},
+		"bad priority class name": {
+			Containers:        []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:     api.RestartPolicyAlways,
+			DNSPolicy:         api.DNSClusterFirst,
+			PriorityClassName: "invalid_name",
+		},
 		"bad restart policy": {
 			RestartPolicy: "UnknowPolicy",
 			DNSPolicy:     api.DNSClusterFirst,
@@ -1521,7 +1708,7 @@ func TestValidatePodUpdate(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "foo"},
 				Spec:       api.PodSpec{Containers: []api.Container{{Image: "foo:V1"}}},
 			},
-			true,
+			false,
 			"deletion timestamp filled out",
 		},
 		{
@@ -2510,6 +2697,19 @@ func TestValidateNode(t *testing.T) {
 				ExternalID: "external",
 			},
 		},
+		{
+			ObjectMeta: api.ObjectMeta{
+				Name: "dedicated",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectPreferNoSchedule},
+					{Key: "example.com/gpu", Effect: api.TaintEffectNoSchedule},
+				},
+			},
+		},
 	}
 	for _, successCase := range successCases {
 		if errs := ValidateNode(&successCase); len(errs) != 0 {
@@ -2561,6 +2761,54 @@ func TestValidateNode(t *testing.T) {
 				},
 			},
 		},
+		"missing-taint-effect": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints:     []api.Taint{{Key: "dedicated", Value: "team-a"}},
+			},
+		},
+		"invalid-taint-effect": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints:     []api.Taint{{Key: "dedicated", Value: "team-a", Effect: "Evict"}},
+			},
+		},
+		"invalid-taint-key": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints:     []api.Taint{{Key: "NoUppercaseOrSpecialCharsLike=Equals", Effect: api.TaintEffectNoSchedule}},
+			},
+		},
+		"invalid-taint-value": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints:     []api.Taint{{Key: "dedicated", Value: "team a", Effect: api.TaintEffectNoSchedule}},
+			},
+		},
+		"duplicate-taint": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				ExternalID: "external",
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "team-b", Effect: api.TaintEffectNoSchedule},
+				},
+			},
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateNode(&v)
@@ -2570,11 +2818,15 @@ func TestValidateNode(t *testing.T) {
 		for i := range errs {
 			field := errs[i].(*errors.ValidationError).Field
 			expectedFields := map[string]bool{
-				"metadata.name":        true,
-				"metadata.labels":      true,
-				"metadata.annotations": true,
-				"metadata.namespace":   true,
-				"spec.ExternalID":      true,
+				"metadata.name":         true,
+				"metadata.labels":       true,
+				"metadata.annotations":  true,
+				"metadata.namespace":    true,
+				"spec.ExternalID":       true,
+				"spec.taints[0].key":    true,
+				"spec.taints[0].value":  true,
+				"spec.taints[0].effect": true,
+				"spec.taints[1].key":    true,
 			}
 			if expectedFields[field] == false {
 				t.Errorf("%s: missing prefix for: %v", k, errs[i])
@@ -2751,6 +3003,30 @@ func TestValidateNodeUpdate(t *testing.T) {
 				},
 			},
 		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule}},
+			},
+		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "team-a"}},
+			},
+		}, false},
 	}
 	for i, test := range tests {
 		test.oldNode.ObjectMeta.ResourceVersion = "1"
@@ -3551,6 +3827,196 @@ func TestValidateSecret(t *testing.T) {
 	}
 }
 
+func TestValidateConfigMap(t *testing.T) {
+	validConfigMap := func() api.ConfigMap {
+		return api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Data: map[string]string{
+				"data-1": "bar",
+			},
+		}
+	}
+
+	var (
+		emptyName     = validConfigMap()
+		invalidName   = validConfigMap()
+		emptyNs       = validConfigMap()
+		overMaxSize   = validConfigMap()
+		invalidKey    = validConfigMap()
+		leadingDotKey = validConfigMap()
+		dotKey        = validConfigMap()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	emptyNs.Namespace = ""
+	overMaxSize.Data = map[string]string{
+		"over": strings.Repeat("a", api.MaxConfigMapSize+1),
+	}
+	invalidKey.Data["a..b"] = "whoops"
+	leadingDotKey.Data[".key"] = "bar"
+	dotKey.Data["."] = "bar"
+
+	tests := map[string]struct {
+		cfg   api.ConfigMap
+		valid bool
+	}{
+		"valid":           {validConfigMap(), true},
+		"empty name":      {emptyName, false},
+		"invalid name":    {invalidName, false},
+		"empty namespace": {emptyNs, false},
+		"over max size":   {overMaxSize, false},
+		"invalid key":     {invalidKey, false},
+		"leading dot key": {leadingDotKey, true},
+		"dot key":         {dotKey, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateConfigMap(&tc.cfg)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateConfigMapUpdate(t *testing.T) {
+	newConfigMap := func(version, name, namespace string, data map[string]string) api.ConfigMap {
+		return api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				ResourceVersion: version,
+			},
+			Data: data,
+		}
+	}
+
+	var (
+		validConfigMap = newConfigMap("1", "validname", "validns", map[string]string{"key": "value"})
+		updatedData    = newConfigMap("1", "validname", "validns", map[string]string{"key": "other"})
+		renamed        = newConfigMap("1", "othername", "validns", map[string]string{"key": "value"})
+		invalidData    = newConfigMap("1", "validname", "validns", map[string]string{"a key": "value"})
+	)
+
+	cases := []struct {
+		name    string
+		newCfg  api.ConfigMap
+		oldCfg  api.ConfigMap
+		isValid bool
+	}{
+		{"valid", validConfigMap, validConfigMap, true},
+		{"updated data", updatedData, validConfigMap, true},
+		{"renamed", renamed, validConfigMap, false},
+		{"invalid data", invalidData, validConfigMap, false},
+	}
+
+	for _, tc := range cases {
+		errs := ValidateConfigMapUpdate(&tc.oldCfg, &tc.newCfg)
+		if tc.isValid && len(errs) > 0 {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		}
+		if !tc.isValid && len(errs) == 0 {
+			t.Errorf("%v: unexpected non-error", tc.name)
+		}
+	}
+}
+
+func TestValidatePriorityClass(t *testing.T) {
+	validPriorityClass := func() api.PriorityClass {
+		return api.PriorityClass{
+			ObjectMeta: api.ObjectMeta{Name: "high"},
+			Value:      1000,
+		}
+	}
+
+	var (
+		emptyName     = validPriorityClass()
+		invalidName   = validPriorityClass()
+		withNs        = validPriorityClass()
+		negative      = validPriorityClass()
+		highest       = validPriorityClass()
+		overHighest   = validPriorityClass()
+		globalDefault = validPriorityClass()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	withNs.Namespace = "bar"
+	negative.Value = -10
+	highest.Value = api.HighestUserDefinablePriority
+	overHighest.Value = api.HighestUserDefinablePriority + 1
+	globalDefault.GlobalDefault = true
+
+	tests := map[string]struct {
+		pc    api.PriorityClass
+		valid bool
+	}{
+		"valid":              {validPriorityClass(), true},
+		"empty name":         {emptyName, false},
+		"invalid name":       {invalidName, false},
+		"with namespace":     {withNs, false},
+		"negative value":     {negative, true},
+		"highest value":      {highest, true},
+		"value over highest": {overHighest, false},
+		"global default":     {globalDefault, true},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePriorityClass(&tc.pc)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePriorityClassUpdate(t *testing.T) {
+	newPriorityClass := func(version, name string, value int, description string) api.PriorityClass {
+		return api.PriorityClass{
+			ObjectMeta: api.ObjectMeta{
+				Name:            name,
+				ResourceVersion: version,
+			},
+			Value:       value,
+			Description: description,
+		}
+	}
+
+	var (
+		validPriorityClass = newPriorityClass("1", "high", 1000, "")
+		updatedDescription = newPriorityClass("1", "high", 1000, "for critical pods")
+		updatedValue       = newPriorityClass("1", "high", 2000, "")
+		renamed            = newPriorityClass("1", "other", 1000, "")
+	)
+
+	cases := []struct {
+		name    string
+		newPC   api.PriorityClass
+		oldPC   api.PriorityClass
+		isValid bool
+	}{
+		{"valid", validPriorityClass, validPriorityClass, true},
+		{"updated description", updatedDescription, validPriorityClass, true},
+		{"updated value", updatedValue, validPriorityClass, false},
+		{"renamed", renamed, validPriorityClass, false},
+	}
+
+	for _, tc := range cases {
+		errs := ValidatePriorityClassUpdate(&tc.oldPC, &tc.newPC)
+		if tc.isValid && len(errs) > 0 {
+			t.Errorf("%v: unexpected error: %v", tc.name, errs)
+		}
+		if !tc.isValid && len(errs) == 0 {
+			t.Errorf("%v: unexpected non-error", tc.name)
+		}
+	}
+}
+
 func TestValidateDockerConfigSecret(t *testing.T) {
 	validDockerSecret := func() api.Secret {
 		return api.Secret{
@@ -3925,3 +4391,265 @@ func TestValidPodLogOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAffinity(t *testing.T) {
+	successCases := []api.Affinity{
+		{},
+		{NodeAffinity: &api.NodeAffinity{}},
+		{
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+					NodeSelectorTerms: []api.NodeSelectorTerm{
+						{
+							MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "kubernetes.io/e2e-az-name", Operator: api.NodeSelectorOpIn, Values: []string{"e2e-az1", "e2e-az2"}},
+								{Key: "retiring", Operator: api.NodeSelectorOpDoesNotExist},
+							},
+						},
+						{
+							MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "cores", Operator: api.NodeSelectorOpGt, Values: []string{"8"}},
+							},
+						},
+					},
+				},
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{
+					{
+						Weight: 10,
+						Preference: api.NodeSelectorTerm{
+							MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "disktype", Operator: api.NodeSelectorOpExists},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	successCases = append(successCases, api.Affinity{
+		PodAffinity: &api.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+				{
+					LabelSelector: &api.LabelSelector{
+						MatchLabels: map[string]string{"app": "cache"},
+						MatchExpressions: []api.LabelSelectorRequirement{
+							{Key: "tier", Operator: api.LabelSelectorOpIn, Values: []string{"backend"}},
+						},
+					},
+					Namespaces:  []string{"ns1", "ns2"},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			},
+		},
+		PodAntiAffinity: &api.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: api.PodAffinityTerm{
+						LabelSelector: &api.LabelSelector{
+							MatchExpressions: []api.LabelSelectorRequirement{
+								{Key: "app", Operator: api.LabelSelectorOpExists},
+							},
+						},
+						TopologyKey: "failure-domain.beta.kubernetes.io/zone",
+					},
+				},
+			},
+		},
+	})
+	for i := range successCases {
+		if errs := validateAffinity(&successCases[i]); len(errs) != 0 {
+			t.Errorf("expected success for %d: %v", i, errs)
+		}
+	}
+
+	required := func(reqs ...api.NodeSelectorRequirement) api.Affinity {
+		return api.Affinity{
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+					NodeSelectorTerms: []api.NodeSelectorTerm{{MatchExpressions: reqs}},
+				},
+			},
+		}
+	}
+	failureCases := map[string]struct {
+		affinity api.Affinity
+		field    string
+	}{
+		"no terms": {
+			api.Affinity{NodeAffinity: &api.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{}}},
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms",
+		},
+		"no expressions": {
+			required(),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions",
+		},
+		"invalid key": {
+			required(api.NodeSelectorRequirement{Key: "a b", Operator: api.NodeSelectorOpExists}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].key",
+		},
+		"unsupported operator": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: "Equals", Values: []string{"b"}}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].operator",
+		},
+		"In without values": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: api.NodeSelectorOpIn}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values",
+		},
+		"Exists with values": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: api.NodeSelectorOpExists, Values: []string{"b"}}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values",
+		},
+		"Gt with two values": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: api.NodeSelectorOpGt, Values: []string{"1", "2"}}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values",
+		},
+		"Lt with a non-integer": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: api.NodeSelectorOpLt, Values: []string{"b"}}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values",
+		},
+		"invalid value": {
+			required(api.NodeSelectorRequirement{Key: "a", Operator: api.NodeSelectorOpIn, Values: []string{"b c"}}),
+			"nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms[0].matchExpressions[0].values[0]",
+		},
+		"weight out of range": {
+			api.Affinity{
+				NodeAffinity: &api.NodeAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{
+						{
+							Weight: 101,
+							Preference: api.NodeSelectorTerm{
+								MatchExpressions: []api.NodeSelectorRequirement{{Key: "a", Operator: api.NodeSelectorOpExists}},
+							},
+						},
+					},
+				},
+			},
+			"nodeAffinity.preferredDuringSchedulingIgnoredDuringExecution[0].weight",
+		},
+		"pod affinity without topology key": {
+			api.Affinity{
+				PodAffinity: &api.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{LabelSelector: &api.LabelSelector{MatchLabels: map[string]string{"app": "cache"}}},
+					},
+				},
+			},
+			"podAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].topologyKey",
+		},
+		"pod affinity with invalid namespace": {
+			api.Affinity{
+				PodAffinity: &api.PodAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{Namespaces: []string{"Not_A_Namespace"}, TopologyKey: "kubernetes.io/hostname"},
+					},
+				},
+			},
+			"podAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].namespaces[0]",
+		},
+		"pod anti-affinity with unsupported label selector operator": {
+			api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{
+							LabelSelector: &api.LabelSelector{
+								MatchExpressions: []api.LabelSelectorRequirement{{Key: "app", Operator: "Gt", Values: []string{"1"}}},
+							},
+							TopologyKey: "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+			"podAntiAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].labelSelector.matchExpressions[0].operator",
+		},
+		"pod anti-affinity with invalid label selector value": {
+			api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []api.PodAffinityTerm{
+						{
+							LabelSelector: &api.LabelSelector{MatchLabels: map[string]string{"app": "not a value"}},
+							TopologyKey:   "kubernetes.io/hostname",
+						},
+					},
+				},
+			},
+			"podAntiAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].labelSelector.matchLabels",
+		},
+		"pod anti-affinity weight out of range": {
+			api.Affinity{
+				PodAntiAffinity: &api.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{
+						{Weight: 0, PodAffinityTerm: api.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname"}},
+					},
+				},
+			},
+			"podAntiAffinity.preferredDuringSchedulingIgnoredDuringExecution[0].weight",
+		},
+	}
+	for k, v := range failureCases {
+		errs := validateAffinity(&v.affinity)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.field {
+			t.Errorf("%s: expected an error for %q, got %v", k, v.field, errs)
+		}
+	}
+}
+
+func TestValidateTolerations(t *testing.T) {
+	successCases := [][]api.Toleration{
+		{{Key: "dedicated", Value: "team-a"}},
+		{{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "team-a", Effect: api.TaintEffectNoSchedule}},
+		{{Key: "example.com/gpu", Operator: api.TolerationOpExists, Effect: api.TaintEffectPreferNoSchedule}},
+		{{Operator: api.TolerationOpExists}},
+	}
+	for i, tolerations := range successCases {
+		if errs := validateTolerations(tolerations); len(errs) != 0 {
+			t.Errorf("%d: expected success: %v", i, errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		tolerations []api.Toleration
+		field       string
+	}{
+		"invalid key": {
+			tolerations: []api.Toleration{{Key: "NoUppercaseOrSpecialCharsLike=Equals", Operator: api.TolerationOpExists}},
+			field:       "[0].key",
+		},
+		"missing key for Equal": {
+			tolerations: []api.Toleration{{Value: "team-a"}},
+			field:       "[0].key",
+		},
+		"invalid value": {
+			tolerations: []api.Toleration{{Key: "dedicated", Value: "team a"}},
+			field:       "[0].value",
+		},
+		"value for Exists": {
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpExists, Value: "team-a"}},
+			field:       "[0].value",
+		},
+		"unsupported operator": {
+			tolerations: []api.Toleration{{Key: "dedicated", Operator: "In", Value: "team-a"}},
+			field:       "[0].operator",
+		},
+		"unsupported effect": {
+			tolerations: []api.Toleration{{Key: "dedicated", Value: "team-a", Effect: "Evict"}},
+			field:       "[0].effect",
+		},
+	}
+	for k, v := range errorCases {
+		errs := validateTolerations(v.tolerations)
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		for i := range errs {
+			if field := errs[i].(*errors.ValidationError).Field; field != v.field {
+				t.Errorf("%s: expected field %q, got %q", k, v.field, field)
+			}
+		}
+	}
+}
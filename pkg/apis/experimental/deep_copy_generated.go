@@ -37,6 +37,34 @@ func deepCopy_api_AWSElasticBlockStoreVolumeSource(in api.AWSElasticBlockStoreVo
 	return nil
 }
 
+func deepCopy_api_Affinity(in api.Affinity, out *api.Affinity, c *conversion.Cloner) error {
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(api.NodeAffinity)
+		if err := deepCopy_api_NodeAffinity(*in.NodeAffinity, out.NodeAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(api.PodAffinity)
+		if err := deepCopy_api_PodAffinity(*in.PodAffinity, out.PodAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(api.PodAntiAffinity)
+		if err := deepCopy_api_PodAntiAffinity(*in.PodAntiAffinity, out.PodAntiAffinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Capabilities(in api.Capabilities, out *api.Capabilities, c *conversion.Cloner) error {
 	if in.Add != nil {
 		out.Add = make([]api.Capability, len(in.Add))
@@ -87,6 +115,21 @@ func deepCopy_api_CinderVolumeSource(in api.CinderVolumeSource, out *api.CinderV
 	return nil
 }
 
+func deepCopy_api_ConfigMapKeySelector(in api.ConfigMapKeySelector, out *api.ConfigMapKeySelector, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func deepCopy_api_ConfigMapVolumeSource(in api.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, c *conversion.Cloner) error {
+	if err := deepCopy_api_LocalObjectReference(in.LocalObjectReference, &out.LocalObjectReference, c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func deepCopy_api_Container(in api.Container, out *api.Container, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Image = in.Image
@@ -238,6 +281,14 @@ func deepCopy_api_EnvVarSource(in api.EnvVarSource, out *api.EnvVarSource, c *co
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := deepCopy_api_ConfigMapKeySelector(*in.ConfigMapKeyRef, out.ConfigMapKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
 	return nil
 }
 
@@ -346,6 +397,42 @@ func deepCopy_api_ISCSIVolumeSource(in api.ISCSIVolumeSource, out *api.ISCSIVolu
 	return nil
 }
 
+func deepCopy_api_LabelSelector(in api.LabelSelector, out *api.LabelSelector, c *conversion.Cloner) error {
+	if in.MatchLabels != nil {
+		out.MatchLabels = make(map[string]string)
+		for key, val := range in.MatchLabels {
+			out.MatchLabels[key] = val
+		}
+	} else {
+		out.MatchLabels = nil
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.LabelSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := deepCopy_api_LabelSelectorRequirement(in.MatchExpressions[i], &out.MatchExpressions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func deepCopy_api_LabelSelectorRequirement(in api.LabelSelectorRequirement, out *api.LabelSelectorRequirement, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Lifecycle(in api.Lifecycle, out *api.Lifecycle, c *conversion.Cloner) error {
 	if in.PostStart != nil {
 		out.PostStart = new(api.Handler)
@@ -398,6 +485,70 @@ func deepCopy_api_NFSVolumeSource(in api.NFSVolumeSource, out *api.NFSVolumeSour
 	return nil
 }
 
+func deepCopy_api_NodeAffinity(in api.NodeAffinity, out *api.NodeAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(api.NodeSelector)
+		if err := deepCopy_api_NodeSelector(*in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, c); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PreferredSchedulingTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelector(in api.NodeSelector, out *api.NodeSelector, c *conversion.Cloner) error {
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]api.NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := deepCopy_api_NodeSelectorTerm(in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorRequirement(in api.NodeSelectorRequirement, out *api.NodeSelectorRequirement, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_api_NodeSelectorTerm(in api.NodeSelectorTerm, out *api.NodeSelectorTerm, c *conversion.Cloner) error {
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := deepCopy_api_NodeSelectorRequirement(in.MatchExpressions[i], &out.MatchExpressions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ObjectFieldSelector(in api.ObjectFieldSelector, out *api.ObjectFieldSelector, c *conversion.Cloner) error {
 	out.APIVersion = in.APIVersion
 	out.FieldPath = in.FieldPath
@@ -445,6 +596,43 @@ func deepCopy_api_ObjectMeta(in api.ObjectMeta, out *api.ObjectMeta, c *conversi
 	} else {
 		out.Annotations = nil
 	}
+	if in.OwnerReferences != nil {
+		out.OwnerReferences = make([]api.OwnerReference, len(in.OwnerReferences))
+		for i := range in.OwnerReferences {
+			if err := deepCopy_api_OwnerReference(in.OwnerReferences[i], &out.OwnerReferences[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OwnerReferences = nil
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]string, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = in.Finalizers[i]
+		}
+	} else {
+		out.Finalizers = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ObjectReference(in api.ObjectReference, out *api.ObjectReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.UID = in.UID
+	out.APIVersion = in.APIVersion
+	out.ResourceVersion = in.ResourceVersion
+	out.FieldPath = in.FieldPath
+	return nil
+}
+
+func deepCopy_api_OwnerReference(in api.OwnerReference, out *api.OwnerReference, c *conversion.Cloner) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.UID = in.UID
 	return nil
 }
 
@@ -454,6 +642,75 @@ func deepCopy_api_PersistentVolumeClaimVolumeSource(in api.PersistentVolumeClaim
 	return nil
 }
 
+func deepCopy_api_PodAffinity(in api.PodAffinity, out *api.PodAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodAffinityTerm(in api.PodAffinityTerm, out *api.PodAffinityTerm, c *conversion.Cloner) error {
+	if in.LabelSelector != nil {
+		out.LabelSelector = new(api.LabelSelector)
+		if err := deepCopy_api_LabelSelector(*in.LabelSelector, out.LabelSelector, c); err != nil {
+			return err
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func deepCopy_api_PodAntiAffinity(in api.PodAntiAffinity, out *api.PodAntiAffinity, c *conversion.Cloner) error {
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_PodAffinityTerm(in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := deepCopy_api_WeightedPodAffinityTerm(in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
 func deepCopy_api_PodSpec(in api.PodSpec, out *api.PodSpec, c *conversion.Cloner) error {
 	if in.Volumes != nil {
 		out.Volumes = make([]api.Volume, len(in.Volumes))
@@ -497,6 +754,31 @@ func deepCopy_api_PodSpec(in api.PodSpec, out *api.PodSpec, c *conversion.Cloner
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := deepCopy_api_Affinity(*in.Affinity, out.Affinity, c); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := deepCopy_api_Toleration(in.Tolerations[i], &out.Tolerations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.Priority != nil {
+		out.Priority = new(int)
+		*out.Priority = *in.Priority
+	} else {
+		out.Priority = nil
+	}
 	out.ServiceAccountName = in.ServiceAccountName
 	out.NodeName = in.NodeName
 	out.HostNetwork = in.HostNetwork
@@ -525,6 +807,14 @@ func deepCopy_api_PodTemplateSpec(in api.PodTemplateSpec, out *api.PodTemplateSp
 	return nil
 }
 
+func deepCopy_api_PreferredSchedulingTerm(in api.PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_NodeSelectorTerm(in.Preference, &out.Preference, c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func deepCopy_api_Probe(in api.Probe, out *api.Probe, c *conversion.Cloner) error {
 	if err := deepCopy_api_Handler(in.Handler, &out.Handler, c); err != nil {
 		return err
@@ -641,6 +931,14 @@ func deepCopy_api_TCPSocketAction(in api.TCPSocketAction, out *api.TCPSocketActi
 	return nil
 }
 
+func deepCopy_api_Toleration(in api.Toleration, out *api.Toleration, c *conversion.Cloner) error {
+	out.Key = in.Key
+	out.Operator = in.Operator
+	out.Value = in.Value
+	out.Effect = in.Effect
+	return nil
+}
+
 func deepCopy_api_Volume(in api.Volume, out *api.Volume, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if err := deepCopy_api_VolumeSource(in.VolumeSource, &out.VolumeSource, c); err != nil {
@@ -777,6 +1075,22 @@ func deepCopy_api_VolumeSource(in api.VolumeSource, out *api.VolumeSource, c *co
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := deepCopy_api_ConfigMapVolumeSource(*in.ConfigMap, out.ConfigMap, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
+	return nil
+}
+
+func deepCopy_api_WeightedPodAffinityTerm(in api.WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if err := deepCopy_api_PodAffinityTerm(in.PodAffinityTerm, &out.PodAffinityTerm, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -799,6 +1113,7 @@ func deepCopy_resource_Quantity(in resource.Quantity, out *resource.Quantity, c
 func deepCopy_unversioned_ListMeta(in unversioned.ListMeta, out *unversioned.ListMeta, c *conversion.Cloner) error {
 	out.SelfLink = in.SelfLink
 	out.ResourceVersion = in.ResourceVersion
+	out.Continue = in.Continue
 	return nil
 }
 
@@ -820,6 +1135,98 @@ func deepCopy_unversioned_TypeMeta(in unversioned.TypeMeta, out *unversioned.Typ
 func deepCopy_experimental_APIVersion(in APIVersion, out *APIVersion, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.APIGroup = in.APIGroup
+	if in.Schema != nil {
+		out.Schema = new(JSONSchemaProps)
+		if err := deepCopy_experimental_JSONSchemaProps(*in.Schema, out.Schema, c); err != nil {
+			return err
+		}
+	} else {
+		out.Schema = nil
+	}
+	out.ConversionHook = in.ConversionHook
+	return nil
+}
+
+func deepCopy_experimental_ClusterRole(in ClusterRole, out *ClusterRole, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.Rules != nil {
+		out.Rules = make([]PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := deepCopy_experimental_PolicyRule(in.Rules[i], &out.Rules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func deepCopy_experimental_ClusterRoleBinding(in ClusterRoleBinding, out *ClusterRoleBinding, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := deepCopy_experimental_Subject(in.Subjects[i], &out.Subjects[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := deepCopy_api_ObjectReference(in.RoleRef, &out.RoleRef, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_experimental_ClusterRoleBindingList(in ClusterRoleBindingList, out *ClusterRoleBindingList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ClusterRoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_experimental_ClusterRoleBinding(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_experimental_ClusterRoleList(in ClusterRoleList, out *ClusterRoleList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ClusterRole, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_experimental_ClusterRole(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1137,6 +1544,68 @@ func deepCopy_experimental_IngressStatus(in IngressStatus, out *IngressStatus, c
 	return nil
 }
 
+func deepCopy_experimental_JSONSchemaProps(in JSONSchemaProps, out *JSONSchemaProps, c *conversion.Cloner) error {
+	out.Description = in.Description
+	out.Type = in.Type
+	if in.Properties != nil {
+		out.Properties = make(map[string]JSONSchemaProps)
+		for key, val := range in.Properties {
+			newVal := new(JSONSchemaProps)
+			if err := deepCopy_experimental_JSONSchemaProps(val, newVal, c); err != nil {
+				return err
+			}
+			out.Properties[key] = *newVal
+		}
+	} else {
+		out.Properties = nil
+	}
+	if in.Required != nil {
+		out.Required = make([]string, len(in.Required))
+		for i := range in.Required {
+			out.Required[i] = in.Required[i]
+		}
+	} else {
+		out.Required = nil
+	}
+	out.AdditionalProperties = in.AdditionalProperties
+	if in.Items != nil {
+		out.Items = new(JSONSchemaProps)
+		if err := deepCopy_experimental_JSONSchemaProps(*in.Items, out.Items, c); err != nil {
+			return err
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Enum != nil {
+		out.Enum = make([]string, len(in.Enum))
+		for i := range in.Enum {
+			out.Enum[i] = in.Enum[i]
+		}
+	} else {
+		out.Enum = nil
+	}
+	out.Pattern = in.Pattern
+	if in.Minimum != nil {
+		out.Minimum = new(int64)
+		*out.Minimum = *in.Minimum
+	} else {
+		out.Minimum = nil
+	}
+	if in.Maximum != nil {
+		out.Maximum = new(int64)
+		*out.Maximum = *in.Maximum
+	} else {
+		out.Maximum = nil
+	}
+	if in.MaxLength != nil {
+		out.MaxLength = new(int64)
+		*out.MaxLength = *in.MaxLength
+	} else {
+		out.MaxLength = nil
+	}
+	return nil
+}
+
 func deepCopy_experimental_Job(in Job, out *Job, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1252,6 +1721,34 @@ func deepCopy_experimental_JobStatus(in JobStatus, out *JobStatus, c *conversion
 	return nil
 }
 
+func deepCopy_experimental_PolicyRule(in PolicyRule, out *PolicyRule, c *conversion.Cloner) error {
+	if in.Verbs != nil {
+		out.Verbs = make([]string, len(in.Verbs))
+		for i := range in.Verbs {
+			out.Verbs[i] = in.Verbs[i]
+		}
+	} else {
+		out.Verbs = nil
+	}
+	if in.APIGroups != nil {
+		out.APIGroups = make([]string, len(in.APIGroups))
+		for i := range in.APIGroups {
+			out.APIGroups[i] = in.APIGroups[i]
+		}
+	} else {
+		out.APIGroups = nil
+	}
+	if in.Resources != nil {
+		out.Resources = make([]string, len(in.Resources))
+		for i := range in.Resources {
+			out.Resources[i] = in.Resources[i]
+		}
+	} else {
+		out.Resources = nil
+	}
+	return nil
+}
+
 func deepCopy_experimental_ReplicationControllerDummy(in ReplicationControllerDummy, out *ReplicationControllerDummy, c *conversion.Cloner) error {
 	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
 		return err
@@ -1267,6 +1764,89 @@ func deepCopy_experimental_ResourceConsumption(in ResourceConsumption, out *Reso
 	return nil
 }
 
+func deepCopy_experimental_Role(in Role, out *Role, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.Rules != nil {
+		out.Rules = make([]PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := deepCopy_experimental_PolicyRule(in.Rules[i], &out.Rules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func deepCopy_experimental_RoleBinding(in RoleBinding, out *RoleBinding, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ObjectMeta(in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := deepCopy_experimental_Subject(in.Subjects[i], &out.Subjects[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := deepCopy_api_ObjectReference(in.RoleRef, &out.RoleRef, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_experimental_RoleBindingList(in RoleBindingList, out *RoleBindingList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]RoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_experimental_RoleBinding(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_experimental_RoleList(in RoleList, out *RoleList, c *conversion.Cloner) error {
+	if err := deepCopy_unversioned_TypeMeta(in.TypeMeta, &out.TypeMeta, c); err != nil {
+		return err
+	}
+	if err := deepCopy_unversioned_ListMeta(in.ListMeta, &out.ListMeta, c); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]Role, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_experimental_Role(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
 func deepCopy_experimental_RollingUpdateDeployment(in RollingUpdateDeployment, out *RollingUpdateDeployment, c *conversion.Cloner) error {
 	if err := deepCopy_util_IntOrString(in.MaxUnavailable, &out.MaxUnavailable, c); err != nil {
 		return err
@@ -1312,6 +1892,13 @@ func deepCopy_experimental_ScaleStatus(in ScaleStatus, out *ScaleStatus, c *conv
 	return nil
 }
 
+func deepCopy_experimental_Subject(in Subject, out *Subject, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
 func deepCopy_experimental_SubresourceReference(in SubresourceReference, out *SubresourceReference, c *conversion.Cloner) error {
 	out.Kind = in.Kind
 	out.Namespace = in.Namespace
@@ -1410,9 +1997,12 @@ func deepCopy_util_IntOrString(in util.IntOrString, out *util.IntOrString, c *co
 func init() {
 	err := api.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_api_AWSElasticBlockStoreVolumeSource,
+		deepCopy_api_Affinity,
 		deepCopy_api_Capabilities,
 		deepCopy_api_CephFSVolumeSource,
 		deepCopy_api_CinderVolumeSource,
+		deepCopy_api_ConfigMapKeySelector,
+		deepCopy_api_ConfigMapVolumeSource,
 		deepCopy_api_Container,
 		deepCopy_api_ContainerPort,
 		deepCopy_api_DownwardAPIVolumeFile,
@@ -1429,16 +2019,28 @@ func init() {
 		deepCopy_api_Handler,
 		deepCopy_api_HostPathVolumeSource,
 		deepCopy_api_ISCSIVolumeSource,
+		deepCopy_api_LabelSelector,
+		deepCopy_api_LabelSelectorRequirement,
 		deepCopy_api_Lifecycle,
 		deepCopy_api_LoadBalancerIngress,
 		deepCopy_api_LoadBalancerStatus,
 		deepCopy_api_LocalObjectReference,
 		deepCopy_api_NFSVolumeSource,
+		deepCopy_api_NodeAffinity,
+		deepCopy_api_NodeSelector,
+		deepCopy_api_NodeSelectorRequirement,
+		deepCopy_api_NodeSelectorTerm,
 		deepCopy_api_ObjectFieldSelector,
 		deepCopy_api_ObjectMeta,
+		deepCopy_api_ObjectReference,
+		deepCopy_api_OwnerReference,
 		deepCopy_api_PersistentVolumeClaimVolumeSource,
+		deepCopy_api_PodAffinity,
+		deepCopy_api_PodAffinityTerm,
+		deepCopy_api_PodAntiAffinity,
 		deepCopy_api_PodSpec,
 		deepCopy_api_PodTemplateSpec,
+		deepCopy_api_PreferredSchedulingTerm,
 		deepCopy_api_Probe,
 		deepCopy_api_RBDVolumeSource,
 		deepCopy_api_ResourceRequirements,
@@ -1446,14 +2048,20 @@ func init() {
 		deepCopy_api_SecretVolumeSource,
 		deepCopy_api_SecurityContext,
 		deepCopy_api_TCPSocketAction,
+		deepCopy_api_Toleration,
 		deepCopy_api_Volume,
 		deepCopy_api_VolumeMount,
 		deepCopy_api_VolumeSource,
+		deepCopy_api_WeightedPodAffinityTerm,
 		deepCopy_resource_Quantity,
 		deepCopy_unversioned_ListMeta,
 		deepCopy_unversioned_Time,
 		deepCopy_unversioned_TypeMeta,
 		deepCopy_experimental_APIVersion,
+		deepCopy_experimental_ClusterRole,
+		deepCopy_experimental_ClusterRoleBinding,
+		deepCopy_experimental_ClusterRoleBindingList,
+		deepCopy_experimental_ClusterRoleList,
 		deepCopy_experimental_DaemonSet,
 		deepCopy_experimental_DaemonSetList,
 		deepCopy_experimental_DaemonSetSpec,
@@ -1474,17 +2082,24 @@ func init() {
 		deepCopy_experimental_IngressRule,
 		deepCopy_experimental_IngressSpec,
 		deepCopy_experimental_IngressStatus,
+		deepCopy_experimental_JSONSchemaProps,
 		deepCopy_experimental_Job,
 		deepCopy_experimental_JobCondition,
 		deepCopy_experimental_JobList,
 		deepCopy_experimental_JobSpec,
 		deepCopy_experimental_JobStatus,
+		deepCopy_experimental_PolicyRule,
 		deepCopy_experimental_ReplicationControllerDummy,
 		deepCopy_experimental_ResourceConsumption,
+		deepCopy_experimental_Role,
+		deepCopy_experimental_RoleBinding,
+		deepCopy_experimental_RoleBindingList,
+		deepCopy_experimental_RoleList,
 		deepCopy_experimental_RollingUpdateDeployment,
 		deepCopy_experimental_Scale,
 		deepCopy_experimental_ScaleSpec,
 		deepCopy_experimental_ScaleStatus,
+		deepCopy_experimental_Subject,
 		deepCopy_experimental_SubresourceReference,
 		deepCopy_experimental_ThirdPartyResource,
 		deepCopy_experimental_ThirdPartyResourceData,
@@ -66,7 +66,10 @@ func init() {
 
 	// the list of kinds that are scoped at the root of the api hierarchy
 	// if a kind is not enumerated here, it is assumed to have a namespace scope
-	rootScoped := sets.NewString()
+	rootScoped := sets.NewString(
+		"ClusterRole",
+		"ClusterRoleBinding",
+	)
 
 	ignoredKinds := sets.NewString()
 
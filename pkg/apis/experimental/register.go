@@ -44,6 +44,14 @@ func addKnownTypes() {
 		&ThirdPartyResourceDataList{},
 		&Ingress{},
 		&IngressList{},
+		&Role{},
+		&RoleList{},
+		&RoleBinding{},
+		&RoleBindingList{},
+		&ClusterRole{},
+		&ClusterRoleList{},
+		&ClusterRoleBinding{},
+		&ClusterRoleBindingList{},
 	)
 }
 
@@ -63,3 +71,11 @@ func (*ThirdPartyResourceData) IsAnAPIObject()      {}
 func (*ThirdPartyResourceDataList) IsAnAPIObject()  {}
 func (*Ingress) IsAnAPIObject()                     {}
 func (*IngressList) IsAnAPIObject()                 {}
+func (*Role) IsAnAPIObject()                        {}
+func (*RoleList) IsAnAPIObject()                    {}
+func (*RoleBinding) IsAnAPIObject()                 {}
+func (*RoleBindingList) IsAnAPIObject()             {}
+func (*ClusterRole) IsAnAPIObject()                 {}
+func (*ClusterRoleList) IsAnAPIObject()             {}
+func (*ClusterRoleBinding) IsAnAPIObject()          {}
+func (*ClusterRoleBindingList) IsAnAPIObject()      {}
@@ -43,6 +43,41 @@ func convert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolu
 	return autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource(in, out, s)
 }
 
+func autoconvert_api_Affinity_To_v1_Affinity(in *api.Affinity, out *v1.Affinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Affinity))(in)
+	}
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(v1.NodeAffinity)
+		if err := convert_api_NodeAffinity_To_v1_NodeAffinity(in.NodeAffinity, out.NodeAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAffinity = nil
+	}
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(v1.PodAffinity)
+		if err := convert_api_PodAffinity_To_v1_PodAffinity(in.PodAffinity, out.PodAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(v1.PodAntiAffinity)
+		if err := convert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in.PodAntiAffinity, out.PodAntiAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
+func convert_api_Affinity_To_v1_Affinity(in *api.Affinity, out *v1.Affinity, s conversion.Scope) error {
+	return autoconvert_api_Affinity_To_v1_Affinity(in, out, s)
+}
+
 func autoconvert_api_Capabilities_To_v1_Capabilities(in *api.Capabilities, out *v1.Capabilities, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Capabilities))(in)
@@ -114,6 +149,35 @@ func convert_api_CinderVolumeSource_To_v1_CinderVolumeSource(in *api.CinderVolum
 	return autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource(in, out, s)
 }
 
+func autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *v1.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapKeySelector))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in *api.ConfigMapKeySelector, out *v1.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *v1.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in *api.ConfigMapVolumeSource, out *v1.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_api_Container_To_v1_Container(in *api.Container, out *v1.Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Container))(in)
@@ -310,6 +374,14 @@ func autoconvert_api_EnvVarSource_To_v1_EnvVarSource(in *api.EnvVarSource, out *
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(v1.ConfigMapKeySelector)
+		if err := convert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
 	return nil
 }
 
@@ -485,6 +557,56 @@ func convert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in *api.ISCSIVolumeSo
 	return autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_api_LabelSelector_To_v1_LabelSelector(in *api.LabelSelector, out *v1.LabelSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.LabelSelector))(in)
+	}
+	if in.MatchLabels != nil {
+		out.MatchLabels = make(map[string]string)
+		for key, val := range in.MatchLabels {
+			out.MatchLabels[key] = val
+		}
+	} else {
+		out.MatchLabels = nil
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]v1.LabelSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_api_LabelSelector_To_v1_LabelSelector(in *api.LabelSelector, out *v1.LabelSelector, s conversion.Scope) error {
+	return autoconvert_api_LabelSelector_To_v1_LabelSelector(in, out, s)
+}
+
+func autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in *api.LabelSelectorRequirement, out *v1.LabelSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.LabelSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = v1.LabelSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in *api.LabelSelectorRequirement, out *v1.LabelSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement(in, out, s)
+}
+
 func autoconvert_api_Lifecycle_To_v1_Lifecycle(in *api.Lifecycle, out *v1.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Lifecycle))(in)
@@ -572,6 +694,98 @@ func convert_api_NFSVolumeSource_To_v1_NFSVolumeSource(in *api.NFSVolumeSource,
 	return autoconvert_api_NFSVolumeSource_To_v1_NFSVolumeSource(in, out, s)
 }
 
+func autoconvert_api_NodeAffinity_To_v1_NodeAffinity(in *api.NodeAffinity, out *v1.NodeAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(v1.NodeSelector)
+		if err := convert_api_NodeSelector_To_v1_NodeSelector(in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, s); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]v1.PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_NodeAffinity_To_v1_NodeAffinity(in *api.NodeAffinity, out *v1.NodeAffinity, s conversion.Scope) error {
+	return autoconvert_api_NodeAffinity_To_v1_NodeAffinity(in, out, s)
+}
+
+func autoconvert_api_NodeSelector_To_v1_NodeSelector(in *api.NodeSelector, out *v1.NodeSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelector))(in)
+	}
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]v1.NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(&in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelector_To_v1_NodeSelector(in *api.NodeSelector, out *v1.NodeSelector, s conversion.Scope) error {
+	return autoconvert_api_NodeSelector_To_v1_NodeSelector(in, out, s)
+}
+
+func autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in *api.NodeSelectorRequirement, out *v1.NodeSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = v1.NodeSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in *api.NodeSelectorRequirement, out *v1.NodeSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(in, out, s)
+}
+
+func autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in *api.NodeSelectorTerm, out *v1.NodeSelectorTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.NodeSelectorTerm))(in)
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]v1.NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in *api.NodeSelectorTerm, out *v1.NodeSelectorTerm, s conversion.Scope) error {
+	return autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(in, out, s)
+}
+
 func autoconvert_api_ObjectFieldSelector_To_v1_ObjectFieldSelector(in *api.ObjectFieldSelector, out *v1.ObjectFieldSelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.ObjectFieldSelector))(in)
@@ -628,6 +842,24 @@ func autoconvert_api_ObjectMeta_To_v1_ObjectMeta(in *api.ObjectMeta, out *v1.Obj
 	} else {
 		out.Annotations = nil
 	}
+	if in.OwnerReferences != nil {
+		out.OwnerReferences = make([]v1.OwnerReference, len(in.OwnerReferences))
+		for i := range in.OwnerReferences {
+			if err := convert_api_OwnerReference_To_v1_OwnerReference(&in.OwnerReferences[i], &out.OwnerReferences[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OwnerReferences = nil
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]string, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = in.Finalizers[i]
+		}
+	} else {
+		out.Finalizers = nil
+	}
 	return nil
 }
 
@@ -635,6 +867,39 @@ func convert_api_ObjectMeta_To_v1_ObjectMeta(in *api.ObjectMeta, out *v1.ObjectM
 	return autoconvert_api_ObjectMeta_To_v1_ObjectMeta(in, out, s)
 }
 
+func autoconvert_api_ObjectReference_To_v1_ObjectReference(in *api.ObjectReference, out *v1.ObjectReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ObjectReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.UID = in.UID
+	out.APIVersion = in.APIVersion
+	out.ResourceVersion = in.ResourceVersion
+	out.FieldPath = in.FieldPath
+	return nil
+}
+
+func convert_api_ObjectReference_To_v1_ObjectReference(in *api.ObjectReference, out *v1.ObjectReference, s conversion.Scope) error {
+	return autoconvert_api_ObjectReference_To_v1_ObjectReference(in, out, s)
+}
+
+func autoconvert_api_OwnerReference_To_v1_OwnerReference(in *api.OwnerReference, out *v1.OwnerReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.OwnerReference))(in)
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.UID = in.UID
+	return nil
+}
+
+func convert_api_OwnerReference_To_v1_OwnerReference(in *api.OwnerReference, out *v1.OwnerReference, s conversion.Scope) error {
+	return autoconvert_api_OwnerReference_To_v1_OwnerReference(in, out, s)
+}
+
 func autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource(in *api.PersistentVolumeClaimVolumeSource, out *v1.PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.PersistentVolumeClaimVolumeSource))(in)
@@ -648,6 +913,96 @@ func convert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVo
 	return autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource(in, out, s)
 }
 
+func autoconvert_api_PodAffinity_To_v1_PodAffinity(in *api.PodAffinity, out *v1.PodAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]v1.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]v1.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_PodAffinity_To_v1_PodAffinity(in *api.PodAffinity, out *v1.PodAffinity, s conversion.Scope) error {
+	return autoconvert_api_PodAffinity_To_v1_PodAffinity(in, out, s)
+}
+
+func autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in *api.PodAffinityTerm, out *v1.PodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAffinityTerm))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = new(v1.LabelSelector)
+		if err := convert_api_LabelSelector_To_v1_LabelSelector(in.LabelSelector, out.LabelSelector, s); err != nil {
+			return err
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in *api.PodAffinityTerm, out *v1.PodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm(in, out, s)
+}
+
+func autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in *api.PodAntiAffinity, out *v1.PodAntiAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PodAntiAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]v1.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]v1.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in *api.PodAntiAffinity, out *v1.PodAntiAffinity, s conversion.Scope) error {
+	return autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity(in, out, s)
+}
+
 func autoconvert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *v1.PodSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.PodSpec))(in)
@@ -694,6 +1049,31 @@ func autoconvert_api_PodSpec_To_v1_PodSpec(in *api.PodSpec, out *v1.PodSpec, s c
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(v1.Affinity)
+		if err := convert_api_Affinity_To_v1_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]v1.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_api_Toleration_To_v1_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.Priority != nil {
+		out.Priority = new(int)
+		*out.Priority = *in.Priority
+	} else {
+		out.Priority = nil
+	}
 	out.ServiceAccountName = in.ServiceAccountName
 	out.NodeName = in.NodeName
 	out.HostNetwork = in.HostNetwork
@@ -729,6 +1109,21 @@ func convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(in *api.PodTemplateSpec,
 	return autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec(in, out, s)
 }
 
+func autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in *api.PreferredSchedulingTerm, out *v1.PreferredSchedulingTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.PreferredSchedulingTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm(&in.Preference, &out.Preference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in *api.PreferredSchedulingTerm, out *v1.PreferredSchedulingTerm, s conversion.Scope) error {
+	return autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm(in, out, s)
+}
+
 func autoconvert_api_Probe_To_v1_Probe(in *api.Probe, out *v1.Probe, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Probe))(in)
@@ -894,6 +1289,21 @@ func convert_api_TCPSocketAction_To_v1_TCPSocketAction(in *api.TCPSocketAction,
 	return autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_api_Toleration_To_v1_Toleration(in *api.Toleration, out *v1.Toleration, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.Toleration))(in)
+	}
+	out.Key = in.Key
+	out.Operator = v1.TolerationOperator(in.Operator)
+	out.Value = in.Value
+	out.Effect = v1.TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_api_Toleration_To_v1_Toleration(in *api.Toleration, out *v1.Toleration, s conversion.Scope) error {
+	return autoconvert_api_Toleration_To_v1_Toleration(in, out, s)
+}
+
 func autoconvert_api_Volume_To_v1_Volume(in *api.Volume, out *v1.Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.Volume))(in)
@@ -1047,6 +1457,14 @@ func autoconvert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(v1.ConfigMapVolumeSource)
+		if err := convert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -1054,6 +1472,21 @@ func convert_api_VolumeSource_To_v1_VolumeSource(in *api.VolumeSource, out *v1.V
 	return autoconvert_api_VolumeSource_To_v1_VolumeSource(in, out, s)
 }
 
+func autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in *api.WeightedPodAffinityTerm, out *v1.WeightedPodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.WeightedPodAffinityTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_api_PodAffinityTerm_To_v1_PodAffinityTerm(&in.PodAffinityTerm, &out.PodAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in *api.WeightedPodAffinityTerm, out *v1.WeightedPodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm(in, out, s)
+}
+
 func autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource(in *v1.AWSElasticBlockStoreVolumeSource, out *api.AWSElasticBlockStoreVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.AWSElasticBlockStoreVolumeSource))(in)
@@ -1069,25 +1502,60 @@ func convert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolu
 	return autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource(in, out, s)
 }
 
-func autoconvert_v1_Capabilities_To_api_Capabilities(in *v1.Capabilities, out *api.Capabilities, s conversion.Scope) error {
+func autoconvert_v1_Affinity_To_api_Affinity(in *v1.Affinity, out *api.Affinity, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1.Capabilities))(in)
+		defaulting.(func(*v1.Affinity))(in)
 	}
-	if in.Add != nil {
-		out.Add = make([]api.Capability, len(in.Add))
-		for i := range in.Add {
-			out.Add[i] = api.Capability(in.Add[i])
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = new(api.NodeAffinity)
+		if err := convert_v1_NodeAffinity_To_api_NodeAffinity(in.NodeAffinity, out.NodeAffinity, s); err != nil {
+			return err
 		}
 	} else {
-		out.Add = nil
+		out.NodeAffinity = nil
 	}
-	if in.Drop != nil {
-		out.Drop = make([]api.Capability, len(in.Drop))
-		for i := range in.Drop {
-			out.Drop[i] = api.Capability(in.Drop[i])
+	if in.PodAffinity != nil {
+		out.PodAffinity = new(api.PodAffinity)
+		if err := convert_v1_PodAffinity_To_api_PodAffinity(in.PodAffinity, out.PodAffinity, s); err != nil {
+			return err
 		}
 	} else {
-		out.Drop = nil
+		out.PodAffinity = nil
+	}
+	if in.PodAntiAffinity != nil {
+		out.PodAntiAffinity = new(api.PodAntiAffinity)
+		if err := convert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in.PodAntiAffinity, out.PodAntiAffinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodAntiAffinity = nil
+	}
+	return nil
+}
+
+func convert_v1_Affinity_To_api_Affinity(in *v1.Affinity, out *api.Affinity, s conversion.Scope) error {
+	return autoconvert_v1_Affinity_To_api_Affinity(in, out, s)
+}
+
+func autoconvert_v1_Capabilities_To_api_Capabilities(in *v1.Capabilities, out *api.Capabilities, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.Capabilities))(in)
+	}
+	if in.Add != nil {
+		out.Add = make([]api.Capability, len(in.Add))
+		for i := range in.Add {
+			out.Add[i] = api.Capability(in.Add[i])
+		}
+	} else {
+		out.Add = nil
+	}
+	if in.Drop != nil {
+		out.Drop = make([]api.Capability, len(in.Drop))
+		for i := range in.Drop {
+			out.Drop[i] = api.Capability(in.Drop[i])
+		}
+	} else {
+		out.Drop = nil
 	}
 	return nil
 }
@@ -1140,6 +1608,35 @@ func convert_v1_CinderVolumeSource_To_api_CinderVolumeSource(in *v1.CinderVolume
 	return autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *v1.ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ConfigMapKeySelector))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in *v1.ConfigMapKeySelector, out *api.ConfigMapKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in, out, s)
+}
+
+func autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *v1.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ConfigMapVolumeSource))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.LocalObjectReference, &out.LocalObjectReference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in *v1.ConfigMapVolumeSource, out *api.ConfigMapVolumeSource, s conversion.Scope) error {
+	return autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in, out, s)
+}
+
 func autoconvert_v1_Container_To_api_Container(in *v1.Container, out *api.Container, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Container))(in)
@@ -1336,6 +1833,14 @@ func autoconvert_v1_EnvVarSource_To_api_EnvVarSource(in *v1.EnvVarSource, out *a
 	} else {
 		out.FieldRef = nil
 	}
+	if in.ConfigMapKeyRef != nil {
+		out.ConfigMapKeyRef = new(api.ConfigMapKeySelector)
+		if err := convert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector(in.ConfigMapKeyRef, out.ConfigMapKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapKeyRef = nil
+	}
 	return nil
 }
 
@@ -1511,6 +2016,56 @@ func convert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in *v1.ISCSIVolumeSou
 	return autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_LabelSelector_To_api_LabelSelector(in *v1.LabelSelector, out *api.LabelSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.LabelSelector))(in)
+	}
+	if in.MatchLabels != nil {
+		out.MatchLabels = make(map[string]string)
+		for key, val := range in.MatchLabels {
+			out.MatchLabels[key] = val
+		}
+	} else {
+		out.MatchLabels = nil
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.LabelSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_v1_LabelSelector_To_api_LabelSelector(in *v1.LabelSelector, out *api.LabelSelector, s conversion.Scope) error {
+	return autoconvert_v1_LabelSelector_To_api_LabelSelector(in, out, s)
+}
+
+func autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in *v1.LabelSelectorRequirement, out *api.LabelSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.LabelSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.LabelSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in *v1.LabelSelectorRequirement, out *api.LabelSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement(in, out, s)
+}
+
 func autoconvert_v1_Lifecycle_To_api_Lifecycle(in *v1.Lifecycle, out *api.Lifecycle, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Lifecycle))(in)
@@ -1598,6 +2153,98 @@ func convert_v1_NFSVolumeSource_To_api_NFSVolumeSource(in *v1.NFSVolumeSource, o
 	return autoconvert_v1_NFSVolumeSource_To_api_NFSVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_NodeAffinity_To_api_NodeAffinity(in *v1.NodeAffinity, out *api.NodeAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.NodeAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = new(api.NodeSelector)
+		if err := convert_v1_NodeSelector_To_api_NodeSelector(in.RequiredDuringSchedulingIgnoredDuringExecution, out.RequiredDuringSchedulingIgnoredDuringExecution, s); err != nil {
+			return err
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.PreferredSchedulingTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeAffinity_To_api_NodeAffinity(in *v1.NodeAffinity, out *api.NodeAffinity, s conversion.Scope) error {
+	return autoconvert_v1_NodeAffinity_To_api_NodeAffinity(in, out, s)
+}
+
+func autoconvert_v1_NodeSelector_To_api_NodeSelector(in *v1.NodeSelector, out *api.NodeSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.NodeSelector))(in)
+	}
+	if in.NodeSelectorTerms != nil {
+		out.NodeSelectorTerms = make([]api.NodeSelectorTerm, len(in.NodeSelectorTerms))
+		for i := range in.NodeSelectorTerms {
+			if err := convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(&in.NodeSelectorTerms[i], &out.NodeSelectorTerms[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NodeSelectorTerms = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelector_To_api_NodeSelector(in *v1.NodeSelector, out *api.NodeSelector, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelector_To_api_NodeSelector(in, out, s)
+}
+
+func autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in *v1.NodeSelectorRequirement, out *api.NodeSelectorRequirement, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.NodeSelectorRequirement))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.NodeSelectorOperator(in.Operator)
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in *v1.NodeSelectorRequirement, out *api.NodeSelectorRequirement, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(in, out, s)
+}
+
+func autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in *v1.NodeSelectorTerm, out *api.NodeSelectorTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.NodeSelectorTerm))(in)
+	}
+	if in.MatchExpressions != nil {
+		out.MatchExpressions = make([]api.NodeSelectorRequirement, len(in.MatchExpressions))
+		for i := range in.MatchExpressions {
+			if err := convert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement(&in.MatchExpressions[i], &out.MatchExpressions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchExpressions = nil
+	}
+	return nil
+}
+
+func convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in *v1.NodeSelectorTerm, out *api.NodeSelectorTerm, s conversion.Scope) error {
+	return autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(in, out, s)
+}
+
 func autoconvert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector(in *v1.ObjectFieldSelector, out *api.ObjectFieldSelector, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.ObjectFieldSelector))(in)
@@ -1654,6 +2301,24 @@ func autoconvert_v1_ObjectMeta_To_api_ObjectMeta(in *v1.ObjectMeta, out *api.Obj
 	} else {
 		out.Annotations = nil
 	}
+	if in.OwnerReferences != nil {
+		out.OwnerReferences = make([]api.OwnerReference, len(in.OwnerReferences))
+		for i := range in.OwnerReferences {
+			if err := convert_v1_OwnerReference_To_api_OwnerReference(&in.OwnerReferences[i], &out.OwnerReferences[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OwnerReferences = nil
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]string, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = in.Finalizers[i]
+		}
+	} else {
+		out.Finalizers = nil
+	}
 	return nil
 }
 
@@ -1661,6 +2326,39 @@ func convert_v1_ObjectMeta_To_api_ObjectMeta(in *v1.ObjectMeta, out *api.ObjectM
 	return autoconvert_v1_ObjectMeta_To_api_ObjectMeta(in, out, s)
 }
 
+func autoconvert_v1_ObjectReference_To_api_ObjectReference(in *v1.ObjectReference, out *api.ObjectReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ObjectReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.UID = in.UID
+	out.APIVersion = in.APIVersion
+	out.ResourceVersion = in.ResourceVersion
+	out.FieldPath = in.FieldPath
+	return nil
+}
+
+func convert_v1_ObjectReference_To_api_ObjectReference(in *v1.ObjectReference, out *api.ObjectReference, s conversion.Scope) error {
+	return autoconvert_v1_ObjectReference_To_api_ObjectReference(in, out, s)
+}
+
+func autoconvert_v1_OwnerReference_To_api_OwnerReference(in *v1.OwnerReference, out *api.OwnerReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.OwnerReference))(in)
+	}
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.UID = in.UID
+	return nil
+}
+
+func convert_v1_OwnerReference_To_api_OwnerReference(in *v1.OwnerReference, out *api.OwnerReference, s conversion.Scope) error {
+	return autoconvert_v1_OwnerReference_To_api_OwnerReference(in, out, s)
+}
+
 func autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource(in *v1.PersistentVolumeClaimVolumeSource, out *api.PersistentVolumeClaimVolumeSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.PersistentVolumeClaimVolumeSource))(in)
@@ -1674,6 +2372,96 @@ func convert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVo
 	return autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_PodAffinity_To_api_PodAffinity(in *v1.PodAffinity, out *api.PodAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PodAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_PodAffinity_To_api_PodAffinity(in *v1.PodAffinity, out *api.PodAffinity, s conversion.Scope) error {
+	return autoconvert_v1_PodAffinity_To_api_PodAffinity(in, out, s)
+}
+
+func autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in *v1.PodAffinityTerm, out *api.PodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PodAffinityTerm))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = new(api.LabelSelector)
+		if err := convert_v1_LabelSelector_To_api_LabelSelector(in.LabelSelector, out.LabelSelector, s); err != nil {
+			return err
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+func convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in *v1.PodAffinityTerm, out *api.PodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm(in, out, s)
+}
+
+func autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in *v1.PodAntiAffinity, out *api.PodAntiAffinity, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PodAntiAffinity))(in)
+	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = make([]api.PodAffinityTerm, len(in.RequiredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.RequiredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.RequiredDuringSchedulingIgnoredDuringExecution[i], &out.RequiredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = make([]api.WeightedPodAffinityTerm, len(in.PreferredDuringSchedulingIgnoredDuringExecution))
+		for i := range in.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(&in.PreferredDuringSchedulingIgnoredDuringExecution[i], &out.PreferredDuringSchedulingIgnoredDuringExecution[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PreferredDuringSchedulingIgnoredDuringExecution = nil
+	}
+	return nil
+}
+
+func convert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in *v1.PodAntiAffinity, out *api.PodAntiAffinity, s conversion.Scope) error {
+	return autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity(in, out, s)
+}
+
 func autoconvert_v1_PodSpec_To_api_PodSpec(in *v1.PodSpec, out *api.PodSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.PodSpec))(in)
@@ -1720,6 +2508,31 @@ func autoconvert_v1_PodSpec_To_api_PodSpec(in *v1.PodSpec, out *api.PodSpec, s c
 	} else {
 		out.NodeSelector = nil
 	}
+	if in.Affinity != nil {
+		out.Affinity = new(api.Affinity)
+		if err := convert_v1_Affinity_To_api_Affinity(in.Affinity, out.Affinity, s); err != nil {
+			return err
+		}
+	} else {
+		out.Affinity = nil
+	}
+	if in.Tolerations != nil {
+		out.Tolerations = make([]api.Toleration, len(in.Tolerations))
+		for i := range in.Tolerations {
+			if err := convert_v1_Toleration_To_api_Toleration(&in.Tolerations[i], &out.Tolerations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Tolerations = nil
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.Priority != nil {
+		out.Priority = new(int)
+		*out.Priority = *in.Priority
+	} else {
+		out.Priority = nil
+	}
 	out.ServiceAccountName = in.ServiceAccountName
 	// in.DeprecatedServiceAccount has no peer in out
 	out.NodeName = in.NodeName
@@ -1756,6 +2569,21 @@ func convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(in *v1.PodTemplateSpec, o
 	return autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec(in, out, s)
 }
 
+func autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in *v1.PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PreferredSchedulingTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm(&in.Preference, &out.Preference, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in *v1.PreferredSchedulingTerm, out *api.PreferredSchedulingTerm, s conversion.Scope) error {
+	return autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm(in, out, s)
+}
+
 func autoconvert_v1_Probe_To_api_Probe(in *v1.Probe, out *api.Probe, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Probe))(in)
@@ -1921,6 +2749,21 @@ func convert_v1_TCPSocketAction_To_api_TCPSocketAction(in *v1.TCPSocketAction, o
 	return autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction(in, out, s)
 }
 
+func autoconvert_v1_Toleration_To_api_Toleration(in *v1.Toleration, out *api.Toleration, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.Toleration))(in)
+	}
+	out.Key = in.Key
+	out.Operator = api.TolerationOperator(in.Operator)
+	out.Value = in.Value
+	out.Effect = api.TaintEffect(in.Effect)
+	return nil
+}
+
+func convert_v1_Toleration_To_api_Toleration(in *v1.Toleration, out *api.Toleration, s conversion.Scope) error {
+	return autoconvert_v1_Toleration_To_api_Toleration(in, out, s)
+}
+
 func autoconvert_v1_Volume_To_api_Volume(in *v1.Volume, out *api.Volume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Volume))(in)
@@ -2074,6 +2917,14 @@ func autoconvert_v1_VolumeSource_To_api_VolumeSource(in *v1.VolumeSource, out *a
 	} else {
 		out.FC = nil
 	}
+	if in.ConfigMap != nil {
+		out.ConfigMap = new(api.ConfigMapVolumeSource)
+		if err := convert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource(in.ConfigMap, out.ConfigMap, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMap = nil
+	}
 	return nil
 }
 
@@ -2081,12 +2932,36 @@ func convert_v1_VolumeSource_To_api_VolumeSource(in *v1.VolumeSource, out *api.V
 	return autoconvert_v1_VolumeSource_To_api_VolumeSource(in, out, s)
 }
 
+func autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in *v1.WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.WeightedPodAffinityTerm))(in)
+	}
+	out.Weight = in.Weight
+	if err := convert_v1_PodAffinityTerm_To_api_PodAffinityTerm(&in.PodAffinityTerm, &out.PodAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in *v1.WeightedPodAffinityTerm, out *api.WeightedPodAffinityTerm, s conversion.Scope) error {
+	return autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm(in, out, s)
+}
+
 func autoconvert_experimental_APIVersion_To_v1alpha1_APIVersion(in *experimental.APIVersion, out *APIVersion, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*experimental.APIVersion))(in)
 	}
 	out.Name = in.Name
 	out.APIGroup = in.APIGroup
+	if in.Schema != nil {
+		out.Schema = new(JSONSchemaProps)
+		if err := convert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps(in.Schema, out.Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.Schema = nil
+	}
+	out.ConversionHook = in.ConversionHook
 	return nil
 }
 
@@ -2094,9 +2969,9 @@ func convert_experimental_APIVersion_To_v1alpha1_APIVersion(in *experimental.API
 	return autoconvert_experimental_APIVersion_To_v1alpha1_APIVersion(in, out, s)
 }
 
-func autoconvert_experimental_DaemonSet_To_v1alpha1_DaemonSet(in *experimental.DaemonSet, out *DaemonSet, s conversion.Scope) error {
+func autoconvert_experimental_ClusterRole_To_v1alpha1_ClusterRole(in *experimental.ClusterRole, out *ClusterRole, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*experimental.DaemonSet))(in)
+		defaulting.(func(*experimental.ClusterRole))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -2104,12 +2979,123 @@ func autoconvert_experimental_DaemonSet_To_v1alpha1_DaemonSet(in *experimental.D
 	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_experimental_DaemonSetSpec_To_v1alpha1_DaemonSetSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_experimental_DaemonSetStatus_To_v1alpha1_DaemonSetStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+	if in.Rules != nil {
+		out.Rules = make([]PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := convert_experimental_PolicyRule_To_v1alpha1_PolicyRule(&in.Rules[i], &out.Rules[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func convert_experimental_ClusterRole_To_v1alpha1_ClusterRole(in *experimental.ClusterRole, out *ClusterRole, s conversion.Scope) error {
+	return autoconvert_experimental_ClusterRole_To_v1alpha1_ClusterRole(in, out, s)
+}
+
+func autoconvert_experimental_ClusterRoleBinding_To_v1alpha1_ClusterRoleBinding(in *experimental.ClusterRoleBinding, out *ClusterRoleBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.ClusterRoleBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := convert_experimental_Subject_To_v1alpha1_Subject(&in.Subjects[i], &out.Subjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_experimental_ClusterRoleBinding_To_v1alpha1_ClusterRoleBinding(in *experimental.ClusterRoleBinding, out *ClusterRoleBinding, s conversion.Scope) error {
+	return autoconvert_experimental_ClusterRoleBinding_To_v1alpha1_ClusterRoleBinding(in, out, s)
+}
+
+func autoconvert_experimental_ClusterRoleBindingList_To_v1alpha1_ClusterRoleBindingList(in *experimental.ClusterRoleBindingList, out *ClusterRoleBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.ClusterRoleBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ClusterRoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_experimental_ClusterRoleBinding_To_v1alpha1_ClusterRoleBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_experimental_ClusterRoleBindingList_To_v1alpha1_ClusterRoleBindingList(in *experimental.ClusterRoleBindingList, out *ClusterRoleBindingList, s conversion.Scope) error {
+	return autoconvert_experimental_ClusterRoleBindingList_To_v1alpha1_ClusterRoleBindingList(in, out, s)
+}
+
+func autoconvert_experimental_ClusterRoleList_To_v1alpha1_ClusterRoleList(in *experimental.ClusterRoleList, out *ClusterRoleList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.ClusterRoleList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]ClusterRole, len(in.Items))
+		for i := range in.Items {
+			if err := convert_experimental_ClusterRole_To_v1alpha1_ClusterRole(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_experimental_ClusterRoleList_To_v1alpha1_ClusterRoleList(in *experimental.ClusterRoleList, out *ClusterRoleList, s conversion.Scope) error {
+	return autoconvert_experimental_ClusterRoleList_To_v1alpha1_ClusterRoleList(in, out, s)
+}
+
+func autoconvert_experimental_DaemonSet_To_v1alpha1_DaemonSet(in *experimental.DaemonSet, out *DaemonSet, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.DaemonSet))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_experimental_DaemonSetSpec_To_v1alpha1_DaemonSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_experimental_DaemonSetStatus_To_v1alpha1_DaemonSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2543,6 +3529,75 @@ func convert_experimental_IngressStatus_To_v1alpha1_IngressStatus(in *experiment
 	return autoconvert_experimental_IngressStatus_To_v1alpha1_IngressStatus(in, out, s)
 }
 
+func autoconvert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps(in *experimental.JSONSchemaProps, out *JSONSchemaProps, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.JSONSchemaProps))(in)
+	}
+	out.Description = in.Description
+	out.Type = in.Type
+	if in.Properties != nil {
+		out.Properties = make(map[string]JSONSchemaProps)
+		for key, val := range in.Properties {
+			newVal := JSONSchemaProps{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Properties[key] = newVal
+		}
+	} else {
+		out.Properties = nil
+	}
+	if in.Required != nil {
+		out.Required = make([]string, len(in.Required))
+		for i := range in.Required {
+			out.Required[i] = in.Required[i]
+		}
+	} else {
+		out.Required = nil
+	}
+	out.AdditionalProperties = in.AdditionalProperties
+	if in.Items != nil {
+		out.Items = new(JSONSchemaProps)
+		if err := convert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps(in.Items, out.Items, s); err != nil {
+			return err
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Enum != nil {
+		out.Enum = make([]string, len(in.Enum))
+		for i := range in.Enum {
+			out.Enum[i] = in.Enum[i]
+		}
+	} else {
+		out.Enum = nil
+	}
+	out.Pattern = in.Pattern
+	if in.Minimum != nil {
+		out.Minimum = new(int64)
+		*out.Minimum = *in.Minimum
+	} else {
+		out.Minimum = nil
+	}
+	if in.Maximum != nil {
+		out.Maximum = new(int64)
+		*out.Maximum = *in.Maximum
+	} else {
+		out.Maximum = nil
+	}
+	if in.MaxLength != nil {
+		out.MaxLength = new(int64)
+		*out.MaxLength = *in.MaxLength
+	} else {
+		out.MaxLength = nil
+	}
+	return nil
+}
+
+func convert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps(in *experimental.JSONSchemaProps, out *JSONSchemaProps, s conversion.Scope) error {
+	return autoconvert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps(in, out, s)
+}
+
 func autoconvert_experimental_Job_To_v1alpha1_Job(in *experimental.Job, out *Job, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*experimental.Job))(in)
@@ -2691,6 +3746,41 @@ func convert_experimental_JobStatus_To_v1alpha1_JobStatus(in *experimental.JobSt
 	return autoconvert_experimental_JobStatus_To_v1alpha1_JobStatus(in, out, s)
 }
 
+func autoconvert_experimental_PolicyRule_To_v1alpha1_PolicyRule(in *experimental.PolicyRule, out *PolicyRule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.PolicyRule))(in)
+	}
+	if in.Verbs != nil {
+		out.Verbs = make([]string, len(in.Verbs))
+		for i := range in.Verbs {
+			out.Verbs[i] = in.Verbs[i]
+		}
+	} else {
+		out.Verbs = nil
+	}
+	if in.APIGroups != nil {
+		out.APIGroups = make([]string, len(in.APIGroups))
+		for i := range in.APIGroups {
+			out.APIGroups[i] = in.APIGroups[i]
+		}
+	} else {
+		out.APIGroups = nil
+	}
+	if in.Resources != nil {
+		out.Resources = make([]string, len(in.Resources))
+		for i := range in.Resources {
+			out.Resources[i] = in.Resources[i]
+		}
+	} else {
+		out.Resources = nil
+	}
+	return nil
+}
+
+func convert_experimental_PolicyRule_To_v1alpha1_PolicyRule(in *experimental.PolicyRule, out *PolicyRule, s conversion.Scope) error {
+	return autoconvert_experimental_PolicyRule_To_v1alpha1_PolicyRule(in, out, s)
+}
+
 func autoconvert_experimental_ReplicationControllerDummy_To_v1alpha1_ReplicationControllerDummy(in *experimental.ReplicationControllerDummy, out *ReplicationControllerDummy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*experimental.ReplicationControllerDummy))(in)
@@ -2720,6 +3810,117 @@ func convert_experimental_ResourceConsumption_To_v1alpha1_ResourceConsumption(in
 	return autoconvert_experimental_ResourceConsumption_To_v1alpha1_ResourceConsumption(in, out, s)
 }
 
+func autoconvert_experimental_Role_To_v1alpha1_Role(in *experimental.Role, out *Role, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.Role))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Rules != nil {
+		out.Rules = make([]PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := convert_experimental_PolicyRule_To_v1alpha1_PolicyRule(&in.Rules[i], &out.Rules[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func convert_experimental_Role_To_v1alpha1_Role(in *experimental.Role, out *Role, s conversion.Scope) error {
+	return autoconvert_experimental_Role_To_v1alpha1_Role(in, out, s)
+}
+
+func autoconvert_experimental_RoleBinding_To_v1alpha1_RoleBinding(in *experimental.RoleBinding, out *RoleBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.RoleBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := convert_experimental_Subject_To_v1alpha1_Subject(&in.Subjects[i], &out.Subjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_experimental_RoleBinding_To_v1alpha1_RoleBinding(in *experimental.RoleBinding, out *RoleBinding, s conversion.Scope) error {
+	return autoconvert_experimental_RoleBinding_To_v1alpha1_RoleBinding(in, out, s)
+}
+
+func autoconvert_experimental_RoleBindingList_To_v1alpha1_RoleBindingList(in *experimental.RoleBindingList, out *RoleBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.RoleBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]RoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_experimental_RoleBinding_To_v1alpha1_RoleBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_experimental_RoleBindingList_To_v1alpha1_RoleBindingList(in *experimental.RoleBindingList, out *RoleBindingList, s conversion.Scope) error {
+	return autoconvert_experimental_RoleBindingList_To_v1alpha1_RoleBindingList(in, out, s)
+}
+
+func autoconvert_experimental_RoleList_To_v1alpha1_RoleList(in *experimental.RoleList, out *RoleList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.RoleList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]Role, len(in.Items))
+		for i := range in.Items {
+			if err := convert_experimental_Role_To_v1alpha1_Role(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_experimental_RoleList_To_v1alpha1_RoleList(in *experimental.RoleList, out *RoleList, s conversion.Scope) error {
+	return autoconvert_experimental_RoleList_To_v1alpha1_RoleList(in, out, s)
+}
+
 func autoconvert_experimental_RollingUpdateDeployment_To_v1alpha1_RollingUpdateDeployment(in *experimental.RollingUpdateDeployment, out *RollingUpdateDeployment, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*experimental.RollingUpdateDeployment))(in)
@@ -2789,6 +3990,20 @@ func convert_experimental_ScaleStatus_To_v1alpha1_ScaleStatus(in *experimental.S
 	return autoconvert_experimental_ScaleStatus_To_v1alpha1_ScaleStatus(in, out, s)
 }
 
+func autoconvert_experimental_Subject_To_v1alpha1_Subject(in *experimental.Subject, out *Subject, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*experimental.Subject))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_experimental_Subject_To_v1alpha1_Subject(in *experimental.Subject, out *Subject, s conversion.Scope) error {
+	return autoconvert_experimental_Subject_To_v1alpha1_Subject(in, out, s)
+}
+
 func autoconvert_experimental_SubresourceReference_To_v1alpha1_SubresourceReference(in *experimental.SubresourceReference, out *SubresourceReference, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*experimental.SubresourceReference))(in)
@@ -2913,6 +4128,15 @@ func autoconvert_v1alpha1_APIVersion_To_experimental_APIVersion(in *APIVersion,
 	}
 	out.Name = in.Name
 	out.APIGroup = in.APIGroup
+	if in.Schema != nil {
+		out.Schema = new(experimental.JSONSchemaProps)
+		if err := convert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps(in.Schema, out.Schema, s); err != nil {
+			return err
+		}
+	} else {
+		out.Schema = nil
+	}
+	out.ConversionHook = in.ConversionHook
 	return nil
 }
 
@@ -2920,6 +4144,117 @@ func convert_v1alpha1_APIVersion_To_experimental_APIVersion(in *APIVersion, out
 	return autoconvert_v1alpha1_APIVersion_To_experimental_APIVersion(in, out, s)
 }
 
+func autoconvert_v1alpha1_ClusterRole_To_experimental_ClusterRole(in *ClusterRole, out *experimental.ClusterRole, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ClusterRole))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Rules != nil {
+		out.Rules = make([]experimental.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := convert_v1alpha1_PolicyRule_To_experimental_PolicyRule(&in.Rules[i], &out.Rules[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_ClusterRole_To_experimental_ClusterRole(in *ClusterRole, out *experimental.ClusterRole, s conversion.Scope) error {
+	return autoconvert_v1alpha1_ClusterRole_To_experimental_ClusterRole(in, out, s)
+}
+
+func autoconvert_v1alpha1_ClusterRoleBinding_To_experimental_ClusterRoleBinding(in *ClusterRoleBinding, out *experimental.ClusterRoleBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ClusterRoleBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]experimental.Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := convert_v1alpha1_Subject_To_experimental_Subject(&in.Subjects[i], &out.Subjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1alpha1_ClusterRoleBinding_To_experimental_ClusterRoleBinding(in *ClusterRoleBinding, out *experimental.ClusterRoleBinding, s conversion.Scope) error {
+	return autoconvert_v1alpha1_ClusterRoleBinding_To_experimental_ClusterRoleBinding(in, out, s)
+}
+
+func autoconvert_v1alpha1_ClusterRoleBindingList_To_experimental_ClusterRoleBindingList(in *ClusterRoleBindingList, out *experimental.ClusterRoleBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ClusterRoleBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]experimental.ClusterRoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1alpha1_ClusterRoleBinding_To_experimental_ClusterRoleBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_ClusterRoleBindingList_To_experimental_ClusterRoleBindingList(in *ClusterRoleBindingList, out *experimental.ClusterRoleBindingList, s conversion.Scope) error {
+	return autoconvert_v1alpha1_ClusterRoleBindingList_To_experimental_ClusterRoleBindingList(in, out, s)
+}
+
+func autoconvert_v1alpha1_ClusterRoleList_To_experimental_ClusterRoleList(in *ClusterRoleList, out *experimental.ClusterRoleList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ClusterRoleList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]experimental.ClusterRole, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1alpha1_ClusterRole_To_experimental_ClusterRole(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_ClusterRoleList_To_experimental_ClusterRoleList(in *ClusterRoleList, out *experimental.ClusterRoleList, s conversion.Scope) error {
+	return autoconvert_v1alpha1_ClusterRoleList_To_experimental_ClusterRoleList(in, out, s)
+}
+
 func autoconvert_v1alpha1_DaemonSet_To_experimental_DaemonSet(in *DaemonSet, out *experimental.DaemonSet, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*DaemonSet))(in)
@@ -3349,6 +4684,75 @@ func convert_v1alpha1_IngressStatus_To_experimental_IngressStatus(in *IngressSta
 	return autoconvert_v1alpha1_IngressStatus_To_experimental_IngressStatus(in, out, s)
 }
 
+func autoconvert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps(in *JSONSchemaProps, out *experimental.JSONSchemaProps, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*JSONSchemaProps))(in)
+	}
+	out.Description = in.Description
+	out.Type = in.Type
+	if in.Properties != nil {
+		out.Properties = make(map[string]experimental.JSONSchemaProps)
+		for key, val := range in.Properties {
+			newVal := experimental.JSONSchemaProps{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Properties[key] = newVal
+		}
+	} else {
+		out.Properties = nil
+	}
+	if in.Required != nil {
+		out.Required = make([]string, len(in.Required))
+		for i := range in.Required {
+			out.Required[i] = in.Required[i]
+		}
+	} else {
+		out.Required = nil
+	}
+	out.AdditionalProperties = in.AdditionalProperties
+	if in.Items != nil {
+		out.Items = new(experimental.JSONSchemaProps)
+		if err := convert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps(in.Items, out.Items, s); err != nil {
+			return err
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Enum != nil {
+		out.Enum = make([]string, len(in.Enum))
+		for i := range in.Enum {
+			out.Enum[i] = in.Enum[i]
+		}
+	} else {
+		out.Enum = nil
+	}
+	out.Pattern = in.Pattern
+	if in.Minimum != nil {
+		out.Minimum = new(int64)
+		*out.Minimum = *in.Minimum
+	} else {
+		out.Minimum = nil
+	}
+	if in.Maximum != nil {
+		out.Maximum = new(int64)
+		*out.Maximum = *in.Maximum
+	} else {
+		out.Maximum = nil
+	}
+	if in.MaxLength != nil {
+		out.MaxLength = new(int64)
+		*out.MaxLength = *in.MaxLength
+	} else {
+		out.MaxLength = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps(in *JSONSchemaProps, out *experimental.JSONSchemaProps, s conversion.Scope) error {
+	return autoconvert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps(in, out, s)
+}
+
 func autoconvert_v1alpha1_Job_To_experimental_Job(in *Job, out *experimental.Job, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*Job))(in)
@@ -3497,6 +4901,41 @@ func convert_v1alpha1_JobStatus_To_experimental_JobStatus(in *JobStatus, out *ex
 	return autoconvert_v1alpha1_JobStatus_To_experimental_JobStatus(in, out, s)
 }
 
+func autoconvert_v1alpha1_PolicyRule_To_experimental_PolicyRule(in *PolicyRule, out *experimental.PolicyRule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*PolicyRule))(in)
+	}
+	if in.Verbs != nil {
+		out.Verbs = make([]string, len(in.Verbs))
+		for i := range in.Verbs {
+			out.Verbs[i] = in.Verbs[i]
+		}
+	} else {
+		out.Verbs = nil
+	}
+	if in.APIGroups != nil {
+		out.APIGroups = make([]string, len(in.APIGroups))
+		for i := range in.APIGroups {
+			out.APIGroups[i] = in.APIGroups[i]
+		}
+	} else {
+		out.APIGroups = nil
+	}
+	if in.Resources != nil {
+		out.Resources = make([]string, len(in.Resources))
+		for i := range in.Resources {
+			out.Resources[i] = in.Resources[i]
+		}
+	} else {
+		out.Resources = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_PolicyRule_To_experimental_PolicyRule(in *PolicyRule, out *experimental.PolicyRule, s conversion.Scope) error {
+	return autoconvert_v1alpha1_PolicyRule_To_experimental_PolicyRule(in, out, s)
+}
+
 func autoconvert_v1alpha1_ReplicationControllerDummy_To_experimental_ReplicationControllerDummy(in *ReplicationControllerDummy, out *experimental.ReplicationControllerDummy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*ReplicationControllerDummy))(in)
@@ -3526,6 +4965,117 @@ func convert_v1alpha1_ResourceConsumption_To_experimental_ResourceConsumption(in
 	return autoconvert_v1alpha1_ResourceConsumption_To_experimental_ResourceConsumption(in, out, s)
 }
 
+func autoconvert_v1alpha1_Role_To_experimental_Role(in *Role, out *experimental.Role, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Role))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Rules != nil {
+		out.Rules = make([]experimental.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := convert_v1alpha1_PolicyRule_To_experimental_PolicyRule(&in.Rules[i], &out.Rules[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_Role_To_experimental_Role(in *Role, out *experimental.Role, s conversion.Scope) error {
+	return autoconvert_v1alpha1_Role_To_experimental_Role(in, out, s)
+}
+
+func autoconvert_v1alpha1_RoleBinding_To_experimental_RoleBinding(in *RoleBinding, out *experimental.RoleBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RoleBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Subjects != nil {
+		out.Subjects = make([]experimental.Subject, len(in.Subjects))
+		for i := range in.Subjects {
+			if err := convert_v1alpha1_Subject_To_experimental_Subject(&in.Subjects[i], &out.Subjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subjects = nil
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1alpha1_RoleBinding_To_experimental_RoleBinding(in *RoleBinding, out *experimental.RoleBinding, s conversion.Scope) error {
+	return autoconvert_v1alpha1_RoleBinding_To_experimental_RoleBinding(in, out, s)
+}
+
+func autoconvert_v1alpha1_RoleBindingList_To_experimental_RoleBindingList(in *RoleBindingList, out *experimental.RoleBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RoleBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]experimental.RoleBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1alpha1_RoleBinding_To_experimental_RoleBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_RoleBindingList_To_experimental_RoleBindingList(in *RoleBindingList, out *experimental.RoleBindingList, s conversion.Scope) error {
+	return autoconvert_v1alpha1_RoleBindingList_To_experimental_RoleBindingList(in, out, s)
+}
+
+func autoconvert_v1alpha1_RoleList_To_experimental_RoleList(in *RoleList, out *experimental.RoleList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*RoleList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]experimental.Role, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1alpha1_Role_To_experimental_Role(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1alpha1_RoleList_To_experimental_RoleList(in *RoleList, out *experimental.RoleList, s conversion.Scope) error {
+	return autoconvert_v1alpha1_RoleList_To_experimental_RoleList(in, out, s)
+}
+
 func autoconvert_v1alpha1_RollingUpdateDeployment_To_experimental_RollingUpdateDeployment(in *RollingUpdateDeployment, out *experimental.RollingUpdateDeployment, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*RollingUpdateDeployment))(in)
@@ -3591,6 +5141,20 @@ func convert_v1alpha1_ScaleStatus_To_experimental_ScaleStatus(in *ScaleStatus, o
 	return autoconvert_v1alpha1_ScaleStatus_To_experimental_ScaleStatus(in, out, s)
 }
 
+func autoconvert_v1alpha1_Subject_To_experimental_Subject(in *Subject, out *experimental.Subject, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*Subject))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_v1alpha1_Subject_To_experimental_Subject(in *Subject, out *experimental.Subject, s conversion.Scope) error {
+	return autoconvert_v1alpha1_Subject_To_experimental_Subject(in, out, s)
+}
+
 func autoconvert_v1alpha1_SubresourceReference_To_experimental_SubresourceReference(in *SubresourceReference, out *experimental.SubresourceReference, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*SubresourceReference))(in)
@@ -3712,9 +5276,12 @@ func convert_v1alpha1_ThirdPartyResourceList_To_experimental_ThirdPartyResourceL
 func init() {
 	err := api.Scheme.AddGeneratedConversionFuncs(
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
+		autoconvert_api_Affinity_To_v1_Affinity,
 		autoconvert_api_Capabilities_To_v1_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
+		autoconvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
+		autoconvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
 		autoconvert_api_ContainerPort_To_v1_ContainerPort,
 		autoconvert_api_Container_To_v1_Container,
 		autoconvert_api_DownwardAPIVolumeFile_To_v1_DownwardAPIVolumeFile,
@@ -3731,16 +5298,28 @@ func init() {
 		autoconvert_api_Handler_To_v1_Handler,
 		autoconvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
 		autoconvert_api_ISCSIVolumeSource_To_v1_ISCSIVolumeSource,
+		autoconvert_api_LabelSelectorRequirement_To_v1_LabelSelectorRequirement,
+		autoconvert_api_LabelSelector_To_v1_LabelSelector,
 		autoconvert_api_Lifecycle_To_v1_Lifecycle,
 		autoconvert_api_LoadBalancerIngress_To_v1_LoadBalancerIngress,
 		autoconvert_api_LoadBalancerStatus_To_v1_LoadBalancerStatus,
 		autoconvert_api_LocalObjectReference_To_v1_LocalObjectReference,
 		autoconvert_api_NFSVolumeSource_To_v1_NFSVolumeSource,
+		autoconvert_api_NodeAffinity_To_v1_NodeAffinity,
+		autoconvert_api_NodeSelectorRequirement_To_v1_NodeSelectorRequirement,
+		autoconvert_api_NodeSelectorTerm_To_v1_NodeSelectorTerm,
+		autoconvert_api_NodeSelector_To_v1_NodeSelector,
 		autoconvert_api_ObjectFieldSelector_To_v1_ObjectFieldSelector,
 		autoconvert_api_ObjectMeta_To_v1_ObjectMeta,
+		autoconvert_api_ObjectReference_To_v1_ObjectReference,
+		autoconvert_api_OwnerReference_To_v1_OwnerReference,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource,
+		autoconvert_api_PodAffinityTerm_To_v1_PodAffinityTerm,
+		autoconvert_api_PodAffinity_To_v1_PodAffinity,
+		autoconvert_api_PodAntiAffinity_To_v1_PodAntiAffinity,
 		autoconvert_api_PodSpec_To_v1_PodSpec,
 		autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
+		autoconvert_api_PreferredSchedulingTerm_To_v1_PreferredSchedulingTerm,
 		autoconvert_api_Probe_To_v1_Probe,
 		autoconvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements,
@@ -3748,10 +5327,16 @@ func init() {
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1_SecurityContext,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
+		autoconvert_api_Toleration_To_v1_Toleration,
 		autoconvert_api_VolumeMount_To_v1_VolumeMount,
 		autoconvert_api_VolumeSource_To_v1_VolumeSource,
 		autoconvert_api_Volume_To_v1_Volume,
+		autoconvert_api_WeightedPodAffinityTerm_To_v1_WeightedPodAffinityTerm,
 		autoconvert_experimental_APIVersion_To_v1alpha1_APIVersion,
+		autoconvert_experimental_ClusterRoleBindingList_To_v1alpha1_ClusterRoleBindingList,
+		autoconvert_experimental_ClusterRoleBinding_To_v1alpha1_ClusterRoleBinding,
+		autoconvert_experimental_ClusterRoleList_To_v1alpha1_ClusterRoleList,
+		autoconvert_experimental_ClusterRole_To_v1alpha1_ClusterRole,
 		autoconvert_experimental_DaemonSetList_To_v1alpha1_DaemonSetList,
 		autoconvert_experimental_DaemonSetSpec_To_v1alpha1_DaemonSetSpec,
 		autoconvert_experimental_DaemonSetStatus_To_v1alpha1_DaemonSetStatus,
@@ -3772,26 +5357,36 @@ func init() {
 		autoconvert_experimental_IngressSpec_To_v1alpha1_IngressSpec,
 		autoconvert_experimental_IngressStatus_To_v1alpha1_IngressStatus,
 		autoconvert_experimental_Ingress_To_v1alpha1_Ingress,
+		autoconvert_experimental_JSONSchemaProps_To_v1alpha1_JSONSchemaProps,
 		autoconvert_experimental_JobCondition_To_v1alpha1_JobCondition,
 		autoconvert_experimental_JobList_To_v1alpha1_JobList,
 		autoconvert_experimental_JobSpec_To_v1alpha1_JobSpec,
 		autoconvert_experimental_JobStatus_To_v1alpha1_JobStatus,
 		autoconvert_experimental_Job_To_v1alpha1_Job,
+		autoconvert_experimental_PolicyRule_To_v1alpha1_PolicyRule,
 		autoconvert_experimental_ReplicationControllerDummy_To_v1alpha1_ReplicationControllerDummy,
 		autoconvert_experimental_ResourceConsumption_To_v1alpha1_ResourceConsumption,
+		autoconvert_experimental_RoleBindingList_To_v1alpha1_RoleBindingList,
+		autoconvert_experimental_RoleBinding_To_v1alpha1_RoleBinding,
+		autoconvert_experimental_RoleList_To_v1alpha1_RoleList,
+		autoconvert_experimental_Role_To_v1alpha1_Role,
 		autoconvert_experimental_RollingUpdateDeployment_To_v1alpha1_RollingUpdateDeployment,
 		autoconvert_experimental_ScaleSpec_To_v1alpha1_ScaleSpec,
 		autoconvert_experimental_ScaleStatus_To_v1alpha1_ScaleStatus,
 		autoconvert_experimental_Scale_To_v1alpha1_Scale,
+		autoconvert_experimental_Subject_To_v1alpha1_Subject,
 		autoconvert_experimental_SubresourceReference_To_v1alpha1_SubresourceReference,
 		autoconvert_experimental_ThirdPartyResourceDataList_To_v1alpha1_ThirdPartyResourceDataList,
 		autoconvert_experimental_ThirdPartyResourceData_To_v1alpha1_ThirdPartyResourceData,
 		autoconvert_experimental_ThirdPartyResourceList_To_v1alpha1_ThirdPartyResourceList,
 		autoconvert_experimental_ThirdPartyResource_To_v1alpha1_ThirdPartyResource,
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
+		autoconvert_v1_Affinity_To_api_Affinity,
 		autoconvert_v1_Capabilities_To_api_Capabilities,
 		autoconvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
+		autoconvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
+		autoconvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
 		autoconvert_v1_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1_Container_To_api_Container,
 		autoconvert_v1_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
@@ -3808,16 +5403,28 @@ func init() {
 		autoconvert_v1_Handler_To_api_Handler,
 		autoconvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoconvert_v1_ISCSIVolumeSource_To_api_ISCSIVolumeSource,
+		autoconvert_v1_LabelSelectorRequirement_To_api_LabelSelectorRequirement,
+		autoconvert_v1_LabelSelector_To_api_LabelSelector,
 		autoconvert_v1_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1_LoadBalancerIngress_To_api_LoadBalancerIngress,
 		autoconvert_v1_LoadBalancerStatus_To_api_LoadBalancerStatus,
 		autoconvert_v1_LocalObjectReference_To_api_LocalObjectReference,
 		autoconvert_v1_NFSVolumeSource_To_api_NFSVolumeSource,
+		autoconvert_v1_NodeAffinity_To_api_NodeAffinity,
+		autoconvert_v1_NodeSelectorRequirement_To_api_NodeSelectorRequirement,
+		autoconvert_v1_NodeSelectorTerm_To_api_NodeSelectorTerm,
+		autoconvert_v1_NodeSelector_To_api_NodeSelector,
 		autoconvert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector,
 		autoconvert_v1_ObjectMeta_To_api_ObjectMeta,
+		autoconvert_v1_ObjectReference_To_api_ObjectReference,
+		autoconvert_v1_OwnerReference_To_api_OwnerReference,
 		autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoconvert_v1_PodAffinityTerm_To_api_PodAffinityTerm,
+		autoconvert_v1_PodAffinity_To_api_PodAffinity,
+		autoconvert_v1_PodAntiAffinity_To_api_PodAntiAffinity,
 		autoconvert_v1_PodSpec_To_api_PodSpec,
 		autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
+		autoconvert_v1_PreferredSchedulingTerm_To_api_PreferredSchedulingTerm,
 		autoconvert_v1_Probe_To_api_Probe,
 		autoconvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements,
@@ -3825,10 +5432,16 @@ func init() {
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_SecurityContext_To_api_SecurityContext,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
+		autoconvert_v1_Toleration_To_api_Toleration,
 		autoconvert_v1_VolumeMount_To_api_VolumeMount,
 		autoconvert_v1_VolumeSource_To_api_VolumeSource,
 		autoconvert_v1_Volume_To_api_Volume,
+		autoconvert_v1_WeightedPodAffinityTerm_To_api_WeightedPodAffinityTerm,
 		autoconvert_v1alpha1_APIVersion_To_experimental_APIVersion,
+		autoconvert_v1alpha1_ClusterRoleBindingList_To_experimental_ClusterRoleBindingList,
+		autoconvert_v1alpha1_ClusterRoleBinding_To_experimental_ClusterRoleBinding,
+		autoconvert_v1alpha1_ClusterRoleList_To_experimental_ClusterRoleList,
+		autoconvert_v1alpha1_ClusterRole_To_experimental_ClusterRole,
 		autoconvert_v1alpha1_DaemonSetList_To_experimental_DaemonSetList,
 		autoconvert_v1alpha1_DaemonSetSpec_To_experimental_DaemonSetSpec,
 		autoconvert_v1alpha1_DaemonSetStatus_To_experimental_DaemonSetStatus,
@@ -3848,17 +5461,24 @@ func init() {
 		autoconvert_v1alpha1_IngressSpec_To_experimental_IngressSpec,
 		autoconvert_v1alpha1_IngressStatus_To_experimental_IngressStatus,
 		autoconvert_v1alpha1_Ingress_To_experimental_Ingress,
+		autoconvert_v1alpha1_JSONSchemaProps_To_experimental_JSONSchemaProps,
 		autoconvert_v1alpha1_JobCondition_To_experimental_JobCondition,
 		autoconvert_v1alpha1_JobList_To_experimental_JobList,
 		autoconvert_v1alpha1_JobSpec_To_experimental_JobSpec,
 		autoconvert_v1alpha1_JobStatus_To_experimental_JobStatus,
 		autoconvert_v1alpha1_Job_To_experimental_Job,
+		autoconvert_v1alpha1_PolicyRule_To_experimental_PolicyRule,
 		autoconvert_v1alpha1_ReplicationControllerDummy_To_experimental_ReplicationControllerDummy,
 		autoconvert_v1alpha1_ResourceConsumption_To_experimental_ResourceConsumption,
+		autoconvert_v1alpha1_RoleBindingList_To_experimental_RoleBindingList,
+		autoconvert_v1alpha1_RoleBinding_To_experimental_RoleBinding,
+		autoconvert_v1alpha1_RoleList_To_experimental_RoleList,
+		autoconvert_v1alpha1_Role_To_experimental_Role,
 		autoconvert_v1alpha1_RollingUpdateDeployment_To_experimental_RollingUpdateDeployment,
 		autoconvert_v1alpha1_ScaleSpec_To_experimental_ScaleSpec,
 		autoconvert_v1alpha1_ScaleStatus_To_experimental_ScaleStatus,
 		autoconvert_v1alpha1_Scale_To_experimental_Scale,
+		autoconvert_v1alpha1_Subject_To_experimental_Subject,
 		autoconvert_v1alpha1_SubresourceReference_To_experimental_SubresourceReference,
 		autoconvert_v1alpha1_ThirdPartyResourceDataList_To_experimental_ThirdPartyResourceDataList,
 		autoconvert_v1alpha1_ThirdPartyResourceData_To_experimental_ThirdPartyResourceData,
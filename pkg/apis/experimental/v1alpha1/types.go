@@ -166,6 +166,54 @@ type APIVersion struct {
 
 	// The API group to add this object into, default 'experimental'.
 	APIGroup string `json:"apiGroup,omitempty"`
+	// Schema describes the objects of this version. Objects created or updated through the API are
+	// rejected if they don't conform to it. If nil, objects are not validated.
+	Schema *JSONSchemaProps `json:"schema,omitempty"`
+
+	// ConversionHook is the https URL of a service which converts objects written in this version
+	// to the version currently served, which is the first version of the resource, before they
+	// are stored. Objects are sent to it in a POST request and the converted object is expected
+	// in the response. It may not be on a loopback or link-local address. If empty, objects
+	// written in this version are stored unchanged.
+	ConversionHook string `json:"conversionHook,omitempty"`
+}
+
+// JSONSchemaProps is the subset of JSON Schema (http://json-schema.org) used to describe and
+// validate third party objects.
+type JSONSchemaProps struct {
+	// Description of the value.
+	Description string `json:"description,omitempty"`
+
+	// Type of the value, one of object, array, string, integer, number or boolean. Any type is
+	// allowed if empty.
+	Type string `json:"type,omitempty"`
+
+	// Properties describes the fields of an object.
+	Properties map[string]JSONSchemaProps `json:"properties,omitempty"`
+
+	// Required lists the fields an object must have.
+	Required []string `json:"required,omitempty"`
+
+	// AdditionalProperties allows an object to have fields which are not listed in Properties.
+	AdditionalProperties bool `json:"additionalProperties,omitempty"`
+
+	// Items describes the elements of an array.
+	Items *JSONSchemaProps `json:"items,omitempty"`
+
+	// Enum lists the values a string may have.
+	Enum []string `json:"enum,omitempty"`
+
+	// Pattern is a regular expression a string must match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// Minimum is the smallest value a number may have.
+	Minimum *int64 `json:"minimum,omitempty"`
+
+	// Maximum is the largest value a number may have.
+	Maximum *int64 `json:"maximum,omitempty"`
+
+	// MaxLength is the maximum length of a string.
+	MaxLength *int64 `json:"maxLength,omitempty"`
 }
 
 // An internal object, used for versioned storage in etcd.  Not exposed to the end user.
@@ -549,3 +597,131 @@ type IngressBackend struct {
 	// Specifies the protocol of the referenced service.
 	Protocol v1.Protocol `json:"protocol,omitempty"`
 }
+
+// Kinds of subjects a RoleBinding or ClusterRoleBinding may refer to.
+const (
+	UserKind           = "User"
+	GroupKind          = "Group"
+	ServiceAccountKind = "ServiceAccount"
+)
+
+// PolicyRule holds information that describes a policy rule, but does not contain information
+// about who the rule applies to or which namespace the rule applies to.
+type PolicyRule struct {
+	// Verbs is a list of verbs that apply to all of the resources in this rule. '*' represents all verbs.
+	Verbs []string `json:"verbs"`
+
+	// APIGroups is a list of API groups of the resources in this rule. The legacy API has the
+	// empty group. '*' represents all groups.
+	APIGroups []string `json:"apiGroups"`
+
+	// Resources is a list of resources this rule applies to. '*' represents all resources.
+	Resources []string `json:"resources"`
+}
+
+// Subject identifies a user, group or service account a role binding grants access to.
+type Subject struct {
+	// Kind of subject being referenced. One of "User", "Group" or "ServiceAccount".
+	Kind string `json:"kind"`
+
+	// Name of the subject being referenced.
+	Name string `json:"name"`
+
+	// Namespace of the referenced service account. Ignored for users and groups.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding.
+type Role struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this Role.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// RoleList is a collection of Roles.
+type RoleList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of Roles.
+	Items []Role `json:"items"`
+}
+
+// RoleBinding grants the permissions of a Role or ClusterRole to a list of subjects within its namespace.
+type RoleBinding struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the identities the role applies to.
+	Subjects []Subject `json:"subjects"`
+
+	// RoleRef references a Role in the binding's namespace or a ClusterRole.
+	RoleRef v1.ObjectReference `json:"roleRef"`
+}
+
+// RoleBindingList is a collection of RoleBindings.
+type RoleBindingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of RoleBindings.
+	Items []RoleBinding `json:"items"`
+}
+
+// ClusterRole is a cluster level, logical grouping of PolicyRules that can be referenced as a unit
+// by a RoleBinding or ClusterRoleBinding.
+type ClusterRole struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules holds all the PolicyRules for this ClusterRole.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// ClusterRoleList is a collection of ClusterRoles.
+type ClusterRoleList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterRoles.
+	Items []ClusterRole `json:"items"`
+}
+
+// ClusterRoleBinding grants the permissions of a ClusterRole to a list of subjects in every namespace.
+type ClusterRoleBinding struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the identities the role applies to.
+	Subjects []Subject `json:"subjects"`
+
+	// RoleRef references a ClusterRole.
+	RoleRef v1.ObjectReference `json:"roleRef"`
+}
+
+// ClusterRoleBindingList is a collection of ClusterRoleBindings.
+type ClusterRoleBindingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterRoleBindings.
+	Items []ClusterRoleBinding `json:"items"`
+}
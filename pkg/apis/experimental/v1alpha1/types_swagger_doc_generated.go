@@ -28,15 +28,58 @@ package v1alpha1
 
 // AUTO-GENERATED FUNCTIONS START HERE
 var map_APIVersion = map[string]string{
-	"":         "An APIVersion represents a single concrete version of an object model.",
-	"name":     "Name of this version (e.g. 'v1').",
-	"apiGroup": "The API group to add this object into, default 'experimental'.",
+	"":               "An APIVersion represents a single concrete version of an object model.",
+	"name":           "Name of this version (e.g. 'v1').",
+	"apiGroup":       "The API group to add this object into, default 'experimental'.",
+	"schema":         "Schema describes the objects of this version. Objects created or updated through the API are rejected if they don't conform to it. If nil, objects are not validated.",
+	"conversionHook": "ConversionHook is the https URL of a service which converts objects written in this version to the version currently served, which is the first version of the resource, before they are stored. Objects are sent to it in a POST request and the converted object is expected in the response. It may not be on a loopback or link-local address. If empty, objects written in this version are stored unchanged.",
 }
 
 func (APIVersion) SwaggerDoc() map[string]string {
 	return map_APIVersion
 }
 
+var map_ClusterRole = map[string]string{
+	"":         "ClusterRole is a cluster level, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding or ClusterRoleBinding.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"rules":    "Rules holds all the PolicyRules for this ClusterRole.",
+}
+
+func (ClusterRole) SwaggerDoc() map[string]string {
+	return map_ClusterRole
+}
+
+var map_ClusterRoleBinding = map[string]string{
+	"":         "ClusterRoleBinding grants the permissions of a ClusterRole to a list of subjects in every namespace.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"subjects": "Subjects holds references to the identities the role applies to.",
+	"roleRef":  "RoleRef references a ClusterRole.",
+}
+
+func (ClusterRoleBinding) SwaggerDoc() map[string]string {
+	return map_ClusterRoleBinding
+}
+
+var map_ClusterRoleBindingList = map[string]string{
+	"":         "ClusterRoleBindingList is a collection of ClusterRoleBindings.",
+	"metadata": "Standard list metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of ClusterRoleBindings.",
+}
+
+func (ClusterRoleBindingList) SwaggerDoc() map[string]string {
+	return map_ClusterRoleBindingList
+}
+
+var map_ClusterRoleList = map[string]string{
+	"":         "ClusterRoleList is a collection of ClusterRoles.",
+	"metadata": "Standard list metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of ClusterRoles.",
+}
+
+func (ClusterRoleList) SwaggerDoc() map[string]string {
+	return map_ClusterRoleList
+}
+
 var map_DaemonSet = map[string]string{
 	"":         "DaemonSet represents the configuration of a daemon set.",
 	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
@@ -248,6 +291,25 @@ func (IngressStatus) SwaggerDoc() map[string]string {
 	return map_IngressStatus
 }
 
+var map_JSONSchemaProps = map[string]string{
+	"":                     "JSONSchemaProps is the subset of JSON Schema (http://json-schema.org) used to describe and validate third party objects.",
+	"description":          "Description of the value.",
+	"type":                 "Type of the value, one of object, array, string, integer, number or boolean. Any type is allowed if empty.",
+	"properties":           "Properties describes the fields of an object.",
+	"required":             "Required lists the fields an object must have.",
+	"additionalProperties": "AdditionalProperties allows an object to have fields which are not listed in Properties.",
+	"items":                "Items describes the elements of an array.",
+	"enum":                 "Enum lists the values a string may have.",
+	"pattern":              "Pattern is a regular expression a string must match.",
+	"minimum":              "Minimum is the smallest value a number may have.",
+	"maximum":              "Maximum is the largest value a number may have.",
+	"maxLength":            "MaxLength is the maximum length of a string.",
+}
+
+func (JSONSchemaProps) SwaggerDoc() map[string]string {
+	return map_JSONSchemaProps
+}
+
 var map_Job = map[string]string{
 	"":         "Job represents the configuration of a single job.",
 	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
@@ -309,6 +371,17 @@ func (JobStatus) SwaggerDoc() map[string]string {
 	return map_JobStatus
 }
 
+var map_PolicyRule = map[string]string{
+	"":          "PolicyRule holds information that describes a policy rule, but does not contain information about who the rule applies to or which namespace the rule applies to.",
+	"verbs":     "Verbs is a list of verbs that apply to all of the resources in this rule. '*' represents all verbs.",
+	"apiGroups": "APIGroups is a list of API groups of the resources in this rule. The legacy API has the empty group. '*' represents all groups.",
+	"resources": "Resources is a list of resources this rule applies to. '*' represents all resources.",
+}
+
+func (PolicyRule) SwaggerDoc() map[string]string {
+	return map_PolicyRule
+}
+
 var map_ReplicationControllerDummy = map[string]string{
 	"": "Dummy definition",
 }
@@ -327,6 +400,47 @@ func (ResourceConsumption) SwaggerDoc() map[string]string {
 	return map_ResourceConsumption
 }
 
+var map_Role = map[string]string{
+	"":         "Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"rules":    "Rules holds all the PolicyRules for this Role.",
+}
+
+func (Role) SwaggerDoc() map[string]string {
+	return map_Role
+}
+
+var map_RoleBinding = map[string]string{
+	"":         "RoleBinding grants the permissions of a Role or ClusterRole to a list of subjects within its namespace.",
+	"metadata": "Standard object's metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"subjects": "Subjects holds references to the identities the role applies to.",
+	"roleRef":  "RoleRef references a Role in the binding's namespace or a ClusterRole.",
+}
+
+func (RoleBinding) SwaggerDoc() map[string]string {
+	return map_RoleBinding
+}
+
+var map_RoleBindingList = map[string]string{
+	"":         "RoleBindingList is a collection of RoleBindings.",
+	"metadata": "Standard list metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of RoleBindings.",
+}
+
+func (RoleBindingList) SwaggerDoc() map[string]string {
+	return map_RoleBindingList
+}
+
+var map_RoleList = map[string]string{
+	"":         "RoleList is a collection of Roles.",
+	"metadata": "Standard list metadata. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata",
+	"items":    "Items is the list of Roles.",
+}
+
+func (RoleList) SwaggerDoc() map[string]string {
+	return map_RoleList
+}
+
 var map_RollingUpdateDeployment = map[string]string{
 	"":                "Spec to control the desired behavior of rolling update.",
 	"maxUnavailable":  "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding up. This can not be 0 if MaxSurge is 0. By default, a fixed value of 1 is used. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
@@ -368,6 +482,17 @@ func (ScaleStatus) SwaggerDoc() map[string]string {
 	return map_ScaleStatus
 }
 
+var map_Subject = map[string]string{
+	"":          "Subject identifies a user, group or service account a role binding grants access to.",
+	"kind":      "Kind of subject being referenced. One of \"User\", \"Group\" or \"ServiceAccount\".",
+	"name":      "Name of the subject being referenced.",
+	"namespace": "Namespace of the referenced service account. Ignored for users and groups.",
+}
+
+func (Subject) SwaggerDoc() map[string]string {
+	return map_Subject
+}
+
 var map_SubresourceReference = map[string]string{
 	"":            "SubresourceReference contains enough information to let you inspect or modify the referred subresource.",
 	"kind":        "Kind of the referent; More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds\"",
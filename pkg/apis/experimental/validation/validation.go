@@ -17,7 +17,14 @@ limitations under the License.
 package validation
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
 	apivalidation "k8s.io/kubernetes/pkg/api/validation"
@@ -93,6 +100,70 @@ func ValidateThirdPartyResource(obj *experimental.ThirdPartyResource) errs.Valid
 			allErrs = append(allErrs, errs.NewFieldDuplicate("version", version))
 		}
 		versions.Insert(version.Name)
+		if version.Schema != nil {
+			allErrs = append(allErrs, validateJSONSchemaProps(version.Schema).Prefix("schema").PrefixIndex(ix).Prefix("versions")...)
+		}
+		if len(version.ConversionHook) > 0 {
+			allErrs = append(allErrs, validateConversionHook(version.ConversionHook).PrefixIndex(ix).Prefix("versions")...)
+		}
+	}
+	return allErrs
+}
+
+// IsForbiddenConversionHookIP returns true if conversion hooks may not be called on ip, because
+// it belongs to the host the API server runs on or to its link, e.g. a cloud metadata service.
+func IsForbiddenConversionHookIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+func validateConversionHook(hook string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	u, err := url.Parse(hook)
+	if err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+		return append(allErrs, errs.NewFieldInvalid("conversionHook", hook, "must be an https URL"))
+	}
+	if u.User != nil || len(u.RawQuery) > 0 || len(u.Fragment) > 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("conversionHook", hook, "may not contain user info, a query or a fragment"))
+	}
+	host := strings.Trim(u.Host, "[]")
+	if h, _, err := net.SplitHostPort(u.Host); err == nil {
+		host = h
+	}
+	if ip := net.ParseIP(host); strings.ToLower(host) == "localhost" || (ip != nil && IsForbiddenConversionHookIP(ip)) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("conversionHook", hook, "may not be a loopback, link-local or unspecified address"))
+	}
+	return allErrs
+}
+
+var supportedSchemaTypes = sets.NewString("object", "array", "string", "integer", "number", "boolean")
+
+func validateJSONSchemaProps(schema *experimental.JSONSchemaProps) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(schema.Type) > 0 && !supportedSchemaTypes.Has(schema.Type) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("type", schema.Type, supportedSchemaTypes.List()))
+	}
+	for name := range schema.Properties {
+		property := schema.Properties[name]
+		allErrs = append(allErrs, validateJSONSchemaProps(&property).Prefix("properties["+name+"]")...)
+	}
+	for i, name := range schema.Required {
+		if _, found := schema.Properties[name]; !found && !schema.AdditionalProperties {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("required[%d]", i), name, "must be one of the properties"))
+		}
+	}
+	if schema.Items != nil {
+		allErrs = append(allErrs, validateJSONSchemaProps(schema.Items).Prefix("items")...)
+	}
+	if len(schema.Pattern) > 0 {
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid("pattern", schema.Pattern, err.Error()))
+		}
+	}
+	if schema.Minimum != nil && schema.Maximum != nil && *schema.Maximum < *schema.Minimum {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maximum", *schema.Maximum, "must be greater than or equal to minimum"))
+	}
+	if schema.MaxLength != nil && *schema.MaxLength < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxLength", *schema.MaxLength, isNegativeErrorMsg))
 	}
 	return allErrs
 }
@@ -289,6 +360,97 @@ func ValidateThirdPartyResourceData(obj *experimental.ThirdPartyResourceData) er
 	return allErrs
 }
 
+// ValidateThirdPartyResourceDataSchema checks that the data of obj conforms to schema. The apiVersion,
+// kind and metadata of the object are validated elsewhere and not described by the schema.
+func ValidateThirdPartyResourceDataSchema(obj *experimental.ThirdPartyResourceData, schema *experimental.JSONSchemaProps) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(obj.Data, &data); err != nil {
+		return append(allErrs, errs.NewFieldInvalid("", string(obj.Data), err.Error()))
+	}
+	delete(data, "apiVersion")
+	delete(data, "kind")
+	delete(data, "metadata")
+	return validateJSONValue(data, schema)
+}
+
+// validateJSONValue checks that value, as decoded by encoding/json, conforms to schema.
+func validateJSONValue(value interface{}, schema *experimental.JSONSchemaProps) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	switch schema.Type {
+	case "":
+	case "object":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be an object"))
+		}
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be an array"))
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be a string"))
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be an integer"))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be a number"))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return append(allErrs, errs.NewFieldInvalid("", value, "must be a boolean"))
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, found := value[name]; !found {
+				allErrs = append(allErrs, errs.NewFieldRequired(name))
+			}
+		}
+		for name, field := range value {
+			property, found := schema.Properties[name]
+			if !found {
+				if len(schema.Properties) > 0 && !schema.AdditionalProperties {
+					allErrs = append(allErrs, errs.NewFieldNotFound(name, field))
+				}
+				continue
+			}
+			allErrs = append(allErrs, validateJSONValue(field, &property).Prefix(name)...)
+		}
+	case []interface{}:
+		if schema.Items != nil {
+			for i, item := range value {
+				allErrs = append(allErrs, validateJSONValue(item, schema.Items).PrefixIndex(i)...)
+			}
+		}
+	case string:
+		if len(schema.Enum) > 0 && !sets.NewString(schema.Enum...).Has(value) {
+			allErrs = append(allErrs, errs.NewFieldValueNotSupported("", value, schema.Enum))
+		}
+		if len(schema.Pattern) > 0 {
+			if matched, err := regexp.MatchString(schema.Pattern, value); err != nil || !matched {
+				allErrs = append(allErrs, errs.NewFieldInvalid("", value, "must match the regular expression "+schema.Pattern))
+			}
+		}
+		if schema.MaxLength != nil && int64(len(value)) > *schema.MaxLength {
+			allErrs = append(allErrs, errs.NewFieldTooLong("", value, int(*schema.MaxLength)))
+		}
+	case float64:
+		if schema.Minimum != nil && value < float64(*schema.Minimum) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("", value, fmt.Sprintf("must be greater than or equal to %d", *schema.Minimum)))
+		}
+		if schema.Maximum != nil && value > float64(*schema.Maximum) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("", value, fmt.Sprintf("must be less than or equal to %d", *schema.Maximum)))
+		}
+	}
+	return allErrs
+}
+
 func ValidateJob(job *experimental.Job) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	// Jobs and rcs have the same name validation
@@ -371,3 +533,147 @@ func ValidateJobStatusUpdate(oldStatus, status experimental.JobStatus) errs.Vali
 	allErrs = append(allErrs, ValidateJobStatus(&status)...)
 	return allErrs
 }
+
+// ValidateRoleName can be used to check whether the given role or role binding name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateRoleName(name string, prefix bool) (bool, string) {
+	return apivalidation.NameIsDNSSubdomain(name, prefix)
+}
+
+func validatePolicyRules(rules []experimental.PolicyRule) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, rule := range rules {
+		ruleErrs := errs.ValidationErrorList{}
+		if len(rule.Verbs) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("verbs"))
+		}
+		if len(rule.APIGroups) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("apiGroups"))
+		}
+		if len(rule.Resources) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("resources"))
+		}
+		allErrs = append(allErrs, ruleErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+var supportedSubjectKinds = sets.NewString(experimental.UserKind, experimental.GroupKind, experimental.ServiceAccountKind)
+
+func validateSubjects(subjects []experimental.Subject) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, subject := range subjects {
+		subjectErrs := errs.ValidationErrorList{}
+		if len(subject.Name) == 0 {
+			subjectErrs = append(subjectErrs, errs.NewFieldRequired("name"))
+		}
+		if !supportedSubjectKinds.Has(subject.Kind) {
+			subjectErrs = append(subjectErrs, errs.NewFieldValueNotSupported("kind", subject.Kind, supportedSubjectKinds.List()))
+		}
+		if subject.Kind == experimental.ServiceAccountKind {
+			if len(subject.Namespace) == 0 {
+				subjectErrs = append(subjectErrs, errs.NewFieldRequired("namespace"))
+			} else if ok, msg := apivalidation.ValidateNamespaceName(subject.Namespace, false); !ok {
+				subjectErrs = append(subjectErrs, errs.NewFieldInvalid("namespace", subject.Namespace, msg))
+			}
+		}
+		allErrs = append(allErrs, subjectErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+func validateRoleRef(roleRef api.ObjectReference, kinds ...string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(roleRef.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if ok, msg := ValidateRoleName(roleRef.Name, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("name", roleRef.Name, msg))
+	}
+	if !sets.NewString(kinds...).Has(roleRef.Kind) {
+		allErrs = append(allErrs, errs.NewFieldValueNotSupported("kind", roleRef.Kind, kinds))
+	}
+	return allErrs
+}
+
+// ValidateRole tests if required fields in the Role are set.
+func ValidateRole(role *experimental.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&role.ObjectMeta, true, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateRoleUpdate tests if required fields in the Role are set and the update is allowed.
+func ValidateRoleUpdate(oldRole, role *experimental.Role) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&role.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+func validateRoleBindingSpec(binding *experimental.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, validateSubjects(binding.Subjects).Prefix("subjects")...)
+	allErrs = append(allErrs, validateRoleRef(binding.RoleRef, "Role", "ClusterRole").Prefix("roleRef")...)
+	if binding.RoleRef.Kind == "Role" && len(binding.RoleRef.Namespace) > 0 && binding.RoleRef.Namespace != binding.Namespace {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef.namespace", binding.RoleRef.Namespace, "must refer to a Role in the binding's namespace"))
+	}
+	return allErrs
+}
+
+// ValidateRoleBinding tests if required fields in the RoleBinding are set.
+func ValidateRoleBinding(binding *experimental.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&binding.ObjectMeta, true, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSpec(binding)...)
+	return allErrs
+}
+
+// ValidateRoleBindingUpdate tests if required fields in the RoleBinding are set and the update is allowed.
+func ValidateRoleBindingUpdate(oldBinding, binding *experimental.RoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&binding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateRoleBindingSpec(binding)...)
+	if !api.Semantic.DeepEqual(oldBinding.RoleRef, binding.RoleRef) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef", binding.RoleRef, "field is immutable"))
+	}
+	return allErrs
+}
+
+// ValidateClusterRole tests if required fields in the ClusterRole are set.
+func ValidateClusterRole(role *experimental.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&role.ObjectMeta, false, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRoleUpdate tests if required fields in the ClusterRole are set and the update is allowed.
+func ValidateClusterRoleUpdate(oldRole, role *experimental.ClusterRole) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&role.ObjectMeta, &oldRole.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validatePolicyRules(role.Rules).Prefix("rules")...)
+	return allErrs
+}
+
+// ValidateClusterRoleBinding tests if required fields in the ClusterRoleBinding are set.
+func ValidateClusterRoleBinding(binding *experimental.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&binding.ObjectMeta, false, ValidateRoleName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateSubjects(binding.Subjects).Prefix("subjects")...)
+	allErrs = append(allErrs, validateRoleRef(binding.RoleRef, "ClusterRole").Prefix("roleRef")...)
+	return allErrs
+}
+
+// ValidateClusterRoleBindingUpdate tests if required fields in the ClusterRoleBinding are set and the update is allowed.
+func ValidateClusterRoleBindingUpdate(oldBinding, binding *experimental.ClusterRoleBinding) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&binding.ObjectMeta, &oldBinding.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateSubjects(binding.Subjects).Prefix("subjects")...)
+	allErrs = append(allErrs, validateRoleRef(binding.RoleRef, "ClusterRole").Prefix("roleRef")...)
+	if !api.Semantic.DeepEqual(oldBinding.RoleRef, binding.RoleRef) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("roleRef", binding.RoleRef, "field is immutable"))
+	}
+	return allErrs
+}
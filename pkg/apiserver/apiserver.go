@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net"
 	"net/http"
 	"path"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/kubernetes/pkg/apiserver/metrics"
 	"k8s.io/kubernetes/pkg/healthz"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/flushwriter"
@@ -95,6 +97,10 @@ type APIGroupVersion struct {
 	Convertor runtime.ObjectConvertor
 	Linker    runtime.SelfLinker
 
+	// ProtobufCodec, if set, is used to serve clients that accept the protobuf
+	// content type and to decode request bodies sent in it.
+	ProtobufCodec runtime.Codec
+
 	Admit   admission.Interface
 	Context api.RequestContextMapper
 
@@ -151,7 +157,7 @@ func (g *APIGroupVersion) UpdateREST(container *restful.Container) error {
 
 // newInstaller is a helper to create the installer.  Used by InstallREST and UpdateREST.
 func (g *APIGroupVersion) newInstaller() *APIInstaller {
-	info := &APIRequestInfoResolver{sets.NewString(strings.TrimPrefix(g.Root, "/")), g.Mapper}
+	info := &APIRequestInfoResolver{APIPrefixes: sets.NewString(strings.TrimPrefix(g.Root, "/")), RestMapper: g.Mapper}
 
 	prefix := path.Join(g.Root, g.Version)
 	installer := &APIInstaller{
@@ -253,7 +259,7 @@ func AddApiWebService(container *restful.Container, apiPrefix string, versions [
 }
 
 // Adds a service to return the supported api versions at /apis.
-func AddApisWebService(container *restful.Container, apiPrefix string, groups []api.APIGroup) {
+func AddApisWebService(container *restful.Container, apiPrefix string, groups func() []api.APIGroup) {
 	rootAPIHandler := RootAPIHandler(groups)
 	ws := new(restful.WebService)
 	ws.Path(apiPrefix)
@@ -307,10 +313,12 @@ func APIVersionHandler(versions ...string) restful.RouteFunction {
 }
 
 // RootAPIHandler returns a handler which will list the provided groups and versions as available.
-func RootAPIHandler(groups []api.APIGroup) restful.RouteFunction {
+// groups is called on every request, so groups which are added later, like those delegated to
+// other API servers, are listed as well.
+func RootAPIHandler(groups func() []api.APIGroup) restful.RouteFunction {
 	return func(req *restful.Request, resp *restful.Response) {
 		// TODO: use restful's Response methods
-		writeRawJSON(http.StatusOK, api.APIGroupList{Groups: groups}, resp.ResponseWriter)
+		writeRawJSON(http.StatusOK, api.APIGroupList{Groups: groups()}, resp.ResponseWriter)
 	}
 }
 
@@ -364,6 +372,47 @@ func write(statusCode int, apiVersion string, codec runtime.Codec, object runtim
 	writeJSON(statusCode, codec, object, w, isPrettyPrint(req))
 }
 
+// writeNegotiated renders object as protobuf when the scope has a protobuf codec and the
+// client accepts it, and otherwise behaves as write.
+func writeNegotiated(statusCode int, scope RequestScope, object runtime.Object, w http.ResponseWriter, req *http.Request) {
+	if _, ok := object.(rest.ResourceStreamer); !ok && scope.ProtobufCodec != nil && acceptsProtobuf(req) {
+		writeProtobuf(statusCode, scope.ProtobufCodec, scope.Codec, object, w)
+		return
+	}
+	write(statusCode, scope.APIVersion, scope.Codec, object, w, req)
+}
+
+// acceptsProtobuf returns true if the Accept header of req lists the protobuf content type
+// without marking it as not acceptable with a quality of zero.
+func acceptsProtobuf(req *http.Request) bool {
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(accept)
+		if err != nil || mediaType != protobuf.ContentType {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if quality, err := strconv.ParseFloat(q, 64); err != nil || quality <= 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// writeProtobuf renders an object as protobuf to the response. Encoding failures are
+// reported as JSON using errorCodec.
+func writeProtobuf(statusCode int, codec, errorCodec runtime.Codec, object runtime.Object, w http.ResponseWriter) {
+	data, err := codec.Encode(object)
+	if err != nil {
+		errorJSONFatal(err, errorCodec, w)
+		return
+	}
+	w.Header().Set("Content-Type", protobuf.ContentType)
+	w.WriteHeader(statusCode)
+	w.Write(data)
+}
+
 func isPrettyPrint(req *http.Request) bool {
 	pp := req.URL.Query().Get("pretty")
 	if len(pp) > 0 {
@@ -41,6 +41,7 @@ import (
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/version"
 	"k8s.io/kubernetes/pkg/watch"
@@ -100,6 +101,9 @@ func addTestTypes() {
 		FieldSelector        string `json:"fields,omitempty"`
 		Watch                bool   `json:"watch,omitempty"`
 		ResourceVersion      string `json:"resourceVersion,omitempty"`
+		Limit                int64  `json:"limit,omitempty"`
+		Continue             string `json:"continue,omitempty"`
+		AllowWatchBookmarks  bool   `json:"allowWatchBookmarks,omitempty"`
 	}
 	api.Scheme.AddKnownTypes(testVersion, &Simple{}, &SimpleList{}, &unversioned.Status{}, &ListOptions{}, &api.DeleteOptions{}, &SimpleGetOptions{}, &SimpleRoot{})
 	api.Scheme.AddKnownTypes(testVersion, &api.Pod{})
@@ -113,6 +117,9 @@ func addNewTestTypes() {
 		FieldSelector        string `json:"fieldSelector,omitempty"`
 		Watch                bool   `json:"watch,omitempty"`
 		ResourceVersion      string `json:"resourceVersion,omitempty"`
+		Limit                int64  `json:"limit,omitempty"`
+		Continue             string `json:"continue,omitempty"`
+		AllowWatchBookmarks  bool   `json:"allowWatchBookmarks,omitempty"`
 	}
 	api.Scheme.AddKnownTypes(newVersion, &Simple{}, &SimpleList{}, &unversioned.Status{}, &ListOptions{}, &api.DeleteOptions{}, &SimpleGetOptions{}, &SimpleRoot{})
 }
@@ -190,7 +197,18 @@ func handleLinker(storage map[string]rest.Storage, selfLinker runtime.SelfLinker
 	return handleInternal(true, storage, admissionControl, selfLinker)
 }
 
+// tests with protobuf negotiation enabled
+func handleProtobuf(storage map[string]rest.Storage) http.Handler {
+	group := newTestGroupVersion(true, storage, admissionControl, selfLinker)
+	group.ProtobufCodec = protobuf.NewCodec(api.Scheme, testVersion)
+	return installTestGroupVersion(group)
+}
+
 func handleInternal(legacy bool, storage map[string]rest.Storage, admissionControl admission.Interface, selfLinker runtime.SelfLinker) http.Handler {
+	return installTestGroupVersion(newTestGroupVersion(legacy, storage, admissionControl, selfLinker))
+}
+
+func newTestGroupVersion(legacy bool, storage map[string]rest.Storage, admissionControl admission.Interface, selfLinker runtime.SelfLinker) *APIGroupVersion {
 	group := &APIGroupVersion{
 		Storage: storage,
 
@@ -215,7 +233,10 @@ func handleInternal(legacy bool, storage map[string]rest.Storage, admissionContr
 		group.Codec = newCodec
 		group.Mapper = namespaceMapper
 	}
+	return group
+}
 
+func installTestGroupVersion(group *APIGroupVersion) http.Handler {
 	container := restful.NewContainer()
 	container.Router(restful.CurlyRouter{})
 	mux := container.ServeMux
@@ -315,6 +336,7 @@ type SimpleRESTStorage struct {
 
 	actualNamespace  string
 	namespacePresent bool
+	dryRun           bool
 
 	// These are set when Watch is called
 	fakeWatch                  *watch.FakeWatcher
@@ -343,6 +365,23 @@ func (storage *SimpleRESTStorage) List(ctx api.Context, label labels.Selector, f
 	return result, storage.errors["list"]
 }
 
+// PagedRESTStorage is a SimpleRESTStorage which can list a page at a time.
+type PagedRESTStorage struct {
+	SimpleRESTStorage
+
+	requestedListOptions *api.ListOptions
+}
+
+func (storage *PagedRESTStorage) ListPage(ctx api.Context, options *api.ListOptions) (runtime.Object, error) {
+	storage.checkContext(ctx)
+	storage.requestedListOptions = options
+	result := &SimpleList{
+		ListMeta: unversioned.ListMeta{Continue: "next"},
+		Items:    storage.list[:options.Limit],
+	}
+	return result, storage.errors["list"]
+}
+
 type SimpleStream struct {
 	version     string
 	accept      string
@@ -389,6 +428,16 @@ func (storage *SimpleRESTStorage) Get(ctx api.Context, id string) (runtime.Objec
 
 func (storage *SimpleRESTStorage) checkContext(ctx api.Context) {
 	storage.actualNamespace, storage.namespacePresent = api.NamespaceFrom(ctx)
+	storage.dryRun = api.IsDryRun(ctx)
+}
+
+// DryRunRESTStorage is a SimpleRESTStorage which accepts dry run requests.
+type DryRunRESTStorage struct {
+	SimpleRESTStorage
+}
+
+func (storage *DryRunRESTStorage) SupportsDryRun() bool {
+	return true
 }
 
 func (storage *SimpleRESTStorage) Delete(ctx api.Context, id string, options *api.DeleteOptions) (runtime.Object, error) {
@@ -880,6 +929,124 @@ func TestList(t *testing.T) {
 	}
 }
 
+// PodRESTStorage is a SimpleRESTStorage for a kind without a field label
+// conversion function.
+type PodRESTStorage struct {
+	SimpleRESTStorage
+}
+
+func (storage *PodRESTStorage) New() runtime.Object {
+	return &api.Pod{}
+}
+
+func TestListObjectFieldSelector(t *testing.T) {
+	testCases := map[string]int{
+		"spec.nodeName=foo":                    http.StatusOK,
+		"spec.containers.0.image in (a,b)":     http.StatusOK,
+		"metadata.labels.app notin (web)":      http.StatusOK,
+		"spec.bogus=foo":                       http.StatusBadRequest,
+		"spec.containers.image=foo":            http.StatusBadRequest,
+		"spec.nodeName=foo,status.bogus!=true": http.StatusBadRequest,
+	}
+	for selector, status := range testCases {
+		simpleStorage := &PodRESTStorage{}
+		handler := handle(map[string]rest.Storage{"pods": simpleStorage})
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/api/version/namespaces/default/pods?fields=" + url.QueryEscape(selector))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", selector, err)
+			continue
+		}
+		if resp.StatusCode != status {
+			t.Errorf("%s: expected status %d, got %d", selector, status, resp.StatusCode)
+			continue
+		}
+		if status != http.StatusOK {
+			continue
+		}
+		expected, _ := fields.ParseSelector(selector)
+		if simpleStorage.requestedFieldSelector == nil || simpleStorage.requestedFieldSelector.String() != expected.String() {
+			t.Errorf("%s: unexpected field selector: %v", selector, simpleStorage.requestedFieldSelector)
+		}
+	}
+}
+
+func TestListPaged(t *testing.T) {
+	simpleStorage := PagedRESTStorage{
+		SimpleRESTStorage: SimpleRESTStorage{list: []Simple{{Other: "foo"}, {Other: "bar"}}},
+	}
+	handler := handleNew(map[string]rest.Storage{"simple": &simpleStorage})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/version2/namespaces/other/simple?labelSelector=a%3Db&limit=1&continue=abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status: %d", resp.StatusCode)
+	}
+	var list SimpleList
+	if _, err := extractBody(resp, &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Continue != "next" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+	options := simpleStorage.requestedListOptions
+	if options == nil || options.Limit != 1 || options.Continue != "abc" || options.LabelSelector.String() != "a=b" {
+		t.Errorf("unexpected list options: %#v", options)
+	}
+
+	// lists without a limit or continue token are not paged
+	simpleStorage.requestedListOptions = nil
+	resp, err = http.Get(server.URL + "/api/version2/namespaces/other/simple")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || simpleStorage.requestedListOptions != nil {
+		t.Errorf("unexpected paged list with status %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(server.URL + "/api/version2/namespaces/other/simple?limit=-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected status for a negative limit: %d", resp.StatusCode)
+	}
+}
+
+func TestListUnpaged(t *testing.T) {
+	simpleStorage := SimpleRESTStorage{list: []Simple{{Other: "foo"}, {Other: "bar"}}}
+	handler := handleNew(map[string]rest.Storage{"simple": &simpleStorage})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// the limit is advisory
+	resp, err := http.Get(server.URL + "/api/version2/namespaces/other/simple?limit=1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var list SimpleList
+	if _, err := extractBody(resp, &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || len(list.Items) != 2 {
+		t.Errorf("unexpected list with status %d: %#v", resp.StatusCode, list)
+	}
+
+	resp, err = http.Get(server.URL + "/api/version2/namespaces/other/simple?continue=abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected status for a continue token: %d", resp.StatusCode)
+	}
+}
+
 func TestErrorList(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{
@@ -1060,6 +1227,62 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetProtobuf(t *testing.T) {
+	simpleStorage := SimpleRESTStorage{
+		item: Simple{
+			Other: "foo",
+		},
+	}
+	handler := handleProtobuf(map[string]rest.Storage{"simple": &simpleStorage})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	table := []struct {
+		accept      string
+		contentType string
+	}{
+		{"", "application/json"},
+		{"application/json", "application/json"},
+		{protobuf.ContentType, protobuf.ContentType},
+		{protobuf.ContentType + ";q=1.0, application/json", protobuf.ContentType},
+		{protobuf.ContentType + ";q=0, application/json", "application/json"},
+		{"application/json, " + protobuf.ContentType + "; q=0.0", "application/json"},
+	}
+	pbCodec := protobuf.NewCodec(api.Scheme, testVersion)
+	for _, item := range table {
+		req, err := http.NewRequest("GET", server.URL+"/api/version/namespaces/default/simple/id", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req.Header.Set("Accept", item.accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%q: unexpected response: %d %s", item.accept, resp.StatusCode, string(body))
+		}
+		if contentType := resp.Header.Get("Content-Type"); contentType != item.contentType {
+			t.Errorf("%q: expected content type %q, got %q", item.accept, item.contentType, contentType)
+		}
+		if protobuf.IsProtobuf(body) != (item.contentType == protobuf.ContentType) {
+			t.Errorf("%q: unexpected body encoding: %q", item.accept, string(body))
+		}
+		var itemOut Simple
+		if err := pbCodec.DecodeInto(body, &itemOut); err != nil {
+			t.Fatalf("%q: unexpected error: %v", item.accept, err)
+		}
+		if itemOut.Other != simpleStorage.item.Other {
+			t.Errorf("%q: unexpected data: %#v", item.accept, itemOut)
+		}
+	}
+}
+
 func TestGetBinary(t *testing.T) {
 	simpleStorage := SimpleRESTStorage{
 		stream: &SimpleStream{
@@ -1673,6 +1896,92 @@ func TestPatch(t *testing.T) {
 	}
 }
 
+func TestCreateProtobuf(t *testing.T) {
+	storage := SimpleRESTStorage{}
+	handler := handleProtobuf(map[string]rest.Storage{"foo": &storage})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	pbCodec := protobuf.NewCodec(api.Scheme, testVersion)
+	simple := &Simple{
+		ObjectMeta: api.ObjectMeta{Name: "bar"},
+		Other:      "baz",
+	}
+	data, err := pbCodec.Encode(simple)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	request, err := http.NewRequest("POST", server.URL+"/api/version/namespaces/default/foo", bytes.NewBuffer(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	request.Header.Set("Content-Type", protobuf.ContentType)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+
+	var itemOut Simple
+	body, err := extractBody(response, &itemOut)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if itemOut.Name != simple.Name || itemOut.Other != simple.Other {
+		t.Errorf("Unexpected data: %#v, expected %#v (%s)", itemOut, simple, string(body))
+	}
+}
+
+func TestPatchApply(t *testing.T) {
+	storage := map[string]rest.Storage{}
+	ID := "id"
+	item := &Simple{
+		ObjectMeta: api.ObjectMeta{
+			Name: ID,
+			Annotations: map[string]string{
+				api.LastAppliedConfigAnnotation: `{"labels":{"stale":"x"},"metadata":{"name":"id"},"other":"bar"}`,
+			},
+		},
+		Other:  "bar",
+		Labels: map[string]string{"stale": "x", "owner": "controller"},
+	}
+	simpleStorage := SimpleRESTStorage{item: *item}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := http.Client{}
+	config := `{"metadata":{"name":"id","annotations":{"` + api.LastAppliedConfigAnnotation + `":"ignored"}},"labels":{"foo":"bar"}}`
+	request, err := http.NewRequest("PATCH", server.URL+"/api/version/namespaces/default/simple/"+ID, bytes.NewReader([]byte(config)))
+	request.Header.Set("Content-Type", string(api.ApplyPatchType))
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response %#v", response)
+	}
+
+	updated := simpleStorage.updated
+	if updated == nil {
+		t.Fatalf("expected an update")
+	}
+	expectedLabels := map[string]string{"foo": "bar", "owner": "controller"}
+	if !reflect.DeepEqual(updated.Labels, expectedLabels) {
+		t.Errorf("expected labels %v, got %v", expectedLabels, updated.Labels)
+	}
+	if updated.Other != "" {
+		t.Errorf("expected field removed from the applied configuration to be deleted, got %q", updated.Other)
+	}
+	expectedConfig := `{"labels":{"foo":"bar"},"metadata":{"name":"id"}}`
+	if applied := updated.Annotations[api.LastAppliedConfigAnnotation]; applied != expectedConfig {
+		t.Errorf("expected applied configuration %s, got %s", expectedConfig, applied)
+	}
+}
+
 func TestPatchRequiresMatchingName(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	ID := "id"
@@ -1947,6 +2256,101 @@ func TestUpdateMissing(t *testing.T) {
 	}
 }
 
+// recordingAdmission admits every request and records the attributes of the last one.
+type recordingAdmission struct {
+	attributes admission.Attributes
+}
+
+func (r *recordingAdmission) Admit(a admission.Attributes) error {
+	r.attributes = a
+	return nil
+}
+
+func (r *recordingAdmission) Handles(operation admission.Operation) bool {
+	return true
+}
+
+func TestDryRun(t *testing.T) {
+	table := map[string]struct {
+		storage      rest.Storage
+		method       string
+		path         string
+		expectStatus int
+	}{
+		"create": {
+			storage:      &DryRunRESTStorage{},
+			method:       "POST",
+			path:         "/api/version/namespaces/default/foo?dryRun=true",
+			expectStatus: http.StatusCreated,
+		},
+		"update": {
+			storage:      &DryRunRESTStorage{},
+			method:       "PUT",
+			path:         "/api/version/namespaces/default/foo/bar?dryRun=true",
+			expectStatus: http.StatusOK,
+		},
+		"delete": {
+			storage:      &DryRunRESTStorage{},
+			method:       "DELETE",
+			path:         "/api/version/namespaces/default/foo/bar?dryRun=true",
+			expectStatus: http.StatusOK,
+		},
+		"unsupported": {
+			storage:      &SimpleRESTStorage{},
+			method:       "POST",
+			path:         "/api/version/namespaces/default/foo?dryRun=true",
+			expectStatus: http.StatusBadRequest,
+		},
+		"invalid": {
+			storage:      &DryRunRESTStorage{},
+			method:       "POST",
+			path:         "/api/version/namespaces/default/foo?dryRun=maybe",
+			expectStatus: http.StatusBadRequest,
+		},
+	}
+	for name, item := range table {
+		admit := &recordingAdmission{}
+		handler := handleInternal(true, map[string]rest.Storage{"foo": item.storage}, admit, selfLinker)
+		server := httptest.NewServer(handler)
+
+		body := &bytes.Buffer{}
+		if item.method != "DELETE" {
+			data, err := codec.Encode(&Simple{ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "default"}, Other: "baz"})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			body.Write(data)
+		}
+		request, err := http.NewRequest(item.method, server.URL+item.path, body)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		response.Body.Close()
+		server.Close()
+
+		if response.StatusCode != item.expectStatus {
+			t.Errorf("%s: expected status %d, got %d", name, item.expectStatus, response.StatusCode)
+			continue
+		}
+		if item.expectStatus >= http.StatusBadRequest {
+			if admit.attributes != nil {
+				t.Errorf("%s: rejected request should not be admitted", name)
+			}
+			continue
+		}
+		if !item.storage.(*DryRunRESTStorage).dryRun {
+			t.Errorf("%s: storage was not asked for a dry run", name)
+		}
+		if admit.attributes == nil || !admit.attributes.IsDryRun() {
+			t.Errorf("%s: admission was not told of the dry run: %#v", name, admit.attributes)
+		}
+	}
+}
+
 func TestCreateNotFound(t *testing.T) {
 	handler := handle(map[string]rest.Storage{
 		"simple": &SimpleRESTStorage{
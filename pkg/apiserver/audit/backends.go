@@ -0,0 +1,171 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// writerBackend writes each event as a single line of JSON to an io.Writer.
+type writerBackend struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterBackend returns a Backend which writes events as JSON lines to w.
+func NewWriterBackend(w io.Writer) Backend {
+	return &writerBackend{w: w}
+}
+
+func (b *writerBackend) Log(event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	_, err = b.w.Write(append(line, '\n'))
+	return err
+}
+
+func (b *writerBackend) Close() error {
+	if c, ok := b.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// fileBackend writes events as JSON lines to a file, rotating it once it
+// grows past maxSize bytes. Rotated files are suffixed with .1, .2, ... with
+// .1 being the most recent, and at most maxBackups of them are retained.
+type fileBackend struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileBackend returns a Backend which appends events to the file at path.
+// If maxSize is zero the file is never rotated.
+func NewFileBackend(path string, maxSize int64, maxBackups int) (Backend, error) {
+	b := &fileBackend{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := b.open(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *fileBackend) open() error {
+	file, err := os.OpenFile(b.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log %q: %v", b.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	b.file = file
+	b.size = info.Size()
+	return nil
+}
+
+// rotate closes the current file, shifts existing backups up by one and
+// starts a new, empty file. Must be called with the lock held.
+func (b *fileBackend) rotate() error {
+	if err := b.file.Close(); err != nil {
+		return err
+	}
+	if b.maxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", b.path, b.maxBackups))
+		for i := b.maxBackups - 1; i > 0; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", b.path, i), fmt.Sprintf("%s.%d", b.path, i+1))
+		}
+		if err := os.Rename(b.path, b.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(b.path); err != nil {
+		return err
+	}
+	return b.open()
+}
+
+func (b *fileBackend) Log(event *Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.maxSize > 0 && b.size > 0 && b.size+int64(len(line)) > b.maxSize {
+		if err := b.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := b.file.Write(line)
+	b.size += int64(n)
+	return err
+}
+
+func (b *fileBackend) Close() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.file.Close()
+}
+
+// unionBackend fans events out to several backends.
+type unionBackend []Backend
+
+// NewUnionBackend returns a Backend which logs every event to each of the given backends.
+func NewUnionBackend(backends ...Backend) Backend {
+	return unionBackend(backends)
+}
+
+func (u unionBackend) Log(event *Event) error {
+	var errlist []error
+	for _, b := range u {
+		if err := b.Log(event); err != nil {
+			errlist = append(errlist, err)
+		}
+	}
+	return utilerrors.NewAggregate(errlist)
+}
+
+func (u unionBackend) Close() error {
+	var errlist []error
+	for _, b := range u {
+		if err := b.Close(); err != nil {
+			errlist = append(errlist, err)
+		}
+	}
+	return utilerrors.NewAggregate(errlist)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileBackendRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	backend, err := NewFileBackend(path, 300, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer backend.Close()
+
+	for i := 0; i < 10; i++ {
+		if err := backend.Log(&Event{ID: strings.Repeat("x", 100)}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for _, name := range []string{"audit.log", "audit.log.1", "audit.log.2"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("expected %s to exist: %v", name, err)
+			continue
+		}
+		if info.Size() > 300 {
+			t.Errorf("expected %s to be rotated before exceeding 300 bytes, got %d", name, info.Size())
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "audit.log.3")); !os.IsNotExist(err) {
+		t.Errorf("expected only 2 backups to be retained: %v", err)
+	}
+}
+
+func TestUnionBackend(t *testing.T) {
+	a, b := &bytes.Buffer{}, &bytes.Buffer{}
+	backend := NewUnionBackend(NewWriterBackend(a), NewWriterBackend(b))
+	if err := backend.Log(&Event{ID: "1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Len() == 0 || a.String() != b.String() {
+		t.Errorf("expected both backends to receive the event, got %q and %q", a.String(), b.String())
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// IDHeader is the response header carrying the ID of the audit event recorded for the request.
+const IDHeader = "Audit-ID"
+
+// WithAudit wraps handler so that an Event is sent to backend for every request once it has been served.
+// It is installed outside the authentication filter so that rejected requests are recorded too. The user
+// is read from the request context after the request was served, and is AnonymousUser if the request was
+// not authenticated. If backend is nil, handler is returned unchanged.
+func WithAudit(handler http.Handler, requestContextMapper api.RequestContextMapper, resolver *apiserver.APIRequestInfoResolver, backend Backend) http.Handler {
+	if backend == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		event := &Event{
+			ID:        string(util.NewUUID()),
+			Timestamp: time.Now(),
+			SourceIP:  req.RemoteAddr,
+			Method:    req.Method,
+			Path:      req.URL.Path,
+		}
+		// Requests outside the REST object store still produce a usable, partial record.
+		if requestInfo, err := resolver.GetAPIRequestInfo(req); err == nil {
+			event.Verb = requestInfo.Verb
+			event.Namespace = requestInfo.Namespace
+			event.Resource = requestInfo.Resource
+			event.Subresource = requestInfo.Subresource
+			event.Name = requestInfo.Name
+		}
+
+		w.Header().Set(IDHeader, event.ID)
+		respWriter := &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			event.ResponseCode = respWriter.status
+			event.User = AnonymousUser
+			if ctx, ok := requestContextMapper.Get(req); ok {
+				if user, ok := api.UserFrom(ctx); ok {
+					event.User = user.GetName()
+					event.Groups = user.GetGroups()
+				}
+			}
+			event.LatencyMicroseconds = int64(time.Since(event.Timestamp) / time.Microsecond)
+			if err := backend.Log(event); err != nil {
+				glog.Errorf("Unable to record audit event %s: %v", event.ID, err)
+			}
+		}()
+		handler.ServeHTTP(respWriter, req)
+	})
+}
+
+// auditResponseWriter records the status code written to the response while
+// preserving the optional interfaces that watch, exec and proxy handlers rely on.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (a *auditResponseWriter) WriteHeader(code int) {
+	a.status = code
+	a.ResponseWriter.WriteHeader(code)
+}
+
+// Flush implements http.Flusher.
+func (a *auditResponseWriter) Flush() {
+	if flusher, ok := a.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// CloseNotify implements http.CloseNotifier. If the underlying writer cannot
+// notify, the returned channel never fires.
+func (a *auditResponseWriter) CloseNotify() <-chan bool {
+	if notifier, ok := a.ResponseWriter.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// Hijack implements http.Hijacker. Hijacked connections are recorded with
+// a 101 Switching Protocols status.
+func (a *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := a.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("unable to hijack response writer %T", a.ResponseWriter)
+	}
+	a.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func TestWithAudit(t *testing.T) {
+	alice := &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}}
+	testCases := []struct {
+		method string
+		url    string
+		user   user.Info
+		code   int
+
+		verb      string
+		namespace string
+		resource  string
+		name      string
+
+		expectedUser   string
+		expectedGroups []string
+	}{
+		{"GET", "/api/v1/namespaces/other/pods", alice, http.StatusOK, "list", "other", "pods", "", "alice", []string{"admins"}},
+		{"DELETE", "/api/v1/namespaces/other/pods/foo", alice, http.StatusForbidden, "delete", "other", "pods", "foo", "alice", []string{"admins"}},
+		{"PUT", "/api/v1/nodes/node1", alice, http.StatusConflict, "update", "", "nodes", "node1", "alice", []string{"admins"}},
+		// Requests rejected by the authenticator are recorded as anonymous.
+		{"GET", "/api/v1/namespaces/other/pods", nil, http.StatusUnauthorized, "list", "other", "pods", "", AnonymousUser, nil},
+	}
+
+	contextMapper := api.NewRequestContextMapper()
+	resolver := &apiserver.APIRequestInfoResolver{APIPrefixes: sets.NewString("api"), RestMapper: testapi.Default.RESTMapper()}
+	for _, tc := range testCases {
+		buf := &bytes.Buffer{}
+		authenticated := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if tc.user == nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			ctx, _ := contextMapper.Get(req)
+			contextMapper.Update(req, api.WithUser(ctx, tc.user))
+			w.WriteHeader(tc.code)
+		})
+		audited := WithAudit(authenticated, contextMapper, resolver, NewWriterBackend(buf))
+		handler, err := api.NewRequestContextFilter(contextMapper, audited)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		event := Event{}
+		if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+			t.Fatalf("%s %s: unable to decode event %q: %v", tc.method, tc.url, buf.String(), err)
+		}
+		if len(event.ID) == 0 || w.Header().Get(IDHeader) != event.ID {
+			t.Errorf("%s %s: expected audit ID %q to be returned, got %q", tc.method, tc.url, event.ID, w.Header().Get(IDHeader))
+		}
+		if event.User != tc.expectedUser || !reflect.DeepEqual(event.Groups, tc.expectedGroups) {
+			t.Errorf("%s %s: unexpected user %q in groups %v", tc.method, tc.url, event.User, event.Groups)
+		}
+		if event.Verb != tc.verb || event.Namespace != tc.namespace || event.Resource != tc.resource || event.Name != tc.name {
+			t.Errorf("%s %s: unexpected request info %#v", tc.method, tc.url, event)
+		}
+		if event.ResponseCode != tc.code {
+			t.Errorf("%s %s: expected response code %d, got %d", tc.method, tc.url, tc.code, event.ResponseCode)
+		}
+	}
+}
+
+func TestWithAuditNilBackend(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {})
+	resolver := &apiserver.APIRequestInfoResolver{APIPrefixes: sets.NewString("api"), RestMapper: testapi.Default.RESTMapper()}
+	w := httptest.NewRecorder()
+	WithAudit(handler, api.NewRequestContextMapper(), resolver, nil).ServeHTTP(w, &http.Request{})
+	if len(w.Header().Get(IDHeader)) != 0 {
+		t.Errorf("expected no audit ID without a backend")
+	}
+}
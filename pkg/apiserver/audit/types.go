@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records one structured event for every request served by
+// the apiserver, identifying who did what to which object and with what result.
+package audit
+
+import (
+	"time"
+)
+
+// AnonymousUser is recorded as the user of requests which were not authenticated.
+const AnonymousUser = "system:anonymous"
+
+// Event is the audit record emitted once a request has been fully served.
+type Event struct {
+	// ID uniquely identifies the request. It is also returned to the client in the Audit-ID header.
+	ID string `json:"id"`
+	// Timestamp is the time at which the request was received.
+	Timestamp time.Time `json:"timestamp"`
+	// SourceIP is the remote address of the client.
+	SourceIP string `json:"sourceIP,omitempty"`
+	// User is the name the request was authenticated as, or AnonymousUser if it was not authenticated.
+	User string `json:"user,omitempty"`
+	// Groups are the groups of the authenticated user.
+	Groups []string `json:"groups,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Path is the URL path of the request.
+	Path string `json:"path"`
+	// Verb is the kube verb of the request, e.g. list, watch or delete.
+	Verb string `json:"verb,omitempty"`
+	// Namespace is the namespace of the object, if the request is for a REST object.
+	Namespace string `json:"namespace,omitempty"`
+	// Resource is the resource being requested, e.g. pods.
+	Resource string `json:"resource,omitempty"`
+	// Subresource is the subresource being requested, e.g. status.
+	Subresource string `json:"subresource,omitempty"`
+	// Name is the name of the object, if the request names one directly.
+	Name string `json:"name,omitempty"`
+	// ResponseCode is the HTTP status code returned to the client.
+	ResponseCode int `json:"responseCode"`
+	// LatencyMicroseconds is the time spent serving the request.
+	LatencyMicroseconds int64 `json:"latencyMicroseconds"`
+}
+
+// Backend persists audit events. Implementations must be safe for concurrent use.
+type Backend interface {
+	// Log records a single event.
+	Log(event *Event) error
+	// Close flushes any buffered events and releases the backend's resources.
+	Close() error
+}
@@ -18,8 +18,34 @@ package apiserver
 
 import (
 	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	"k8s.io/kubernetes/pkg/registry/clusterrolebinding"
+	"k8s.io/kubernetes/pkg/registry/role"
+	"k8s.io/kubernetes/pkg/registry/rolebinding"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
 )
 
+// emptyStorage lists no objects and watches for none, for the caches of the RBAC authorizer.
+type emptyStorage struct {
+	rest.StandardStorage
+	list runtime.Object
+}
+
+func (s emptyStorage) List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return s.list, nil
+}
+
+func (s emptyStorage) Watch(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
 // NewAlwaysAllowAuthorizer must return a struct which implements authorizer.Authorizer
 // and always return nil.
 func TestNewAlwaysAllowAuthorizer(t *testing.T) {
@@ -42,30 +68,57 @@ func TestNewAlwaysDenyAuthorizer(t *testing.T) {
 // validates that errors are returned only when proper.
 func TestNewAuthorizerFromAuthorizationConfig(t *testing.T) {
 	// Unknown modes should return errors
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{"DoesNotExist"}, ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{"DoesNotExist"}, AuthorizationConfig{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 
 	// ModeAlwaysAllow and ModeAlwaysDeny should return without authorizationPolicyFile
 	// but error if one is given
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, ""); err != nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, AuthorizationConfig{}); err != nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig returned an error: %s", err)
 	}
 
 	// ModeABAC requires a policy file
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, ""); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, AuthorizationConfig{}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig using a fake mode should have returned an error")
 	}
 	// ModeABAC should not error if a valid policy path is provided
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, "../auth/authorizer/abac/example_policy_file.jsonl"); err != nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny, ModeABAC}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err != nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig errored while using a valid policy file: %s", err)
 	}
 	// Authorization Policy file cannot be used without ModeABAC
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, "../auth/authorizer/abac/example_policy_file.jsonl"); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow, ModeAlwaysDeny}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when Authorization Policy File is used without ModeABAC")
 	}
 	// Atleast one authorizationMode is necessary
-	if _, err := NewAuthorizerFromAuthorizationConfig([]string{}, "../auth/authorizer/abac/example_policy_file.jsonl"); err == nil {
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{}, AuthorizationConfig{PolicyFile: "../auth/authorizer/abac/example_policy_file.jsonl"}); err == nil {
 		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when no authorization modes are passed")
 	}
+	// ModeRBAC requires the role and binding registries
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeRBAC}, AuthorizationConfig{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when ModeRBAC is used without registries")
+	}
+	// ModeRBAC should not error if the registries are provided
+	rbacConfig := AuthorizationConfig{
+		RBACSuperUser:                  "admin",
+		RBACRoleRegistry:               role.NewRegistry(emptyStorage{list: &experimental.RoleList{}}),
+		RBACRoleBindingRegistry:        rolebinding.NewRegistry(emptyStorage{list: &experimental.RoleBindingList{}}),
+		RBACClusterRoleRegistry:        clusterrole.NewRegistry(emptyStorage{list: &experimental.ClusterRoleList{}}),
+		RBACClusterRoleBindingRegistry: clusterrolebinding.NewRegistry(emptyStorage{list: &experimental.ClusterRoleBindingList{}}),
+	}
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysDeny, ModeRBAC}, rbacConfig); err != nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig errored while using ModeRBAC with registries: %s", err)
+	}
+	// The RBAC super user cannot be used without ModeRBAC
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow}, AuthorizationConfig{RBACSuperUser: "admin"}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when the RBAC super user is used without ModeRBAC")
+	}
+	// ModeWebhook requires a configuration file
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeWebhook}, AuthorizationConfig{}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when ModeWebhook is used without a configuration file")
+	}
+	// The webhook configuration file cannot be used without ModeWebhook
+	if _, err := NewAuthorizerFromAuthorizationConfig([]string{ModeAlwaysAllow}, AuthorizationConfig{WebhookConfigFile: "webhook.kubeconfig"}); err == nil {
+		t.Errorf("NewAuthorizerFromAuthorizationConfig should have errored when the webhook configuration file is used without ModeWebhook")
+	}
 }
@@ -348,8 +348,8 @@ type requestAttributeGetter struct {
 }
 
 // NewAttributeGetter returns an object which implements the RequestAttributeGetter interface.
-func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, restMapper meta.RESTMapper, apiRoots ...string) RequestAttributeGetter {
-	return &requestAttributeGetter{requestContextMapper, &APIRequestInfoResolver{sets.NewString(apiRoots...), restMapper}}
+func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, apiRequestInfoResolver *APIRequestInfoResolver) RequestAttributeGetter {
+	return &requestAttributeGetter{requestContextMapper, apiRequestInfoResolver}
 }
 
 func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attributes {
@@ -364,12 +364,22 @@ func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attrib
 	}
 
 	attribs.ReadOnly = IsReadOnlyReq(*req)
+	attribs.Path = req.URL.Path
+
+	apiRequestInfo, err := r.apiRequestInfoResolver.GetAPIRequestInfo(req)
+	if err != nil || !apiRequestInfo.IsResourceRequest {
+		// Requests outside of the REST object store, like /healthz or /version,
+		// are described by their path and HTTP method alone.
+		attribs.Verb = strings.ToLower(req.Method)
+		return &attribs
+	}
 
-	apiRequestInfo, _ := r.apiRequestInfoResolver.GetAPIRequestInfo(req)
-
-	// If a path follows the conventions of the REST object store, then
-	// we can extract the resource.  Otherwise, not.
+	attribs.ResourceRequest = true
+	attribs.Verb = apiRequestInfo.Verb
+	attribs.APIGroup = apiRequestInfo.APIGroup
 	attribs.Resource = apiRequestInfo.Resource
+	attribs.Subresource = apiRequestInfo.Subresource
+	attribs.Name = apiRequestInfo.Name
 
 	// If the request specifies a namespace, then the namespace is filled in.
 	// Assumes there is no empty string namespace.  Unspecified results
@@ -394,7 +404,9 @@ func WithAuthorizationCheck(handler http.Handler, getAttribs RequestAttributeGet
 // APIRequestInfo holds information parsed from the http.Request
 type APIRequestInfo struct {
 	// Verb is the kube verb associated with the request, not the http verb.  This includes things like list and watch.
-	Verb       string
+	Verb string
+	// APIGroup is the API group of the request, or empty for the legacy API under /api.
+	APIGroup   string
 	APIVersion string
 	Namespace  string
 	// Resource is the name of the resource being requested.  This is not the kind.  For example: pods
@@ -412,11 +424,17 @@ type APIRequestInfo struct {
 	// Raw is the unparsed form of everything other than parts.
 	// Raw + Parts = complete URL path
 	Raw []string
+	// IsResourceRequest is true when the path was found under one of the resolver's
+	// API prefixes and names a resource, as opposed to a discovery or other endpoint.
+	IsResourceRequest bool
 }
 
 type APIRequestInfoResolver struct {
+	// APIPrefixes are the roots of paths of the form /{prefix}/{version}/*, e.g. api.
 	APIPrefixes sets.String
-	RestMapper  meta.RESTMapper
+	// APIGroupPrefixes are the roots of paths of the form /{prefix}/{group}/{version}/*, e.g. apis.
+	APIGroupPrefixes sets.String
+	RestMapper       meta.RESTMapper
 }
 
 // TODO write an integration test against the swagger doc to test the APIRequestInfo and match up behavior to responses
@@ -440,7 +458,7 @@ type APIRequestInfoResolver struct {
 //
 // Fully qualified paths for above:
 // /api/{version}/*
-// /api/{version}/*
+// /apis/{group}/{version}/*
 func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIRequestInfo, error) {
 	requestInfo := APIRequestInfo{
 		Raw: splitPath(req.URL.Path),
@@ -451,6 +469,25 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 		return requestInfo, fmt.Errorf("Unable to determine kind and namespace from an empty URL path")
 	}
 
+	for _, currPrefix := range r.APIGroupPrefixes.List() {
+		// handle input of form /apis/{group}/{version}/* by adjusting special paths
+		if currentParts[0] == currPrefix {
+			if len(currentParts) > 1 {
+				requestInfo.APIGroup = currentParts[1]
+			}
+			if len(currentParts) > 2 {
+				requestInfo.APIVersion = currentParts[2]
+			}
+
+			if len(currentParts) > 3 {
+				currentParts = currentParts[3:]
+				requestInfo.IsResourceRequest = true
+			} else {
+				return requestInfo, fmt.Errorf("Unable to determine kind and namespace from url, %v", req.URL)
+			}
+		}
+	}
+
 	for _, currPrefix := range r.APIPrefixes.List() {
 		// handle input of form /api/{version}/* by adjusting special paths
 		if currentParts[0] == currPrefix {
@@ -460,6 +497,7 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 
 			if len(currentParts) > 2 {
 				currentParts = currentParts[2:]
+				requestInfo.IsResourceRequest = true
 			} else {
 				return requestInfo, fmt.Errorf("Unable to determine kind and namespace from url, %v", req.URL)
 			}
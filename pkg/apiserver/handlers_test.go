@@ -30,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/util/sets"
 )
 
@@ -244,7 +245,7 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		{"DELETE", "/namespaces/other/pods/foo", "delete", "", "other", "pods", "", "Pod", "foo", []string{"pods", "foo"}},
 	}
 
-	apiRequestInfoResolver := &APIRequestInfoResolver{sets.NewString("api"), testapi.Default.RESTMapper()}
+	apiRequestInfoResolver := &APIRequestInfoResolver{APIPrefixes: sets.NewString("api"), RestMapper: testapi.Default.RESTMapper()}
 
 	for _, successCase := range successCases {
 		req, _ := http.NewRequest(successCase.method, successCase.url, nil)
@@ -295,3 +296,144 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestGetAPIRequestInfoForAPIGroups(t *testing.T) {
+	successCases := []struct {
+		method              string
+		url                 string
+		expectedVerb        string
+		expectedAPIGroup    string
+		expectedAPIVersion  string
+		expectedNamespace   string
+		expectedResource    string
+		expectedSubresource string
+		expectedName        string
+	}{
+		{"GET", "/apis/experimental/v1alpha1/jobs", "list", "experimental", "v1alpha1", api.NamespaceAll, "jobs", "", ""},
+		{"GET", "/apis/experimental/v1alpha1/namespaces/other/jobs/foo", "get", "experimental", "v1alpha1", "other", "jobs", "", "foo"},
+		{"PUT", "/apis/experimental/v1alpha1/namespaces/other/jobs/foo/status", "update", "experimental", "v1alpha1", "other", "jobs", "status", "foo"},
+		{"GET", "/apis/experimental/v1alpha1/watch/namespaces/other/jobs", "watch", "experimental", "v1alpha1", "other", "jobs", "", ""},
+		{"DELETE", "/api/v1/namespaces/other/pods/foo", "delete", "", "v1", "other", "pods", "", "foo"},
+	}
+
+	apiRequestInfoResolver := &APIRequestInfoResolver{
+		APIPrefixes:      sets.NewString("api"),
+		APIGroupPrefixes: sets.NewString("apis"),
+		RestMapper:       testapi.Default.RESTMapper(),
+	}
+
+	for _, successCase := range successCases {
+		req, _ := http.NewRequest(successCase.method, successCase.url, nil)
+
+		apiRequestInfo, err := apiRequestInfoResolver.GetAPIRequestInfo(req)
+		if err != nil {
+			t.Errorf("Unexpected error for url: %s %v", successCase.url, err)
+		}
+		if !apiRequestInfo.IsResourceRequest {
+			t.Errorf("Expected a resource request for url: %s", successCase.url)
+		}
+		if successCase.expectedVerb != apiRequestInfo.Verb {
+			t.Errorf("Unexpected verb for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedVerb, apiRequestInfo.Verb)
+		}
+		if successCase.expectedAPIGroup != apiRequestInfo.APIGroup {
+			t.Errorf("Unexpected apiGroup for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedAPIGroup, apiRequestInfo.APIGroup)
+		}
+		if successCase.expectedAPIVersion != apiRequestInfo.APIVersion {
+			t.Errorf("Unexpected apiVersion for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedAPIVersion, apiRequestInfo.APIVersion)
+		}
+		if successCase.expectedNamespace != apiRequestInfo.Namespace {
+			t.Errorf("Unexpected namespace for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedNamespace, apiRequestInfo.Namespace)
+		}
+		if successCase.expectedResource != apiRequestInfo.Resource {
+			t.Errorf("Unexpected resource for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedResource, apiRequestInfo.Resource)
+		}
+		if successCase.expectedSubresource != apiRequestInfo.Subresource {
+			t.Errorf("Unexpected subresource for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedSubresource, apiRequestInfo.Subresource)
+		}
+		if successCase.expectedName != apiRequestInfo.Name {
+			t.Errorf("Unexpected name for url: %s, expected: %s, actual: %s", successCase.url, successCase.expectedName, apiRequestInfo.Name)
+		}
+	}
+
+	errorCases := map[string]string{
+		"just group":                 "/apis/experimental",
+		"group version with no path": "/apis/experimental/v1alpha1",
+	}
+	for k, v := range errorCases {
+		req, err := http.NewRequest("GET", v, nil)
+		if err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+		_, err = apiRequestInfoResolver.GetAPIRequestInfo(req)
+		if err == nil {
+			t.Errorf("Expected error for key: %s", k)
+		}
+	}
+}
+
+func TestGetAttribs(t *testing.T) {
+	mapper := api.NewRequestContextMapper()
+	getter := NewRequestAttributeGetter(mapper, &APIRequestInfoResolver{
+		APIPrefixes:      sets.NewString("api"),
+		APIGroupPrefixes: sets.NewString("apis"),
+		RestMapper:       testapi.Default.RESTMapper(),
+	})
+
+	testCases := map[string]struct {
+		method   string
+		url      string
+		expected authorizer.AttributesRecord
+	}{
+		"resource": {
+			method: "POST",
+			url:    "/api/v1/namespaces/other/pods/foo/exec",
+			expected: authorizer.AttributesRecord{
+				Verb:            "create",
+				Namespace:       "other",
+				Resource:        "pods",
+				Subresource:     "exec",
+				Name:            "foo",
+				ResourceRequest: true,
+				Path:            "/api/v1/namespaces/other/pods/foo/exec",
+			},
+		},
+		"api group resource": {
+			method: "GET",
+			url:    "/apis/experimental/v1alpha1/namespaces/other/jobs",
+			expected: authorizer.AttributesRecord{
+				Verb:            "list",
+				ReadOnly:        true,
+				Namespace:       "other",
+				APIGroup:        "experimental",
+				Resource:        "jobs",
+				ResourceRequest: true,
+				Path:            "/apis/experimental/v1alpha1/namespaces/other/jobs",
+			},
+		},
+		"non-resource": {
+			method: "GET",
+			url:    "/healthz",
+			expected: authorizer.AttributesRecord{
+				Verb:     "get",
+				ReadOnly: true,
+				Path:     "/healthz",
+			},
+		},
+		"api discovery": {
+			method: "GET",
+			url:    "/api/v1",
+			expected: authorizer.AttributesRecord{
+				Verb:     "get",
+				ReadOnly: true,
+				Path:     "/api/v1",
+			},
+		},
+	}
+	for k, tc := range testCases {
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		attribs := getter.GetAttribs(req)
+		if !reflect.DeepEqual(&tc.expected, attribs) {
+			t.Errorf("%s: expected %#v, got %#v", k, &tc.expected, attribs)
+		}
+	}
+}
@@ -53,6 +53,27 @@ var (
 		},
 		[]string{"verb", "resource"},
 	)
+	requestQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "apiserver_request_queue_depth",
+			Help: "Number of requests waiting to be served in each priority bucket.",
+		},
+		[]string{"bucket"},
+	)
+	requestsInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "apiserver_requests_inflight",
+			Help: "Number of requests being served in each priority bucket.",
+		},
+		[]string{"bucket"},
+	)
+	rejectedRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "apiserver_rejected_request_count",
+			Help: "Counter of requests rejected with 429 Too Many Requests, broken out for each priority bucket and request kind.",
+		},
+		[]string{"bucket", "kind"},
+	)
 )
 
 // Register all metrics.
@@ -60,6 +81,9 @@ func Register() {
 	prometheus.MustRegister(requestCounter)
 	prometheus.MustRegister(requestLatencies)
 	prometheus.MustRegister(requestLatenciesSummary)
+	prometheus.MustRegister(requestQueueDepth)
+	prometheus.MustRegister(requestsInFlight)
+	prometheus.MustRegister(rejectedRequestCounter)
 }
 
 func Monitor(verb, resource *string, client string, httpCode *int, reqStart time.Time) {
@@ -68,9 +92,21 @@ func Monitor(verb, resource *string, client string, httpCode *int, reqStart time
 	requestLatenciesSummary.WithLabelValues(*verb, *resource).Observe(float64((time.Since(reqStart)) / time.Microsecond))
 }
 
+// MonitorPriorityBucket records the number of queued and in-flight requests of a priority bucket.
+func MonitorPriorityBucket(bucket string, queued, inFlight int) {
+	requestQueueDepth.WithLabelValues(bucket).Set(float64(queued))
+	requestsInFlight.WithLabelValues(bucket).Set(float64(inFlight))
+}
+
+// MonitorRejection records a request of the given kind rejected by a priority bucket.
+func MonitorRejection(bucket, kind string) {
+	rejectedRequestCounter.WithLabelValues(bucket, kind).Inc()
+}
+
 func Reset(w http.ResponseWriter, req *http.Request) {
 	requestCounter.Reset()
 	requestLatencies.Reset()
 	requestLatenciesSummary.Reset()
+	rejectedRequestCounter.Reset()
 	io.WriteString(w, "metrics reset\n")
 }
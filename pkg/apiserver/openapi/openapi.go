@@ -0,0 +1,306 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// Config describes the document built by BuildSpec.
+type Config struct {
+	Info Info
+	// WebServices returns the web services to describe. It is called for every document
+	// built, so that web services installed later, like those of third party resources,
+	// are described too.
+	WebServices func() []*restful.WebService
+	// IgnorePrefixes excludes the web services whose root path starts with any of them.
+	IgnorePrefixes []string
+	// PostBuildHandler, if set, may amend the document before it is served.
+	PostBuildHandler func(*Swagger)
+}
+
+// NewHandler returns a handler which serves the document described by config as JSON.
+func NewHandler(config Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		spec := BuildSpec(config)
+		if config.PostBuildHandler != nil {
+			config.PostBuildHandler(spec)
+		}
+		data, err := json.Marshal(spec)
+		if err != nil {
+			glog.Errorf("Unable to encode the OpenAPI document: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	})
+}
+
+// BuildSpec returns a document describing the routes of the web services of config and
+// the types they read and write.
+func BuildSpec(config Config) *Swagger {
+	b := &builder{
+		spec: &Swagger{
+			Swagger:     "2.0",
+			Info:        config.Info,
+			Paths:       map[string]*PathItem{},
+			Definitions: map[string]*Schema{},
+		},
+		operationIDs: map[string]bool{},
+	}
+	for _, ws := range config.WebServices() {
+		if hasAnyPrefix(ws.RootPath(), config.IgnorePrefixes) {
+			continue
+		}
+		tag := strings.Replace(strings.Trim(ws.RootPath(), "/"), "/", "_", -1)
+		for _, route := range ws.Routes() {
+			b.addRoute(tag, route)
+		}
+	}
+	return b.spec
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type builder struct {
+	spec         *Swagger
+	operationIDs map[string]bool
+}
+
+// pathParameterRE matches the path parameters of go-restful routes, which may carry a
+// pattern, like {path:*}, that OpenAPI path templates do not allow.
+var pathParameterRE = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+func (b *builder) addRoute(tag string, route restful.Route) {
+	path := pathParameterRE.ReplaceAllString(route.Path, "{$1}")
+	item, found := b.spec.Paths[path]
+	if !found {
+		item = &PathItem{}
+		b.spec.Paths[path] = item
+	}
+	op := &Operation{
+		Summary:     route.Doc,
+		Description: route.Notes,
+		OperationID: b.uniqueOperationID(route.Operation),
+		Consumes:    route.Consumes,
+		Produces:    route.Produces,
+		Responses:   map[string]Response{},
+	}
+	if len(tag) > 0 {
+		op.Tags = []string{tag}
+	}
+	for _, param := range route.ParameterDocs {
+		op.Parameters = append(op.Parameters, b.parameter(param.Data(), route.ReadSample))
+	}
+	for code, responseError := range route.ResponseErrors {
+		response := Response{Description: responseError.Message}
+		if responseError.Model != nil {
+			response.Schema = b.schemaFor(reflect.TypeOf(responseError.Model))
+		}
+		op.Responses[strconv.Itoa(code)] = response
+	}
+	if _, found := op.Responses["200"]; !found {
+		response := Response{Description: "OK"}
+		if route.WriteSample != nil {
+			response.Schema = b.schemaFor(reflect.TypeOf(route.WriteSample))
+		}
+		op.Responses["200"] = response
+	}
+	(*item)[strings.ToLower(route.Method)] = op
+}
+
+// uniqueOperationID returns id, suffixed with a number if it was returned before.
+func (b *builder) uniqueOperationID(id string) string {
+	if len(id) == 0 {
+		return ""
+	}
+	unique := id
+	for i := 2; b.operationIDs[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", id, i)
+	}
+	b.operationIDs[unique] = true
+	return unique
+}
+
+func (b *builder) parameter(param restful.ParameterData, readSample interface{}) Parameter {
+	p := Parameter{
+		Name:        param.Name,
+		Description: param.Description,
+		Required:    param.Required,
+	}
+	switch param.Kind {
+	case restful.PathParameterKind:
+		p.In = "path"
+		// OpenAPI requires path parameters
+		p.Required = true
+	case restful.QueryParameterKind:
+		p.In = "query"
+	case restful.HeaderParameterKind:
+		p.In = "header"
+	case restful.FormParameterKind:
+		p.In = "formData"
+	case restful.BodyParameterKind:
+		p.In = "body"
+		if readSample != nil {
+			p.Schema = b.schemaFor(reflect.TypeOf(readSample))
+		} else {
+			p.Schema = &Schema{Type: "object"}
+		}
+		return p
+	}
+	switch param.DataType {
+	case "integer", "boolean", "number":
+		p.Type = param.DataType
+	default:
+		p.Type = "string"
+	}
+	return p
+}
+
+// customSchemas describes the types which serialize themselves other than their fields suggest.
+var customSchemas = map[reflect.Type]Schema{
+	reflect.TypeOf(unversioned.Time{}):     {Type: "string", Format: "date-time"},
+	reflect.TypeOf(unversioned.Duration{}): {Type: "string"},
+	reflect.TypeOf(resource.Quantity{}):    {Type: "string"},
+	reflect.TypeOf(util.IntOrString{}):     {Type: "string", Format: "int-or-string"},
+	reflect.TypeOf(runtime.RawExtension{}): {Type: "object"},
+}
+
+// schemaFor returns the schema of t, adding definitions for the structs it refers to.
+func (b *builder) schemaFor(t reflect.Type) *Schema {
+	if custom, found := customSchemas[t]; found {
+		return &custom
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return b.schemaFor(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaFor(t.Elem())}
+	case reflect.Struct:
+		name := t.String()
+		if _, found := b.spec.Definitions[name]; !found {
+			// Add the definition before its fields so that recursive types terminate.
+			definition := &Schema{Type: "object", Properties: map[string]*Schema{}}
+			b.spec.Definitions[name] = definition
+			doc := swaggerDoc(t)
+			definition.Description = doc[""]
+			b.addProperties(definition, t, doc)
+		}
+		return DefinitionRef(name)
+	}
+	// interfaces may hold any value
+	return &Schema{}
+}
+
+// addProperties adds the serialized fields of the struct t to definition, described by doc.
+func (b *builder) addProperties(definition *Schema, t reflect.Type, doc map[string]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if len(field.PkgPath) > 0 {
+			// unexported
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		inline := false
+		omitEmpty := false
+		for _, option := range parts[1:] {
+			switch option {
+			case "inline":
+				inline = true
+			case "omitempty":
+				omitEmpty = true
+			}
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && fieldType.Kind() == reflect.Struct && (inline || len(name) == 0) {
+			b.addProperties(definition, fieldType, swaggerDoc(fieldType))
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		property := b.schemaFor(field.Type)
+		// siblings of $ref are ignored, so fields of struct types are described by their type
+		if description := doc[name]; len(description) > 0 && len(property.Ref) == 0 {
+			property.Description = description
+		}
+		definition.Properties[name] = property
+		if !omitEmpty {
+			definition.Required = append(definition.Required, name)
+		}
+	}
+}
+
+type swaggerDoccer interface {
+	SwaggerDoc() map[string]string
+}
+
+// swaggerDoc returns the documentation of t and its fields, keyed by serialized field name,
+// with the type itself documented under the empty key.
+func swaggerDoc(t reflect.Type) map[string]string {
+	if doccer, ok := reflect.Zero(t).Interface().(swaggerDoccer); ok {
+		return doccer.SwaggerDoc()
+	}
+	return map[string]string{}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+type testMeta struct {
+	Name              string           `json:"name,omitempty"`
+	CreationTimestamp unversioned.Time `json:"creationTimestamp,omitempty"`
+}
+
+type testObject struct {
+	unversioned.TypeMeta `json:",inline"`
+	Metadata             testMeta          `json:"metadata,omitempty"`
+	Replicas             int32             `json:"replicas"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	Children             []*testObject     `json:"children,omitempty"`
+	Data                 []byte            `json:"data,omitempty"`
+	ignored              string
+}
+
+func (testObject) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "testObject is an object for testing.",
+		"replicas": "Number of replicas.",
+	}
+}
+
+func noop(*restful.Request, *restful.Response) {}
+
+func testWebServices() []*restful.WebService {
+	ws := new(restful.WebService)
+	ws.Path("/api/v1").Consumes("application/json").Produces("application/json")
+	ws.Route(ws.GET("/namespaces/{namespace}/objects/{name}").To(noop).
+		Doc("read the specified object").
+		Operation("readObject").
+		Param(ws.PathParameter("namespace", "object name and auth scope")).
+		Param(ws.PathParameter("name", "name of the object")).
+		Param(ws.QueryParameter("pretty", "If 'true', then the output is pretty printed.")).
+		Writes(testObject{}))
+	ws.Route(ws.POST("/namespaces/{namespace}/objects").To(noop).
+		Operation("createObject").
+		Param(ws.PathParameter("namespace", "object name and auth scope")).
+		Param(ws.BodyParameter("body", "")).
+		Reads(testObject{}).
+		Returns(http.StatusCreated, "Created", testObject{}))
+	ws.Route(ws.GET("/proxy/objects/{name}/{path:*}").To(noop).
+		Operation("proxyObject").
+		Param(ws.PathParameter("name", "name of the object")).
+		Param(ws.PathParameter("path", "path to the resource")))
+	ws.Route(ws.PUT("/proxy/objects/{name}/{path:*}").To(noop).
+		Operation("proxyObject"))
+
+	ignored := new(restful.WebService)
+	ignored.Path("/swaggerapi")
+	ignored.Route(ignored.GET("/").To(noop).Operation("listApis"))
+
+	return []*restful.WebService{ws, ignored}
+}
+
+func testConfig() Config {
+	return Config{
+		Info:           Info{Title: "Test", Version: "v0"},
+		WebServices:    testWebServices,
+		IgnorePrefixes: []string{"/swaggerapi"},
+	}
+}
+
+func TestBuildSpecPaths(t *testing.T) {
+	spec := BuildSpec(testConfig())
+
+	if spec.Swagger != "2.0" || spec.Info.Title != "Test" {
+		t.Errorf("unexpected document header: %q %#v", spec.Swagger, spec.Info)
+	}
+	expectedPaths := []string{
+		"/api/v1/namespaces/{namespace}/objects/{name}",
+		"/api/v1/namespaces/{namespace}/objects",
+		"/api/v1/proxy/objects/{name}/{path}",
+	}
+	if len(spec.Paths) != len(expectedPaths) {
+		t.Errorf("expected %d paths, got %#v", len(expectedPaths), spec.Paths)
+	}
+	for _, path := range expectedPaths {
+		if _, found := spec.Paths[path]; !found {
+			t.Errorf("expected path %q, got %#v", path, spec.Paths)
+		}
+	}
+
+	read := (*spec.Paths["/api/v1/namespaces/{namespace}/objects/{name}"])["get"]
+	if read == nil {
+		t.Fatalf("expected a get operation")
+	}
+	if read.OperationID != "readObject" || read.Summary != "read the specified object" {
+		t.Errorf("unexpected operation: %#v", read)
+	}
+	if !reflect.DeepEqual(read.Tags, []string{"api_v1"}) {
+		t.Errorf("unexpected tags: %v", read.Tags)
+	}
+	expectedParameters := []Parameter{
+		{Name: "namespace", In: "path", Description: "object name and auth scope", Required: true, Type: "string"},
+		{Name: "name", In: "path", Description: "name of the object", Required: true, Type: "string"},
+		{Name: "pretty", In: "query", Description: "If 'true', then the output is pretty printed.", Type: "string"},
+	}
+	if !reflect.DeepEqual(read.Parameters, expectedParameters) {
+		t.Errorf("expected parameters %#v, got %#v", expectedParameters, read.Parameters)
+	}
+	if response := read.Responses["200"]; !reflect.DeepEqual(response.Schema, DefinitionRef("openapi.testObject")) {
+		t.Errorf("unexpected response: %#v", response)
+	}
+
+	create := (*spec.Paths["/api/v1/namespaces/{namespace}/objects"])["post"]
+	if create == nil {
+		t.Fatalf("expected a post operation")
+	}
+	if body := create.Parameters[1]; body.In != "body" || !reflect.DeepEqual(body.Schema, DefinitionRef("openapi.testObject")) {
+		t.Errorf("unexpected body parameter: %#v", body)
+	}
+	if _, found := create.Responses["201"]; !found {
+		t.Errorf("expected a 201 response, got %#v", create.Responses)
+	}
+
+	proxy := spec.Paths["/api/v1/proxy/objects/{name}/{path}"]
+	if (*proxy)["get"].OperationID != "proxyObject" || (*proxy)["put"].OperationID != "proxyObject2" {
+		t.Errorf("expected unique operation ids, got %q and %q", (*proxy)["get"].OperationID, (*proxy)["put"].OperationID)
+	}
+}
+
+func TestBuildSpecDefinitions(t *testing.T) {
+	spec := BuildSpec(testConfig())
+
+	object, found := spec.Definitions["openapi.testObject"]
+	if !found {
+		t.Fatalf("expected a definition for testObject, got %#v", spec.Definitions)
+	}
+	if object.Description != "testObject is an object for testing." {
+		t.Errorf("unexpected description: %q", object.Description)
+	}
+	typeMetaDoc := unversioned.TypeMeta{}.SwaggerDoc()
+	expected := map[string]*Schema{
+		"kind":       {Type: "string", Description: typeMetaDoc["kind"]},
+		"apiVersion": {Type: "string", Description: typeMetaDoc["apiVersion"]},
+		"metadata":   DefinitionRef("openapi.testMeta"),
+		"replicas":   {Type: "integer", Format: "int32", Description: "Number of replicas."},
+		"labels":     {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		"children":   {Type: "array", Items: DefinitionRef("openapi.testObject")},
+		"data":       {Type: "string", Format: "byte"},
+	}
+	for name, property := range expected {
+		if !reflect.DeepEqual(object.Properties[name], property) {
+			t.Errorf("%s: expected %#v, got %#v", name, property, object.Properties[name])
+		}
+	}
+	if len(object.Properties) != len(expected) {
+		t.Errorf("unexpected properties: %#v", object.Properties)
+	}
+	if !reflect.DeepEqual(object.Required, []string{"replicas"}) {
+		t.Errorf("unexpected required properties: %v", object.Required)
+	}
+
+	meta := spec.Definitions["openapi.testMeta"]
+	if meta == nil {
+		t.Fatalf("expected a definition for testMeta, got %#v", spec.Definitions)
+	}
+	if timestamp := meta.Properties["creationTimestamp"]; !reflect.DeepEqual(timestamp, &Schema{Type: "string", Format: "date-time"}) {
+		t.Errorf("unexpected creationTimestamp: %#v", timestamp)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	config := testConfig()
+	config.PostBuildHandler = func(spec *Swagger) {
+		spec.Definitions["extra"] = &Schema{Type: "object"}
+	}
+	server := httptest.NewServer(NewHandler(config))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	spec := Swagger{}
+	if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := spec.Definitions["extra"]; !found {
+		t.Errorf("expected the post build handler to amend the document")
+	}
+	if _, found := spec.Paths["/api/v1/namespaces/{namespace}/objects"]; !found {
+		t.Errorf("unexpected paths: %#v", spec.Paths)
+	}
+}
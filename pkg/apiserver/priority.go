@@ -0,0 +1,401 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver/metrics"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// RequestKind classifies requests for priority limiting.
+type RequestKind string
+
+const (
+	// ReadRequest is a request without side effects, like a get or a list.
+	ReadRequest RequestKind = "read"
+	// MutatingRequest is a request which changes state, like a create or a delete.
+	MutatingRequest RequestKind = "mutating"
+	// WatchRequest is a request for a stream of changes.
+	WatchRequest RequestKind = "watch"
+)
+
+// DefaultPriorityBucket is the name of the bucket which limits the read and mutating
+// requests that match no configured bucket.
+const DefaultPriorityBucket = "default"
+
+// defaultQueueWait is how long a request may wait in a bucket queue before it is rejected.
+const defaultQueueWait = 10 * time.Second
+
+// PriorityBucket limits the number of concurrently served requests of the users, groups
+// and request kinds it matches. Requests beyond the limit wait in a queue that serves the
+// users of the bucket in turn, so that one busy user cannot starve the others.
+type PriorityBucket struct {
+	Name string `json:"name"`
+	// Users and Groups select the requesting users. A request matches if its user is
+	// listed in Users or belongs to one of Groups. If both are empty, every user matches.
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	// RequestKinds selects the kinds of request. If empty, read and mutating requests match.
+	// Watches hold their slot until they end, so they only match buckets which list them.
+	RequestKinds []RequestKind `json:"requestKinds,omitempty"`
+	// MaxInFlight is the number of requests of the bucket served at a given time.
+	MaxInFlight int `json:"maxInFlight"`
+	// QueueLength is the number of requests which may wait for a slot. Requests beyond it are rejected.
+	QueueLength int `json:"queueLength,omitempty"`
+}
+
+// LoadPriorityBuckets reads priority buckets from a file with one JSON object per line.
+// Requests are assigned to the first bucket which matches them, so order matters.
+func LoadPriorityBuckets(path string) ([]PriorityBucket, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	buckets := []PriorityBucket{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		b := scanner.Bytes()
+		if len(strings.TrimSpace(string(b))) == 0 {
+			continue
+		}
+		var bucket PriorityBucket
+		if err := json.Unmarshal(b, &bucket); err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		buckets = append(buckets, bucket)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+// PriorityLimiter assigns requests to priority buckets and limits each bucket separately.
+type PriorityLimiter struct {
+	buckets []*priorityBucket
+	// defaultBucket limits read and mutating requests which match no bucket. If nil, they are not limited.
+	defaultBucket        *priorityBucket
+	longRunningRequestRE *regexp.Regexp
+	queueWait            time.Duration
+}
+
+// NewPriorityLimiter returns a limiter for the given buckets. Read and mutating requests
+// which match no bucket share a default bucket limited to defaultMaxInFlight requests,
+// or are not limited if defaultMaxInFlight is zero. Unmatched watches are never limited.
+// Requests matching longRunningRequestRE, other than watches, are never limited.
+func NewPriorityLimiter(buckets []PriorityBucket, defaultMaxInFlight int, longRunningRequestRE *regexp.Regexp) (*PriorityLimiter, error) {
+	l := &PriorityLimiter{
+		longRunningRequestRE: longRunningRequestRE,
+		queueWait:            defaultQueueWait,
+	}
+	names := sets.NewString(DefaultPriorityBucket)
+	for i, bucket := range buckets {
+		if len(bucket.Name) == 0 {
+			return nil, fmt.Errorf("priority bucket %d has no name", i)
+		}
+		if names.Has(bucket.Name) {
+			return nil, fmt.Errorf("priority bucket name %q is not unique", bucket.Name)
+		}
+		names.Insert(bucket.Name)
+		if bucket.MaxInFlight <= 0 {
+			return nil, fmt.Errorf("priority bucket %q must allow at least one request in flight", bucket.Name)
+		}
+		if bucket.QueueLength < 0 {
+			return nil, fmt.Errorf("priority bucket %q has a negative queue length", bucket.Name)
+		}
+		for _, kind := range bucket.RequestKinds {
+			switch kind {
+			case ReadRequest, MutatingRequest, WatchRequest:
+			default:
+				return nil, fmt.Errorf("priority bucket %q has unknown request kind %q", bucket.Name, kind)
+			}
+		}
+		l.buckets = append(l.buckets, newPriorityBucket(bucket))
+	}
+	if defaultMaxInFlight > 0 {
+		l.defaultBucket = newPriorityBucket(PriorityBucket{
+			Name:         DefaultPriorityBucket,
+			RequestKinds: []RequestKind{ReadRequest, MutatingRequest},
+			MaxInFlight:  defaultMaxInFlight,
+		})
+	}
+	return l, nil
+}
+
+// WithPriorityLimit wraps handler so that requests are served within the limits of their priority bucket,
+// and rejected with 429 Too Many Requests if their bucket is full. It must be installed inside the
+// authentication filter so the user is available from the request context.
+// If limiter is nil, handler is returned unchanged.
+func WithPriorityLimit(handler http.Handler, requestContextMapper api.RequestContextMapper, resolver *APIRequestInfoResolver, limiter *PriorityLimiter) http.Handler {
+	if limiter == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		kind, limited := limiter.classify(req, resolver)
+		if !limited {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		var u user.Info
+		if ctx, ok := requestContextMapper.Get(req); ok {
+			u, _ = api.UserFrom(ctx)
+		}
+		bucket := limiter.match(u, kind)
+		if bucket == nil {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		flow := ""
+		if u != nil {
+			flow = u.GetName()
+		}
+		if !bucket.acquire(flow, limiter.queueWait) {
+			metrics.MonitorRejection(bucket.Name, string(kind))
+			tooManyRequests(w)
+			return
+		}
+		defer bucket.release()
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// WithTotalLimit wraps handler so that no more read and mutating requests are served at a time than the
+// buckets of limiter can hold together, and rejects the others with 429 Too Many Requests. Unlike
+// WithPriorityLimit, it is installed outside the authentication filter, so that authenticating requests,
+// including those which fail authentication, is limited as well. If limiter is nil, or does not limit
+// requests which match no bucket, handler is returned unchanged.
+func WithTotalLimit(handler http.Handler, resolver *APIRequestInfoResolver, limiter *PriorityLimiter) http.Handler {
+	if limiter == nil || limiter.defaultBucket == nil {
+		return handler
+	}
+	// Requests waiting in the queue of a bucket are counted as well. Buckets of watches only are not.
+	total := limiter.defaultBucket.MaxInFlight + limiter.defaultBucket.QueueLength
+	for _, bucket := range limiter.buckets {
+		if !bucket.watchesOnly() {
+			total += bucket.MaxInFlight + bucket.QueueLength
+		}
+	}
+	sem := make(chan bool, total)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Watches are limited by the buckets which list them once the user is known.
+		if kind, limited := limiter.classify(req, resolver); !limited || kind == WatchRequest {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		select {
+		case sem <- true:
+			defer func() { <-sem }()
+			handler.ServeHTTP(w, req)
+		default:
+			tooManyRequests(w)
+		}
+	})
+}
+
+// classify returns the kind of req, and false if req is long running and not subject to limits.
+func (l *PriorityLimiter) classify(req *http.Request, resolver *APIRequestInfoResolver) (RequestKind, bool) {
+	if watch := req.URL.Query().Get("watch"); watch == "true" || watch == "1" {
+		return WatchRequest, true
+	}
+	if requestInfo, err := resolver.GetAPIRequestInfo(req); err == nil && requestInfo.Verb == "watch" {
+		return WatchRequest, true
+	}
+	if l.longRunningRequestRE != nil && l.longRunningRequestRE.MatchString(req.URL.Path) {
+		return "", false
+	}
+	if IsReadOnlyReq(*req) {
+		return ReadRequest, true
+	}
+	return MutatingRequest, true
+}
+
+// match returns the first bucket which matches a request of kind from u, or nil if the request is not limited.
+func (l *PriorityLimiter) match(u user.Info, kind RequestKind) *priorityBucket {
+	for _, bucket := range l.buckets {
+		if bucket.matches(u, kind) {
+			return bucket
+		}
+	}
+	if l.defaultBucket != nil && l.defaultBucket.matches(u, kind) {
+		return l.defaultBucket
+	}
+	return nil
+}
+
+type priorityBucket struct {
+	PriorityBucket
+
+	lock     sync.Mutex
+	inFlight int
+	queued   int
+	// queues holds the channels of the waiting requests of each user, in arrival order.
+	queues map[string][]chan struct{}
+	// flows lists the users with waiting requests in the order they are served.
+	flows []string
+}
+
+func newPriorityBucket(config PriorityBucket) *priorityBucket {
+	return &priorityBucket{
+		PriorityBucket: config,
+		queues:         map[string][]chan struct{}{},
+	}
+}
+
+// watchesOnly returns true if the bucket matches no requests but watches.
+func (b *priorityBucket) watchesOnly() bool {
+	if len(b.RequestKinds) == 0 {
+		return false
+	}
+	for _, kind := range b.RequestKinds {
+		if kind != WatchRequest {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *priorityBucket) matches(u user.Info, kind RequestKind) bool {
+	if len(b.RequestKinds) == 0 && kind == WatchRequest {
+		return false
+	}
+	if len(b.RequestKinds) > 0 {
+		found := false
+		for _, k := range b.RequestKinds {
+			if k == kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(b.Users) == 0 && len(b.Groups) == 0 {
+		return true
+	}
+	if u == nil {
+		return false
+	}
+	if sets.NewString(b.Users...).Has(u.GetName()) {
+		return true
+	}
+	return sets.NewString(b.Groups...).HasAny(u.GetGroups()...)
+}
+
+// acquire waits until a request from flow may be served, and returns false if
+// the queue is full or the request waited longer than wait.
+func (b *priorityBucket) acquire(flow string, wait time.Duration) bool {
+	b.lock.Lock()
+	if b.inFlight < b.MaxInFlight && b.queued == 0 {
+		b.inFlight++
+		b.monitor()
+		b.lock.Unlock()
+		return true
+	}
+	if b.queued >= b.QueueLength {
+		b.lock.Unlock()
+		return false
+	}
+	ready := make(chan struct{})
+	if len(b.queues[flow]) == 0 {
+		b.flows = append(b.flows, flow)
+	}
+	b.queues[flow] = append(b.queues[flow], ready)
+	b.queued++
+	b.monitor()
+	b.lock.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ready:
+		return true
+	case <-timer.C:
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	// The request may have been dispatched after the timer fired, in which case it holds a slot.
+	return !b.dequeue(flow, ready)
+}
+
+// release frees the slot of a served request and dispatches waiting requests, taking one
+// from each user in turn.
+func (b *priorityBucket) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.inFlight--
+	for b.inFlight < b.MaxInFlight && len(b.flows) > 0 {
+		flow := b.flows[0]
+		b.flows = b.flows[1:]
+		queue := b.queues[flow]
+		close(queue[0])
+		if len(queue) > 1 {
+			b.queues[flow] = queue[1:]
+			b.flows = append(b.flows, flow)
+		} else {
+			delete(b.queues, flow)
+		}
+		b.queued--
+		b.inFlight++
+	}
+	b.monitor()
+}
+
+// dequeue removes a waiting request, returning false if it is no longer queued. Callers must hold the lock.
+func (b *priorityBucket) dequeue(flow string, ready chan struct{}) bool {
+	queue := b.queues[flow]
+	for i := range queue {
+		if queue[i] != ready {
+			continue
+		}
+		if len(queue) > 1 {
+			b.queues[flow] = append(queue[:i], queue[i+1:]...)
+		} else {
+			delete(b.queues, flow)
+			for j := range b.flows {
+				if b.flows[j] == flow {
+					b.flows = append(b.flows[:j], b.flows[j+1:]...)
+					break
+				}
+			}
+		}
+		b.queued--
+		b.monitor()
+		return true
+	}
+	return false
+}
+
+// monitor records the state of the bucket. Callers must hold the lock.
+func (b *priorityBucket) monitor() {
+	metrics.MonitorPriorityBucket(b.Name, b.queued, b.inFlight)
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func TestLoadPriorityBuckets(t *testing.T) {
+	file, err := ioutil.TempFile("", "priority")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString(`{"name": "controllers", "groups": ["system:controllers"], "maxInFlight": 50, "queueLength": 100}
+
+{"name": "kubelet-watches", "users": ["kubelet"], "requestKinds": ["watch"], "maxInFlight": 1000}
+`)
+	file.Close()
+
+	buckets, err := LoadPriorityBuckets(file.Name())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []PriorityBucket{
+		{Name: "controllers", Groups: []string{"system:controllers"}, MaxInFlight: 50, QueueLength: 100},
+		{Name: "kubelet-watches", Users: []string{"kubelet"}, RequestKinds: []RequestKind{WatchRequest}, MaxInFlight: 1000},
+	}
+	if !reflect.DeepEqual(expected, buckets) {
+		t.Errorf("expected %#v, got %#v", expected, buckets)
+	}
+}
+
+func TestNewPriorityLimiterValidation(t *testing.T) {
+	testCases := map[string][]PriorityBucket{
+		"no name":        {{MaxInFlight: 1}},
+		"duplicate name": {{Name: "a", MaxInFlight: 1}, {Name: "a", MaxInFlight: 1}},
+		"reserved name":  {{Name: DefaultPriorityBucket, MaxInFlight: 1}},
+		"no slots":       {{Name: "a"}},
+		"negative queue": {{Name: "a", MaxInFlight: 1, QueueLength: -1}},
+		"unknown kind":   {{Name: "a", MaxInFlight: 1, RequestKinds: []RequestKind{"proxy"}}},
+	}
+	for name, buckets := range testCases {
+		if _, err := NewPriorityLimiter(buckets, 0, nil); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestPriorityLimiterMatch(t *testing.T) {
+	limiter, err := NewPriorityLimiter([]PriorityBucket{
+		{Name: "admin", Users: []string{"admin"}, MaxInFlight: 1},
+		{Name: "controller-writes", Groups: []string{"controllers"}, RequestKinds: []RequestKind{MutatingRequest}, MaxInFlight: 1},
+		{Name: "watches", RequestKinds: []RequestKind{WatchRequest}, MaxInFlight: 1},
+	}, 10, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	admin := &user.DefaultInfo{Name: "admin"}
+	controller := &user.DefaultInfo{Name: "rc", Groups: []string{"controllers"}}
+	testCases := []struct {
+		user     user.Info
+		kind     RequestKind
+		expected string
+	}{
+		{admin, ReadRequest, "admin"},
+		{admin, WatchRequest, "watches"},
+		{controller, MutatingRequest, "controller-writes"},
+		{controller, ReadRequest, DefaultPriorityBucket},
+		{controller, WatchRequest, "watches"},
+		{nil, ReadRequest, DefaultPriorityBucket},
+	}
+	for _, tc := range testCases {
+		bucket := limiter.match(tc.user, tc.kind)
+		if bucket == nil || bucket.Name != tc.expected {
+			t.Errorf("%v %s: expected bucket %s, got %v", tc.user, tc.kind, tc.expected, bucket)
+		}
+	}
+
+	limiter, err = NewPriorityLimiter([]PriorityBucket{
+		{Name: "admin", Users: []string{"admin"}, MaxInFlight: 1},
+	}, 10, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bucket := limiter.match(admin, WatchRequest); bucket != nil {
+		t.Errorf("expected watches not to match buckets which don't list them, got %s", bucket.Name)
+	}
+}
+
+func TestPriorityBucketFairness(t *testing.T) {
+	bucket := newPriorityBucket(PriorityBucket{Name: "test", MaxInFlight: 1, QueueLength: 10})
+	if !bucket.acquire("busy", time.Minute) {
+		t.Fatalf("expected the first request to be served")
+	}
+
+	lock := sync.Mutex{}
+	served := []string{}
+	wg := sync.WaitGroup{}
+	for i, flow := range []string{"busy", "busy", "busy", "quiet"} {
+		wg.Add(1)
+		go func(flow string) {
+			defer wg.Done()
+			if !bucket.acquire(flow, time.Minute) {
+				t.Errorf("unexpected rejection of %s", flow)
+				return
+			}
+			lock.Lock()
+			served = append(served, flow)
+			lock.Unlock()
+			bucket.release()
+		}(flow)
+		waitForQueued(t, bucket, i+1)
+	}
+	bucket.release()
+	wg.Wait()
+
+	expected := []string{"busy", "quiet", "busy", "busy"}
+	if !reflect.DeepEqual(expected, served) {
+		t.Errorf("expected requests served in order %v, got %v", expected, served)
+	}
+}
+
+func TestPriorityBucketRejects(t *testing.T) {
+	bucket := newPriorityBucket(PriorityBucket{Name: "test", MaxInFlight: 1, QueueLength: 1})
+	if !bucket.acquire("a", time.Minute) {
+		t.Fatalf("expected the first request to be served")
+	}
+	if bucket.acquire("a", time.Millisecond) {
+		t.Errorf("expected a request which waited too long to be rejected")
+	}
+	if bucket.queued != 0 || len(bucket.flows) != 0 || len(bucket.queues) != 0 {
+		t.Errorf("expected a rejected request to leave the queue, got %d queued", bucket.queued)
+	}
+
+	done := make(chan bool)
+	go func() {
+		done <- bucket.acquire("b", time.Minute)
+	}()
+	waitForQueued(t, bucket, 1)
+	if bucket.acquire("c", time.Minute) {
+		t.Errorf("expected a request to be rejected when the queue is full")
+	}
+	bucket.release()
+	if !<-done {
+		t.Errorf("expected the queued request to be served")
+	}
+}
+
+func TestWithPriorityLimit(t *testing.T) {
+	limiter, err := NewPriorityLimiter([]PriorityBucket{
+		{Name: "watches", RequestKinds: []RequestKind{WatchRequest}, MaxInFlight: 1},
+	}, 1, regexp.MustCompile("(/|^)(watch|proxy)(/|$)"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resolver := &APIRequestInfoResolver{APIPrefixes: sets.NewString("api"), RestMapper: testapi.Default.RESTMapper()}
+
+	block := make(chan struct{})
+	calls := sync.WaitGroup{}
+	handler := WithPriorityLimit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("block") == "true" {
+			calls.Done()
+			<-block
+		}
+	}), api.NewRequestContextMapper(), resolver, limiter)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Fill the default bucket and the watch bucket.
+	blocked := sync.WaitGroup{}
+	for _, query := range []string{"block=true", "block=true&watch=true"} {
+		calls.Add(1)
+		blocked.Add(1)
+		go func(query string) {
+			defer blocked.Done()
+			expectHTTP(server.URL+"/api/v1/namespaces/default/pods?"+query, http.StatusOK, t)
+		}(query)
+	}
+	calls.Wait()
+
+	expectHTTP(server.URL+"/api/v1/namespaces/default/pods", errors.StatusTooManyRequests, t)
+	expectHTTP(server.URL+"/api/v1/watch/namespaces/default/pods", errors.StatusTooManyRequests, t)
+	// Long running requests other than watches are not limited.
+	expectHTTP(server.URL+"/api/v1/proxy/namespaces/default/pods/foo", http.StatusOK, t)
+	close(block)
+	blocked.Wait()
+}
+
+func TestWithTotalLimit(t *testing.T) {
+	limiter, err := NewPriorityLimiter([]PriorityBucket{
+		{Name: "admin", Users: []string{"admin"}, MaxInFlight: 1},
+		{Name: "watches", RequestKinds: []RequestKind{WatchRequest}, MaxInFlight: 5},
+	}, 1, regexp.MustCompile("(/|^)(watch|proxy)(/|$)"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resolver := &APIRequestInfoResolver{APIPrefixes: sets.NewString("api"), RestMapper: testapi.Default.RESTMapper()}
+
+	block := make(chan struct{})
+	calls := sync.WaitGroup{}
+	// The handler stands in for an authenticator which does not identify the users.
+	handler := WithTotalLimit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("block") == "true" {
+			calls.Done()
+			<-block
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}), resolver, limiter)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// The admin and default buckets hold two requests together; the watch bucket is not counted.
+	blocked := sync.WaitGroup{}
+	for i := 0; i < 2; i++ {
+		calls.Add(1)
+		blocked.Add(1)
+		go func() {
+			defer blocked.Done()
+			expectHTTP(server.URL+"/api/v1/namespaces/default/pods?block=true", http.StatusUnauthorized, t)
+		}()
+	}
+	calls.Wait()
+
+	expectHTTP(server.URL+"/api/v1/namespaces/default/pods", errors.StatusTooManyRequests, t)
+	// Watches and other long running requests are not limited before authentication.
+	expectHTTP(server.URL+"/api/v1/watch/namespaces/default/pods", http.StatusUnauthorized, t)
+	expectHTTP(server.URL+"/api/v1/proxy/namespaces/default/pods/foo", http.StatusUnauthorized, t)
+	close(block)
+	blocked.Wait()
+
+	// Requests which match no bucket are not limited if the default bucket is unlimited.
+	unlimited, err := NewPriorityLimiter(nil, 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reflect.ValueOf(WithTotalLimit(handler, resolver, unlimited)).Pointer() != reflect.ValueOf(handler).Pointer() {
+		t.Errorf("expected the handler to be returned unchanged")
+	}
+}
+
+func waitForQueued(t *testing.T, bucket *priorityBucket, queued int) {
+	for i := 0; i < 1000; i++ {
+		bucket.lock.Lock()
+		n := bucket.queued
+		bucket.lock.Unlock()
+		if n == queued {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d queued requests", queued)
+}
@@ -164,7 +164,7 @@ func (r *ProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// TODO convert this entire proxy to an UpgradeAwareProxy similar to
 	// https://github.com/openshift/origin/blob/master/pkg/util/httpproxy/upgradeawareproxy.go.
 	// That proxy needs to be modified to support multiple backends, not just 1.
-	if r.tryUpgrade(w, req, newReq, location, roundTripper) {
+	if tryUpgrade(w, req, newReq, location, roundTripper, r.codec) {
 		return
 	}
 
@@ -212,15 +212,93 @@ func (r *ProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	proxy.ServeHTTP(w, newReq)
 }
 
+// Headers which tell a delegate API server the user the master authenticated a request as.
+const (
+	DelegateUserHeader  = "X-Remote-User"
+	DelegateGroupHeader = "X-Remote-Group"
+)
+
+// delegateStrippedHeaders are not passed on to delegates: the credentials of the caller are
+// only meant for the master, and only the master may assert the user of a request.
+var delegateStrippedHeaders = []string{"Authorization", "Proxy-Authorization", DelegateUserHeader, DelegateGroupHeader}
+
+// DelegateHandler provides a http.Handler which forwards requests for an API group version
+// to the external API server that serves it. The request path is kept as is, so the
+// delegate must serve the group version under the same prefix as the master. The
+// credentials of the caller are removed, and the user the master authenticated the request
+// as is sent in the DelegateUserHeader and DelegateGroupHeader headers instead, so the
+// delegate should only trust them from the master, e.g. by requiring its client certificate.
+type DelegateHandler struct {
+	location  *url.URL
+	transport http.RoundTripper
+	mapper    api.RequestContextMapper
+	codec     runtime.Codec
+}
+
+// NewDelegateHandler returns a DelegateHandler proxying to the API server at location. If
+// transport is nil, http.DefaultTransport is used. The user of a request is looked up in
+// its context from mapper. Errors are encoded with codec.
+func NewDelegateHandler(location *url.URL, transport http.RoundTripper, mapper api.RequestContextMapper, codec runtime.Codec) *DelegateHandler {
+	return &DelegateHandler{location: location, transport: transport, mapper: mapper, codec: codec}
+}
+
+func (d *DelegateHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	location := *d.location
+	location.Path = singleJoiningSlash(location.Path, req.URL.Path)
+	location.RawQuery = req.URL.RawQuery
+
+	newReq, err := http.NewRequest(req.Method, location.String(), req.Body)
+	if err != nil {
+		status := errToAPIStatus(err)
+		writeJSON(status.Code, d.codec, status, w, true)
+		return
+	}
+	newReq.Header = d.delegateHeader(req)
+
+	if tryUpgrade(w, req, newReq, &location, d.transport, d.codec) {
+		return
+	}
+
+	glog.V(4).Infof("Delegating %s %s to %s", req.Method, req.URL, location.Host)
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: location.Scheme, Host: location.Host})
+	proxy.Transport = d.transport
+	proxy.FlushInterval = 200 * time.Millisecond
+	proxy.ServeHTTP(w, newReq)
+}
+
+// delegateHeader returns the header of req without the credentials of the caller, and with
+// the user the request was authenticated as.
+func (d *DelegateHandler) delegateHeader(req *http.Request) http.Header {
+	header := http.Header{}
+	for key, values := range req.Header {
+		header[key] = values
+	}
+	for _, key := range delegateStrippedHeaders {
+		header.Del(key)
+	}
+	if d.mapper == nil {
+		return header
+	}
+	if ctx, ok := d.mapper.Get(req); ok {
+		if user, ok := api.UserFrom(ctx); ok {
+			header.Set(DelegateUserHeader, user.GetName())
+			for _, group := range user.GetGroups() {
+				header.Add(DelegateGroupHeader, group)
+			}
+		}
+	}
+	return header
+}
+
 // tryUpgrade returns true if the request was handled.
-func (r *ProxyHandler) tryUpgrade(w http.ResponseWriter, req, newReq *http.Request, location *url.URL, transport http.RoundTripper) bool {
+func tryUpgrade(w http.ResponseWriter, req, newReq *http.Request, location *url.URL, transport http.RoundTripper, codec runtime.Codec) bool {
 	if !httpstream.IsUpgradeRequest(req) {
 		return false
 	}
 	backendConn, err := dialURL(location, transport)
 	if err != nil {
 		status := errToAPIStatus(err)
-		writeJSON(status.Code, r.codec, status, w, true)
+		writeJSON(status.Code, codec, status, w, true)
 		return true
 	}
 	defer backendConn.Close()
@@ -231,14 +309,14 @@ func (r *ProxyHandler) tryUpgrade(w http.ResponseWriter, req, newReq *http.Reque
 	requestHijackedConn, _, err := w.(http.Hijacker).Hijack()
 	if err != nil {
 		status := errToAPIStatus(err)
-		writeJSON(status.Code, r.codec, status, w, true)
+		writeJSON(status.Code, codec, status, w, true)
 		return true
 	}
 	defer requestHijackedConn.Close()
 
 	if err = newReq.Write(backendConn); err != nil {
 		status := errToAPIStatus(err)
-		writeJSON(status.Code, r.codec, status, w, true)
+		writeJSON(status.Code, codec, status, w, true)
 		return true
 	}
 
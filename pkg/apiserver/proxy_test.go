@@ -28,7 +28,9 @@ import (
 	"testing"
 
 	"golang.org/x/net/websocket"
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/auth/user"
 )
 
 func TestProxy(t *testing.T) {
@@ -225,3 +227,53 @@ func TestRedirectOnMissingTrailingSlash(t *testing.T) {
 		}
 	}
 }
+
+func TestDelegateHandlerHeaders(t *testing.T) {
+	var delegatedHeader http.Header
+	delegate := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		delegatedHeader = req.Header
+	}))
+	defer delegate.Close()
+	location, _ := url.Parse(delegate.URL)
+
+	mapper := api.NewRequestContextMapper()
+	delegateHandler := NewDelegateHandler(location, nil, mapper, codec)
+	authenticated := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx, _ := mapper.Get(req)
+		mapper.Update(req, api.WithUser(ctx, &user.DefaultInfo{Name: "alice", Groups: []string{"admins", "devs"}}))
+		delegateHandler.ServeHTTP(w, req)
+	})
+	handler, _ := api.NewRequestContextFilter(mapper, authenticated)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL+"/apis/company.com/v1/foos", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Proxy-Authorization", "Basic secret")
+	req.Header.Set(DelegateUserHeader, "root")
+	req.Header.Set(DelegateGroupHeader, "system:masters")
+	req.Header.Set("X-Custom", "kept")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	for _, key := range []string{"Authorization", "Proxy-Authorization"} {
+		if value := delegatedHeader.Get(key); value != "" {
+			t.Errorf("expected %s not to be forwarded, got %q", key, value)
+		}
+	}
+	if users := delegatedHeader[DelegateUserHeader]; len(users) != 1 || users[0] != "alice" {
+		t.Errorf("unexpected user header: %v", users)
+	}
+	if groups := delegatedHeader[DelegateGroupHeader]; len(groups) != 2 || groups[0] != "admins" || groups[1] != "devs" {
+		t.Errorf("unexpected group header: %v", groups)
+	}
+	if value := delegatedHeader.Get("X-Custom"); value != "kept" {
+		t.Errorf("expected other headers to be forwarded, got %q", value)
+	}
+	if value := req.Header.Get("Authorization"); value != "Bearer secret" {
+		t.Errorf("expected the original request to be unchanged, got %q", value)
+	}
+}
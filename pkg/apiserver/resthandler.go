@@ -17,21 +17,27 @@ limitations under the License.
 package apiserver
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	gpath "path"
+	"strconv"
 	"time"
 
 	"k8s.io/kubernetes/pkg/admission"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/registry/generic"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/emicklei/go-restful"
 	"github.com/evanphx/json-patch"
@@ -69,6 +75,10 @@ type RequestScope struct {
 	Creater   runtime.ObjectCreater
 	Convertor runtime.ObjectConvertor
 
+	// ProtobufCodec is optional. When set, it is negotiated with clients that
+	// accept the protobuf content type.
+	ProtobufCodec runtime.Codec
+
 	Resource    string
 	Subresource string
 	Kind        string
@@ -78,6 +88,14 @@ type RequestScope struct {
 	ServerAPIVersion string
 }
 
+// bodyCodec returns the codec that should be used to decode the request body.
+func (scope RequestScope) bodyCodec(body []byte) runtime.Codec {
+	if scope.ProtobufCodec != nil && protobuf.IsProtobuf(body) {
+		return scope.ProtobufCodec
+	}
+	return scope.Codec
+}
+
 // getterFunc performs a get request with the given context and object name. The request
 // may be used to deserialize an options object to pass to the getter.
 type getterFunc func(ctx api.Context, name string, req *restful.Request) (runtime.Object, error)
@@ -104,7 +122,7 @@ func getResourceHandler(scope RequestScope, getter getterFunc) restful.RouteFunc
 			errorJSON(err, scope.Codec, w)
 			return
 		}
-		write(http.StatusOK, scope.APIVersion, scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
@@ -220,7 +238,13 @@ func ListResource(r rest.Lister, rw rest.Watcher, scope RequestScope, forceWatch
 		// transform fields
 		// TODO: queryToObject should do this.
 		fn := func(label, value string) (newLabel, newValue string, err error) {
-			return scope.Convertor.ConvertFieldLabel(scope.APIVersion, scope.Kind, label, value)
+			newLabel, newValue, err = scope.Convertor.ConvertFieldLabel(scope.APIVersion, scope.Kind, label, value)
+			if err != nil && isObjectField(scope, label) {
+				// generic.SelectionPredicate evaluates any field of the
+				// object by its JSON path.
+				return label, value, nil
+			}
+			return newLabel, newValue, err
 		}
 		if opts.FieldSelector, err = opts.FieldSelector.Transform(fn); err != nil {
 			// TODO: allow bad request to set field causes based on query parameters
@@ -256,11 +280,29 @@ func ListResource(r rest.Lister, rw rest.Watcher, scope RequestScope, forceWatch
 				errorJSON(err, scope.Codec, w)
 				return
 			}
+			if !opts.AllowWatchBookmarks {
+				// Clients which did not ask for bookmarks may not understand them.
+				watcher = watch.Filter(watcher, dropBookmarks)
+			}
 			serveWatch(watcher, scope, w, req, minRequestTimeout)
 			return
 		}
 
-		result, err := r.List(ctx, opts.LabelSelector, opts.FieldSelector)
+		if opts.Limit < 0 {
+			errorJSON(errors.NewBadRequest("limit must not be negative"), scope.Codec, w)
+			return
+		}
+		var result runtime.Object
+		pagedLister, paged := r.(rest.PagedLister)
+		switch {
+		case paged && (opts.Limit > 0 || len(opts.Continue) > 0 || len(opts.ResourceVersion) > 0):
+			result, err = pagedLister.ListPage(ctx, &opts)
+		case len(opts.Continue) > 0:
+			err = errors.NewBadRequest("continuing a list is not supported for this resource")
+		default:
+			// a limit is advisory, so resources which cannot be paged return every item
+			result, err = r.List(ctx, opts.LabelSelector, opts.FieldSelector)
+		}
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
@@ -269,7 +311,7 @@ func ListResource(r rest.Lister, rw rest.Watcher, scope RequestScope, forceWatch
 			errorJSON(err, scope.Codec, w)
 			return
 		}
-		write(http.StatusOK, scope.APIVersion, scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
@@ -296,6 +338,11 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, dryRun, err := withDryRun(ctx, req.Request, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -304,7 +351,7 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		}
 
 		obj := r.New()
-		if err := scope.Codec.DecodeIntoWithSpecifiedVersionKind(body, obj, scope.APIVersion, scope.Kind); err != nil {
+		if err := scope.bodyCodec(body).DecodeIntoWithSpecifiedVersionKind(body, obj, scope.APIVersion, scope.Kind); err != nil {
 			err = transformDecodeError(typer, err, obj, body)
 			errorJSON(err, scope.Codec, w)
 			return
@@ -313,7 +360,7 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		if admit != nil && admit.Handles(admission.Create) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo))
+			err = admit.Admit(admissionAttributes(dryRun, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Create, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -337,7 +384,7 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 			return
 		}
 
-		write(http.StatusCreated, scope.APIVersion, scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusCreated, scope, result, w, req.Request)
 	}
 }
 
@@ -359,6 +406,12 @@ func (c *namedCreaterAdapter) Create(ctx api.Context, name string, obj runtime.O
 	return c.Creater.Create(ctx, obj)
 }
 
+// SupportsDryRun implements rest.DryRunner for the adapted Creater.
+func (c *namedCreaterAdapter) SupportsDryRun() bool {
+	dryRunner, ok := c.Creater.(rest.DryRunner)
+	return ok && dryRunner.SupportsDryRun()
+}
+
 // PatchResource returns a function that will handle a resource patch
 // TODO: Eventually PatchResource should just use GuaranteedUpdate and this routine should be a bit cleaner
 func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper, admit admission.Interface, converter runtime.ObjectConvertor) restful.RouteFunction {
@@ -379,12 +432,17 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 		obj := r.New()
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, dryRun, err := withDryRun(ctx, req.Request, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		// PATCH requires same permission as UPDATE
 		if admit.Handles(admission.Update) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(dryRun, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -414,7 +472,19 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			return
 		}
 		contentType := req.HeaderParameter("Content-Type")
-		patchedObjJS, err := getPatchedJS(contentType, originalObjJS, patchJS, versionedObj)
+		var patchedObjJS []byte
+		if api.PatchType(contentType) == api.ApplyPatchType {
+			// The applied configuration is recorded without any configuration
+			// a client may have copied from the object it read.
+			patchJS, err = stripLastAppliedConfig(patchJS)
+			if err != nil {
+				errorJSON(errors.NewBadRequest(err.Error()), scope.Codec, w)
+				return
+			}
+			patchedObjJS, err = getAppliedJS(original, originalObjJS, patchJS, versionedObj)
+		} else {
+			patchedObjJS, err = getPatchedJS(contentType, originalObjJS, patchJS, versionedObj)
+		}
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
@@ -424,6 +494,12 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			errorJSON(err, scope.Codec, w)
 			return
 		}
+		if api.PatchType(contentType) == api.ApplyPatchType {
+			if err := setLastAppliedConfig(obj, patchJS); err != nil {
+				errorJSON(err, scope.Codec, w)
+				return
+			}
+		}
 		if err := checkName(obj, name, namespace, scope.Namer); err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
@@ -444,7 +520,7 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			return
 		}
 
-		write(http.StatusOK, scope.APIVersion, scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
@@ -463,6 +539,11 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, dryRun, err := withDryRun(ctx, req.Request, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -471,7 +552,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 
 		obj := r.New()
-		if err := scope.Codec.DecodeIntoWithSpecifiedVersionKind(body, obj, scope.APIVersion, scope.Kind); err != nil {
+		if err := scope.bodyCodec(body).DecodeIntoWithSpecifiedVersionKind(body, obj, scope.APIVersion, scope.Kind); err != nil {
 			err = transformDecodeError(typer, err, obj, body)
 			errorJSON(err, scope.Codec, w)
 			return
@@ -485,7 +566,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		if admit != nil && admit.Handles(admission.Update) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(dryRun, admission.NewAttributesRecord(obj, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Update, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -512,7 +593,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		if wasCreated {
 			status = http.StatusCreated
 		}
-		writeJSON(status, scope.Codec, result, w, isPrettyPrint(req.Request))
+		writeNegotiated(status, scope, result, w, req.Request)
 	}
 }
 
@@ -531,6 +612,11 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, dryRun, err := withDryRun(ctx, req.Request, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		options := &api.DeleteOptions{}
 		if checkBody {
@@ -550,7 +636,7 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 		if admit != nil && admit.Handles(admission.Delete) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(nil, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Delete, userInfo))
+			err = admit.Admit(admissionAttributes(dryRun, admission.NewAttributesRecord(nil, scope.Kind, namespace, name, scope.Resource, scope.Subresource, admission.Delete, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -585,10 +671,53 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 				}
 			}
 		}
-		write(http.StatusOK, scope.APIVersion, scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
+// withDryRun marks ctx as a dry run if the request sets the dryRun query parameter.
+// Dry runs are rejected for storage which cannot honor them.
+func withDryRun(ctx api.Context, req *http.Request, storage interface{}) (api.Context, bool, error) {
+	value := req.URL.Query().Get("dryRun")
+	if len(value) == 0 {
+		return ctx, false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, false, errors.NewBadRequest(fmt.Sprintf("invalid dryRun value %q: %v", value, err))
+	}
+	if !dryRun {
+		return ctx, false, nil
+	}
+	if dryRunner, ok := storage.(rest.DryRunner); !ok || !dryRunner.SupportsDryRun() {
+		return nil, false, errors.NewBadRequest("dry run is not supported for this resource")
+	}
+	return api.WithDryRun(ctx), true, nil
+}
+
+// admissionAttributes marks attributes as a dry run if requested.
+func admissionAttributes(dryRun bool, attributes admission.Attributes) admission.Attributes {
+	if dryRun {
+		return admission.WithDryRun(attributes)
+	}
+	return attributes
+}
+
+// dropBookmarks is a watch.FilterFunc removing watch.Bookmark events.
+func dropBookmarks(in watch.Event) (watch.Event, bool) {
+	return in, in.Type != watch.Bookmark
+}
+
+// isObjectField returns true if label is the JSON path of a field of the
+// internal type of the scope's kind.
+func isObjectField(scope RequestScope, label string) bool {
+	obj, err := scope.Creater.New("", scope.Kind)
+	if err != nil {
+		return false
+	}
+	return generic.IsObjectField(obj, label)
+}
+
 // queryToObject converts query parameters into a structured internal object by
 // kind. The caller must cast the returned object to the matching internal Kind
 // to use it.
@@ -753,3 +882,52 @@ func getPatchedJS(contentType string, originalJS, patchJS []byte, obj runtime.Ob
 		return nil, fmt.Errorf("unknown Content-Type header for patch: %s", contentType)
 	}
 }
+
+// getAppliedJS reconciles the configuration in configJS with the current object by a
+// three-way merge against the configuration last applied to it, so that fields set by
+// other writers are preserved while fields removed from the configuration are deleted.
+func getAppliedJS(current runtime.Object, currentJS, configJS []byte, obj runtime.Object) ([]byte, error) {
+	accessor, err := meta.Accessor(current)
+	if err != nil {
+		return nil, err
+	}
+	lastAppliedJS := []byte(accessor.Annotations()[api.LastAppliedConfigAnnotation])
+	patchJS, err := strategicpatch.CreateThreeWayMergePatch(lastAppliedJS, configJS, currentJS, obj)
+	if err != nil {
+		return nil, err
+	}
+	return strategicpatch.StrategicMergePatch(currentJS, patchJS, obj)
+}
+
+// stripLastAppliedConfig removes the last applied configuration annotation from configJS,
+// so that applying an object which was read back from the server does not nest it.
+func stripLastAppliedConfig(configJS []byte) ([]byte, error) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(configJS, &config); err != nil {
+		return nil, fmt.Errorf("the applied configuration must be a JSON object: %v", err)
+	}
+	if metadata, ok := config["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, api.LastAppliedConfigAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	return json.Marshal(config)
+}
+
+// setLastAppliedConfig records configJS on obj as the configuration last applied to it.
+func setLastAppliedConfig(obj runtime.Object, configJS []byte) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	annotations := accessor.Annotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[api.LastAppliedConfigAnnotation] = string(configJS)
+	accessor.SetAnnotations(annotations)
+	return nil
+}
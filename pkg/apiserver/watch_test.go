@@ -166,6 +166,47 @@ func TestWatchHTTP(t *testing.T) {
 	}
 }
 
+func TestWatchBookmarks(t *testing.T) {
+	bookmark := &Simple{ObjectMeta: api.ObjectMeta{ResourceVersion: "10"}}
+	added := &Simple{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "11"}}
+	testCases := map[string][]watch.EventType{
+		"":                         {watch.Added},
+		"allowWatchBookmarks=true": {watch.Bookmark, watch.Added},
+	}
+	for query, expected := range testCases {
+		simpleStorage := &SimpleRESTStorage{}
+		handler := handle(map[string]rest.Storage{"simples": simpleStorage})
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		response, err := http.Get(server.URL + "/api/version/watch/simples?" + query)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", query, err)
+		}
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("%q: unexpected response %#v", query, response)
+		}
+
+		go func() {
+			simpleStorage.fakeWatch.Action(watch.Bookmark, bookmark)
+			simpleStorage.fakeWatch.Action(watch.Added, added)
+			simpleStorage.fakeWatch.Stop()
+		}()
+		var types []watch.EventType
+		decoder := json.NewDecoder(response.Body)
+		for {
+			var got watchJSON
+			if err := decoder.Decode(&got); err != nil {
+				break
+			}
+			types = append(types, got.Type)
+		}
+		if !reflect.DeepEqual(expected, types) {
+			t.Errorf("%q: expected events %v, got %v", query, expected, types)
+		}
+	}
+}
+
 func TestWatchParamParsing(t *testing.T) {
 	simpleStorage := &SimpleRESTStorage{}
 	handler := handle(map[string]rest.Storage{
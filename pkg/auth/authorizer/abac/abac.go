@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/auth/authorizer"
 )
@@ -51,10 +52,21 @@ type policy struct {
 	// the API, we don't have to add lots of policy?
 
 	// TODO: make this a proper REST object with its own registry.
-	Readonly  bool   `json:"readonly,omitempty"`
+	Readonly bool `json:"readonly,omitempty"`
+	// Verb, if set, must equal the verb of the request, e.g. delete or proxy.
+	Verb string `json:"verb,omitempty"`
+	// APIGroup, if set, must equal the API group of the request.
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Resource is either a resource, like pods, which also matches all of its
+	// subresources, or a single subresource, like pods/exec.
 	Resource  string `json:"resource,omitempty"`
+	Name      string `json:"name,omitempty"`
 	Namespace string `json:"namespace,omitempty"`
 
+	// NonResourcePath restricts the policy to requests outside of the REST
+	// object store, like /version. A trailing * matches any suffix.
+	NonResourcePath string `json:"nonResourcePath,omitempty"`
+
 	// TODO: "expires" string in RFC3339 format.
 
 	// TODO: want a way to allow some users to restart containers of a pod but
@@ -100,9 +112,18 @@ func NewFromFile(path string) (policyList, error) {
 func (p policy) matches(a authorizer.Attributes) bool {
 	if p.subjectMatches(a) {
 		if p.Readonly == false || (p.Readonly == a.IsReadOnly()) {
-			if p.Resource == "" || (p.Resource == a.GetResource()) {
-				if p.Namespace == "" || (p.Namespace == a.GetNamespace()) {
-					return true
+			if p.Verb == "" || (p.Verb == a.GetVerb()) {
+				if p.NonResourcePath != "" {
+					return p.nonResourcePathMatches(a)
+				}
+				if p.APIGroup == "" || (p.APIGroup == a.GetAPIGroup()) {
+					if p.resourceMatches(a) {
+						if p.Name == "" || (p.Name == a.GetName()) {
+							if p.Namespace == "" || (p.Namespace == a.GetNamespace()) {
+								return true
+							}
+						}
+					}
 				}
 			}
 		}
@@ -110,6 +131,23 @@ func (p policy) matches(a authorizer.Attributes) bool {
 	return false
 }
 
+func (p policy) resourceMatches(a authorizer.Attributes) bool {
+	if p.Resource == "" || p.Resource == a.GetResource() {
+		return true
+	}
+	return len(a.GetSubresource()) > 0 && p.Resource == a.GetResource()+"/"+a.GetSubresource()
+}
+
+func (p policy) nonResourcePathMatches(a authorizer.Attributes) bool {
+	if a.IsResourceRequest() {
+		return false
+	}
+	if strings.HasSuffix(p.NonResourcePath, "*") {
+		return strings.HasPrefix(a.GetPath(), strings.TrimSuffix(p.NonResourcePath, "*"))
+	}
+	return p.NonResourcePath == a.GetPath()
+}
+
 func (p policy) subjectMatches(a authorizer.Attributes) bool {
 	if p.User != "" {
 		// Require user match
@@ -336,6 +336,144 @@ func TestPolicy(t *testing.T) {
 			matches: false,
 			name:    "resource mis-match",
 		},
+		{
+			policy: policy{
+				Verb: "get",
+			},
+			attr: authorizer.AttributesRecord{
+				Verb: "delete",
+			},
+			matches: false,
+			name:    "verb mis-match",
+		},
+		{
+			policy: policy{
+				APIGroup: "experimental",
+				Resource: "jobs",
+			},
+			attr: authorizer.AttributesRecord{
+				APIGroup: "experimental",
+				Resource: "jobs",
+			},
+			matches: true,
+			name:    "api group match",
+		},
+		{
+			policy: policy{
+				APIGroup: "experimental",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource: "pods",
+			},
+			matches: false,
+			name:    "api group mis-match",
+		},
+		{
+			policy: policy{
+				Resource: "pods",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource:    "pods",
+				Subresource: "exec",
+			},
+			matches: true,
+			name:    "resource matches its subresources",
+		},
+		{
+			policy: policy{
+				Resource: "pods/exec",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource:    "pods",
+				Subresource: "exec",
+			},
+			matches: true,
+			name:    "subresource match",
+		},
+		{
+			policy: policy{
+				Resource: "pods/exec",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource: "pods",
+			},
+			matches: false,
+			name:    "subresource does not match its resource",
+		},
+		{
+			policy: policy{
+				Resource: "pods/exec",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource:    "pods",
+				Subresource: "log",
+			},
+			matches: false,
+			name:    "subresource mis-match",
+		},
+		{
+			policy: policy{
+				Name: "foo",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource: "pods",
+			},
+			matches: false,
+			name:    "name does not match collection",
+		},
+		{
+			policy: policy{
+				Resource: "pods",
+				Name:     "foo",
+			},
+			attr: authorizer.AttributesRecord{
+				Resource: "pods",
+				Name:     "foo",
+			},
+			matches: true,
+			name:    "name match",
+		},
+		{
+			policy: policy{
+				NonResourcePath: "/version",
+			},
+			attr: authorizer.AttributesRecord{
+				Path: "/version",
+			},
+			matches: true,
+			name:    "non-resource path match",
+		},
+		{
+			policy: policy{
+				NonResourcePath: "/version",
+			},
+			attr: authorizer.AttributesRecord{
+				ResourceRequest: true,
+				Path:            "/version",
+			},
+			matches: false,
+			name:    "non-resource path does not match resource requests",
+		},
+		{
+			policy: policy{
+				NonResourcePath: "/swaggerapi/*",
+			},
+			attr: authorizer.AttributesRecord{
+				Path: "/swaggerapi/api/v1",
+			},
+			matches: true,
+			name:    "non-resource path wildcard match",
+		},
+		{
+			policy: policy{
+				NonResourcePath: "/swaggerapi/*",
+			},
+			attr: authorizer.AttributesRecord{
+				Path: "/healthz",
+			},
+			matches: false,
+			name:    "non-resource path mis-match",
+		},
 	}
 	for _, test := range tests {
 		matches := test.policy.matches(test.attr)
@@ -32,6 +32,11 @@ type Attributes interface {
 	// authentication occurred.
 	GetGroups() []string
 
+	// The kube verb of the request, e.g. get, list, watch, create, update, patch,
+	// delete or proxy, if a request is for a REST object. Otherwise the lowercased
+	// HTTP method of the request.
+	GetVerb() string
+
 	// When IsReadOnly() == true, the request has no side effects, other than
 	// caching, logging, and other incidentals.
 	IsReadOnly() bool
@@ -39,8 +44,26 @@ type Attributes interface {
 	// The namespace of the object, if a request is for a REST object.
 	GetNamespace() string
 
+	// The API group of the object, if a request is for a REST object. The
+	// legacy API at /api has the empty group.
+	GetAPIGroup() string
+
 	// The kind of object, if a request is for a REST object.
 	GetResource() string
+
+	// The subresource being requested, e.g. status or exec, if a request is
+	// for a REST object.
+	GetSubresource() string
+
+	// The name of the object, if a request is for a single named REST object.
+	GetName() string
+
+	// IsResourceRequest returns true for requests to API resources, like
+	// /api/v1/nodes, and false for non-resource endpoints like /api and /healthz.
+	IsResourceRequest() bool
+
+	// The URL path of the request, which is mostly useful for non-resource requests.
+	GetPath() string
 }
 
 // Authorizer makes an authorization decision based on information gained by making
@@ -58,10 +81,16 @@ func (f AuthorizerFunc) Authorize(a Attributes) error {
 
 // AttributesRecord implements Attributes interface.
 type AttributesRecord struct {
-	User      user.Info
-	ReadOnly  bool
-	Namespace string
-	Resource  string
+	User            user.Info
+	Verb            string
+	ReadOnly        bool
+	Namespace       string
+	APIGroup        string
+	Resource        string
+	Subresource     string
+	Name            string
+	ResourceRequest bool
+	Path            string
 }
 
 func (a AttributesRecord) GetUserName() string {
@@ -72,6 +101,10 @@ func (a AttributesRecord) GetGroups() []string {
 	return a.User.GetGroups()
 }
 
+func (a AttributesRecord) GetVerb() string {
+	return a.Verb
+}
+
 func (a AttributesRecord) IsReadOnly() bool {
 	return a.ReadOnly
 }
@@ -83,3 +116,23 @@ func (a AttributesRecord) GetNamespace() string {
 func (a AttributesRecord) GetResource() string {
 	return a.Resource
 }
+
+func (a AttributesRecord) GetAPIGroup() string {
+	return a.APIGroup
+}
+
+func (a AttributesRecord) GetSubresource() string {
+	return a.Subresource
+}
+
+func (a AttributesRecord) GetName() string {
+	return a.Name
+}
+
+func (a AttributesRecord) IsResourceRequest() bool {
+	return a.ResourceRequest
+}
+
+func (a AttributesRecord) GetPath() string {
+	return a.Path
+}
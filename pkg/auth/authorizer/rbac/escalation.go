@@ -0,0 +1,165 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/registry/role"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// RulesFunc returns the rules granted by a role or binding.
+type RulesFunc func(ctx api.Context, obj runtime.Object) ([]experimental.PolicyRule, error)
+
+// EscalationCheckingStorage stores roles or bindings. It forbids creating or updating a role
+// or binding which grants permissions that the requesting user does not hold itself, so that
+// users cannot escalate their own privileges by writing roles and bindings.
+type EscalationCheckingStorage struct {
+	*etcdgeneric.Etcd
+
+	kind       string
+	authorizer authorizer.Authorizer
+	rulesFor   RulesFunc
+}
+
+// NewEscalationCheckingStorage wraps the storage of roles or bindings of the given kind.
+// The permissions of the requesting user are checked with authorizer.
+func NewEscalationCheckingStorage(store *etcdgeneric.Etcd, kind string, authorizer authorizer.Authorizer, rulesFor RulesFunc) *EscalationCheckingStorage {
+	return &EscalationCheckingStorage{
+		Etcd:       store,
+		kind:       kind,
+		authorizer: authorizer,
+		rulesFor:   rulesFor,
+	}
+}
+
+// Create checks that the requesting user holds every permission granted by obj, then creates it.
+func (s *EscalationCheckingStorage) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	if err := s.confirmNoEscalation(ctx, obj); err != nil {
+		return nil, err
+	}
+	return s.Etcd.Create(ctx, obj)
+}
+
+// Update checks that the requesting user holds every permission granted by obj, then updates it.
+func (s *EscalationCheckingStorage) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	if err := s.confirmNoEscalation(ctx, obj); err != nil {
+		return nil, false, err
+	}
+	return s.Etcd.Update(ctx, obj)
+}
+
+func (s *EscalationCheckingStorage) confirmNoEscalation(ctx api.Context, obj runtime.Object) error {
+	name, err := s.ObjectNameFunc(obj)
+	if err != nil {
+		return err
+	}
+	rules, err := s.rulesFor(ctx, obj)
+	if err != nil {
+		return errors.NewForbidden(s.kind, name, err)
+	}
+	if err := ConfirmNoEscalation(ctx, s.authorizer, rules); err != nil {
+		return errors.NewForbidden(s.kind, name, err)
+	}
+	return nil
+}
+
+// ConfirmNoEscalation returns an error unless the user of ctx is allowed by a to perform every
+// verb on every resource of every group of rules in the namespace of ctx. Requests without a user are served
+// on the insecure port, which skips authorization, so they may grant any permission.
+func ConfirmNoEscalation(ctx api.Context, a authorizer.Authorizer, rules []experimental.PolicyRule) error {
+	user, ok := api.UserFrom(ctx)
+	if !ok {
+		return nil
+	}
+	namespace := api.NamespaceValue(ctx)
+	for _, rule := range rules {
+		for _, verb := range rule.Verbs {
+			for _, group := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					attributes := authorizer.AttributesRecord{
+						User:            user,
+						Verb:            verb,
+						Namespace:       namespace,
+						APIGroup:        group,
+						Resource:        resource,
+						ResourceRequest: true,
+					}
+					if parts := strings.SplitN(resource, "/", 2); len(parts) == 2 {
+						attributes.Resource = parts[0]
+						attributes.Subresource = parts[1]
+					}
+					if err := a.Authorize(attributes); err != nil {
+						return fmt.Errorf("user %q cannot grant %q on %q in group %q: %v", user.GetName(), verb, resource, group, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RoleRules returns the rules of a Role.
+func RoleRules(ctx api.Context, obj runtime.Object) ([]experimental.PolicyRule, error) {
+	return obj.(*experimental.Role).Rules, nil
+}
+
+// ClusterRoleRules returns the rules of a ClusterRole.
+func ClusterRoleRules(ctx api.Context, obj runtime.Object) ([]experimental.PolicyRule, error) {
+	return obj.(*experimental.ClusterRole).Rules, nil
+}
+
+// RoleBindingRules returns a RulesFunc which returns the rules of the Role or ClusterRole a
+// RoleBinding refers to.
+func RoleBindingRules(roleRegistry role.Registry, clusterRoleRegistry clusterrole.Registry) RulesFunc {
+	return func(ctx api.Context, obj runtime.Object) ([]experimental.PolicyRule, error) {
+		binding := obj.(*experimental.RoleBinding)
+		if binding.RoleRef.Kind == "ClusterRole" {
+			clusterRole, err := clusterRoleRegistry.GetClusterRole(api.NewContext(), binding.RoleRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			return clusterRole.Rules, nil
+		}
+		role, err := roleRegistry.GetRole(ctx, binding.RoleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return role.Rules, nil
+	}
+}
+
+// ClusterRoleBindingRules returns a RulesFunc which returns the rules of the ClusterRole a
+// ClusterRoleBinding refers to.
+func ClusterRoleBindingRules(clusterRoleRegistry clusterrole.Registry) RulesFunc {
+	return func(ctx api.Context, obj runtime.Object) ([]experimental.PolicyRule, error) {
+		binding := obj.(*experimental.ClusterRoleBinding)
+		clusterRole, err := clusterRoleRegistry.GetClusterRole(api.NewContext(), binding.RoleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return clusterRole.Rules, nil
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+func (r *fakeClusterRoleRegistry) GetClusterRole(ctx api.Context, name string) (*experimental.ClusterRole, error) {
+	for i := range r.roles {
+		if r.roles[i].Name == name {
+			return &r.roles[i], nil
+		}
+	}
+	return nil, errors.NewNotFound("clusterroles", name)
+}
+
+func TestConfirmNoEscalation(t *testing.T) {
+	a := newTestAuthorizer()
+
+	uAlice := &user.DefaultInfo{Name: "alice"}
+	uBob := &user.DefaultInfo{Name: "bob", Groups: []string{"ns1-admins"}}
+	uRoot := &user.DefaultInfo{Name: "root"}
+
+	testCases := []struct {
+		User      user.Info
+		Namespace string
+		Rules     []experimental.PolicyRule
+		Allowed   bool
+	}{
+		// Users may grant the permissions they hold.
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, Allowed: true},
+		{User: uBob, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}}, Allowed: true},
+		{User: uRoot, Rules: []experimental.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}}, Allowed: true},
+		{User: uAlice, Namespace: "ns1", Allowed: true},
+
+		// But not more.
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"get", "delete"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, Allowed: false},
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, Allowed: false},
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/exec"}}}, Allowed: false},
+		{User: uAlice, Namespace: "ns2", Rules: []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, Allowed: false},
+		{User: uBob, Rules: []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, Allowed: false},
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{"experimental"}, Resources: []string{"pods"}}}, Allowed: false},
+		{User: uAlice, Namespace: "ns1", Rules: []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{"*"}, Resources: []string{"pods"}}}, Allowed: false},
+	}
+	for i, tc := range testCases {
+		ctx := api.WithUser(api.WithNamespace(api.NewContext(), tc.Namespace), tc.User)
+		err := ConfirmNoEscalation(ctx, a, tc.Rules)
+		if tc.Allowed && err != nil {
+			t.Errorf("%d: expected %s to be allowed to grant %#v, got %v", i, tc.User.GetName(), tc.Rules, err)
+		}
+		if !tc.Allowed && err == nil {
+			t.Errorf("%d: expected %s not to be allowed to grant %#v", i, tc.User.GetName(), tc.Rules)
+		}
+	}
+
+	// requests on the insecure port carry no user and are not authorized
+	all := []experimental.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}}
+	if err := ConfirmNoEscalation(api.WithNamespace(api.NewContext(), "ns1"), a, all); err != nil {
+		t.Errorf("expected a request without a user to be allowed to grant anything, got %v", err)
+	}
+}
+
+func TestClusterRoleBindingRules(t *testing.T) {
+	rules := []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"nodes"}}}
+	rulesFor := ClusterRoleBindingRules(&fakeClusterRoleRegistry{roles: []experimental.ClusterRole{
+		{ObjectMeta: api.ObjectMeta{Name: "node-reader"}, Rules: rules},
+	}})
+
+	got, err := rulesFor(api.NewContext(), &experimental.ClusterRoleBinding{RoleRef: api.ObjectReference{Kind: "ClusterRole", Name: "node-reader"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Verbs[0] != "get" {
+		t.Errorf("expected %#v, got %#v", rules, got)
+	}
+	if _, err := rulesFor(api.NewContext(), &experimental.ClusterRoleBinding{RoleRef: api.ObjectReference{Kind: "ClusterRole", Name: "missing"}}); err == nil {
+		t.Errorf("expected an error for a binding to a missing role")
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac implements an authorizer.Authorizer which grants access based on
+// Role, RoleBinding, ClusterRole and ClusterRoleBinding objects stored in the API.
+package rbac
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	"k8s.io/kubernetes/pkg/registry/clusterrolebinding"
+	"k8s.io/kubernetes/pkg/registry/role"
+	"k8s.io/kubernetes/pkg/registry/rolebinding"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// RBACAuthorizer authorizes requests using the rules of the roles bound to the requesting user.
+// Roles and bindings are read from local caches which are kept up to date by watching the
+// registries, so authorizing a request does not read from storage.
+type RBACAuthorizer struct {
+	superUser string
+
+	// roles and roleBindings are indexed by namespace.
+	roles               cache.Indexer
+	roleBindings        cache.Indexer
+	clusterRoles        cache.Store
+	clusterRoleBindings cache.Store
+}
+
+// New returns an RBACAuthorizer backed by the given registries. Requests made by superUser,
+// if not empty, are always allowed so that the initial roles and bindings can be created.
+func New(roleRegistry role.Registry, roleBindingRegistry rolebinding.Registry, clusterRoleRegistry clusterrole.Registry, clusterRoleBindingRegistry clusterrolebinding.Registry, superUser string) *RBACAuthorizer {
+	r := newRBACAuthorizer(superUser)
+	cache.NewReflector(&cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return roleRegistry.ListRoles(api.NewContext(), labels.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return roleRegistry.WatchRoles(api.NewContext(), labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}, &experimental.Role{}, r.roles, 0).Run()
+	cache.NewReflector(&cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return roleBindingRegistry.ListRoleBindings(api.NewContext(), labels.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return roleBindingRegistry.WatchRoleBindings(api.NewContext(), labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}, &experimental.RoleBinding{}, r.roleBindings, 0).Run()
+	cache.NewReflector(&cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return clusterRoleRegistry.ListClusterRoles(api.NewContext(), labels.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return clusterRoleRegistry.WatchClusterRoles(api.NewContext(), labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}, &experimental.ClusterRole{}, r.clusterRoles, 0).Run()
+	cache.NewReflector(&cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return clusterRoleBindingRegistry.ListClusterRoleBindings(api.NewContext(), labels.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return clusterRoleBindingRegistry.WatchClusterRoleBindings(api.NewContext(), labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}, &experimental.ClusterRoleBinding{}, r.clusterRoleBindings, 0).Run()
+	return r
+}
+
+// newRBACAuthorizer returns an RBACAuthorizer with empty caches.
+func newRBACAuthorizer(superUser string) *RBACAuthorizer {
+	return &RBACAuthorizer{
+		superUser:           superUser,
+		roles:               cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc}),
+		roleBindings:        cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc}),
+		clusterRoles:        cache.NewStore(cache.MetaNamespaceKeyFunc),
+		clusterRoleBindings: cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+}
+
+// Authorize implements authorizer.Authorizer.
+func (r *RBACAuthorizer) Authorize(a authorizer.Attributes) error {
+	if len(r.superUser) > 0 && a.GetUserName() == r.superUser {
+		return nil
+	}
+
+	rules, err := r.rulesFor(a)
+	for _, rule := range rules {
+		if ruleMatches(rule, a) {
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("RBAC: unable to determine the roles of user %q: %v", a.GetUserName(), err)
+	}
+	return fmt.Errorf("RBAC: user %q is not allowed to access %q in namespace %q", a.GetUserName(), a.GetResource(), a.GetNamespace())
+}
+
+// rulesFor returns the rules granted to the requesting user by every ClusterRoleBinding and, for
+// namespaced requests, every RoleBinding in the request's namespace. Bindings whose roles cannot
+// be resolved are skipped, and the first such error is returned along with the rules that could be.
+func (r *RBACAuthorizer) rulesFor(a authorizer.Attributes) ([]experimental.PolicyRule, error) {
+	var rules []experimental.PolicyRule
+	var firstErr error
+	recordErr := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	for _, obj := range r.clusterRoleBindings.List() {
+		binding := obj.(*experimental.ClusterRoleBinding)
+		if !appliesTo(binding.Subjects, a) {
+			continue
+		}
+		clusterRole, err := r.getClusterRole(binding.RoleRef.Name)
+		if err != nil {
+			recordErr(err)
+			continue
+		}
+		rules = append(rules, clusterRole.Rules...)
+	}
+
+	namespace := a.GetNamespace()
+	if len(namespace) == 0 {
+		return rules, firstErr
+	}
+	bindings, err := r.roleBindings.ByIndex("namespace", namespace)
+	if err != nil {
+		recordErr(err)
+		return rules, firstErr
+	}
+	for _, obj := range bindings {
+		binding := obj.(*experimental.RoleBinding)
+		if !appliesTo(binding.Subjects, a) {
+			continue
+		}
+		switch binding.RoleRef.Kind {
+		case "ClusterRole":
+			clusterRole, err := r.getClusterRole(binding.RoleRef.Name)
+			if err != nil {
+				recordErr(err)
+				continue
+			}
+			rules = append(rules, clusterRole.Rules...)
+		default:
+			role, err := r.getRole(namespace, binding.RoleRef.Name)
+			if err != nil {
+				recordErr(err)
+				continue
+			}
+			rules = append(rules, role.Rules...)
+		}
+	}
+	return rules, firstErr
+}
+
+func (r *RBACAuthorizer) getRole(namespace, name string) (*experimental.Role, error) {
+	obj, exists, err := r.roles.GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("roles", name)
+	}
+	return obj.(*experimental.Role), nil
+}
+
+func (r *RBACAuthorizer) getClusterRole(name string) (*experimental.ClusterRole, error) {
+	obj, exists, err := r.clusterRoles.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound("clusterroles", name)
+	}
+	return obj.(*experimental.ClusterRole), nil
+}
+
+// appliesTo returns true if any of the subjects identifies the requesting user.
+func appliesTo(subjects []experimental.Subject, a authorizer.Attributes) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case experimental.UserKind:
+			if subject.Name == a.GetUserName() {
+				return true
+			}
+		case experimental.GroupKind:
+			for _, group := range a.GetGroups() {
+				if subject.Name == group {
+					return true
+				}
+			}
+		case experimental.ServiceAccountKind:
+			if serviceaccount.MakeUsername(subject.Namespace, subject.Name) == a.GetUserName() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ruleMatches returns true if the rule allows the request described by a. Subresources
+// must be granted explicitly, e.g. pods/exec, and are not implied by their resource.
+func ruleMatches(rule experimental.PolicyRule, a authorizer.Attributes) bool {
+	verbs := sets.NewString(rule.Verbs...)
+	if !verbs.Has("*") && !verbs.Has(a.GetVerb()) {
+		return false
+	}
+	groups := sets.NewString(rule.APIGroups...)
+	if !groups.Has("*") && !groups.Has(a.GetAPIGroup()) {
+		return false
+	}
+	resource := a.GetResource()
+	if len(a.GetSubresource()) > 0 {
+		resource = resource + "/" + a.GetSubresource()
+	}
+	resources := sets.NewString(rule.Resources...)
+	return resources.Has("*") || resources.Has(resource)
+}
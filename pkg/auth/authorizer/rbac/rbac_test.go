@@ -0,0 +1,268 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	"k8s.io/kubernetes/pkg/registry/clusterrolebinding"
+	"k8s.io/kubernetes/pkg/registry/role"
+	"k8s.io/kubernetes/pkg/registry/rolebinding"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/wait"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+func newTestAuthorizer() *RBACAuthorizer {
+	a := newRBACAuthorizer("root")
+	roles := []experimental.Role{
+		{
+			ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "pod-reader"},
+			Rules:      []experimental.PolicyRule{{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{""}, Resources: []string{"pods"}}},
+		},
+	}
+	roleBindings := []experimental.RoleBinding{
+		{
+			ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "read-pods"},
+			Subjects:   []experimental.Subject{{Kind: experimental.UserKind, Name: "alice"}},
+			RoleRef:    api.ObjectReference{Kind: "Role", Name: "pod-reader"},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "admins"},
+			Subjects:   []experimental.Subject{{Kind: experimental.GroupKind, Name: "ns1-admins"}},
+			RoleRef:    api.ObjectReference{Kind: "ClusterRole", Name: "admin"},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Namespace: "ns1", Name: "dangling"},
+			Subjects:   []experimental.Subject{{Kind: experimental.UserKind, Name: "carol"}},
+			RoleRef:    api.ObjectReference{Kind: "Role", Name: "missing"},
+		},
+	}
+	clusterRoles := []experimental.ClusterRole{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "admin"},
+			Rules:      []experimental.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "node-writer"},
+			Rules: []experimental.PolicyRule{
+				{Verbs: []string{"get", "list", "watch", "create", "update", "patch", "delete"}, APIGroups: []string{""}, Resources: []string{"nodes"}},
+			},
+		},
+	}
+	clusterRoleBindings := []experimental.ClusterRoleBinding{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "node-controller"},
+			Subjects:   []experimental.Subject{{Kind: experimental.ServiceAccountKind, Namespace: "kube-system", Name: "node-controller"}},
+			RoleRef:    api.ObjectReference{Kind: "ClusterRole", Name: "node-writer"},
+		},
+	}
+	for i := range roles {
+		a.roles.Add(&roles[i])
+	}
+	for i := range roleBindings {
+		a.roleBindings.Add(&roleBindings[i])
+	}
+	for i := range clusterRoles {
+		a.clusterRoles.Add(&clusterRoles[i])
+	}
+	for i := range clusterRoleBindings {
+		a.clusterRoleBindings.Add(&clusterRoleBindings[i])
+	}
+	return a
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthorizer()
+
+	uAlice := &user.DefaultInfo{Name: "alice"}
+	uBob := &user.DefaultInfo{Name: "bob", Groups: []string{"ns1-admins"}}
+	uCarol := &user.DefaultInfo{Name: "carol"}
+	uRoot := &user.DefaultInfo{Name: "root"}
+	uNodeController := &user.DefaultInfo{Name: "system:serviceaccount:kube-system:node-controller"}
+
+	testCases := []struct {
+		User      user.Info
+		Verb      string
+		Resource  string
+		Namespace string
+		Allowed   bool
+	}{
+		// The super user may do anything.
+		{User: uRoot, Verb: "create", Resource: "roles", Namespace: "ns1", Allowed: true},
+		{User: uRoot, Verb: "create", Resource: "clusterroles", Allowed: true},
+
+		// Role bound with a RoleBinding only applies in its namespace.
+		{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns1", Allowed: true},
+		{User: uAlice, Verb: "create", Resource: "pods", Namespace: "ns1", Allowed: false},
+		{User: uAlice, Verb: "watch", Resource: "pods", Namespace: "ns1", Allowed: true},
+		{User: uAlice, Verb: "delete", Resource: "pods", Namespace: "ns1", Allowed: false},
+		{User: uAlice, Verb: "get", Resource: "services", Namespace: "ns1", Allowed: false},
+		{User: uAlice, Verb: "get", Resource: "pods", Namespace: "ns2", Allowed: false},
+		{User: uAlice, Verb: "get", Resource: "pods", Allowed: false},
+
+		// ClusterRole bound to a group with a RoleBinding only applies in the binding's namespace.
+		{User: uBob, Verb: "create", Resource: "secrets", Namespace: "ns1", Allowed: true},
+		{User: uBob, Verb: "get", Resource: "secrets", Namespace: "ns2", Allowed: false},
+		{User: uBob, Verb: "get", Resource: "nodes", Allowed: false},
+
+		// ClusterRoleBindings apply everywhere.
+		{User: uNodeController, Verb: "create", Resource: "nodes", Allowed: true},
+		{User: uNodeController, Verb: "get", Resource: "nodes", Namespace: "ns2", Allowed: true},
+		{User: uNodeController, Verb: "get", Resource: "pods", Allowed: false},
+
+		// A binding to a missing role grants nothing.
+		{User: uCarol, Verb: "get", Resource: "pods", Namespace: "ns1", Allowed: false},
+	}
+	for i, tc := range testCases {
+		attr := authorizer.AttributesRecord{
+			User:      tc.User,
+			Verb:      tc.Verb,
+			Resource:  tc.Resource,
+			Namespace: tc.Namespace,
+		}
+		err := a.Authorize(attr)
+		if tc.Allowed && err != nil {
+			t.Errorf("%d: expected %#v to be allowed, got %v", i, attr, err)
+		}
+		if !tc.Allowed && err == nil {
+			t.Errorf("%d: expected %#v to be denied", i, attr)
+		}
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	testCases := []struct {
+		Verbs       []string
+		APIGroups   []string
+		Resources   []string
+		Verb        string
+		APIGroup    string
+		Subresource string
+		Matches     bool
+	}{
+		{Verbs: []string{"*"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "delete", Matches: true},
+		{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "get", Matches: true},
+		{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "watch", Matches: false},
+		{Verbs: []string{"update"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "delete", Matches: false},
+		{Verbs: []string{}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "get", Matches: false},
+		{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"*"}, Verb: "get", Subresource: "log", Matches: true},
+		{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"pods/exec"}, Verb: "create", Subresource: "exec", Matches: true},
+		{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "create", Subresource: "exec", Matches: false},
+		{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"pods/exec"}, Verb: "create", Matches: false},
+		{Verbs: []string{"get"}, APIGroups: []string{"experimental"}, Resources: []string{"pods"}, Verb: "get", APIGroup: "experimental", Matches: true},
+		{Verbs: []string{"get"}, APIGroups: []string{"*"}, Resources: []string{"pods"}, Verb: "get", APIGroup: "experimental", Matches: true},
+		{Verbs: []string{"get"}, APIGroups: []string{"experimental"}, Resources: []string{"pods"}, Verb: "get", Matches: false},
+		{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}, Verb: "get", APIGroup: "experimental", Matches: false},
+		{Verbs: []string{"get"}, APIGroups: []string{}, Resources: []string{"pods"}, Verb: "get", Matches: false},
+	}
+	for i, tc := range testCases {
+		rule := experimental.PolicyRule{Verbs: tc.Verbs, APIGroups: tc.APIGroups, Resources: tc.Resources}
+		attr := authorizer.AttributesRecord{User: &user.DefaultInfo{}, Verb: tc.Verb, APIGroup: tc.APIGroup, Resource: "pods", Subresource: tc.Subresource}
+		if got := ruleMatches(rule, attr); got != tc.Matches {
+			t.Errorf("%d: expected %v for rule %#v and %#v, got %v", i, tc.Matches, rule, attr, got)
+		}
+	}
+}
+
+// The fake registries implement only the list and watch methods used by the authorizer's caches.
+
+type fakeRoleRegistry struct {
+	role.Registry
+}
+
+func (r *fakeRoleRegistry) ListRoles(ctx api.Context, selector labels.Selector) (*experimental.RoleList, error) {
+	return &experimental.RoleList{}, nil
+}
+
+func (r *fakeRoleRegistry) WatchRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
+type fakeRoleBindingRegistry struct {
+	rolebinding.Registry
+}
+
+func (r *fakeRoleBindingRegistry) ListRoleBindings(ctx api.Context, selector labels.Selector) (*experimental.RoleBindingList, error) {
+	return &experimental.RoleBindingList{}, nil
+}
+
+func (r *fakeRoleBindingRegistry) WatchRoleBindings(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
+type fakeClusterRoleRegistry struct {
+	clusterrole.Registry
+	roles []experimental.ClusterRole
+}
+
+func (r *fakeClusterRoleRegistry) ListClusterRoles(ctx api.Context, selector labels.Selector) (*experimental.ClusterRoleList, error) {
+	return &experimental.ClusterRoleList{Items: r.roles}, nil
+}
+
+func (r *fakeClusterRoleRegistry) WatchClusterRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
+type fakeClusterRoleBindingRegistry struct {
+	clusterrolebinding.Registry
+	watcher *watch.FakeWatcher
+}
+
+func (r *fakeClusterRoleBindingRegistry) ListClusterRoleBindings(ctx api.Context, selector labels.Selector) (*experimental.ClusterRoleBindingList, error) {
+	return &experimental.ClusterRoleBindingList{}, nil
+}
+
+func (r *fakeClusterRoleBindingRegistry) WatchClusterRoleBindings(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return r.watcher, nil
+}
+
+func TestAuthorizerWatchesRegistries(t *testing.T) {
+	clusterRoles := &fakeClusterRoleRegistry{roles: []experimental.ClusterRole{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "node-reader"},
+			Rules:      []experimental.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"nodes"}}},
+		},
+	}}
+	clusterRoleBindings := &fakeClusterRoleBindingRegistry{watcher: watch.NewFake()}
+	a := New(&fakeRoleRegistry{}, &fakeRoleBindingRegistry{}, clusterRoles, clusterRoleBindings, "")
+
+	attr := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get", Resource: "nodes"}
+	if err := a.Authorize(attr); err == nil {
+		t.Fatalf("expected %#v to be denied before the binding is created", attr)
+	}
+	clusterRoleBindings.watcher.Add(&experimental.ClusterRoleBinding{
+		ObjectMeta: api.ObjectMeta{Name: "read-nodes", ResourceVersion: "2"},
+		Subjects:   []experimental.Subject{{Kind: experimental.UserKind, Name: "alice"}},
+		RoleRef:    api.ObjectReference{Kind: "ClusterRole", Name: "node-reader"},
+	})
+	err := wait.Poll(10*time.Millisecond, util.ForeverTestTimeout, func() (bool, error) {
+		return a.Authorize(attr) == nil, nil
+	})
+	if err != nil {
+		t.Errorf("expected %#v to be allowed once the binding is observed: %v", attr, err)
+	}
+}
@@ -71,3 +71,40 @@ func TestAuthorizationNonePasses(t *testing.T) {
 		t.Errorf("Expected error: %v", err)
 	}
 }
+
+func TestAuthorizationPassesAttributes(t *testing.T) {
+	attrs := authorizer.AttributesRecord{
+		Verb:            "create",
+		APIGroup:        "experimental",
+		Namespace:       "ns1",
+		Resource:        "jobs",
+		Subresource:     "status",
+		Name:            "foo",
+		ResourceRequest: true,
+		Path:            "/apis/experimental/v1alpha1/namespaces/ns1/jobs/foo/status",
+	}
+	var seen []authorizer.Attributes
+	record := func(allow bool) authorizer.Authorizer {
+		return authorizer.AuthorizerFunc(func(a authorizer.Attributes) error {
+			seen = append(seen, a)
+			if !allow {
+				return errors.New("Request unauthorized")
+			}
+			return nil
+		})
+	}
+	authzHandler := New(record(false), record(true), record(true))
+
+	if err := authzHandler.Authorize(attrs); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(seen) != 2 {
+		t.Fatalf("Expected the chain to stop at the first authorizer that allows, got %d calls", len(seen))
+	}
+	for i, a := range seen {
+		if a.GetVerb() != attrs.Verb || a.GetAPIGroup() != attrs.APIGroup || a.GetSubresource() != attrs.Subresource ||
+			a.GetName() != attrs.Name || a.IsResourceRequest() != attrs.ResourceRequest || a.GetPath() != attrs.Path {
+			t.Errorf("%d: Expected attributes %#v, got %#v", i, attrs, a)
+		}
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package cache
 
 import (
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/api"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -26,15 +29,26 @@ import (
 // ListFunc knows how to list resources
 type ListFunc func() (runtime.Object, error)
 
+// ListPageFunc knows how to list a single page of at most limit resources, resuming after
+// the page whose list returned continueToken, or from the beginning if it is empty.
+type ListPageFunc func(limit int64, continueToken string) (runtime.Object, error)
+
 // WatchFunc knows how to watch resources
 type WatchFunc func(resourceVersion string) (watch.Interface, error)
 
+// DefaultPageSize is the number of resources requested per page by NewListWatchFromClient.
+const DefaultPageSize = 500
+
 // ListWatch knows how to list and watch a set of apiserver resources.  It satisfies the ListerWatcher interface.
 // It is a convenience function for users of NewReflector, etc.
-// ListFunc and WatchFunc must not be nil
+// WatchFunc, and ListFunc unless ListPageFunc is set, must not be nil
 type ListWatch struct {
 	ListFunc  ListFunc
 	WatchFunc WatchFunc
+	// ListPageFunc, if set, is used in place of ListFunc to list resources PageSize at a time.
+	// The pages are merged into a single list.
+	ListPageFunc ListPageFunc
+	PageSize     int64
 }
 
 // Getter interface knows how to access Get method from RESTClient.
@@ -52,22 +66,81 @@ func NewListWatchFromClient(c Getter, resource string, namespace string, fieldSe
 			Do().
 			Get()
 	}
+	listPageFunc := func(limit int64, continueToken string) (runtime.Object, error) {
+		req := c.Get().
+			Namespace(namespace).
+			Resource(resource).
+			FieldsSelectorParam(fieldSelector).
+			Param("limit", strconv.FormatInt(limit, 10))
+		// paged lists are read from storage rather than the cache of the
+		// apiserver: every page costs a read from etcd, but stays bounded
+		if len(continueToken) > 0 {
+			req.Param("continue", continueToken)
+		}
+		return req.Do().Get()
+	}
 	watchFunc := func(resourceVersion string) (watch.Interface, error) {
 		return c.Get().
 			Prefix("watch").
 			Namespace(namespace).
 			Resource(resource).
 			FieldsSelectorParam(fieldSelector).
-			Param("resourceVersion", resourceVersion).Watch()
+			Param("resourceVersion", resourceVersion).
+			Param("allowWatchBookmarks", "true").Watch()
 	}
-	return &ListWatch{ListFunc: listFunc, WatchFunc: watchFunc}
+	return &ListWatch{ListFunc: listFunc, WatchFunc: watchFunc, ListPageFunc: listPageFunc, PageSize: DefaultPageSize}
 }
 
 // List a set of apiserver resources
 func (lw *ListWatch) List() (runtime.Object, error) {
+	if lw.ListPageFunc != nil {
+		return ListAllPages(lw.ListPageFunc, lw.PageSize)
+	}
 	return lw.ListFunc()
 }
 
+// ListAllPages lists resources pageSize at a time using listPage, and returns a single list
+// of every resource with the resource version of the first page. Servers which do not
+// support limiting a list return every resource in the first page.
+func ListAllPages(listPage ListPageFunc, pageSize int64) (runtime.Object, error) {
+	list, err := listPage(pageSize, "")
+	if err != nil {
+		return nil, err
+	}
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil {
+		return nil, err
+	}
+	if len(listMeta.Continue) == 0 {
+		return list, nil
+	}
+	items, err := runtime.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	for continueToken := listMeta.Continue; len(continueToken) > 0; {
+		page, err := listPage(pageSize, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		pageItems, err := runtime.ExtractList(page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		pageMeta, err := api.ListMetaFor(page)
+		if err != nil {
+			return nil, err
+		}
+		continueToken = pageMeta.Continue
+	}
+	if err := runtime.SetList(list, items); err != nil {
+		return nil, err
+	}
+	listMeta.Continue = ""
+	return list, nil
+}
+
 // Watch a set of apiserver resources
 func (lw *ListWatch) Watch(resourceVersion string) (watch.Interface, error) {
 	return lw.WatchFunc(resourceVersion)
@@ -17,14 +17,18 @@ limitations under the License.
 package cache
 
 import (
+	"errors"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 )
 
@@ -63,7 +67,9 @@ func TestListWatchesCanList(t *testing.T) {
 	}{
 		// Node
 		{
-			location:      testapi.Default.ResourcePath("nodes", api.NamespaceAll, ""),
+			location: buildLocation(
+				testapi.Default.ResourcePath("nodes", api.NamespaceAll, ""),
+				buildQueryValues(url.Values{"limit": []string{"500"}})),
 			resource:      "nodes",
 			namespace:     api.NamespaceAll,
 			fieldSelector: parseSelectorOrDie(""),
@@ -72,7 +78,7 @@ func TestListWatchesCanList(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePath("pods", api.NamespaceAll, ""),
-				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}})),
+				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "limit": []string{"500"}})),
 			resource:      "pods",
 			namespace:     api.NamespaceAll,
 			fieldSelector: fields.Set{"spec.host": ""}.AsSelector(),
@@ -81,7 +87,7 @@ func TestListWatchesCanList(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePath("pods", "foo", ""),
-				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}})),
+				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "limit": []string{"500"}})),
 			resource:      "pods",
 			namespace:     "foo",
 			fieldSelector: fields.Set{"spec.host": ""}.AsSelector(),
@@ -103,6 +109,60 @@ func TestListWatchesCanList(t *testing.T) {
 	}
 }
 
+func TestListAllPages(t *testing.T) {
+	pages := map[string]*api.PodList{
+		"": {
+			ListMeta: unversioned.ListMeta{ResourceVersion: "10", Continue: "a"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "foo"}}, {ObjectMeta: api.ObjectMeta{Name: "bar"}}},
+		},
+		"a": {
+			ListMeta: unversioned.ListMeta{ResourceVersion: "10", Continue: "b"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "baz"}}, {ObjectMeta: api.ObjectMeta{Name: "qux"}}},
+		},
+		"b": {
+			ListMeta: unversioned.ListMeta{ResourceVersion: "10"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "quux"}}},
+		},
+	}
+	requested := []string{}
+	listPage := func(limit int64, continueToken string) (runtime.Object, error) {
+		if limit != 2 {
+			t.Errorf("unexpected limit %d", limit)
+		}
+		requested = append(requested, continueToken)
+		return pages[continueToken], nil
+	}
+	obj, err := ListAllPages(listPage, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(requested, []string{"", "a", "b"}) {
+		t.Errorf("unexpected pages requested: %v", requested)
+	}
+	list := obj.(*api.PodList)
+	names := []string{}
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"foo", "bar", "baz", "qux", "quux"}) {
+		t.Errorf("unexpected items: %v", names)
+	}
+	if list.ResourceVersion != "10" || list.Continue != "" {
+		t.Errorf("unexpected list metadata: %#v", list.ListMeta)
+	}
+
+	expectedErr := errors.New("failed")
+	failing := func(limit int64, continueToken string) (runtime.Object, error) {
+		if continueToken == "a" {
+			return nil, expectedErr
+		}
+		return &api.PodList{ListMeta: unversioned.ListMeta{Continue: "a"}}, nil
+	}
+	if _, err := ListAllPages(failing, 2); err != expectedErr {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+}
+
 func TestListWatchesCanWatch(t *testing.T) {
 	fieldSelectorQueryParamName := api.FieldSelectorQueryParam(testapi.Default.Version())
 	table := []struct {
@@ -116,7 +176,7 @@ func TestListWatchesCanWatch(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePathWithPrefix("watch", "nodes", api.NamespaceAll, ""),
-				buildQueryValues(url.Values{"resourceVersion": []string{""}})),
+				buildQueryValues(url.Values{"resourceVersion": []string{""}, "allowWatchBookmarks": []string{"true"}})),
 			rv:            "",
 			resource:      "nodes",
 			namespace:     api.NamespaceAll,
@@ -125,7 +185,7 @@ func TestListWatchesCanWatch(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePathWithPrefix("watch", "nodes", api.NamespaceAll, ""),
-				buildQueryValues(url.Values{"resourceVersion": []string{"42"}})),
+				buildQueryValues(url.Values{"resourceVersion": []string{"42"}, "allowWatchBookmarks": []string{"true"}})),
 			rv:            "42",
 			resource:      "nodes",
 			namespace:     api.NamespaceAll,
@@ -135,7 +195,7 @@ func TestListWatchesCanWatch(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePathWithPrefix("watch", "pods", api.NamespaceAll, ""),
-				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "resourceVersion": []string{"0"}})),
+				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "resourceVersion": []string{"0"}, "allowWatchBookmarks": []string{"true"}})),
 			rv:            "0",
 			resource:      "pods",
 			namespace:     api.NamespaceAll,
@@ -145,7 +205,7 @@ func TestListWatchesCanWatch(t *testing.T) {
 		{
 			location: buildLocation(
 				testapi.Default.ResourcePathWithPrefix("watch", "pods", "foo", ""),
-				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "resourceVersion": []string{"0"}})),
+				buildQueryValues(url.Values{fieldSelectorQueryParamName: []string{"spec.host="}, "resourceVersion": []string{"0"}, "allowWatchBookmarks": []string{"true"}})),
 			rv:            "0",
 			resource:      "pods",
 			namespace:     "foo",
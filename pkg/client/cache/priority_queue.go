@@ -0,0 +1,254 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// LessFunc returns true if a should be popped before b.
+type LessFunc func(a, b interface{}) bool
+
+// PriorityQueue is like FIFO, but pops items in the order given by a LessFunc.
+// Items that are equal according to the LessFunc are popped in the order in
+// which they were first added, just like FIFO.
+//
+// Like FIFO, adding an item which is already queued only updates it; its
+// position is recomputed, as the update may change its order.
+type PriorityQueue struct {
+	lock sync.RWMutex
+	cond sync.Cond
+	// We depend on the property that items in the set are in the heap and vice versa.
+	items map[string]*priorityQueueEntry
+	heap  priorityQueueHeap
+	// seq is the sequence number of the next new item, used to break ties in FIFO order.
+	seq uint64
+	// keyFunc is used to make the key used for queued item insertion and retrieval, and
+	// should be deterministic.
+	keyFunc KeyFunc
+}
+
+var (
+	_ = Queue(&PriorityQueue{}) // PriorityQueue is a Queue
+)
+
+type priorityQueueEntry struct {
+	key   string
+	obj   interface{}
+	seq   uint64
+	index int
+}
+
+// priorityQueueHeap implements heap.Interface.
+type priorityQueueHeap struct {
+	entries  []*priorityQueueEntry
+	lessFunc LessFunc
+}
+
+func (h *priorityQueueHeap) Len() int {
+	return len(h.entries)
+}
+
+func (h *priorityQueueHeap) Less(i, j int) bool {
+	a, b := h.entries[i], h.entries[j]
+	if h.lessFunc(a.obj, b.obj) {
+		return true
+	}
+	if h.lessFunc(b.obj, a.obj) {
+		return false
+	}
+	return a.seq < b.seq
+}
+
+func (h *priorityQueueHeap) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].index = i
+	h.entries[j].index = j
+}
+
+func (h *priorityQueueHeap) Push(x interface{}) {
+	entry := x.(*priorityQueueEntry)
+	entry.index = len(h.entries)
+	h.entries = append(h.entries, entry)
+}
+
+func (h *priorityQueueHeap) Pop() interface{} {
+	n := len(h.entries)
+	entry := h.entries[n-1]
+	h.entries = h.entries[:n-1]
+	return entry
+}
+
+// Add inserts an item, and puts it in the queue. If the item is already
+// queued, it is updated and keeps its place among items of equal order.
+func (q *PriorityQueue) Add(obj interface{}) error {
+	id, err := q.keyFunc(obj)
+	if err != nil {
+		return KeyError{obj, err}
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.addLocked(id, obj)
+	q.cond.Broadcast()
+	return nil
+}
+
+func (q *PriorityQueue) addLocked(id string, obj interface{}) {
+	if entry, exists := q.items[id]; exists {
+		entry.obj = obj
+		heap.Fix(&q.heap, entry.index)
+		return
+	}
+	entry := &priorityQueueEntry{key: id, obj: obj, seq: q.seq}
+	q.seq++
+	q.items[id] = entry
+	heap.Push(&q.heap, entry)
+}
+
+// AddIfNotPresent inserts an item, and puts it in the queue. If the item is already
+// present in the set, it is neither enqueued nor added to the set.
+func (q *PriorityQueue) AddIfNotPresent(obj interface{}) error {
+	id, err := q.keyFunc(obj)
+	if err != nil {
+		return KeyError{obj, err}
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if _, exists := q.items[id]; exists {
+		return nil
+	}
+	q.addLocked(id, obj)
+	q.cond.Broadcast()
+	return nil
+}
+
+// Update is the same as Add in this implementation.
+func (q *PriorityQueue) Update(obj interface{}) error {
+	return q.Add(obj)
+}
+
+// Delete removes an item from the set and the queue.
+func (q *PriorityQueue) Delete(obj interface{}) error {
+	id, err := q.keyFunc(obj)
+	if err != nil {
+		return KeyError{obj, err}
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if entry, exists := q.items[id]; exists {
+		heap.Remove(&q.heap, entry.index)
+		delete(q.items, id)
+	}
+	return nil
+}
+
+// List returns a list of all the items.
+func (q *PriorityQueue) List() []interface{} {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	list := make([]interface{}, 0, len(q.items))
+	for _, entry := range q.items {
+		list = append(list, entry.obj)
+	}
+	return list
+}
+
+// ListKeys returns a list of all the keys of the objects currently
+// in the queue.
+func (q *PriorityQueue) ListKeys() []string {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	list := make([]string, 0, len(q.items))
+	for key := range q.items {
+		list = append(list, key)
+	}
+	return list
+}
+
+// Get returns the requested item, or sets exists=false.
+func (q *PriorityQueue) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	key, err := q.keyFunc(obj)
+	if err != nil {
+		return nil, false, KeyError{obj, err}
+	}
+	return q.GetByKey(key)
+}
+
+// GetByKey returns the requested item, or sets exists=false.
+func (q *PriorityQueue) GetByKey(key string) (item interface{}, exists bool, err error) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	entry, exists := q.items[key]
+	if !exists {
+		return nil, false, nil
+	}
+	return entry.obj, true, nil
+}
+
+// Pop waits until an item is ready and returns the first one in order.
+// The item is removed from the queue (and the store) before it is returned,
+// so if you don't successfully process it, you need to add it back with
+// AddIfNotPresent().
+func (q *PriorityQueue) Pop() interface{} {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for q.heap.Len() == 0 {
+		q.cond.Wait()
+	}
+	entry := heap.Pop(&q.heap).(*priorityQueueEntry)
+	delete(q.items, entry.key)
+	return entry.obj
+}
+
+// Replace will delete the contents of 'q', using instead the given list.
+// Items are ordered by the LessFunc; items of equal order are queued in
+// the order of the list.
+func (q *PriorityQueue) Replace(list []interface{}, resourceVersion string) error {
+	ids := make([]string, 0, len(list))
+	for _, item := range list {
+		key, err := q.keyFunc(item)
+		if err != nil {
+			return KeyError{item, err}
+		}
+		ids = append(ids, key)
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.items = map[string]*priorityQueueEntry{}
+	q.heap.entries = q.heap.entries[:0]
+	for i, id := range ids {
+		q.addLocked(id, list[i])
+	}
+	if len(q.items) > 0 {
+		q.cond.Broadcast()
+	}
+	return nil
+}
+
+// NewPriorityQueue returns a Store which can be used to queue up items to
+// process in the order given by lessFunc.
+func NewPriorityQueue(keyFunc KeyFunc, lessFunc LessFunc) *PriorityQueue {
+	q := &PriorityQueue{
+		items:   map[string]*priorityQueueEntry{},
+		heap:    priorityQueueHeap{lessFunc: lessFunc},
+		keyFunc: keyFunc,
+	}
+	q.cond.L = &q.lock
+	return q
+}
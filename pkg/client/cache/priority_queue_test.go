@@ -0,0 +1,126 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testPriorityLess pops testFifoObjects with larger int values first.
+func testPriorityLess(a, b interface{}) bool {
+	return a.(testFifoObject).val.(int) > b.(testFifoObject).val.(int)
+}
+
+func popNames(q *PriorityQueue, n int) []string {
+	names := []string{}
+	for i := 0; i < n; i++ {
+		names = append(names, q.Pop().(testFifoObject).name)
+	}
+	return names
+}
+
+func TestPriorityQueue_order(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	q.Add(mkFifoObj("low", 1))
+	q.Add(mkFifoObj("first-high", 10))
+	q.Add(mkFifoObj("mid", 5))
+	q.Add(mkFifoObj("second-high", 10))
+
+	expected := []string{"first-high", "second-high", "mid", "low"}
+	if got := popNames(q, 4); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestPriorityQueue_addUpdate(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	q.Add(mkFifoObj("foo", 1))
+	q.Add(mkFifoObj("bar", 5))
+	q.Update(mkFifoObj("foo", 10))
+
+	if e, a := []string{"foo", "bar"}, popNames(q, 2); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if _, exists, _ := q.Get(mkFifoObj("foo", 10)); exists {
+		t.Errorf("item did not get removed")
+	}
+}
+
+func TestPriorityQueue_addIfNotPresent(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	q.Add(mkFifoObj("a", 1))
+	q.AddIfNotPresent(mkFifoObj("a", 10))
+	q.AddIfNotPresent(mkFifoObj("b", 2))
+
+	if e, a := []interface{}{mkFifoObj("b", 2), mkFifoObj("a", 1)}, []interface{}{q.Pop(), q.Pop()}; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %+v, got %+v", e, a)
+	}
+}
+
+func TestPriorityQueue_delete(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	q.Add(mkFifoObj("a", 3))
+	q.Add(mkFifoObj("b", 2))
+	q.Add(mkFifoObj("c", 1))
+	q.Delete(mkFifoObj("a", 3))
+
+	if e, a := []string{"b", "c"}, popNames(q, 2); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if len(q.ListKeys()) != 0 {
+		t.Errorf("expected empty queue, got %v", q.ListKeys())
+	}
+}
+
+func TestPriorityQueue_replace(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	q.Add(mkFifoObj("stale", 100))
+	q.Replace([]interface{}{mkFifoObj("a", 1), mkFifoObj("b", 2), mkFifoObj("c", 1)}, "0")
+
+	if _, exists, _ := q.GetByKey("stale"); exists {
+		t.Errorf("expected stale item to be replaced")
+	}
+	if e, a := []string{"b", "a", "c"}, popNames(q, 3); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
+
+func TestPriorityQueue_popBlocks(t *testing.T) {
+	q := NewPriorityQueue(testFifoObjectKeyFunc, testPriorityLess)
+	popped := make(chan interface{})
+	go func() {
+		popped <- q.Pop()
+	}()
+
+	select {
+	case obj := <-popped:
+		t.Fatalf("Pop returned %v from an empty queue", obj)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	q.Add(mkFifoObj("foo", 1))
+	select {
+	case obj := <-popped:
+		if e, a := mkFifoObj("foo", 1), obj; !reflect.DeepEqual(e, a) {
+			t.Errorf("expected %+v, got %+v", e, a)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Pop did not return after an item was added")
+	}
+}
@@ -67,6 +67,10 @@ type Reflector struct {
 	lastSyncResourceVersion string
 	// lastSyncResourceVersionMutex guards read/write access to lastSyncResourceVersion
 	lastSyncResourceVersionMutex sync.RWMutex
+	// resumeResourceVersion, if set, is the resource version the next ListAndWatch
+	// starts watching from instead of listing. It is only accessed by the goroutine
+	// running ListAndWatch.
+	resumeResourceVersion string
 }
 
 // NewNamespaceKeyedIndexerAndReflector creates an Indexer and a Reflector
@@ -176,23 +180,16 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 	resyncCh, cleanup := r.resyncChan()
 	defer cleanup()
 
-	list, err := r.listerWatcher.List()
-	if err != nil {
-		return fmt.Errorf("%s: Failed to list %v: %v", r.name, r.expectedType, err)
-	}
-	meta, err := meta.Accessor(list)
-	if err != nil {
-		return fmt.Errorf("%s: Unable to understand list result %#v", r.name, list)
-	}
-	resourceVersion = meta.ResourceVersion()
-	items, err := runtime.ExtractList(list)
-	if err != nil {
-		return fmt.Errorf("%s: Unable to understand list result %#v (%v)", r.name, list, err)
-	}
-	if err := r.syncWith(items, resourceVersion); err != nil {
-		return fmt.Errorf("%s: Unable to sync list result: %v", r.name, err)
+	if r.resumeResourceVersion != "" {
+		// The store is still in sync with the watch that was interrupted, so pick
+		// up where it ended. If that fails with an error from the server we list again.
+		resourceVersion, r.resumeResourceVersion = r.resumeResourceVersion, ""
+	} else {
+		var err error
+		if resourceVersion, err = r.list(); err != nil {
+			return err
+		}
 	}
-	r.setLastSyncResourceVersion(resourceVersion)
 
 	for {
 		w, err := r.listerWatcher.Watch(resourceVersion)
@@ -217,6 +214,11 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 					}
 				}
 			}
+			// Transport errors, like a dropped connection while the apiserver restarts, leave
+			// the store intact, so the next attempt resumes the watch instead of listing.
+			if _, ok := err.(*url.Error); ok || err == io.EOF || err == io.ErrUnexpectedEOF {
+				r.resumeResourceVersion = resourceVersion
+			}
 			return nil
 		}
 		if err := r.watchHandler(w, &resourceVersion, resyncCh, stopCh); err != nil {
@@ -228,6 +230,28 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 	}
 }
 
+// list replaces the store's items with a fresh list and returns its resource version.
+func (r *Reflector) list() (string, error) {
+	list, err := r.listerWatcher.List()
+	if err != nil {
+		return "", fmt.Errorf("%s: Failed to list %v: %v", r.name, r.expectedType, err)
+	}
+	meta, err := meta.Accessor(list)
+	if err != nil {
+		return "", fmt.Errorf("%s: Unable to understand list result %#v", r.name, list)
+	}
+	resourceVersion := meta.ResourceVersion()
+	items, err := runtime.ExtractList(list)
+	if err != nil {
+		return "", fmt.Errorf("%s: Unable to understand list result %#v (%v)", r.name, list, err)
+	}
+	if err := r.syncWith(items, resourceVersion); err != nil {
+		return "", fmt.Errorf("%s: Unable to sync list result: %v", r.name, err)
+	}
+	r.setLastSyncResourceVersion(resourceVersion)
+	return resourceVersion, nil
+}
+
 // syncWith replaces the store's items with the given list.
 func (r *Reflector) syncWith(items []runtime.Object, resourceVersion string) error {
 	found := make([]interface{}, 0, len(items))
@@ -271,6 +295,8 @@ loop:
 			}
 			newResourceVersion := meta.ResourceVersion()
 			switch event.Type {
+			case watch.Bookmark:
+				// Only the resource version moves forward, so a later watch can resume from it.
 			case watch.Added:
 				r.store.Add(event.Object)
 			case watch.Modified:
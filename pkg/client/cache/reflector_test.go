@@ -18,7 +18,10 @@ package cache
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
+	"net/url"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
@@ -360,3 +363,71 @@ func TestReflector_ListAndWatchWithErrors(t *testing.T) {
 		r.ListAndWatch(util.NeverStop)
 	}
 }
+
+func TestReflector_watchHandlerBookmark(t *testing.T) {
+	s := NewStore(MetaNamespaceKeyFunc)
+	g := NewReflector(&testLW{}, &api.Pod{}, s, 0)
+	fw := watch.NewFake()
+	go func() {
+		fw.Add(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "10"}})
+		fw.Action(watch.Bookmark, &api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "15"}})
+		fw.Stop()
+	}()
+	var resumeRV string
+	if err := g.watchHandler(fw, &resumeRV, neverExitWatch, util.NeverStop); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if e, a := []string{"foo"}, s.ListKeys(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "15", resumeRV; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "15", g.LastSyncResourceVersion(); e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
+
+func TestReflector_ListAndWatchResume(t *testing.T) {
+	lists := 0
+	var watchRVs []string
+	watchErr := &url.Error{Op: "Get", URL: "http://localhost", Err: io.ErrUnexpectedEOF}
+	lw := &testLW{
+		WatchFunc: func(rv string) (watch.Interface, error) {
+			watchRVs = append(watchRVs, rv)
+			if len(watchRVs) == 1 {
+				fw := watch.NewFake()
+				go func() {
+					fw.Action(watch.Bookmark, &api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "7"}})
+					fw.Stop()
+				}()
+				return fw, nil
+			}
+			return nil, watchErr
+		},
+		ListFunc: func() (runtime.Object, error) {
+			lists++
+			return &api.PodList{ListMeta: unversioned.ListMeta{ResourceVersion: "1"}}, nil
+		},
+	}
+	r := NewReflector(lw, &api.Pod{}, NewStore(MetaNamespaceKeyFunc), 0)
+	r.ListAndWatch(util.NeverStop)
+	// The interrupted watch is resumed from the bookmark without listing again.
+	watchErr = nil
+	lw.WatchFunc = func(rv string) (watch.Interface, error) {
+		watchRVs = append(watchRVs, rv)
+		return nil, fmt.Errorf("a watch error")
+	}
+	r.ListAndWatch(util.NeverStop)
+	if e, a := 1, lists; e != a {
+		t.Errorf("expected %d lists, got %d", e, a)
+	}
+	// An error from the server requires a new list.
+	r.ListAndWatch(util.NeverStop)
+	if e, a := 2, lists; e != a {
+		t.Errorf("expected %d lists, got %d", e, a)
+	}
+	if e, a := []string{"1", "7", "7", "1"}, watchRVs; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
@@ -46,8 +46,10 @@ type Interface interface {
 	ResourceQuotasNamespacer
 	ServiceAccountsNamespacer
 	SecretsNamespacer
+	ConfigMapsNamespacer
 	NamespacesInterface
 	PersistentVolumesInterface
+	PriorityClassesInterface
 	PersistentVolumeClaimsNamespacer
 	ComponentStatusesInterface
 	SwaggerSchemaInterface
@@ -97,6 +99,10 @@ func (c *Client) Secrets(namespace string) SecretsInterface {
 	return newSecrets(c, namespace)
 }
 
+func (c *Client) ConfigMaps(namespace string) ConfigMapsInterface {
+	return newConfigMaps(c, namespace)
+}
+
 func (c *Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
@@ -105,6 +111,10 @@ func (c *Client) PersistentVolumes() PersistentVolumeInterface {
 	return newPersistentVolumes(c)
 }
 
+func (c *Client) PriorityClasses() PriorityClassInterface {
+	return newPriorityClasses(c)
+}
+
 func (c *Client) PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface {
 	return newPersistentVolumeClaims(c, namespace)
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unversioned
+
+import (
+	"net/url"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+func getConfigMapsResourceName() string {
+	return "configmaps"
+}
+
+func TestConfigMapCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	cfg := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: ns,
+		},
+		Data: map[string]string{
+			"foo": "bar",
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   testapi.Default.ResourcePath(getConfigMapsResourceName(), ns, ""),
+			Query:  buildQueryValues(nil),
+			Body:   cfg,
+		},
+		Response: Response{StatusCode: 200, Body: cfg},
+	}
+
+	response, err := c.Setup(t).ConfigMaps(ns).Create(cfg)
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapGet(t *testing.T) {
+	ns := api.NamespaceDefault
+	cfg := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "foo",
+			Namespace: ns,
+		},
+		Data: map[string]string{
+			"foo": "bar",
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePath(getConfigMapsResourceName(), ns, "foo"),
+			Query:  buildQueryValues(nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: cfg},
+	}
+
+	response, err := c.Setup(t).ConfigMaps(ns).Get("foo")
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapList(t *testing.T) {
+	ns := api.NamespaceDefault
+	cfgList := &api.ConfigMapList{
+		Items: []api.ConfigMap{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo"},
+				Data:       map[string]string{"foo": "bar"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePath(getConfigMapsResourceName(), ns, ""),
+			Query:  buildQueryValues(nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: cfgList},
+	}
+	response, err := c.Setup(t).ConfigMaps(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	cfg := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "foo",
+			Namespace:       ns,
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{
+			"foo": "bar",
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.Default.ResourcePath(getConfigMapsResourceName(), ns, "foo"), Query: buildQueryValues(nil)},
+		Response: Response{StatusCode: 200, Body: cfg},
+	}
+	response, err := c.Setup(t).ConfigMaps(ns).Update(cfg)
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapDelete(t *testing.T) {
+	ns := api.NamespaceDefault
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.Default.ResourcePath(getConfigMapsResourceName(), ns, "foo"), Query: buildQueryValues(nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup(t).ConfigMaps(ns).Delete("foo")
+	c.Validate(t, nil, err)
+}
+
+func TestConfigMapWatch(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePathWithPrefix("watch", getConfigMapsResourceName(), "", ""),
+			Query:  url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup(t).ConfigMaps(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -143,7 +143,7 @@ func setExperimentalDefaults(config *Config) error {
 		return fmt.Errorf("Experimental API version '%s' is not recognized (valid values: %s)",
 			config.Version, strings.Join(latest.GroupOrDie("experimental").Versions, ", "))
 	}
-	config.Codec = versionInterfaces.Codec
+	config.Codec = codecFor(config, versionInterfaces.Codec)
 	if config.QPS == 0 {
 		config.QPS = 5
 	}
@@ -51,8 +51,8 @@ func (c *RESTClient) Put() *unversioned.Request {
 	return unversioned.NewRequest(c, "PUT", &url.URL{Host: "localhost"}, testapi.Default.Version(), c.Codec)
 }
 
-func (c *RESTClient) Patch(_ api.PatchType) *unversioned.Request {
-	return unversioned.NewRequest(c, "PATCH", &url.URL{Host: "localhost"}, testapi.Default.Version(), c.Codec)
+func (c *RESTClient) Patch(pt api.PatchType) *unversioned.Request {
+	return unversioned.NewRequest(c, "PATCH", &url.URL{Host: "localhost"}, testapi.Default.Version(), c.Codec).SetHeader("Content-Type", string(pt))
 }
 
 func (c *RESTClient) Post() *unversioned.Request {
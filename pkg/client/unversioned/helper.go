@@ -34,6 +34,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/latest"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/version"
@@ -55,6 +56,10 @@ type Config struct {
 	// to a RESTClient or Client. Required when initializing a RESTClient, optional
 	// when initializing a Client.
 	Codec runtime.Codec
+	// ContentType is the wire format requested from the server. If empty, JSON is
+	// used. Setting it to protobuf.ContentType makes a Client negotiate protobuf,
+	// falling back to JSON for responses the server cannot encode that way.
+	ContentType string
 
 	// Server requires Basic authentication
 	Username string
@@ -299,7 +304,7 @@ func SetKubernetesDefaults(config *Config) error {
 		return fmt.Errorf("API version '%s' is not recognized (valid values: %s)", version, strings.Join(latest.GroupOrDie("").Versions, ", "))
 	}
 	if config.Codec == nil {
-		config.Codec = versionInterfaces.Codec
+		config.Codec = codecFor(config, versionInterfaces.Codec)
 	}
 	if config.QPS == 0.0 {
 		config.QPS = 5.0
@@ -310,6 +315,15 @@ func SetKubernetesDefaults(config *Config) error {
 	return nil
 }
 
+// codecFor returns the codec matching the content type requested by config,
+// or defaultCodec if JSON was requested.
+func codecFor(config *Config, defaultCodec runtime.Codec) runtime.Codec {
+	if config.ContentType == protobuf.ContentType {
+		return protobuf.NewCodec(api.Scheme, config.Version)
+	}
+	return defaultCodec
+}
+
 // RESTClientFor returns a RESTClient that satisfies the requested attributes on a client Config
 // object. Note that a RESTClient may require fields that are optional when initializing a Client.
 // A RESTClient created by this method is generic - it expects to operate on an API that follows
@@ -328,6 +342,7 @@ func RESTClientFor(config *Config) (*RESTClient, error) {
 	}
 
 	client := NewRESTClient(baseURL, config.Version, config.Codec, config.QPS, config.Burst)
+	client.ContentType = config.ContentType
 
 	transport, err := TransportFor(config)
 	if err != nil {
@@ -0,0 +1,97 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unversioned
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+type PriorityClassesInterface interface {
+	PriorityClasses() PriorityClassInterface
+}
+
+// PriorityClassInterface has methods to work with PriorityClass resources.
+type PriorityClassInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.PriorityClassList, error)
+	Get(name string) (*api.PriorityClass, error)
+	Create(priorityClass *api.PriorityClass) (*api.PriorityClass, error)
+	Update(priorityClass *api.PriorityClass) (*api.PriorityClass, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// priorityClasses implements PriorityClassesInterface
+type priorityClasses struct {
+	client *Client
+}
+
+func newPriorityClasses(c *Client) *priorityClasses {
+	return &priorityClasses{c}
+}
+
+func (c *priorityClasses) List(label labels.Selector, field fields.Selector) (result *api.PriorityClassList, err error) {
+	result = &api.PriorityClassList{}
+	err = c.client.Get().
+		Resource("priorityClasses").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+
+	return result, err
+}
+
+func (c *priorityClasses) Get(name string) (result *api.PriorityClass, err error) {
+	result = &api.PriorityClass{}
+	err = c.client.Get().Resource("priorityClasses").Name(name).Do().Into(result)
+	return
+}
+
+func (c *priorityClasses) Create(priorityClass *api.PriorityClass) (result *api.PriorityClass, err error) {
+	result = &api.PriorityClass{}
+	err = c.client.Post().Resource("priorityClasses").Body(priorityClass).Do().Into(result)
+	return
+}
+
+func (c *priorityClasses) Update(priorityClass *api.PriorityClass) (result *api.PriorityClass, err error) {
+	result = &api.PriorityClass{}
+	if len(priorityClass.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", priorityClass)
+		return
+	}
+	err = c.client.Put().Resource("priorityClasses").Name(priorityClass.Name).Body(priorityClass).Do().Into(result)
+	return
+}
+
+func (c *priorityClasses) Delete(name string) error {
+	return c.client.Delete().Resource("priorityClasses").Name(name).Do().Error()
+}
+
+func (c *priorityClasses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.client.Get().
+		Prefix("watch").
+		Resource("priorityClasses").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unversioned
+
+import (
+	"net/url"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+func getPriorityClassesResourceName() string {
+	return "priorityclasses"
+}
+
+func TestPriorityClassCreate(t *testing.T) {
+	priorityClass := &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{
+			Name: "high",
+		},
+		Value: 1000,
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   testapi.Default.ResourcePath(getPriorityClassesResourceName(), "", ""),
+			Query:  buildQueryValues(nil),
+			Body:   priorityClass,
+		},
+		Response: Response{StatusCode: 200, Body: priorityClass},
+	}
+
+	response, err := c.Setup(t).PriorityClasses().Create(priorityClass)
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassGet(t *testing.T) {
+	priorityClass := &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{
+			Name: "high",
+		},
+		Value: 1000,
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePath(getPriorityClassesResourceName(), "", "high"),
+			Query:  buildQueryValues(nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: priorityClass},
+	}
+
+	response, err := c.Setup(t).PriorityClasses().Get("high")
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassList(t *testing.T) {
+	priorityClassList := &api.PriorityClassList{
+		Items: []api.PriorityClass{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "high"},
+				Value:      1000,
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePath(getPriorityClassesResourceName(), "", ""),
+			Query:  buildQueryValues(nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: priorityClassList},
+	}
+	response, err := c.Setup(t).PriorityClasses().List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassUpdate(t *testing.T) {
+	priorityClass := &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "high",
+			ResourceVersion: "1",
+		},
+		Value:       1000,
+		Description: "for critical pods",
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: testapi.Default.ResourcePath(getPriorityClassesResourceName(), "", "high"), Query: buildQueryValues(nil)},
+		Response: Response{StatusCode: 200, Body: priorityClass},
+	}
+	response, err := c.Setup(t).PriorityClasses().Update(priorityClass)
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassDelete(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: testapi.Default.ResourcePath(getPriorityClassesResourceName(), "", "high"), Query: buildQueryValues(nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup(t).PriorityClasses().Delete("high")
+	c.Validate(t, nil, err)
+}
+
+func TestPriorityClassWatch(t *testing.T) {
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   testapi.Default.ResourcePathWithPrefix("watch", getPriorityClassesResourceName(), "", ""),
+			Query:  url.Values{"resourceVersion": []string{}}},
+		Response: Response{StatusCode: 200},
+	}
+	_, err := c.Setup(t).PriorityClasses().Watch(labels.Everything(), fields.Everything(), "")
+	c.Validate(t, nil, err)
+}
@@ -42,6 +42,10 @@ type RESTClient struct {
 	// REST resources.
 	Codec runtime.Codec
 
+	// ContentType, if set, is sent as the preferred format in the Accept header
+	// of every request, with JSON as the fallback.
+	ContentType string
+
 	// Set specific behavior of the client.  If not set http.DefaultClient will be
 	// used.
 	Client HTTPClient
@@ -91,7 +95,11 @@ func (c *RESTClient) Verb(verb string) *Request {
 	if c.Throttle != nil {
 		c.Throttle.Accept()
 	}
-	return NewRequest(c.Client, verb, c.baseURL, c.apiVersion, c.Codec).Timeout(c.Timeout)
+	req := NewRequest(c.Client, verb, c.baseURL, c.apiVersion, c.Codec).Timeout(c.Timeout)
+	if len(c.ContentType) > 0 {
+		req.SetHeader("Accept", c.ContentType+", application/json")
+	}
+	return req
 }
 
 // Post begins a POST request. Short for c.Verb("POST").
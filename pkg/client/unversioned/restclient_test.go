@@ -22,9 +22,11 @@ import (
 	"reflect"
 	"testing"
 
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/testapi"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/util"
 )
 
@@ -58,6 +60,44 @@ func TestSetsCodec(t *testing.T) {
 	}
 }
 
+func TestProtobufContentType(t *testing.T) {
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"}}
+	pbCodec := protobuf.NewCodec(api.Scheme, testapi.Default.Version())
+	expectedBody, err := pbCodec.Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fakeHandler := util.FakeHandler{
+		StatusCode:   200,
+		ResponseBody: string(expectedBody),
+		T:            t,
+	}
+	testServer := httptest.NewServer(&fakeHandler)
+	defer testServer.Close()
+
+	client, err := New(&Config{Host: testServer.URL, Version: testapi.Default.Version(), ContentType: protobuf.ContentType})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := client.Pods("default").Update(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Name != pod.Name {
+		t.Errorf("unexpected pod: %#v", out)
+	}
+	header := fakeHandler.RequestReceived.Header
+	if e, a := protobuf.ContentType+", application/json", header.Get("Accept"); e != a {
+		t.Errorf("expected Accept %q, got %q", e, a)
+	}
+	if e, a := protobuf.ContentType, header.Get("Content-Type"); e != a {
+		t.Errorf("expected Content-Type %q, got %q", e, a)
+	}
+	if !protobuf.IsProtobuf([]byte(fakeHandler.RequestBody)) {
+		t.Errorf("expected a protobuf request body, got %q", fakeHandler.RequestBody)
+	}
+}
+
 func TestRESTClientRequires(t *testing.T) {
 	if _, err := RESTClientFor(&Config{Host: "127.0.0.1", Version: "", Codec: testapi.Default.Codec()}); err == nil {
 		t.Errorf("unexpected non-error")
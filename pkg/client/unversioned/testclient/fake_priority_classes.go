@@ -0,0 +1,75 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testclient
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// FakePriorityClasses implements PriorityClassInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakePriorityClasses struct {
+	Fake *Fake
+}
+
+func (c *FakePriorityClasses) Get(name string) (*api.PriorityClass, error) {
+	obj, err := c.Fake.Invokes(NewRootGetAction("priorityclasses", name), &api.PriorityClass{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*api.PriorityClass), err
+}
+
+func (c *FakePriorityClasses) List(label labels.Selector, field fields.Selector) (*api.PriorityClassList, error) {
+	obj, err := c.Fake.Invokes(NewRootListAction("priorityclasses", label, field), &api.PriorityClassList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*api.PriorityClassList), err
+}
+
+func (c *FakePriorityClasses) Create(priorityClass *api.PriorityClass) (*api.PriorityClass, error) {
+	obj, err := c.Fake.Invokes(NewRootCreateAction("priorityclasses", priorityClass), priorityClass)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*api.PriorityClass), err
+}
+
+func (c *FakePriorityClasses) Update(priorityClass *api.PriorityClass) (*api.PriorityClass, error) {
+	obj, err := c.Fake.Invokes(NewRootUpdateAction("priorityclasses", priorityClass), priorityClass)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*api.PriorityClass), err
+}
+
+func (c *FakePriorityClasses) Delete(name string) error {
+	_, err := c.Fake.Invokes(NewRootDeleteAction("priorityclasses", name), &api.PriorityClass{})
+	return err
+}
+
+func (c *FakePriorityClasses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(NewRootWatchAction("priorityclasses", label, field, resourceVersion))
+}
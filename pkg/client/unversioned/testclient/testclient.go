@@ -229,6 +229,10 @@ func (c *Fake) PersistentVolumes() client.PersistentVolumeInterface {
 	return &FakePersistentVolumes{Fake: c}
 }
 
+func (c *Fake) PriorityClasses() client.PriorityClassInterface {
+	return &FakePriorityClasses{Fake: c}
+}
+
 func (c *Fake) PersistentVolumeClaims(namespace string) client.PersistentVolumeClaimInterface {
 	return &FakePersistentVolumeClaims{Fake: c, Namespace: namespace}
 }
@@ -253,6 +257,10 @@ func (c *Fake) Secrets(namespace string) client.SecretsInterface {
 	return &FakeSecrets{Fake: c, Namespace: namespace}
 }
 
+func (c *Fake) ConfigMaps(namespace string) client.ConfigMapsInterface {
+	return &FakeConfigMaps{Fake: c, Namespace: namespace}
+}
+
 func (c *Fake) Namespaces() client.NamespaceInterface {
 	return &FakeNamespaces{Fake: c}
 }
@@ -18,6 +18,8 @@ package controller
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,6 +27,7 @@ import (
 	"github.com/golang/glog"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/latest"
+	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
@@ -255,6 +258,41 @@ func getPodsAnnotationSet(template *api.PodTemplateSpec, object runtime.Object)
 	return desiredAnnotations, nil
 }
 
+// NewOwnerReference returns a reference to object suitable for listing it as
+// the owner of the objects a controller creates on its behalf, so that they are
+// garbage collected once object is deleted.
+func NewOwnerReference(object runtime.Object) (*api.OwnerReference, error) {
+	ref, err := api.GetReference(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get owner reference: %v", err)
+	}
+	return &api.OwnerReference{
+		APIVersion: ownerAPIVersion(object, ref.APIVersion),
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+		UID:        ref.UID,
+	}, nil
+}
+
+// ownerAPIVersion returns the group and version of object. api.GetReference takes the
+// version from the self link of objects without one, which for objects of API groups,
+// served under /apis/<group>/<version>, is only the group.
+func ownerAPIVersion(object runtime.Object, version string) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil || len(accessor.APIVersion()) > 0 {
+		return version
+	}
+	selfLink, err := url.Parse(accessor.SelfLink())
+	if err != nil {
+		return version
+	}
+	parts := strings.Split(selfLink.Path, "/")
+	if len(parts) > 3 && parts[1] == "apis" {
+		return parts[2] + "/" + parts[3]
+	}
+	return version
+}
+
 func getPodsPrefix(controllerName string) string {
 	// use the dash (if the name isn't too long) to make the pod name a bit prettier
 	prefix := fmt.Sprintf("%s-", controllerName)
@@ -278,6 +316,10 @@ func (r RealPodControl) createPods(nodeName, namespace string, template *api.Pod
 	if err != nil {
 		return err
 	}
+	ownerRef, err := NewOwnerReference(object)
+	if err != nil {
+		return err
+	}
 	meta, err := api.ObjectMetaFor(object)
 	if err != nil {
 		return fmt.Errorf("object does not have ObjectMeta, %v", err)
@@ -286,9 +328,10 @@ func (r RealPodControl) createPods(nodeName, namespace string, template *api.Pod
 
 	pod := &api.Pod{
 		ObjectMeta: api.ObjectMeta{
-			Labels:       desiredLabels,
-			Annotations:  desiredAnnotations,
-			GenerateName: prefix,
+			Labels:          desiredLabels,
+			Annotations:     desiredAnnotations,
+			GenerateName:    prefix,
+			OwnerReferences: []api.OwnerReference{*ownerRef},
 		},
 	}
 	if err := api.Scheme.Convert(&template.Spec, &pod.Spec); err != nil {
@@ -206,6 +206,12 @@ func TestCreatePods(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{
 			Labels:       controllerSpec.Spec.Template.Labels,
 			GenerateName: fmt.Sprintf("%s-", controllerSpec.Name),
+			OwnerReferences: []api.OwnerReference{{
+				APIVersion: testapi.Default.Version(),
+				Kind:       "ReplicationController",
+				Name:       controllerSpec.Name,
+				UID:        controllerSpec.UID,
+			}},
 		},
 		Spec: controllerSpec.Spec.Template.Spec,
 	}
@@ -292,3 +298,27 @@ func TestSortingActivePods(t *testing.T) {
 		}
 	}
 }
+
+func TestNewOwnerReference(t *testing.T) {
+	testCases := []struct {
+		selfLink   string
+		apiVersion string
+	}{
+		{selfLink: "/api/v1/namespaces/default/replicationcontrollers/foo", apiVersion: "v1"},
+		{selfLink: "/apis/experimental/v1alpha1/namespaces/default/jobs/foo", apiVersion: "experimental/v1alpha1"},
+	}
+	for _, tc := range testCases {
+		object := &api.ReplicationController{
+			ObjectMeta: api.ObjectMeta{Name: "foo", UID: "123", SelfLink: tc.selfLink},
+		}
+		ref, err := NewOwnerReference(object)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.selfLink, err)
+			continue
+		}
+		expected := &api.OwnerReference{APIVersion: tc.apiVersion, Kind: "ReplicationController", Name: "foo", UID: "123"}
+		if !reflect.DeepEqual(expected, ref) {
+			t.Errorf("%s: expected %#v, got %#v", tc.selfLink, expected, ref)
+		}
+	}
+}
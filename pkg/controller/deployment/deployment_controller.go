@@ -25,6 +25,7 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/experimental"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util"
@@ -167,12 +168,17 @@ func (d *DeploymentController) getNewRC(deployment experimental.Deployment) (*ap
 		}
 	}
 	// new RC does not exist, create one.
+	ownerRef, err := controller.NewOwnerReference(&deployment)
+	if err != nil {
+		return nil, err
+	}
 	podTemplateSpecHash := getPodTemplateSpecHash(deployment.Spec.Template)
 	rcName := fmt.Sprintf("deploymentrc-%d", podTemplateSpecHash)
 	newRC := api.ReplicationController{
 		ObjectMeta: api.ObjectMeta{
-			Name:      rcName,
-			Namespace: namespace,
+			Name:            rcName,
+			Namespace:       namespace,
+			OwnerReferences: []api.OwnerReference{*ownerRef},
 		},
 		Spec: api.ReplicationControllerSpec{
 			Replicas: 0,
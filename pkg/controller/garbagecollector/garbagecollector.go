@@ -0,0 +1,450 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+
+	"github.com/golang/glog"
+)
+
+// ResyncPeriod is how often every monitored object is re-examined. Dependents
+// whose deletion failed are retried at this interval.
+const ResyncPeriod = 5 * time.Minute
+
+// objectReference identifies an object in the graph. Owners are always in the
+// namespace of their dependents, so the namespace is carried alongside.
+type objectReference struct {
+	api.OwnerReference
+	Namespace string
+}
+
+func (r objectReference) String() string {
+	return fmt.Sprintf("%s %s/%s (uid %s)", r.Kind, r.Namespace, r.Name, r.UID)
+}
+
+// node is a vertex in the graph of owners and dependents. All fields other
+// than identity are guarded by the graph lock.
+type node struct {
+	identity objectReference
+	// owners is the last observed value of the object's ownerReferences.
+	owners []api.OwnerReference
+	// dependents are the nodes which list this node as an owner.
+	dependents map[*node]struct{}
+	// virtual is true if the node was created because a dependent refers to
+	// it, but the object itself has not been observed.
+	virtual bool
+	// orphaning is true if the object was deleted with the orphan dependents
+	// annotation. The node is kept, so its dependents are not deleted, until
+	// it has been removed from the owners of all of them.
+	orphaning bool
+}
+
+// orphanRequest asks for the reference to owner to be removed from dependent.
+type orphanRequest struct {
+	dependent *node
+	owner     types.UID
+}
+
+type eventType int
+
+const (
+	addEvent eventType = iota
+	updateEvent
+	deleteEvent
+)
+
+// event is a change to a monitored object, queued for the graph builder.
+type event struct {
+	eventType eventType
+	kind      string
+	obj       interface{}
+}
+
+// GarbageCollector deletes objects once all of their owners are gone.
+type GarbageCollector struct {
+	monitors map[string]*monitor
+	// controllers feed graphChanges from watches of the monitored kinds.
+	controllers []*framework.Controller
+
+	// graphChanges is drained by a single goroutine, so changes are applied
+	// to the graph in the order they were observed.
+	graphChanges *workqueue.Type
+	// dirtyQueue holds nodes whose owners need to be checked.
+	dirtyQueue *workqueue.Type
+	// orphanQueue holds orphanRequests for dependents of orphaning owners.
+	orphanQueue *workqueue.Type
+
+	lock      sync.RWMutex
+	uidToNode map[types.UID]*node
+}
+
+// New returns a GarbageCollector which monitors pods and replication
+// controllers, and additionally deployments, jobs and daemon sets if
+// experimental is true.
+func New(kubeClient client.Interface, experimental bool) *GarbageCollector {
+	return newGarbageCollector(defaultMonitors(kubeClient, experimental))
+}
+
+func newGarbageCollector(monitors []*monitor) *GarbageCollector {
+	gc := &GarbageCollector{
+		monitors:     map[string]*monitor{},
+		graphChanges: workqueue.New(),
+		dirtyQueue:   workqueue.New(),
+		orphanQueue:  workqueue.New(),
+		uidToNode:    map[types.UID]*node{},
+	}
+	for _, m := range monitors {
+		kind := m.kind
+		gc.monitors[kind] = m
+		_, controller := framework.NewInformer(
+			m.listWatch,
+			m.objType,
+			ResyncPeriod,
+			framework.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					gc.graphChanges.Add(&event{eventType: addEvent, kind: kind, obj: obj})
+				},
+				UpdateFunc: func(old, cur interface{}) {
+					gc.graphChanges.Add(&event{eventType: updateEvent, kind: kind, obj: cur})
+				},
+				DeleteFunc: func(obj interface{}) {
+					if deleted, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+						obj = deleted.Obj
+					}
+					gc.graphChanges.Add(&event{eventType: deleteEvent, kind: kind, obj: obj})
+				},
+			},
+		)
+		gc.controllers = append(gc.controllers, controller)
+	}
+	return gc
+}
+
+// Run starts the watches and the given number of workers deleting dependents
+// whose owners are gone, and as many orphaning the dependents of owners deleted
+// with the orphan dependents annotation. It blocks until stopCh is closed.
+func (gc *GarbageCollector) Run(workers int, stopCh <-chan struct{}) {
+	defer util.HandleCrash()
+	for _, controller := range gc.controllers {
+		go controller.Run(stopCh)
+	}
+	go util.Until(gc.graphBuilder, 0, stopCh)
+	for i := 0; i < workers; i++ {
+		go util.Until(gc.worker, time.Second, stopCh)
+		go util.Until(gc.orphanWorker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down garbage collector")
+	gc.graphChanges.ShutDown()
+	gc.dirtyQueue.ShutDown()
+	gc.orphanQueue.ShutDown()
+}
+
+func (gc *GarbageCollector) graphBuilder() {
+	for {
+		item, quit := gc.graphChanges.Get()
+		if quit {
+			return
+		}
+		gc.processGraphChanges(item.(*event))
+		gc.graphChanges.Done(item)
+	}
+}
+
+func (gc *GarbageCollector) worker() {
+	for {
+		item, quit := gc.dirtyQueue.Get()
+		if quit {
+			return
+		}
+		if err := gc.processItem(item.(*node)); err != nil {
+			util.HandleError(err)
+		}
+		gc.dirtyQueue.Done(item)
+	}
+}
+
+func (gc *GarbageCollector) orphanWorker() {
+	for {
+		item, quit := gc.orphanQueue.Get()
+		if quit {
+			return
+		}
+		if err := gc.orphanDependent(item.(orphanRequest)); err != nil {
+			util.HandleError(err)
+		}
+		gc.orphanQueue.Done(item)
+	}
+}
+
+// processGraphChanges applies a single observed change to the graph and
+// queues the nodes which may have lost their last owner as a result, or which
+// must be orphaned because their owner was deleted without them.
+func (gc *GarbageCollector) processGraphChanges(e *event) {
+	obj, ok := e.obj.(runtime.Object)
+	if !ok {
+		glog.Errorf("Unexpected object in %s event: %#v", e.kind, e.obj)
+		return
+	}
+	meta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		glog.Errorf("Unable to get metadata of %s: %v", e.kind, err)
+		return
+	}
+
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	existing, found := gc.uidToNode[meta.UID]
+	if e.eventType == deleteEvent {
+		if !found {
+			return
+		}
+		if meta.Annotations[api.OrphanDependentsAnnotation] == "true" && len(existing.dependents) != 0 {
+			existing.orphaning = true
+			for dependent := range existing.dependents {
+				gc.orphanQueue.Add(orphanRequest{dependent: dependent, owner: existing.identity.UID})
+			}
+			return
+		}
+		gc.removeNode(existing)
+		for dependent := range existing.dependents {
+			gc.dirtyQueue.Add(dependent)
+		}
+		return
+	}
+
+	if !found {
+		existing = &node{
+			identity: objectReference{
+				OwnerReference: api.OwnerReference{
+					Kind: e.kind,
+					Name: meta.Name,
+					UID:  meta.UID,
+				},
+				Namespace: meta.Namespace,
+			},
+			dependents: map[*node]struct{}{},
+		}
+		gc.uidToNode[meta.UID] = existing
+	} else if existing.virtual {
+		// The object a dependent pointed at has now been observed.
+		existing.virtual = false
+		existing.identity.Kind = e.kind
+		existing.identity.Name = meta.Name
+	}
+	gc.setOwners(existing, meta.OwnerReferences)
+
+	if len(existing.owners) != 0 && !gc.hasObservedOwner(existing) {
+		gc.dirtyQueue.Add(existing)
+	}
+	// Orphaning which failed is retried when the dependent is resynced.
+	for _, owner := range existing.owners {
+		if ownerNode, ok := gc.uidToNode[owner.UID]; ok && ownerNode.orphaning {
+			gc.orphanQueue.Add(orphanRequest{dependent: existing, owner: owner.UID})
+		}
+	}
+}
+
+// setOwners replaces the owners of n, updating the dependents of the old and
+// new owners. Owners which have not been observed are added as virtual nodes
+// and queued so their existence is verified. Must be called with the lock held.
+func (gc *GarbageCollector) setOwners(n *node, owners []api.OwnerReference) {
+	kept := map[types.UID]bool{}
+	for _, owner := range owners {
+		kept[owner.UID] = true
+	}
+	for _, owner := range n.owners {
+		if ownerNode, ok := gc.uidToNode[owner.UID]; ok && !kept[owner.UID] {
+			gc.removeDependent(ownerNode, n)
+		}
+	}
+	n.owners = owners
+	for _, owner := range owners {
+		ownerNode, ok := gc.uidToNode[owner.UID]
+		if !ok {
+			ownerNode = &node{
+				identity: objectReference{
+					OwnerReference: owner,
+					Namespace:      n.identity.Namespace,
+				},
+				dependents: map[*node]struct{}{},
+				virtual:    true,
+			}
+			gc.uidToNode[owner.UID] = ownerNode
+			gc.dirtyQueue.Add(ownerNode)
+		}
+		ownerNode.dependents[n] = struct{}{}
+	}
+}
+
+// removeNode removes n from the graph and from the dependents of its owners.
+// Must be called with the lock held.
+func (gc *GarbageCollector) removeNode(n *node) {
+	delete(gc.uidToNode, n.identity.UID)
+	for _, owner := range n.owners {
+		if ownerNode, ok := gc.uidToNode[owner.UID]; ok {
+			gc.removeDependent(ownerNode, n)
+		}
+	}
+}
+
+// removeDependent unlinks n from owner, and removes an orphaning owner from the
+// graph once it has no dependents left. Must be called with the lock held.
+func (gc *GarbageCollector) removeDependent(owner, n *node) {
+	delete(owner.dependents, n)
+	if owner.orphaning && len(owner.dependents) == 0 {
+		delete(gc.uidToNode, owner.identity.UID)
+	}
+}
+
+// hasObservedOwner returns true if at least one owner of n has been observed
+// by the watches. Must be called with the lock held.
+func (gc *GarbageCollector) hasObservedOwner(n *node) bool {
+	for _, owner := range n.owners {
+		if ownerNode, ok := gc.uidToNode[owner.UID]; ok && !ownerNode.virtual {
+			return true
+		}
+	}
+	return false
+}
+
+// processItem verifies the existence of a virtual node, or deletes the object
+// behind a node once all of its owners are gone.
+func (gc *GarbageCollector) processItem(n *node) error {
+	gc.lock.RLock()
+	virtual := n.virtual
+	owners := n.owners
+	observed := gc.hasObservedOwner(n)
+	gc.lock.RUnlock()
+
+	if virtual {
+		exists, err := gc.objectExists(n.identity)
+		if err != nil || exists {
+			return err
+		}
+		// Nothing will ever report the deletion of an object that was never
+		// observed, so treat it as deleted now.
+		gc.lock.Lock()
+		defer gc.lock.Unlock()
+		if n.virtual && gc.uidToNode[n.identity.UID] == n {
+			glog.V(2).Infof("Owner %s does not exist", n.identity)
+			gc.removeNode(n)
+			for dependent := range n.dependents {
+				gc.dirtyQueue.Add(dependent)
+			}
+		}
+		return nil
+	}
+
+	if len(owners) == 0 || observed {
+		return nil
+	}
+	for _, owner := range owners {
+		exists, err := gc.objectExists(objectReference{OwnerReference: owner, Namespace: n.identity.Namespace})
+		if err != nil || exists {
+			return err
+		}
+	}
+	glog.V(2).Infof("Deleting %s, all of its owners are gone", n.identity)
+	return gc.deleteObject(n.identity)
+}
+
+// objectExists returns true if the object identified by ref exists with the
+// same UID. Objects of unmonitored kinds are assumed to exist, since their
+// absence cannot be confirmed.
+func (gc *GarbageCollector) objectExists(ref objectReference) (bool, error) {
+	m, ok := gc.monitors[ref.Kind]
+	if !ok {
+		glog.V(4).Infof("Kind %q of %s is not monitored, assuming it exists", ref.Kind, ref)
+		return true, nil
+	}
+	obj, err := m.get(ref.Namespace, ref.Name)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to get %s: %v", ref, err)
+	}
+	meta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return false, err
+	}
+	// An object recreated under the same name is not the same owner.
+	return meta.UID == ref.UID, nil
+}
+
+// deleteObject deletes the object identified by ref, unless it has already
+// been replaced by an object with a different UID.
+func (gc *GarbageCollector) deleteObject(ref objectReference) error {
+	exists, err := gc.objectExists(ref)
+	if err != nil || !exists {
+		return err
+	}
+	err = gc.monitors[ref.Kind].delete(ref.Namespace, ref.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete %s: %v", ref, err)
+	}
+	return nil
+}
+
+// orphanDependent removes the reference to the deleted owner of req from its
+// dependent. The graph is updated once the change to the dependent is observed.
+func (gc *GarbageCollector) orphanDependent(req orphanRequest) error {
+	ref := req.dependent.identity
+	obj, err := gc.monitors[ref.Kind].get(ref.Namespace, ref.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get %s: %v", ref, err)
+	}
+	meta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return err
+	}
+	if meta.UID != ref.UID {
+		return nil
+	}
+	var owners []api.OwnerReference
+	for _, owner := range meta.OwnerReferences {
+		if owner.UID != req.owner {
+			owners = append(owners, owner)
+		}
+	}
+	if len(owners) == len(meta.OwnerReferences) {
+		return nil
+	}
+	glog.V(2).Infof("Orphaning %s, its owner %s was deleted without its dependents", ref, req.owner)
+	meta.OwnerReferences = owners
+	if err := gc.monitors[ref.Kind].update(obj); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to orphan %s: %v", ref, err)
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+// fakeObjects stands in for the apiserver when owners are looked up and
+// dependents updated or deleted.
+type fakeObjects struct {
+	lock    sync.Mutex
+	objects map[string]runtime.Object
+	deleted sets.String
+}
+
+func newFakeObjects() *fakeObjects {
+	return &fakeObjects{objects: map[string]runtime.Object{}, deleted: sets.NewString()}
+}
+
+func (f *fakeObjects) key(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func (f *fakeObjects) add(kind string, obj runtime.Object) {
+	meta, _ := api.ObjectMetaFor(obj)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.objects[f.key(kind, meta.Namespace, meta.Name)] = obj
+}
+
+func (f *fakeObjects) remove(kind string, obj runtime.Object) {
+	meta, _ := api.ObjectMetaFor(obj)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.objects, f.key(kind, meta.Namespace, meta.Name))
+}
+
+func (f *fakeObjects) get(kind, namespace, name string) runtime.Object {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.objects[f.key(kind, namespace, name)]
+}
+
+func (f *fakeObjects) wasDeleted(kind, namespace, name string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.deleted.Has(f.key(kind, namespace, name))
+}
+
+func (f *fakeObjects) monitor(kind string, objType runtime.Object, source *framework.FakeControllerSource) *monitor {
+	return &monitor{
+		kind:    kind,
+		objType: objType,
+		listWatch: &cache.ListWatch{
+			ListFunc:  source.List,
+			WatchFunc: source.Watch,
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			f.lock.Lock()
+			defer f.lock.Unlock()
+			if obj, ok := f.objects[f.key(kind, namespace, name)]; ok {
+				return obj, nil
+			}
+			return nil, errors.NewNotFound(kind, name)
+		},
+		update: func(obj runtime.Object) error {
+			meta, _ := api.ObjectMetaFor(obj)
+			f.lock.Lock()
+			defer f.lock.Unlock()
+			key := f.key(kind, meta.Namespace, meta.Name)
+			if _, ok := f.objects[key]; !ok {
+				return errors.NewNotFound(kind, meta.Name)
+			}
+			f.objects[key] = obj
+			return nil
+		},
+		delete: func(namespace, name string) error {
+			f.lock.Lock()
+			defer f.lock.Unlock()
+			key := f.key(kind, namespace, name)
+			if _, ok := f.objects[key]; !ok {
+				return errors.NewNotFound(kind, name)
+			}
+			delete(f.objects, key)
+			f.deleted.Insert(key)
+			return nil
+		},
+	}
+}
+
+func newTestGarbageCollector(objects *fakeObjects) (*GarbageCollector, *framework.FakeControllerSource, *framework.FakeControllerSource) {
+	podSource := framework.NewFakeControllerSource()
+	rcSource := framework.NewFakeControllerSource()
+	gc := newGarbageCollector([]*monitor{
+		objects.monitor("Pod", &api.Pod{}, podSource),
+		objects.monitor("ReplicationController", &api.ReplicationController{}, rcSource),
+	})
+	return gc, podSource, rcSource
+}
+
+func newRC(name string, uid types.UID) *api.ReplicationController {
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault, UID: uid},
+	}
+}
+
+func newPod(name string, uid types.UID, owners ...*api.ReplicationController) *api.Pod {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault, UID: uid},
+	}
+	for _, owner := range owners {
+		pod.OwnerReferences = append(pod.OwnerReferences, api.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "ReplicationController",
+			Name:       owner.Name,
+			UID:        owner.UID,
+		})
+	}
+	return pod
+}
+
+// drain processes everything currently in the dirty queue, including nodes
+// queued while processing.
+func drain(t *testing.T, gc *GarbageCollector) {
+	for gc.dirtyQueue.Len() != 0 {
+		item, _ := gc.dirtyQueue.Get()
+		if err := gc.processItem(item.(*node)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		gc.dirtyQueue.Done(item)
+	}
+}
+
+func TestDependentsLinkedToObservedOwner(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	rc := newRC("rc", "rc-uid")
+	pod := newPod("pod", "pod-uid", rc)
+
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "ReplicationController", obj: rc})
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+
+	rcNode := gc.uidToNode["rc-uid"]
+	podNode := gc.uidToNode["pod-uid"]
+	if rcNode == nil || podNode == nil {
+		t.Fatalf("expected both objects in the graph, got %v", gc.uidToNode)
+	}
+	if _, ok := rcNode.dependents[podNode]; !ok {
+		t.Errorf("expected pod to be a dependent of the rc")
+	}
+	if gc.dirtyQueue.Len() != 0 {
+		t.Errorf("expected nothing to be queued while the owner exists, got %d items", gc.dirtyQueue.Len())
+	}
+
+	// Dropping the owner reference unlinks the pod.
+	gc.processGraphChanges(&event{eventType: updateEvent, kind: "Pod", obj: newPod("pod", "pod-uid")})
+	if len(rcNode.dependents) != 0 {
+		t.Errorf("expected the rc to have no dependents, got %v", rcNode.dependents)
+	}
+}
+
+func TestDeletingOwnerDeletesDependents(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	rc := newRC("rc", "rc-uid")
+	owned := newPod("owned", "owned-uid", rc)
+	unowned := newPod("unowned", "unowned-uid")
+	for _, pod := range []*api.Pod{owned, unowned} {
+		objects.add("Pod", pod)
+		gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+	}
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "ReplicationController", obj: rc})
+	drain(t, gc)
+
+	gc.processGraphChanges(&event{eventType: deleteEvent, kind: "ReplicationController", obj: rc})
+	drain(t, gc)
+
+	if !objects.wasDeleted("Pod", api.NamespaceDefault, "owned") {
+		t.Errorf("expected the pod owned by the deleted rc to be deleted")
+	}
+	if objects.wasDeleted("Pod", api.NamespaceDefault, "unowned") {
+		t.Errorf("expected the pod without owners to be kept")
+	}
+}
+
+// drainOrphans processes everything currently in the orphan queue.
+func drainOrphans(t *testing.T, gc *GarbageCollector) {
+	for gc.orphanQueue.Len() != 0 {
+		item, _ := gc.orphanQueue.Get()
+		if err := gc.orphanDependent(item.(orphanRequest)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		gc.orphanQueue.Done(item)
+	}
+}
+
+func TestDeletingOwnerOrphansDependents(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	rc := newRC("rc", "rc-uid")
+	other := newRC("other", "other-uid")
+	pod := newPod("pod", "pod-uid", rc, other)
+	objects.add("Pod", pod)
+	objects.add("ReplicationController", other)
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "ReplicationController", obj: rc})
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "ReplicationController", obj: other})
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+	drain(t, gc)
+
+	orphaned := newRC("rc", "rc-uid")
+	orphaned.Annotations = map[string]string{api.OrphanDependentsAnnotation: "true"}
+	gc.processGraphChanges(&event{eventType: deleteEvent, kind: "ReplicationController", obj: orphaned})
+	drain(t, gc)
+	drainOrphans(t, gc)
+
+	if objects.wasDeleted("Pod", api.NamespaceDefault, "pod") {
+		t.Fatalf("expected the pod of an rc deleted with its dependents orphaned to be kept")
+	}
+	updated := objects.get("Pod", api.NamespaceDefault, "pod")
+	owners := updated.(*api.Pod).OwnerReferences
+	if len(owners) != 1 || owners[0].UID != "other-uid" {
+		t.Errorf("expected only the remaining owner to be listed, got %v", owners)
+	}
+	if n, ok := gc.uidToNode["rc-uid"]; !ok || !n.orphaning {
+		t.Errorf("expected the rc to be kept in the graph until its dependent is updated")
+	}
+
+	gc.processGraphChanges(&event{eventType: updateEvent, kind: "Pod", obj: updated})
+	if _, ok := gc.uidToNode["rc-uid"]; ok {
+		t.Errorf("expected the rc to be removed from the graph once it has no dependents")
+	}
+	drain(t, gc)
+	if objects.wasDeleted("Pod", api.NamespaceDefault, "pod") {
+		t.Errorf("expected the orphaned pod to be kept")
+	}
+}
+
+func TestDependentKeptWhileAnyOwnerExists(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	first := newRC("first", "first-uid")
+	second := newRC("second", "second-uid")
+	pod := newPod("pod", "pod-uid", first, second)
+	objects.add("Pod", pod)
+	objects.add("ReplicationController", second)
+
+	// Neither owner has been observed yet, so both are checked with the apiserver.
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+	drain(t, gc)
+
+	if objects.wasDeleted("Pod", api.NamespaceDefault, "pod") {
+		t.Errorf("expected the pod to be kept while one of its owners exists")
+	}
+	if _, ok := gc.uidToNode["first-uid"]; ok {
+		t.Errorf("expected the missing owner to be removed from the graph")
+	}
+	if n, ok := gc.uidToNode["second-uid"]; !ok || !n.virtual {
+		t.Errorf("expected the existing owner to remain as an unobserved node")
+	}
+}
+
+func TestMissingOwnerDeletesDependent(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	pod := newPod("pod", "pod-uid", newRC("rc", "rc-uid"))
+	objects.add("Pod", pod)
+	// An rc with the same name but a different UID is not the owner.
+	objects.add("ReplicationController", newRC("rc", "other-uid"))
+
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+	drain(t, gc)
+
+	if !objects.wasDeleted("Pod", api.NamespaceDefault, "pod") {
+		t.Errorf("expected the pod whose owner is gone to be deleted")
+	}
+}
+
+func TestOwnerOfUnmonitoredKindIsAssumedToExist(t *testing.T) {
+	objects := newFakeObjects()
+	gc, _, _ := newTestGarbageCollector(objects)
+	pod := newPod("pod", "pod-uid")
+	pod.OwnerReferences = []api.OwnerReference{{APIVersion: "v1", Kind: "Unknown", Name: "owner", UID: "owner-uid"}}
+	objects.add("Pod", pod)
+
+	gc.processGraphChanges(&event{eventType: addEvent, kind: "Pod", obj: pod})
+	drain(t, gc)
+
+	if objects.wasDeleted("Pod", api.NamespaceDefault, "pod") {
+		t.Errorf("expected the pod to be kept when its owner cannot be looked up")
+	}
+}
+
+func TestCascadingDeletionFromWatches(t *testing.T) {
+	objects := newFakeObjects()
+	gc, podSource, rcSource := newTestGarbageCollector(objects)
+	rc := newRC("rc", "rc-uid")
+	pods := []*api.Pod{newPod("a", "a-uid", rc), newPod("b", "b-uid", rc)}
+	objects.add("ReplicationController", rc)
+	rcSource.Add(rc)
+	for _, pod := range pods {
+		objects.add("Pod", pod)
+		podSource.Add(pod)
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go gc.Run(1, stopCh)
+
+	objects.remove("ReplicationController", rc)
+	rcSource.Delete(rc)
+
+	err := wait.Poll(10*time.Millisecond, util.ForeverTestTimeout, func() (bool, error) {
+		return objects.wasDeleted("Pod", api.NamespaceDefault, "a") && objects.wasDeleted("Pod", api.NamespaceDefault, "b"), nil
+	})
+	if err != nil {
+		t.Errorf("expected the pods of the deleted rc to be deleted: %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// monitor describes how the garbage collector watches, looks up, updates and
+// deletes objects of a single kind.
+type monitor struct {
+	kind      string
+	objType   runtime.Object
+	listWatch *cache.ListWatch
+	get       func(namespace, name string) (runtime.Object, error)
+	update    func(obj runtime.Object) error
+	delete    func(namespace, name string) error
+}
+
+// defaultMonitors returns the monitors for the kinds which own or are owned
+// by objects the built-in controllers create. The experimental kinds are only
+// included if experimental is true, since watching them fails when the
+// experimental API is disabled.
+func defaultMonitors(kubeClient client.Interface, experimental bool) []*monitor {
+	monitors := []*monitor{
+		podMonitor(kubeClient),
+		replicationControllerMonitor(kubeClient),
+	}
+	if experimental {
+		monitors = append(monitors,
+			deploymentMonitor(kubeClient),
+			jobMonitor(kubeClient),
+			daemonSetMonitor(kubeClient),
+		)
+	}
+	return monitors
+}
+
+func podMonitor(kubeClient client.Interface) *monitor {
+	return &monitor{
+		kind:    "Pod",
+		objType: &api.Pod{},
+		listWatch: &cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Pods(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			return kubeClient.Pods(namespace).Get(name)
+		},
+		update: func(obj runtime.Object) error {
+			pod := obj.(*api.Pod)
+			_, err := kubeClient.Pods(pod.Namespace).Update(pod)
+			return err
+		},
+		delete: func(namespace, name string) error {
+			return kubeClient.Pods(namespace).Delete(name, nil)
+		},
+	}
+}
+
+func replicationControllerMonitor(kubeClient client.Interface) *monitor {
+	return &monitor{
+		kind:    "ReplicationController",
+		objType: &api.ReplicationController{},
+		listWatch: &cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.ReplicationControllers(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return kubeClient.ReplicationControllers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			return kubeClient.ReplicationControllers(namespace).Get(name)
+		},
+		update: func(obj runtime.Object) error {
+			rc := obj.(*api.ReplicationController)
+			_, err := kubeClient.ReplicationControllers(rc.Namespace).Update(rc)
+			return err
+		},
+		delete: func(namespace, name string) error {
+			return kubeClient.ReplicationControllers(namespace).Delete(name)
+		},
+	}
+}
+
+func deploymentMonitor(kubeClient client.Interface) *monitor {
+	return &monitor{
+		kind:    "Deployment",
+		objType: &experimental.Deployment{},
+		listWatch: &cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Experimental().Deployments(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return kubeClient.Experimental().Deployments(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			return kubeClient.Experimental().Deployments(namespace).Get(name)
+		},
+		update: func(obj runtime.Object) error {
+			deployment := obj.(*experimental.Deployment)
+			_, err := kubeClient.Experimental().Deployments(deployment.Namespace).Update(deployment)
+			return err
+		},
+		delete: func(namespace, name string) error {
+			return kubeClient.Experimental().Deployments(namespace).Delete(name, nil)
+		},
+	}
+}
+
+func jobMonitor(kubeClient client.Interface) *monitor {
+	return &monitor{
+		kind:    "Job",
+		objType: &experimental.Job{},
+		listWatch: &cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Experimental().Jobs(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return kubeClient.Experimental().Jobs(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			return kubeClient.Experimental().Jobs(namespace).Get(name)
+		},
+		update: func(obj runtime.Object) error {
+			job := obj.(*experimental.Job)
+			_, err := kubeClient.Experimental().Jobs(job.Namespace).Update(job)
+			return err
+		},
+		delete: func(namespace, name string) error {
+			return kubeClient.Experimental().Jobs(namespace).Delete(name, nil)
+		},
+	}
+}
+
+func daemonSetMonitor(kubeClient client.Interface) *monitor {
+	return &monitor{
+		kind:    "DaemonSet",
+		objType: &experimental.DaemonSet{},
+		listWatch: &cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Experimental().DaemonSets(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(rv string) (watch.Interface, error) {
+				return kubeClient.Experimental().DaemonSets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), rv)
+			},
+		},
+		get: func(namespace, name string) (runtime.Object, error) {
+			return kubeClient.Experimental().DaemonSets(namespace).Get(name)
+		},
+		update: func(obj runtime.Object) error {
+			daemonSet := obj.(*experimental.DaemonSet)
+			_, err := kubeClient.Experimental().DaemonSets(daemonSet.Namespace).Update(daemonSet)
+			return err
+		},
+		delete: func(namespace, name string) error {
+			return kubeClient.Experimental().DaemonSets(namespace).Delete(name)
+		},
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"k8s.io/kubernetes/pkg/util/sets"
 )
 
 // Selector represents a field selector.
@@ -107,6 +109,86 @@ func (t *notHasTerm) String() string {
 	return fmt.Sprintf("%v!=%v", t.field, t.value)
 }
 
+type inTerm struct {
+	field  string
+	values sets.String
+}
+
+func (t *inTerm) Matches(ls Fields) bool {
+	return t.values.Has(ls.Get(t.field))
+}
+
+func (t *inTerm) Empty() bool {
+	return false
+}
+
+func (t *inTerm) RequiresExactMatch(field string) (value string, found bool) {
+	if t.field == field && t.values.Len() == 1 {
+		return t.values.List()[0], true
+	}
+	return "", false
+}
+
+func (t *inTerm) Transform(fn TransformFunc) (Selector, error) {
+	field, values, err := transformSet(t.field, t.values, fn)
+	if err != nil {
+		return nil, err
+	}
+	return &inTerm{field, values}, nil
+}
+
+func (t *inTerm) String() string {
+	return fmt.Sprintf("%v in (%v)", t.field, strings.Join(t.values.List(), ","))
+}
+
+type notInTerm struct {
+	field  string
+	values sets.String
+}
+
+func (t *notInTerm) Matches(ls Fields) bool {
+	return !t.values.Has(ls.Get(t.field))
+}
+
+func (t *notInTerm) Empty() bool {
+	return false
+}
+
+func (t *notInTerm) RequiresExactMatch(field string) (value string, found bool) {
+	return "", false
+}
+
+func (t *notInTerm) Transform(fn TransformFunc) (Selector, error) {
+	field, values, err := transformSet(t.field, t.values, fn)
+	if err != nil {
+		return nil, err
+	}
+	return &notInTerm{field, values}, nil
+}
+
+func (t *notInTerm) String() string {
+	return fmt.Sprintf("%v notin (%v)", t.field, strings.Join(t.values.List(), ","))
+}
+
+// transformSet applies fn to every value of a set-based term. fn must map the
+// field to the same new field for every value.
+func transformSet(field string, values sets.String, fn TransformFunc) (string, sets.String, error) {
+	newField := field
+	newValues := sets.NewString()
+	for i, value := range values.List() {
+		f, v, err := fn(field, value)
+		if err != nil {
+			return "", nil, err
+		}
+		if i > 0 && f != newField {
+			return "", nil, fmt.Errorf("field %q was transformed to both %q and %q", field, newField, f)
+		}
+		newField = f
+		newValues.Insert(v)
+	}
+	return newField, newValues, nil
+}
+
 type andTerm []Selector
 
 func (t andTerm) Matches(ls Fields) bool {
@@ -206,15 +288,55 @@ func try(selectorPiece, op string) (lhs, rhs string, ok bool) {
 	return "", "", false
 }
 
+// trySet parses a set-based term of the form "field op (value1,value2)".
+func trySet(selectorPiece, op string) (lhs string, values sets.String, ok bool) {
+	i := strings.Index(selectorPiece, " "+op+" (")
+	if i < 0 || !strings.HasSuffix(selectorPiece, ")") {
+		return "", nil, false
+	}
+	lhs = strings.TrimSpace(selectorPiece[:i])
+	list := selectorPiece[i+len(op)+3 : len(selectorPiece)-1]
+	values = sets.NewString()
+	for _, value := range strings.Split(list, ",") {
+		values.Insert(strings.TrimSpace(value))
+	}
+	return lhs, values, lhs != "" && !strings.ContainsAny(lhs, "=!") && strings.TrimSpace(list) != ""
+}
+
+// splitTerms splits a selector on the commas which are not part of the value
+// list of a set-based term.
+func splitTerms(selector string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
 func parseSelector(selector string, fn TransformFunc) (Selector, error) {
-	parts := strings.Split(selector, ",")
+	parts := splitTerms(selector)
 	sort.StringSlice(parts).Sort()
 	var items []Selector
 	for _, part := range parts {
 		if part == "" {
 			continue
 		}
-		if lhs, rhs, ok := try(part, "!="); ok {
+		if lhs, values, ok := trySet(part, "notin"); ok {
+			items = append(items, &notInTerm{field: lhs, values: values})
+		} else if lhs, values, ok := trySet(part, "in"); ok {
+			items = append(items, &inTerm{field: lhs, values: values})
+		} else if lhs, rhs, ok := try(part, "!="); ok {
 			items = append(items, &notHasTerm{field: lhs, value: rhs})
 		} else if lhs, rhs, ok := try(part, "=="); ok {
 			items = append(items, &hasTerm{field: lhs, value: rhs})
@@ -17,7 +17,10 @@ limitations under the License.
 package fields
 
 import (
+	"fmt"
 	"testing"
+
+	"k8s.io/kubernetes/pkg/util/sets"
 )
 
 func TestSelectorParse(t *testing.T) {
@@ -25,10 +28,15 @@ func TestSelectorParse(t *testing.T) {
 		"x=a,y=b,z=c",
 		"",
 		"x!=a,y=b",
+		"x in (a,b),y=c",
+		"x notin (a),y in (b)",
 	}
 	testBadStrings := []string{
 		"x=a||y=b",
 		"x==a==b",
+		"x in ()",
+		"x in (a",
+		"in (a,b)",
 	}
 	for _, test := range testGoodStrings {
 		lq, err := ParseSelector(test)
@@ -98,6 +106,13 @@ func TestSelectorMatches(t *testing.T) {
 	expectNoMatch(t, "x=y", Set{"x": "z"})
 	expectNoMatch(t, "x=y,z=w", Set{"x": "w", "z": "w"})
 	expectNoMatch(t, "x!=y,z!=w", Set{"x": "z", "z": "w"})
+	expectMatch(t, "x in (y,z)", Set{"x": "z"})
+	expectMatch(t, "x in (y,z),w=v", Set{"x": "y", "w": "v"})
+	expectMatch(t, "x notin (y,z)", Set{"x": "w"})
+	expectMatch(t, "x notin (y,z)", Set{})
+	expectNoMatch(t, "x in (y,z)", Set{"x": "w"})
+	expectNoMatch(t, "x in (y,z)", Set{})
+	expectNoMatch(t, "x notin (y,z)", Set{"x": "y"})
 
 	labelset := Set{
 		"foo": "bar",
@@ -169,6 +184,12 @@ func TestSetIsEmpty(t *testing.T) {
 	if (&notHasTerm{}).Empty() {
 		t.Errorf("notHasTerm should not be empty")
 	}
+	if (&inTerm{}).Empty() {
+		t.Errorf("inTerm should not be empty")
+	}
+	if (&notInTerm{}).Empty() {
+		t.Errorf("notInTerm should not be empty")
+	}
 	if !(andTerm{andTerm{}}).Empty() {
 		t.Errorf("Nested andTerm should be empty")
 	}
@@ -195,6 +216,9 @@ func TestRequiresExactMatch(t *testing.T) {
 		"nested andTerm":            {andTerm{andTerm{}}, "test", "", false},
 		"nested andTerm matches":    {andTerm{&hasTerm{"test", "b"}}, "test", "b", true},
 		"andTerm with non-match":    {andTerm{&hasTerm{}, &hasTerm{"test", "b"}}, "test", "b", true},
+		"single value inTerm":       {&inTerm{"test", sets.NewString("b")}, "test", "b", true},
+		"multi value inTerm":        {&inTerm{"test", sets.NewString("b", "c")}, "test", "", false},
+		"valid notInTerm":           {&notInTerm{"test", sets.NewString("b")}, "test", "", false},
 	}
 	for k, v := range testCases {
 		value, found := v.S.RequiresExactMatch(v.Label)
@@ -206,3 +230,32 @@ func TestRequiresExactMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTermTransform(t *testing.T) {
+	s, err := ParseAndTransformSelector("spec.host in (a,b)", func(field, value string) (string, string, error) {
+		if field == "spec.host" {
+			field = "spec.nodeName"
+		}
+		return field, value + "1", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "spec.nodeName in (a1,b1)", s.String(); e != a {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+
+	_, err = ParseAndTransformSelector("x notin (a,b)", func(field, value string) (string, string, error) {
+		return field + value, value, nil
+	})
+	if err == nil {
+		t.Errorf("expected an error when values transform the field differently")
+	}
+
+	_, err = ParseAndTransformSelector("x in (a,b)", func(field, value string) (string, string, error) {
+		return "", "", fmt.Errorf("field label not supported: %s", field)
+	})
+	if err == nil {
+		t.Errorf("expected the transform error to be returned")
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/kubectl"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+)
+
+// ApplyOptions is the start of the data required to perform the operation.  As new fields are added, add them here instead of
+// referencing the cmd.Flags()
+type ApplyOptions struct {
+	Filenames []string
+}
+
+const (
+	apply_long = `Apply a configuration to a resource by filename or stdin.
+
+The resource will be created if it doesn't exist yet. Otherwise the configuration
+is merged into the live resource: fields present in the configuration are set,
+fields that were removed since the configuration was last applied are deleted,
+and fields that were never part of the configuration, such as those set by
+controllers, are left untouched. The applied configuration is recorded in the
+` + api.LastAppliedConfigAnnotation + ` annotation.
+
+JSON and YAML formats are accepted.`
+	apply_example = `# Apply the configuration in pod.json to a pod.
+$ kubectl apply -f ./pod.json
+
+# Apply the JSON passed into stdin to a pod.
+$ cat pod.json | kubectl apply -f -`
+)
+
+func NewCmdApply(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	options := &ApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "apply -f FILENAME",
+		Short:   "Apply a configuration to a resource by filename or stdin",
+		Long:    apply_long,
+		Example: apply_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(ValidateArgs(cmd, args))
+			cmdutil.CheckErr(cmdutil.ValidateOutputArgs(cmd))
+			cmdutil.CheckErr(RunApply(f, cmd, out, options))
+		},
+	}
+
+	usage := "Filename, directory, or URL to file that contains the configuration to apply"
+	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
+	cmd.MarkFlagRequired("filename")
+	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddOutputFlagsForMutation(cmd)
+	return cmd
+}
+
+func RunApply(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *ApplyOptions) error {
+	schema, err := f.Validator(cmdutil.GetFlagBool(cmd, "validate"), cmdutil.GetFlagString(cmd, "schema-cache-dir"))
+	if err != nil {
+		return err
+	}
+
+	cmdNamespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		Schema(schema).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, options.Filenames...).
+		Flatten().
+		Do()
+	err = r.Err()
+	if err != nil {
+		return err
+	}
+
+	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
+	count := 0
+	err = r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		// The configuration is recorded by the server, so a copy read back from it must not be nested.
+		if err := setAnnotation(info, api.LastAppliedConfigAnnotation, ""); err != nil {
+			return cmdutil.AddSourceToErr("applying", info.Source, err)
+		}
+		config, err := info.Mapping.Codec.Encode(info.Object)
+		if err != nil {
+			return cmdutil.AddSourceToErr("applying", info.Source, err)
+		}
+
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		if _, err := helper.Get(info.Namespace, info.Name); err != nil {
+			if !errors.IsNotFound(err) {
+				return cmdutil.AddSourceToErr("retrieving current configuration for", info.Source, err)
+			}
+			// The object doesn't exist yet, so create it recording the configuration it was created from.
+			if err := setAnnotation(info, api.LastAppliedConfigAnnotation, string(config)); err != nil {
+				return cmdutil.AddSourceToErr("applying", info.Source, err)
+			}
+			data, err := info.Mapping.Codec.Encode(info.Object)
+			if err != nil {
+				return cmdutil.AddSourceToErr("applying", info.Source, err)
+			}
+			obj, err := helper.Create(info.Namespace, true, data)
+			if err != nil {
+				return cmdutil.AddSourceToErr("creating", info.Source, err)
+			}
+			count++
+			info.Refresh(obj, true)
+			if !shortOutput {
+				printObjectSpecificMessage(info.Object, out)
+			}
+			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created")
+			return nil
+		}
+
+		obj, err := helper.Patch(info.Namespace, info.Name, api.ApplyPatchType, config)
+		if err != nil {
+			return cmdutil.AddSourceToErr("applying", info.Source, err)
+		}
+		count++
+		info.Refresh(obj, true)
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "configured")
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("no objects passed to apply")
+	}
+	return nil
+}
+
+// setAnnotation sets the annotation with the given key on the object in info,
+// removing it if value is empty.
+func setAnnotation(info *resource.Info, key, value string) error {
+	annotations, err := info.Mapping.MetadataAccessor.Annotations(info.Object)
+	if err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		if _, ok := annotations[key]; !ok {
+			return nil
+		}
+		delete(annotations, key)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+	}
+	return info.Mapping.MetadataAccessor.SetAnnotations(info.Object, annotations)
+}
@@ -66,6 +66,7 @@ func NewCmdCreate(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	kubectl.AddJsonFilenameFlag(cmd, &options.Filenames, usage)
 	cmd.MarkFlagRequired("filename")
 	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	return cmd
 }
@@ -101,6 +102,7 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 		return err
 	}
 
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	count := 0
 	err = r.Visit(func(info *resource.Info, err error) error {
 		if err != nil {
@@ -110,7 +112,9 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 		if err != nil {
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
-		obj, err := resource.NewHelper(info.Client, info.Mapping).Create(info.Namespace, true, data)
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.DryRun = dryRun
+		obj, err := helper.Create(info.Namespace, true, data)
 		if err != nil {
 			return cmdutil.AddSourceToErr("creating", info.Source, err)
 		}
@@ -120,7 +124,7 @@ func RunCreate(f *cmdutil.Factory, cmd *cobra.Command, out io.Writer, options *C
 		if !shortOutput {
 			printObjectSpecificMessage(info.Object, out)
 		}
-		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "created")
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, cmdutil.DryRunOperation("created", dryRun))
 		return nil
 	})
 	if err != nil {
@@ -97,6 +97,7 @@ func NewCmdDelete(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().Bool("cascade", true, "If true, cascade the deletion of the resources managed by this resource (e.g. Pods created by a ReplicationController).  Default true.")
 	cmd.Flags().Int("grace-period", -1, "Period of time in seconds given to the resource to terminate gracefully. Ignored if negative.")
 	cmd.Flags().Duration("timeout", 0, "The length of time to wait before giving up on a delete, zero means determine a timeout from the size of the object")
+	cmdutil.AddDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	return cmd
 }
@@ -135,12 +136,14 @@ func RunDelete(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []str
 		}
 	}
 
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
-	// By default use a reaper to delete all related resources.
-	if cmdutil.GetFlagBool(cmd, "cascade") {
+	// By default use a reaper to delete all related resources. Reapers modify the
+	// resources they stop, so a dry run only checks the deletion of the named resources.
+	if cmdutil.GetFlagBool(cmd, "cascade") && !dryRun {
 		return ReapResult(r, f, out, cmdutil.GetFlagBool(cmd, "cascade"), ignoreNotFound, cmdutil.GetFlagDuration(cmd, "timeout"), cmdutil.GetFlagInt(cmd, "grace-period"), shortOutput, mapper)
 	}
-	return DeleteResult(r, out, ignoreNotFound, shortOutput, mapper)
+	return DeleteResult(r, out, ignoreNotFound, !cmdutil.GetFlagBool(cmd, "cascade"), dryRun, shortOutput, mapper)
 }
 
 func ReapResult(r *resource.Result, f *cmdutil.Factory, out io.Writer, isDefaultDelete, ignoreNotFound bool, timeout time.Duration, gracePeriod int, shortOutput bool, mapper meta.RESTMapper) error {
@@ -157,7 +160,7 @@ func ReapResult(r *resource.Result, f *cmdutil.Factory, out io.Writer, isDefault
 		if err != nil {
 			// If there is no reaper for this resources and the user didn't explicitly ask for stop.
 			if kubectl.IsNoSuchReaperError(err) && isDefaultDelete {
-				return deleteResource(info, out, shortOutput, mapper)
+				return deleteResource(info, out, false, false, shortOutput, mapper)
 			}
 			return cmdutil.AddSourceToErr("reaping", info.Source, err)
 		}
@@ -180,7 +183,9 @@ func ReapResult(r *resource.Result, f *cmdutil.Factory, out io.Writer, isDefault
 	return nil
 }
 
-func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool, shortOutput bool, mapper meta.RESTMapper) error {
+// DeleteResult deletes the resources of r. If orphan is true, the resources are
+// annotated first so that the garbage collector keeps their dependents.
+func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool, orphan bool, dryRun bool, shortOutput bool, mapper meta.RESTMapper) error {
 	found := 0
 	if ignoreNotFound {
 		r = r.IgnoreErrors(errors.IsNotFound)
@@ -190,7 +195,7 @@ func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool, shortO
 			return err
 		}
 		found++
-		return deleteResource(info, out, shortOutput, mapper)
+		return deleteResource(info, out, orphan, dryRun, shortOutput, mapper)
 	})
 	if err != nil {
 		return err
@@ -201,10 +206,21 @@ func DeleteResult(r *resource.Result, out io.Writer, ignoreNotFound bool, shortO
 	return nil
 }
 
-func deleteResource(info *resource.Info, out io.Writer, shortOutput bool, mapper meta.RESTMapper) error {
-	if err := resource.NewHelper(info.Client, info.Mapping).Delete(info.Namespace, info.Name); err != nil {
+// orphanDependentsPatch annotates a resource to have its dependents orphaned
+// rather than deleted by the garbage collector once it is deleted.
+var orphanDependentsPatch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, api.OrphanDependentsAnnotation))
+
+func deleteResource(info *resource.Info, out io.Writer, orphan bool, dryRun bool, shortOutput bool, mapper meta.RESTMapper) error {
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	helper.DryRun = dryRun
+	if orphan && !dryRun {
+		if _, err := helper.Patch(info.Namespace, info.Name, api.MergePatchType, orphanDependentsPatch); err != nil {
+			return cmdutil.AddSourceToErr("deleting", info.Source, err)
+		}
+	}
+	if err := helper.Delete(info.Namespace, info.Name); err != nil {
 		return cmdutil.AddSourceToErr("deleting", info.Source, err)
 	}
-	cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "deleted")
+	cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, cmdutil.DryRunOperation("deleted", dryRun))
 	return nil
 }
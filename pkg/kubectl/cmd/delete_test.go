@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"bytes"
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
@@ -37,7 +38,7 @@ func TestDeleteObjectByTuple(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master-controller" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master-controller" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
 			default:
 				// Ensures no GET is performed when deleting by name
@@ -69,7 +70,7 @@ func TestDeleteNamedObject(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master-controller" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master-controller" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
 			default:
 				// Ensures no GET is performed when deleting by name
@@ -101,7 +102,7 @@ func TestDeleteObject(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -124,14 +125,90 @@ func TestDeleteObject(t *testing.T) {
 	}
 }
 
-func TestDeleteObjectNotFound(t *testing.T) {
+func TestDeleteObjectDryRun(t *testing.T) {
+	_, _, rc := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE" && req.URL.Query().Get("dryRun") == "true":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	// the reaper is not used for a dry run even though cascade defaults to true
+	cmd := NewCmdDelete(f, buf)
+	cmd.Flags().Set("filename", "../../../examples/guestbook/redis-master-controller.yaml")
+	cmd.Flags().Set("dry-run", "true")
+	cmd.Run(cmd, []string{})
+
+	if buf.String() != "replicationcontroller \"redis-master\" deleted (dry run)\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestDeleteObjectOrphansDependents(t *testing.T) {
+	_, _, rc := testData()
+
 	f, tf, codec := NewAPIFactory()
 	tf.Printer = &testPrinter{}
+	patched := false
 	tf.Client = &fake.RESTClient{
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "PATCH":
+				data, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !strings.Contains(string(data), `"`+api.OrphanDependentsAnnotation+`":"true"`) {
+					t.Errorf("unexpected patch: %s", data)
+				}
+				patched = true
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
 			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE":
+				if !patched {
+					t.Errorf("expected the controller to be annotated before it is deleted")
+				}
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdDelete(f, buf)
+	cmd.Flags().Set("filename", "../../../examples/guestbook/redis-master-controller.yaml")
+	cmd.Flags().Set("cascade", "false")
+	cmd.Flags().Set("output", "name")
+	cmd.Run(cmd, []string{})
+
+	if !patched {
+		t.Errorf("expected the controller to be annotated to orphan its pods")
+	}
+}
+
+func TestDeleteObjectNotFound(t *testing.T) {
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -161,7 +238,7 @@ func TestDeleteObjectIgnoreNotFound(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -200,9 +277,9 @@ func TestDeleteAllNotFound(t *testing.T) {
 			switch p, m := req.URL.Path, req.Method; {
 			case p == "/namespaces/test/services" && m == "GET":
 				return &http.Response{StatusCode: 200, Body: objBody(codec, svc)}, nil
-			case p == "/namespaces/test/services/foo" && m == "DELETE":
+			case p == "/namespaces/test/services/foo" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 404, Body: objBody(codec, notFoundError)}, nil
-			case p == "/namespaces/test/services/baz" && m == "DELETE":
+			case p == "/namespaces/test/services/baz" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -242,9 +319,9 @@ func TestDeleteAllIgnoreNotFound(t *testing.T) {
 			switch p, m := req.URL.Path, req.Method; {
 			case p == "/namespaces/test/services" && m == "GET":
 				return &http.Response{StatusCode: 200, Body: objBody(codec, svc)}, nil
-			case p == "/namespaces/test/services/foo" && m == "DELETE":
+			case p == "/namespaces/test/services/foo" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 404, Body: objBody(codec, notFoundError)}, nil
-			case p == "/namespaces/test/services/baz" && m == "DELETE":
+			case p == "/namespaces/test/services/baz" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -275,9 +352,9 @@ func TestDeleteMultipleObject(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
-			case p == "/namespaces/test/services/frontend" && m == "DELETE":
+			case p == "/namespaces/test/services/frontend" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -309,9 +386,9 @@ func TestDeleteMultipleObjectContinueOnMissing(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/redis-master" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/redis-master" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 404, Body: stringBody("")}, nil
-			case p == "/namespaces/test/services/frontend" && m == "DELETE":
+			case p == "/namespaces/test/services/frontend" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -346,13 +423,13 @@ func TestDeleteMultipleResourcesWithTheSameName(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case p == "/namespaces/test/replicationcontrollers/baz" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/baz" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
-			case p == "/namespaces/test/replicationcontrollers/foo" && m == "DELETE":
+			case p == "/namespaces/test/replicationcontrollers/foo" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
-			case p == "/namespaces/test/services/baz" && m == "DELETE":
+			case p == "/namespaces/test/services/baz" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
-			case p == "/namespaces/test/services/foo" && m == "DELETE":
+			case p == "/namespaces/test/services/foo" && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				// Ensures no GET is performed when deleting by name
@@ -382,9 +459,9 @@ func TestDeleteDirectory(t *testing.T) {
 		Codec: codec,
 		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
 			switch p, m := req.URL.Path, req.Method; {
-			case strings.HasPrefix(p, "/namespaces/test/services/") && m == "DELETE":
+			case strings.HasPrefix(p, "/namespaces/test/services/") && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
-			case strings.HasPrefix(p, "/namespaces/test/replicationcontrollers/") && m == "DELETE":
+			case strings.HasPrefix(p, "/namespaces/test/replicationcontrollers/") && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &rc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
@@ -425,9 +502,9 @@ func TestDeleteMultipleSelector(t *testing.T) {
 					t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
 				}
 				return &http.Response{StatusCode: 200, Body: objBody(codec, svc)}, nil
-			case strings.HasPrefix(p, "/namespaces/test/pods/") && m == "DELETE":
+			case strings.HasPrefix(p, "/namespaces/test/pods/") && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &pods.Items[0])}, nil
-			case strings.HasPrefix(p, "/namespaces/test/services/") && m == "DELETE":
+			case strings.HasPrefix(p, "/namespaces/test/services/") && (m == "PATCH" || m == "DELETE"):
 				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
 			default:
 				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
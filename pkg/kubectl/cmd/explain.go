@@ -81,5 +81,12 @@ func RunExplain(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []st
 		return err
 	}
 
-	return kubectl.PrintModelDescription(inModel, fieldsPath, out, swagSchema, recursive)
+	err = kubectl.PrintModelDescription(inModel, fieldsPath, out, swagSchema, recursive)
+	if err != nil && client.ExperimentalClient != nil {
+		// The resource may be added by a ThirdPartyResource, which describes it with its schema.
+		if rsrc, tprErr := kubectl.GetThirdPartyResource(client.ExperimentalClient, inModel); tprErr == nil && rsrc != nil {
+			return kubectl.PrintThirdPartyResourceDescription(rsrc, fieldsPath, out, recursive)
+		}
+	}
+	return err
 }
@@ -67,6 +67,7 @@ func NewCmdPatch(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	}
 	cmd.Flags().StringP("patch", "p", "", "The patch to be applied to the resource JSON file.")
 	cmd.MarkFlagRequired("patch")
+	cmdutil.AddDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 
 	usage := "Filename, directory, or URL to a file identifying the resource to update"
@@ -113,11 +114,13 @@ func RunPatch(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []stri
 		return err
 	}
 
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	helper := resource.NewHelper(client, mapping)
+	helper.DryRun = dryRun
 	_, err = helper.Patch(namespace, name, api.StrategicMergePatchType, []byte(patch))
 	if err != nil {
 		return err
 	}
-	cmdutil.PrintSuccess(mapper, shortOutput, out, "", name, "patched")
+	cmdutil.PrintSuccess(mapper, shortOutput, out, "", name, cmdutil.DryRunOperation("patched", dryRun))
 	return nil
 }
@@ -56,6 +56,39 @@ func TestPatchObject(t *testing.T) {
 	}
 }
 
+func TestPatchObjectDryRun(t *testing.T) {
+	_, svc, _ := testData()
+
+	f, tf, codec := NewAPIFactory()
+	tf.Printer = &testPrinter{}
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch p, m := req.URL.Path, req.Method; {
+			case p == "/namespaces/test/services/frontend" && m == "GET":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
+			case p == "/namespaces/test/services/frontend" && m == "PATCH" && req.URL.Query().Get("dryRun") == "true":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, &svc.Items[0])}, nil
+			default:
+				t.Fatalf("unexpected request: %#v\n%#v", req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.Namespace = "test"
+	buf := bytes.NewBuffer([]byte{})
+
+	cmd := NewCmdPatch(f, buf)
+	cmd.Flags().Set("namespace", "test")
+	cmd.Flags().Set("patch", `{"spec":{"type":"NodePort"}}`)
+	cmd.Flags().Set("dry-run", "true")
+	cmd.Run(cmd, []string{"services/frontend"})
+
+	if buf.String() != "\"frontend\" patched (dry run)\n" {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
 func TestPatchObjectFromFile(t *testing.T) {
 	_, svc, _ := testData()
 
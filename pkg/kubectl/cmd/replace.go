@@ -82,6 +82,7 @@ func NewCmdReplace(f *cmdutil.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().Int("grace-period", -1, "Only relevant during a force replace. Period of time in seconds given to the old resource to terminate gracefully. Ignored if negative.")
 	cmd.Flags().Duration("timeout", 0, "Only relevant during a force replace. The length of time to wait before giving up on a delete of the old resource, zero means determine a timeout from the size of the object")
 	cmdutil.AddValidateFlags(cmd)
+	cmdutil.AddDryRunFlag(cmd)
 	cmdutil.AddOutputFlagsForMutation(cmd)
 	return cmd
 }
@@ -105,8 +106,12 @@ func RunReplace(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []st
 		return cmdutil.UsageError(cmd, "Must specify --filename to replace")
 	}
 
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 	shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
 	if force {
+		if dryRun {
+			return cmdutil.UsageError(cmd, "--dry-run cannot be used with --force")
+		}
 		return forceReplace(f, out, cmd, args, shortOutput, options)
 	}
 
@@ -131,13 +136,15 @@ func RunReplace(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []st
 		if err != nil {
 			return cmdutil.AddSourceToErr("replacing", info.Source, err)
 		}
-		obj, err := resource.NewHelper(info.Client, info.Mapping).Replace(info.Namespace, info.Name, true, data)
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		helper.DryRun = dryRun
+		obj, err := helper.Replace(info.Namespace, info.Name, true, data)
 		if err != nil {
 			return cmdutil.AddSourceToErr("replacing", info.Source, err)
 		}
 		info.Refresh(obj, true)
 		printObjectSpecificMessage(obj, out)
-		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "replaced")
+		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, cmdutil.DryRunOperation("replaced", dryRun))
 		return nil
 	})
 }
@@ -188,7 +195,7 @@ func forceReplace(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []
 		glog.Warningf("\"cascade\" is set, kubectl will delete and re-create all resources managed by this resource (e.g. Pods created by a ReplicationController). Consider using \"kubectl rolling-update\" if you want to update a ReplicationController together with its Pods.")
 		err = ReapResult(r, f, out, cmdutil.GetFlagBool(cmd, "cascade"), ignoreNotFound, cmdutil.GetFlagDuration(cmd, "timeout"), cmdutil.GetFlagInt(cmd, "grace-period"), shortOutput, mapper)
 	} else {
-		err = DeleteResult(r, out, ignoreNotFound, shortOutput, mapper)
+		err = DeleteResult(r, out, ignoreNotFound, true, false, shortOutput, mapper)
 	}
 	if err != nil {
 		return err
@@ -0,0 +1,264 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubernetes/pkg/api"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+const (
+	taint_long = `Update the taints on one or more nodes.
+
+A taint consists of a key, a value and an effect. As an argument here, it is expressed as key=value:effect.
+The key must begin with a letter or number, and may contain letters, numbers, hyphens, dots, and underscores, up to %[1]d characters.
+The value must begin with a letter or number, and may contain letters, numbers, hyphens, dots, and underscores, up to %[1]d characters.
+The effect must be NoSchedule or PreferNoSchedule.
+If --overwrite is true, then existing taints with the same key and effect can be overwritten, otherwise attempting to overwrite a taint will result in an error.`
+	taint_example = `# Update node 'foo' with a taint with key 'dedicated' and value 'special-user' and effect 'NoSchedule'.
+# If a taint with that key and effect already exists, its value is replaced as specified.
+$ kubectl taint nodes foo dedicated=special-user:NoSchedule --overwrite
+
+# Remove from node 'foo' the taint with key 'dedicated' and effect 'NoSchedule' if one exists.
+$ kubectl taint nodes foo dedicated:NoSchedule-
+
+# Remove from node 'foo' all the taints with key 'dedicated'.
+$ kubectl taint nodes foo dedicated-`
+)
+
+func NewCmdTaint(f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "taint [--overwrite] NODE NAME KEY_1=VAL_1:TAINT_EFFECT_1 ... KEY_N=VAL_N:TAINT_EFFECT_N",
+		Short:   "Update the taints on one or more nodes",
+		Long:    fmt.Sprintf(taint_long, validation.LabelValueMaxLength),
+		Example: taint_example,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := RunTaint(f, out, cmd, args)
+			cmdutil.CheckErr(err)
+		},
+		ValidArgs: []string{"node"},
+	}
+	cmdutil.AddPrinterFlags(cmd)
+	cmd.Flags().Bool("overwrite", false, "If true, allow taints to be overwritten, otherwise reject taint updates that overwrite existing taints.")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
+	cmd.Flags().Bool("all", false, "select all nodes in the cluster")
+	return cmd
+}
+
+// parseTaint parses a taint of the form key=value:effect, or key:effect for a taint with an empty value.
+func parseTaint(spec string) (api.Taint, error) {
+	var taint api.Taint
+	parts := strings.Split(spec, ":")
+	if len(parts) != 2 {
+		return taint, fmt.Errorf("invalid taint spec: %v", spec)
+	}
+	taint.Effect = api.TaintEffect(parts[1])
+	if err := validateTaintEffect(taint.Effect); err != nil {
+		return taint, err
+	}
+	keyValue := strings.Split(parts[0], "=")
+	switch len(keyValue) {
+	case 1:
+		taint.Key = keyValue[0]
+	case 2:
+		taint.Key, taint.Value = keyValue[0], keyValue[1]
+		if !validation.IsValidLabelValue(taint.Value) {
+			return taint, fmt.Errorf("invalid taint spec: %v", spec)
+		}
+	default:
+		return taint, fmt.Errorf("invalid taint spec: %v", spec)
+	}
+	if !validation.IsQualifiedName(taint.Key) {
+		return taint, fmt.Errorf("invalid taint spec: %v", spec)
+	}
+	return taint, nil
+}
+
+func validateTaintEffect(effect api.TaintEffect) error {
+	if effect != api.TaintEffectNoSchedule && effect != api.TaintEffectPreferNoSchedule {
+		return fmt.Errorf("invalid taint effect: %v, unsupported taint effect", effect)
+	}
+	return nil
+}
+
+// parseTaints splits the taint arguments into the taints to add and the taints to remove. A taint to
+// remove with an empty effect stands for the taints of the key with any effect.
+func parseTaints(spec []string) ([]api.Taint, []api.Taint, error) {
+	var taints, remove []api.Taint
+	for _, taintSpec := range spec {
+		if strings.HasSuffix(taintSpec, "-") {
+			var taint api.Taint
+			parts := strings.Split(taintSpec[:len(taintSpec)-1], ":")
+			switch len(parts) {
+			case 1:
+				taint.Key = parts[0]
+			case 2:
+				taint.Key, taint.Effect = parts[0], api.TaintEffect(parts[1])
+				if err := validateTaintEffect(taint.Effect); err != nil {
+					return nil, nil, err
+				}
+			default:
+				return nil, nil, fmt.Errorf("unknown taint spec: %v", taintSpec)
+			}
+			if !validation.IsQualifiedName(taint.Key) {
+				return nil, nil, fmt.Errorf("unknown taint spec: %v", taintSpec)
+			}
+			remove = append(remove, taint)
+		} else {
+			taint, err := parseTaint(taintSpec)
+			if err != nil {
+				return nil, nil, err
+			}
+			taints = append(taints, taint)
+		}
+	}
+	keyEffects := sets.NewString()
+	for _, taint := range taints {
+		keyEffect := taint.Key + ":" + string(taint.Effect)
+		if keyEffects.Has(keyEffect) {
+			return nil, nil, fmt.Errorf("can not add the taint %s more than once in the same command", keyEffect)
+		}
+		keyEffects.Insert(keyEffect)
+		for _, removeTaint := range remove {
+			if taint.Key == removeTaint.Key && (len(removeTaint.Effect) == 0 || taint.Effect == removeTaint.Effect) {
+				return nil, nil, fmt.Errorf("can not both modify and remove a taint in the same command")
+			}
+		}
+	}
+	return taints, remove, nil
+}
+
+func validateNoTaintOverwrites(node *api.Node, taints []api.Taint) error {
+	allErrs := []error{}
+	for _, taint := range taints {
+		for _, oldTaint := range node.Spec.Taints {
+			if taint.Key == oldTaint.Key && taint.Effect == oldTaint.Effect {
+				allErrs = append(allErrs, fmt.Errorf("node %s already has %s taint(s) with same effect(s) and --overwrite is false", node.Name, taint.Key))
+			}
+		}
+	}
+	return errors.NewAggregate(allErrs)
+}
+
+func taintFunc(obj runtime.Object, overwrite bool, taints []api.Taint, remove []api.Taint) error {
+	node, ok := obj.(*api.Node)
+	if !ok {
+		return fmt.Errorf("unexpected type %T, taints can only be set on nodes", obj)
+	}
+	if !overwrite {
+		if err := validateNoTaintOverwrites(node, taints); err != nil {
+			return err
+		}
+	}
+
+	newTaints := []api.Taint{}
+	for _, oldTaint := range node.Spec.Taints {
+		keep := true
+		for _, taint := range taints {
+			if taint.Key == oldTaint.Key && taint.Effect == oldTaint.Effect {
+				keep = false
+			}
+		}
+		for _, taint := range remove {
+			if taint.Key == oldTaint.Key && (len(taint.Effect) == 0 || taint.Effect == oldTaint.Effect) {
+				keep = false
+			}
+		}
+		if keep {
+			newTaints = append(newTaints, oldTaint)
+		}
+	}
+	node.Spec.Taints = append(newTaints, taints...)
+	return nil
+}
+
+func RunTaint(f *cmdutil.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	resources, taintArgs := []string{}, []string{}
+	first := true
+	for _, s := range args {
+		isTaint := strings.Contains(s, "=") || strings.Contains(s, ":") || strings.HasSuffix(s, "-")
+		switch {
+		case first && isTaint:
+			first = false
+			fallthrough
+		case !first && isTaint:
+			taintArgs = append(taintArgs, s)
+		case first && !isTaint:
+			resources = append(resources, s)
+		case !first && !isTaint:
+			return cmdutil.UsageError(cmd, "all resources must be specified before taint changes: %s", s)
+		}
+	}
+	if len(resources) < 1 {
+		return cmdutil.UsageError(cmd, "one or more resources must be specified as <resource> <name> or <resource>/<name>")
+	}
+	if len(taintArgs) < 1 {
+		return cmdutil.UsageError(cmd, "at least one taint update is required")
+	}
+
+	selector := cmdutil.GetFlagString(cmd, "selector")
+	all := cmdutil.GetFlagBool(cmd, "all")
+	overwrite := cmdutil.GetFlagBool(cmd, "overwrite")
+
+	taints, remove, err := parseTaints(taintArgs)
+	if err != nil {
+		return cmdutil.UsageError(cmd, "%v", err)
+	}
+	mapper, typer := f.Object()
+	r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		SelectorParam(selector).
+		ResourceTypeOrNameArgs(all, resources...).
+		Flatten().
+		Latest().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	return r.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mapping.Kind != "Node" {
+			return fmt.Errorf("taints can only be set on nodes, not on %s %q", info.Mapping.Resource, info.Name)
+		}
+
+		outputObj, err := cmdutil.UpdateObject(info, func(obj runtime.Object) error {
+			return taintFunc(obj, overwrite, taints, remove)
+		})
+		if err != nil {
+			return err
+		}
+		outputFormat := cmdutil.GetFlagString(cmd, "output")
+		if outputFormat != "" {
+			return f.PrintObject(cmd, outputObj, out)
+		}
+		cmdutil.PrintSuccess(mapper, false, out, info.Mapping.Resource, info.Name, "tainted")
+		return nil
+	})
+}
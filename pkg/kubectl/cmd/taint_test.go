@@ -0,0 +1,205 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+)
+
+func TestParseTaints(t *testing.T) {
+	tests := []struct {
+		taints         []string
+		expectedTaints []api.Taint
+		expectedRemove []api.Taint
+		expectErr      bool
+	}{
+		{
+			taints:         []string{"a=b:NoSchedule", "c:PreferNoSchedule"},
+			expectedTaints: []api.Taint{{Key: "a", Value: "b", Effect: api.TaintEffectNoSchedule}, {Key: "c", Effect: api.TaintEffectPreferNoSchedule}},
+		},
+		{
+			taints:         []string{"a=b:NoSchedule", "c:PreferNoSchedule-", "d-"},
+			expectedTaints: []api.Taint{{Key: "a", Value: "b", Effect: api.TaintEffectNoSchedule}},
+			expectedRemove: []api.Taint{{Key: "c", Effect: api.TaintEffectPreferNoSchedule}, {Key: "d"}},
+		},
+		{
+			taints:    []string{"a=b"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a=b:Evict"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a=b=c:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a=b c:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a:Evict-"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a=b:NoSchedule", "a=c:NoSchedule"},
+			expectErr: true,
+		},
+		{
+			taints:    []string{"a=b:NoSchedule", "a-"},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		taints, remove, err := parseTaints(test.taints)
+		if test.expectErr && err == nil {
+			t.Errorf("unexpected non-error: %v", test)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("unexpected error: %v %v", err, test)
+		}
+		if !reflect.DeepEqual(taints, test.expectedTaints) {
+			t.Errorf("expected: %v, got %v", test.expectedTaints, taints)
+		}
+		if !reflect.DeepEqual(remove, test.expectedRemove) {
+			t.Errorf("expected: %v, got %v", test.expectedRemove, remove)
+		}
+	}
+}
+
+func TestTaintFunc(t *testing.T) {
+	noSchedule := api.Taint{Key: "a", Value: "b", Effect: api.TaintEffectNoSchedule}
+	preferNoSchedule := api.Taint{Key: "a", Value: "b", Effect: api.TaintEffectPreferNoSchedule}
+	other := api.Taint{Key: "c", Value: "d", Effect: api.TaintEffectNoSchedule}
+	tests := []struct {
+		taints    []api.Taint
+		overwrite bool
+		add       []api.Taint
+		remove    []api.Taint
+		expected  []api.Taint
+		expectErr bool
+	}{
+		{
+			taints:   []api.Taint{noSchedule},
+			add:      []api.Taint{other},
+			expected: []api.Taint{noSchedule, other},
+		},
+		{
+			taints:    []api.Taint{noSchedule},
+			add:       []api.Taint{{Key: "a", Value: "c", Effect: api.TaintEffectNoSchedule}},
+			expectErr: true,
+		},
+		{
+			taints:    []api.Taint{noSchedule, other},
+			add:       []api.Taint{{Key: "a", Value: "c", Effect: api.TaintEffectNoSchedule}},
+			overwrite: true,
+			expected:  []api.Taint{other, {Key: "a", Value: "c", Effect: api.TaintEffectNoSchedule}},
+		},
+		{
+			taints:   []api.Taint{noSchedule, preferNoSchedule, other},
+			remove:   []api.Taint{{Key: "a", Effect: api.TaintEffectNoSchedule}},
+			expected: []api.Taint{preferNoSchedule, other},
+		},
+		{
+			taints:   []api.Taint{noSchedule, preferNoSchedule, other},
+			remove:   []api.Taint{{Key: "a"}},
+			expected: []api.Taint{other},
+		},
+		{
+			taints:   []api.Taint{other},
+			remove:   []api.Taint{{Key: "a"}},
+			expected: []api.Taint{other},
+		},
+	}
+	for i, test := range tests {
+		node := &api.Node{Spec: api.NodeSpec{Taints: test.taints}}
+		err := taintFunc(node, test.overwrite, test.add, test.remove)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: unexpected non-error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+		if !reflect.DeepEqual(node.Spec.Taints, test.expected) {
+			t.Errorf("%d: expected: %v, got %v", i, test.expected, node.Spec.Taints)
+		}
+	}
+
+	if err := taintFunc(&api.Pod{}, false, []api.Taint{other}, nil); err == nil {
+		t.Errorf("unexpected non-error for a pod")
+	}
+}
+
+func TestTaintNode(t *testing.T) {
+	node := &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: "node1", ResourceVersion: "10"},
+		Spec:       api.NodeSpec{Taints: []api.Taint{{Key: "a", Value: "b", Effect: api.TaintEffectNoSchedule}}},
+	}
+	f, tf, codec := NewAPIFactory()
+	var updated *api.Node
+	tf.Client = &fake.RESTClient{
+		Codec: codec,
+		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/nodes/node1":
+				return &http.Response{StatusCode: 200, Body: objBody(codec, node)}, nil
+			case req.Method == "PUT" && req.URL.Path == "/nodes/node1":
+				data, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				obj, err := codec.Decode(data)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				updated = obj.(*api.Node)
+				return &http.Response{StatusCode: 200, Body: objBody(codec, updated)}, nil
+			default:
+				t.Fatalf("unexpected request: %s %#v\n%#v", req.Method, req.URL, req)
+				return nil, nil
+			}
+		}),
+	}
+	tf.ClientConfig = &client.Config{Version: testapi.Default.Version()}
+
+	buf := bytes.NewBuffer([]byte{})
+	cmd := NewCmdTaint(f, buf)
+	if err := RunTaint(f, buf, cmd, []string{"nodes", "node1", "c=d:PreferNoSchedule", "a-"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "tainted") {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+	expected := []api.Taint{{Key: "c", Value: "d", Effect: api.TaintEffectPreferNoSchedule}}
+	if updated == nil || !reflect.DeepEqual(updated.Spec.Taints, expected) {
+		t.Errorf("expected taints %v, got %#v", expected, updated)
+	}
+}
@@ -45,6 +45,15 @@ func AddOutputFlagsForMutation(cmd *cobra.Command) {
 	cmd.Flags().StringP("output", "o", "", "Output mode. Use \"-o name\" for shorter output (resource/name).")
 }
 
+// DryRunOperation returns the past tense operation reported by PrintSuccess, marked
+// as not persisted if dryRun is true.
+func DryRunOperation(operation string, dryRun bool) string {
+	if dryRun {
+		return operation + " (dry run)"
+	}
+	return operation
+}
+
 // PrintSuccess prints message after finishing mutating operations
 func PrintSuccess(mapper meta.RESTMapper, shortOutput bool, out io.Writer, resource string, name string, operation string) {
 	resource, _ = mapper.ResourceSingularizer(resource)
@@ -70,6 +70,7 @@ func describerMap(c *client.Client) map[string]Describer {
 		"Pod": &PodDescriber{c},
 		"ReplicationController": &ReplicationControllerDescriber{c},
 		"Secret":                &SecretDescriber{c},
+		"ConfigMap":             &ConfigMapDescriber{c},
 		"Service":               &ServiceDescriber{c},
 		"ServiceAccount":        &ServiceAccountDescriber{c},
 		"Minion":                &NodeDescriber{c},
@@ -518,6 +519,8 @@ func describeVolumes(volumes []api.Volume, out io.Writer) {
 			printGitRepoVolumeSource(volume.VolumeSource.GitRepo, out)
 		case volume.VolumeSource.Secret != nil:
 			printSecretVolumeSource(volume.VolumeSource.Secret, out)
+		case volume.VolumeSource.ConfigMap != nil:
+			printConfigMapVolumeSource(volume.VolumeSource.ConfigMap, out)
 		case volume.VolumeSource.NFS != nil:
 			printNFSVolumeSource(volume.VolumeSource.NFS, out)
 		case volume.VolumeSource.ISCSI != nil:
@@ -574,6 +577,11 @@ func printSecretVolumeSource(secret *api.SecretVolumeSource, out io.Writer) {
 		"    SecretName:\t%v\n", secret.SecretName)
 }
 
+func printConfigMapVolumeSource(configMap *api.ConfigMapVolumeSource, out io.Writer) {
+	fmt.Fprintf(out, "    Type:\tConfigMap (a configMap that should populate this volume)\n"+
+		"    Name:\t%v\n", configMap.Name)
+}
+
 func printNFSVolumeSource(nfs *api.NFSVolumeSource, out io.Writer) {
 	fmt.Fprintf(out, "    Type:\tNFS (an NFS mount that lasts the lifetime of a pod)\n"+
 		"    Server:\t%v\n"+
@@ -983,6 +991,38 @@ func describeSecret(secret *api.Secret) (string, error) {
 	})
 }
 
+// ConfigMapDescriber generates information about a config map
+type ConfigMapDescriber struct {
+	client.Interface
+}
+
+func (d *ConfigMapDescriber) Describe(namespace, name string) (string, error) {
+	c := d.ConfigMaps(namespace)
+
+	configMap, err := c.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return describeConfigMap(configMap)
+}
+
+func describeConfigMap(configMap *api.ConfigMap) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", configMap.Name)
+		fmt.Fprintf(out, "Namespace:\t%s\n", configMap.Namespace)
+		fmt.Fprintf(out, "Labels:\t%s\n", labels.FormatLabels(configMap.Labels))
+		fmt.Fprintf(out, "Annotations:\t%s\n", labels.FormatLabels(configMap.Annotations))
+
+		fmt.Fprintf(out, "\nData\n====\n")
+		for k, v := range configMap.Data {
+			fmt.Fprintf(out, "%s:\t%d bytes\n", k, len(v))
+		}
+
+		return nil
+	})
+}
+
 // ServiceDescriber generates information about a service.
 type ServiceDescriber struct {
 	client.Interface
@@ -1175,6 +1215,7 @@ func describeNode(node *api.Node, pods []*api.Pod, events *api.EventList) (strin
 	return tabbedString(func(out io.Writer) error {
 		fmt.Fprintf(out, "Name:\t%s\n", node.Name)
 		fmt.Fprintf(out, "Labels:\t%s\n", labels.FormatLabels(node.Labels))
+		fmt.Fprintf(out, "Taints:\t%s\n", formatTaints(node.Spec.Taints))
 		fmt.Fprintf(out, "CreationTimestamp:\t%s\n", node.CreationTimestamp.Time.Format(time.RFC1123Z))
 		fmt.Fprintf(out, "Phase:\t%v\n", node.Status.Phase)
 		if len(node.Status.Conditions) > 0 {
@@ -1278,6 +1319,22 @@ func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string) (str
 	})
 }
 
+// formatTaints formats the taints of a node as key=value:effect, in the form kubectl taint accepts them.
+func formatTaints(taints []api.Taint) string {
+	if len(taints) == 0 {
+		return "<none>"
+	}
+	list := []string{}
+	for _, taint := range taints {
+		if len(taint.Value) > 0 {
+			list = append(list, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		} else {
+			list = append(list, fmt.Sprintf("%s:%s", taint.Key, taint.Effect))
+		}
+	}
+	return strings.Join(list, ",")
+}
+
 func describeNodeResource(pods []*api.Pod, node *api.Node, out io.Writer) error {
 	nonTerminatedPods := filterTerminatedPods(pods)
 	fmt.Fprintf(out, "Non-terminated Pods:\t(%d in total)\n", len(nonTerminatedPods))
@@ -78,6 +78,29 @@ func TestDescribeService(t *testing.T) {
 	}
 }
 
+func TestDescribeNode(t *testing.T) {
+	fake := testclient.NewSimpleFake(&api.Node{
+		ObjectMeta: api.ObjectMeta{
+			Name: "bar",
+		},
+		Spec: api.NodeSpec{
+			Taints: []api.Taint{
+				{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule},
+				{Key: "gpu", Effect: api.TaintEffectPreferNoSchedule},
+			},
+		},
+	})
+	c := &describeClient{T: t, Namespace: "", Interface: fake}
+	d := NodeDescriber{c}
+	out, err := d.Describe("", "bar")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "bar") || !strings.Contains(out, "dedicated=team-a:NoSchedule,gpu:PreferNoSchedule") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
 func TestPodDescribeResultsSorted(t *testing.T) {
 	// Arrange
 	fake := testclient.NewSimpleFake(&api.EventList{
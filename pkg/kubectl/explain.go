@@ -19,12 +19,16 @@ package kubectl
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/emicklei/go-restful/swagger"
 
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/apis/experimental"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
 )
 
 var allModels = make(map[string]*swagger.NamedModel)
@@ -84,6 +88,124 @@ func PrintModelDescription(inModel string, fieldsPath []string, w io.Writer, swa
 	return printModelInfo(w, pointedModel, pointedModelAsProp)
 }
 
+// GetThirdPartyResource returns the ThirdPartyResource which adds resource to the API, or nil if there is none.
+func GetThirdPartyResource(c *client.ExperimentalClient, resource string) (*experimental.ThirdPartyResource, error) {
+	obj, err := c.Get().Namespace(api.NamespaceDefault).Resource("thirdpartyresources").Do().Get()
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.(*experimental.ThirdPartyResourceList)
+	if !ok {
+		return nil, fmt.Errorf("expected a *ThirdPartyResourceList, got %#v", obj)
+	}
+	resource = strings.ToLower(resource)
+	for i := range list.Items {
+		kind, _, err := thirdpartyresourcedata.ExtractApiGroupAndKind(&list.Items[i])
+		if err != nil || len(list.Items[i].Versions) == 0 {
+			continue
+		}
+		kind = strings.ToLower(kind)
+		if resource == kind || resource == kind+"s" {
+			return &list.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// PrintThirdPartyResourceDescription prints the description of the objects added by a
+// ThirdPartyResource, or of a dot path within them, from the schema of its served version.
+func PrintThirdPartyResourceDescription(rsrc *experimental.ThirdPartyResource, fieldsPath []string, w io.Writer, r bool) error {
+	recursive = r // this is global for convenience
+	schema := rsrc.Versions[0].Schema
+	if schema == nil {
+		schema = &experimental.JSONSchemaProps{}
+	}
+	if len(fieldsPath) == 0 {
+		fmt.Fprintf(w, "DESCRIPTION:\n%s\n", rsrc.Description)
+		return printSchemaFields(w, schema)
+	}
+
+	name := ""
+	for _, field := range fieldsPath {
+		if schema.Type == "array" && schema.Items != nil {
+			schema = schema.Items
+		}
+		prop, found := schema.Properties[field]
+		if !found {
+			return fmt.Errorf("field: %s doesn't exist", field)
+		}
+		name, schema = field, &prop
+	}
+	if len(schemaFields(schema).Properties) == 0 {
+		fmt.Fprintf(w, "FIELD: %s <%s>\n\n", name, getSchemaType(schema))
+		d, _ := wrapAndIndentText(schema.Description, "    ", 80)
+		fmt.Fprintf(w, "DESCRIPTION:\n%s\n", d)
+		return nil
+	}
+	fmt.Fprintf(w, "RESOURCE: %s <%s>\n\n", name, getSchemaType(schema))
+	d, _ := wrapAndIndentText(schema.Description, "    ", 80)
+	fmt.Fprintf(w, "DESCRIPTION:\n%s\n", d)
+	return printSchemaFields(w, schemaFields(schema))
+}
+
+// schemaFields returns the schema describing the fields of values of schema, which are the
+// elements for arrays.
+func schemaFields(schema *experimental.JSONSchemaProps) *experimental.JSONSchemaProps {
+	if schema.Type == "array" && schema.Items != nil {
+		return schema.Items
+	}
+	return schema
+}
+
+func printSchemaFields(w io.Writer, schema *experimental.JSONSchemaProps) error {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprint(w, "\nFIELDS:\n")
+	for _, name := range names {
+		prop := schema.Properties[name]
+		if arrayContains(schema.Required, name) {
+			fmt.Fprintf(w, "   %s\t<%s> -required-\n", name, getSchemaType(&prop))
+		} else {
+			fmt.Fprintf(w, "   %s\t<%s>\n", name, getSchemaType(&prop))
+		}
+
+		if recursive {
+			nested := schemaFields(&prop)
+			nestedNames := make([]string, 0, len(nested.Properties))
+			for nestedName := range nested.Properties {
+				nestedNames = append(nestedNames, nestedName)
+			}
+			sort.Strings(nestedNames)
+			for _, nestedName := range nestedNames {
+				nestedProp := nested.Properties[nestedName]
+				fmt.Fprintf(w, "       %s\t<%s>\n", nestedName, getSchemaType(&nestedProp))
+			}
+		} else {
+			fieldDesc, _ := wrapAndIndentText(prop.Description, "    ", 80)
+			fmt.Fprintf(w, "%s\n\n", fieldDesc)
+		}
+	}
+	fmt.Fprint(w, "\n")
+	return nil
+}
+
+func getSchemaType(schema *experimental.JSONSchemaProps) string {
+	switch schema.Type {
+	case "", "object":
+		return "Object"
+	case "array":
+		if schema.Items == nil {
+			return "[]Object"
+		}
+		return "[]" + getSchemaType(schema.Items)
+	}
+	return schema.Type
+}
+
 func splitDotNotation(model string) (string, []string) {
 	var fieldsPath []string
 	dotModel := strings.Split(model, ".")
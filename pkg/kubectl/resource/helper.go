@@ -19,6 +19,7 @@ package resource
 import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/watch"
@@ -38,6 +39,9 @@ type Helper struct {
 	Versioner runtime.ResourceVersioner
 	// True if the resource type is scoped to namespaces
 	NamespaceScoped bool
+	// If true, create, replace, patch and delete requests are checked by the
+	// server without being persisted
+	DryRun bool
 }
 
 // NewHelper creates a Helper from a ResourceMapping
@@ -91,7 +95,7 @@ func (m *Helper) WatchSingle(namespace, name, resourceVersion string) (watch.Int
 }
 
 func (m *Helper) Delete(namespace, name string) error {
-	return m.RESTClient.Delete().
+	return m.dryRunParam(m.RESTClient.Delete()).
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		Name(name).
@@ -129,10 +133,10 @@ func (m *Helper) Create(namespace string, modify bool, data []byte) (runtime.Obj
 }
 
 func (m *Helper) createResource(c RESTClient, resource, namespace string, data []byte) (runtime.Object, error) {
-	return c.Post().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(data).Do().Get()
+	return m.dryRunParam(c.Post()).NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Body(data).Do().Get()
 }
 func (m *Helper) Patch(namespace, name string, pt api.PatchType, data []byte) (runtime.Object, error) {
-	return m.RESTClient.Patch(pt).
+	return m.dryRunParam(m.RESTClient.Patch(pt)).
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
 		Name(name).
@@ -181,5 +185,13 @@ func (m *Helper) Replace(namespace, name string, overwrite bool, data []byte) (r
 }
 
 func (m *Helper) replaceResource(c RESTClient, resource, namespace, name string, data []byte) (runtime.Object, error) {
-	return c.Put().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(data).Do().Get()
+	return m.dryRunParam(c.Put()).NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(data).Do().Get()
+}
+
+// dryRunParam asks the server not to persist req if the helper is in dry run mode.
+func (m *Helper) dryRunParam(req *client.Request) *client.Request {
+	if m.DryRun {
+		return req.Param("dryRun", "true")
+	}
+	return req
 }
@@ -876,6 +876,12 @@ func TestPrintHumanReadableWithNamespace(t *testing.T) {
 			},
 			isNamespaced: true,
 		},
+		{
+			obj: &api.ConfigMap{
+				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
+			},
+			isNamespaced: true,
+		},
 		{
 			obj: &api.ServiceAccount{
 				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
@@ -890,6 +896,13 @@ func TestPrintHumanReadableWithNamespace(t *testing.T) {
 			},
 			isNamespaced: false,
 		},
+		{
+			obj: &api.PriorityClass{
+				ObjectMeta: api.ObjectMeta{Name: name},
+				Value:      1000,
+			},
+			isNamespaced: false,
+		},
 		{
 			obj: &api.PersistentVolume{
 				ObjectMeta: api.ObjectMeta{Name: name, Namespace: namespaceName},
@@ -513,6 +513,22 @@ func Rename(c client.ReplicationControllersNamespacer, rc *api.ReplicationContro
 	if err != nil {
 		return err
 	}
+	// the pods of the old controller are taken over by the renamed one, so the
+	// garbage collector must not delete them along with it
+	oldRc, err := c.ReplicationControllers(rc.Namespace).Get(oldName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if oldRc.Annotations == nil {
+		oldRc.Annotations = map[string]string{}
+	}
+	oldRc.Annotations[api.OrphanDependentsAnnotation] = "true"
+	if _, err := c.ReplicationControllers(rc.Namespace).Update(oldRc); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
 	err = c.ReplicationControllers(rc.Namespace).Delete(oldName)
 	if err != nil && !errors.IsNotFound(err) {
 		return err
@@ -792,6 +792,8 @@ func TestRollingUpdater_cleanupWithClients(t *testing.T) {
 				"get",
 				"delete",
 				"create",
+				"get",
+				"update",
 				"delete",
 			},
 		},
@@ -1075,6 +1075,7 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 	// 3.  Add remaining service environment vars
 
 	tmpEnv := make(map[string]string)
+	configMaps := make(map[string]*api.ConfigMap)
 	mappingFunc := expansion.MappingFuncFor(tmpEnv, serviceEnv)
 	for _, envVar := range container.Env {
 		// Accesses apiserver+Pods.
@@ -1088,11 +1089,19 @@ func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Contain
 		if runtimeVal != "" {
 			// Step 1a: expand variable references
 			runtimeVal = expansion.Expand(runtimeVal, mappingFunc)
-		} else if envVar.ValueFrom != nil && envVar.ValueFrom.FieldRef != nil {
+		} else if envVar.ValueFrom != nil {
 			// Step 1b: resolve alternate env var sources
-			runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
-			if err != nil {
-				return result, err
+			switch {
+			case envVar.ValueFrom.FieldRef != nil:
+				runtimeVal, err = kl.podFieldSelectorRuntimeValue(envVar.ValueFrom.FieldRef, pod)
+				if err != nil {
+					return result, err
+				}
+			case envVar.ValueFrom.ConfigMapKeyRef != nil:
+				runtimeVal, err = kl.configMapKeyRuntimeValue(envVar.ValueFrom.ConfigMapKeyRef, pod.Namespace, configMaps)
+				if err != nil {
+					return result, err
+				}
 			}
 		}
 
@@ -1119,6 +1128,29 @@ func (kl *Kubelet) podFieldSelectorRuntimeValue(fs *api.ObjectFieldSelector, pod
 	return fieldpath.ExtractFieldPathAsString(pod, internalFieldPath)
 }
 
+// configMapKeyRuntimeValue returns the value of the selected key of a config map in the given
+// namespace. Config maps already retrieved are remembered in configMaps so that a config map
+// referenced by several variables is only fetched once.
+func (kl *Kubelet) configMapKeyRuntimeValue(selector *api.ConfigMapKeySelector, namespace string, configMaps map[string]*api.ConfigMap) (string, error) {
+	configMap, ok := configMaps[selector.Name]
+	if !ok {
+		if kl.kubeClient == nil {
+			return "", fmt.Errorf("cannot get config map %s/%s because kube client is not configured", namespace, selector.Name)
+		}
+		var err error
+		configMap, err = kl.kubeClient.ConfigMaps(namespace).Get(selector.Name)
+		if err != nil {
+			return "", err
+		}
+		configMaps[selector.Name] = configMap
+	}
+	value, ok := configMap.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("couldn't find key %s in config map %s/%s", selector.Key, namespace, selector.Name)
+	}
+	return value, nil
+}
+
 // getClusterDNS returns a list of the DNS servers and a list of the DNS search
 // domains of the cluster.
 func (kl *Kubelet) getClusterDNS(pod *api.Pod) ([]string, []string, error) {
@@ -1455,6 +1455,136 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 	}
 }
 
+func TestMakeEnvironmentVariablesFromConfigMap(t *testing.T) {
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Namespace: "test1", Name: "test-config-map"},
+		Data: map[string]string{
+			"a": "b",
+			"c": "d",
+		},
+	}
+	testCases := []struct {
+		name         string
+		configMaps   []runtime.Object
+		container    *api.Container
+		expectedEnvs []kubecontainer.EnvVar
+		expectErr    bool
+	}{
+		{
+			name:       "keys of a config map",
+			configMaps: []runtime.Object{configMap},
+			container: &api.Container{
+				Env: []api.EnvVar{
+					{
+						Name: "A",
+						ValueFrom: &api.EnvVarSource{
+							ConfigMapKeyRef: &api.ConfigMapKeySelector{
+								LocalObjectReference: api.LocalObjectReference{Name: "test-config-map"},
+								Key:                  "a",
+							},
+						},
+					},
+					{
+						Name: "C",
+						ValueFrom: &api.EnvVarSource{
+							ConfigMapKeyRef: &api.ConfigMapKeySelector{
+								LocalObjectReference: api.LocalObjectReference{Name: "test-config-map"},
+								Key:                  "c",
+							},
+						},
+					},
+					{
+						Name:  "EXPANDED",
+						Value: "$(A)-$(C)",
+					},
+				},
+			},
+			expectedEnvs: []kubecontainer.EnvVar{
+				{Name: "A", Value: "b"},
+				{Name: "C", Value: "d"},
+				{Name: "EXPANDED", Value: "b-d"},
+			},
+		},
+		{
+			name:       "missing key",
+			configMaps: []runtime.Object{configMap},
+			container: &api.Container{
+				Env: []api.EnvVar{
+					{
+						Name: "MISSING",
+						ValueFrom: &api.EnvVarSource{
+							ConfigMapKeyRef: &api.ConfigMapKeySelector{
+								LocalObjectReference: api.LocalObjectReference{Name: "test-config-map"},
+								Key:                  "missing",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing config map",
+			container: &api.Container{
+				Env: []api.EnvVar{
+					{
+						Name: "MISSING",
+						ValueFrom: &api.EnvVarSource{
+							ConfigMapKeyRef: &api.ConfigMapKeySelector{
+								LocalObjectReference: api.LocalObjectReference{Name: "missing-config-map"},
+								Key:                  "a",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		testKubelet := newTestKubelet(t)
+		kl := testKubelet.kubelet
+		kl.serviceLister = nil
+		testKubelet.fakeKubeClient.ReactionChain = testclient.NewSimpleFake(tc.configMaps...).ReactionChain
+
+		testPod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Namespace: "test1",
+				Name:      "dapi-test-pod-name",
+			},
+		}
+
+		result, err := kl.makeEnvironmentVariables(testPod, tc.container)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("[%v] Expected error, got none", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%v] Unexpected error: %v", tc.name, err)
+		}
+
+		sort.Sort(envs(result))
+		sort.Sort(envs(tc.expectedEnvs))
+
+		if !reflect.DeepEqual(result, tc.expectedEnvs) {
+			t.Errorf("[%v] Unexpected env entries; expected {%v}, got {%v}", tc.name, tc.expectedEnvs, result)
+		}
+
+		gets := 0
+		for _, action := range testKubelet.fakeKubeClient.Actions() {
+			if action.Matches("get", "configmaps") {
+				gets++
+			}
+		}
+		if gets != 1 {
+			t.Errorf("[%v] Expected the config map to be fetched once, got %d", tc.name, gets)
+		}
+	}
+}
+
 func runningState(cName string) api.ContainerStatus {
 	return api.ContainerStatus{
 		Name: cName,
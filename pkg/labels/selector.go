@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/util/fielderrors"
@@ -47,6 +48,18 @@ func Everything() Selector {
 	return LabelSelector{}
 }
 
+type nothingSelector struct{}
+
+func (n nothingSelector) Matches(_ Labels) bool                         { return false }
+func (n nothingSelector) Empty() bool                                   { return false }
+func (n nothingSelector) String() string                                { return "" }
+func (n nothingSelector) Add(_ string, _ Operator, _ []string) Selector { return n }
+
+// Nothing returns a selector that matches no labels.
+func Nothing() Selector {
+	return nothingSelector{}
+}
+
 // Operator represents a key's relationship
 // to a set of values in a Requirement.
 type Operator string
@@ -58,6 +71,9 @@ const (
 	NotEqualsOperator    Operator = "!="
 	NotInOperator        Operator = "notin"
 	ExistsOperator       Operator = "exists"
+	DoesNotExistOperator Operator = "!"
+	GreaterThanOperator  Operator = "gt"
+	LessThanOperator     Operator = "lt"
 )
 
 //LabelSelector is a list of Requirements.
@@ -85,10 +101,12 @@ type Requirement struct {
 
 // NewRequirement is the constructor for a Requirement.
 // If any of these rules is violated, an error is returned:
-// (1) The operator can only be In, NotIn or Exists.
+// (1) The operator can only be In, NotIn, Exists, DoesNotExist, GreaterThan or LessThan.
 // (2) If the operator is In or NotIn, the values set must
 //     be non-empty.
-// (3) The key is invalid due to its length, or sequence
+// (3) If the operator is GreaterThan or LessThan, the values set
+//     must hold a single integer.
+// (4) The key is invalid due to its length, or sequence
 //     of characters. See validateLabelKey for more details.
 //
 // The empty string is a valid value in the input values set.
@@ -105,7 +123,16 @@ func NewRequirement(key string, op Operator, vals sets.String) (*Requirement, er
 		if len(vals) != 1 {
 			return nil, fmt.Errorf("exact match compatibility requires one single value")
 		}
-	case ExistsOperator:
+	case GreaterThanOperator, LessThanOperator:
+		if len(vals) != 1 {
+			return nil, fmt.Errorf("for 'gt', 'lt' operators, exactly one value is required")
+		}
+		for v := range vals {
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("for 'gt', 'lt' operators, the value must be an integer")
+			}
+		}
+	case ExistsOperator, DoesNotExistOperator:
 	default:
 		return nil, fmt.Errorf("operator '%v' is not recognized", op)
 	}
@@ -127,6 +154,11 @@ func NewRequirement(key string, op Operator, vals sets.String) (*Requirement, er
 //     Labels' value for that key is not in Requirement's value set.
 // (4) The operator is NotIn and Labels does not have the
 //     Requirement's key.
+// (5) The operator is DoesNotExist and Labels does not have the
+//     Requirement's key.
+// (6) The operator is GreaterThan or LessThan, Labels has the
+//     Requirement's key and Labels' value for that key is an integer
+//     greater or less than the Requirement's value.
 func (r *Requirement) Matches(ls Labels) bool {
 	switch r.operator {
 	case InOperator, EqualsOperator, DoubleEqualsOperator:
@@ -141,6 +173,22 @@ func (r *Requirement) Matches(ls Labels) bool {
 		return !r.strValues.Has(ls.Get(r.key))
 	case ExistsOperator:
 		return ls.Has(r.key)
+	case DoesNotExistOperator:
+		return !ls.Has(r.key)
+	case GreaterThanOperator, LessThanOperator:
+		if !ls.Has(r.key) {
+			return false
+		}
+		value, err := strconv.ParseInt(ls.Get(r.key), 10, 64)
+		if err != nil {
+			return false
+		}
+		// NewRequirement ensures a single integer value
+		bound, _ := strconv.ParseInt(r.strValues.List()[0], 10, 64)
+		if r.operator == GreaterThanOperator {
+			return value > bound
+		}
+		return value < bound
 	default:
 		return false
 	}
@@ -159,6 +207,9 @@ func (lsel LabelSelector) Empty() bool {
 // returned. See NewRequirement for creating a valid Requirement.
 func (r *Requirement) String() string {
 	var buffer bytes.Buffer
+	if r.operator == DoesNotExistOperator {
+		buffer.WriteString("!")
+	}
 	buffer.WriteString(r.key)
 
 	switch r.operator {
@@ -172,7 +223,11 @@ func (r *Requirement) String() string {
 		buffer.WriteString(" in ")
 	case NotInOperator:
 		buffer.WriteString(" notin ")
-	case ExistsOperator:
+	case GreaterThanOperator:
+		buffer.WriteString(">")
+	case LessThanOperator:
+		buffer.WriteString("<")
+	case ExistsOperator, DoesNotExistOperator:
 		return buffer.String()
 	}
 
@@ -235,10 +290,13 @@ const (
 	EndOfStringToken
 	ClosedParToken
 	CommaToken
+	DoesNotExistToken
 	DoubleEqualsToken
 	EqualsToken
+	GreaterThanToken
 	IdentifierToken // to represent keys and values
 	InToken
+	LessThanToken
 	NotEqualsToken
 	NotInToken
 	OpenParToken
@@ -249,9 +307,12 @@ const (
 var string2token = map[string]Token{
 	")":     ClosedParToken,
 	",":     CommaToken,
+	"!":     DoesNotExistToken,
 	"==":    DoubleEqualsToken,
 	"=":     EqualsToken,
+	">":     GreaterThanToken,
 	"in":    InToken,
+	"<":     LessThanToken,
 	"!=":    NotEqualsToken,
 	"notin": NotInToken,
 	"(":     OpenParToken,
@@ -271,7 +332,7 @@ func isWhitespace(ch byte) bool {
 // isSpecialSymbol detect if the character ch can be an operator
 func isSpecialSymbol(ch byte) bool {
 	switch ch {
-	case '=', '!', '(', ')', ',':
+	case '=', '!', '(', ')', ',', '>', '<':
 		return true
 	}
 	return false
@@ -325,7 +386,7 @@ IdentifierLoop:
 }
 
 // scanSpecialSymbol scans string starting with special symbol.
-// special symbol identify non literal operators. "!=", "==", "="
+// special symbol identify non literal operators. "!=", "==", "=", "!", ">", "<"
 func (l *Lexer) scanSpecialSymbol() (Token, string) {
 	lastScannedItem := ScannedItem{}
 	var buffer []byte
@@ -443,7 +504,7 @@ func (p *Parser) parse() ([]Requirement, error) {
 	for {
 		tok, lit := p.lookahead(Values)
 		switch tok {
-		case IdentifierToken:
+		case IdentifierToken, DoesNotExistToken:
 			r, err := p.parseRequirement()
 			if err != nil {
 				return nil, fmt.Errorf("unable to parse requirement: %v", err)
@@ -455,7 +516,7 @@ func (p *Parser) parse() ([]Requirement, error) {
 				return requirements, nil
 			case CommaToken:
 				t2, l2 := p.lookahead(Values)
-				if t2 != IdentifierToken {
+				if t2 != IdentifierToken && t2 != DoesNotExistToken {
 					return nil, fmt.Errorf("found '%s', expected: identifier after ','", l2)
 				}
 			default:
@@ -474,7 +535,7 @@ func (p *Parser) parseRequirement() (*Requirement, error) {
 	if err != nil {
 		return nil, err
 	}
-	if operator == ExistsOperator { // operator Exists found lookahead set checked
+	if operator == ExistsOperator || operator == DoesNotExistOperator { // operator found lookahead set checked
 		return NewRequirement(key, operator, nil)
 	}
 	operator, err = p.parseOperator()
@@ -485,7 +546,7 @@ func (p *Parser) parseRequirement() (*Requirement, error) {
 	switch operator {
 	case InOperator, NotInOperator:
 		values, err = p.parseValues()
-	case EqualsOperator, DoubleEqualsOperator, NotEqualsOperator:
+	case EqualsOperator, DoubleEqualsOperator, NotEqualsOperator, GreaterThanOperator, LessThanOperator:
 		values, err = p.parseExactValue()
 	}
 	if err != nil {
@@ -496,10 +557,16 @@ func (p *Parser) parseRequirement() (*Requirement, error) {
 }
 
 // parseKeyAndInferOperator parse literals.
-// in case of no operator 'in, notin, ==, =, !=' are found
-// the 'exists' operattor is inferred
+// in case of no operator 'in, notin, ==, =, !=, >, <' are found
+// the 'exists' operattor is inferred, or 'doesnotexist' if the key
+// is preceded by '!'
 func (p *Parser) parseKeyAndInferOperator() (string, Operator, error) {
+	var operator Operator
 	tok, literal := p.consume(Values)
+	if tok == DoesNotExistToken {
+		operator = DoesNotExistOperator
+		tok, literal = p.consume(Values)
+	}
 	if tok != IdentifierToken {
 		err := fmt.Errorf("found '%s', expected: identifier", literal)
 		return "", "", err
@@ -507,9 +574,12 @@ func (p *Parser) parseKeyAndInferOperator() (string, Operator, error) {
 	if err := validateLabelKey(literal); err != nil {
 		return "", "", err
 	}
-	var operator Operator
 	if t, _ := p.lookahead(Values); t == EndOfStringToken || t == CommaToken {
-		operator = ExistsOperator
+		if operator != DoesNotExistOperator {
+			operator = ExistsOperator
+		}
+	} else if operator == DoesNotExistOperator {
+		return "", "", fmt.Errorf("found '%s', expected: ',' or 'end of string' after '!%s'", p.scannedItems[p.position].literal, literal)
 	}
 	return literal, operator, nil
 }
@@ -529,8 +599,12 @@ func (p *Parser) parseOperator() (op Operator, err error) {
 		op = NotInOperator
 	case NotEqualsToken:
 		op = NotEqualsOperator
+	case GreaterThanToken:
+		op = GreaterThanOperator
+	case LessThanToken:
+		op = LessThanOperator
 	default:
-		return "", fmt.Errorf("found '%s', expected: '=', '!=', '==', 'in', notin'", lit)
+		return "", fmt.Errorf("found '%s', expected: '=', '!=', '==', 'in', notin', '>', '<'", lit)
 	}
 	return op, nil
 }
@@ -614,14 +688,14 @@ func (p *Parser) parseExactValue() (sets.String, error) {
 // The input will cause an error if it does not follow this form:
 //
 // <selector-syntax> ::= <requirement> | <requirement> "," <selector-syntax> ]
-// <requirement> ::= KEY [ <set-based-restriction> | <exact-match-restriction>
+// <requirement> ::= [ "!" ] KEY [ <set-based-restriction> | <exact-match-restriction>
 // <set-based-restriction> ::= "" | <inclusion-exclusion> <value-set>
 // <inclusion-exclusion> ::= <inclusion> | <exclusion>
 //           <exclusion> ::= "notin"
 //           <inclusion> ::= "in"
 //           <value-set> ::= "(" <values> ")"
 //              <values> ::= VALUE | VALUE "," <values>
-// <exact-match-restriction> ::= ["="|"=="|"!="] VALUE
+// <exact-match-restriction> ::= ["="|"=="|"!="|">"|"<"] VALUE
 // KEY is a sequence of one or more characters following [ DNS_SUBDOMAIN "/" ] DNS_LABEL
 // VALUE is a sequence of zero or more characters "([A-Za-z0-9_-\.])". Max length is 64 character.
 // Delimiter is white space: (' ', '\t')
@@ -636,6 +710,10 @@ func (p *Parser) parseExactValue() (sets.String, error) {
 //  (3) The empty string is a valid VALUE
 //  (4) A requirement with just a KEY - as in "y" above - denotes that
 //      the KEY exists and can be any VALUE.
+//  (5) A requirement with just "!" and a KEY - as in "!w" - denotes
+//      that the KEY does not exist.
+//  (6) ">" and "<" denote that the value of the KEY is an integer greater
+//      or less than VALUE, which must be an integer.
 //
 func Parse(selector string) (Selector, error) {
 	p := &Parser{l: &Lexer{s: selector, pos: 0}}
@@ -178,7 +178,9 @@ func TestLexer(t *testing.T) {
 		{"(", OpenParToken},
 		{")", ClosedParToken},
 		{"||", IdentifierToken},
-		{"!", ErrorToken},
+		{"!", DoesNotExistToken},
+		{">", GreaterThanToken},
+		{"<", LessThanToken},
 	}
 	for _, v := range testcases {
 		l := &Lexer{s: v.s, pos: 0}
@@ -212,6 +214,7 @@ func TestLexerSequence(t *testing.T) {
 		{"()", []Token{OpenParToken, ClosedParToken}},
 		{"x in (),y", []Token{IdentifierToken, InToken, OpenParToken, ClosedParToken, CommaToken, IdentifierToken}},
 		{"== != (), = notin", []Token{DoubleEqualsToken, NotEqualsToken, OpenParToken, ClosedParToken, CommaToken, EqualsToken, NotInToken}},
+		{"!key,key>1,key<2", []Token{DoesNotExistToken, IdentifierToken, CommaToken, IdentifierToken, GreaterThanToken, IdentifierToken, CommaToken, IdentifierToken, LessThanToken, IdentifierToken}},
 	}
 	for _, v := range testcases {
 		var literals []string
@@ -281,6 +284,12 @@ func TestRequirementConstructor(t *testing.T) {
 		{"x", InOperator, sets.NewString("foo"), true},
 		{"x", NotInOperator, sets.NewString("foo"), true},
 		{"x", ExistsOperator, nil, true},
+		{"x", DoesNotExistOperator, nil, true},
+		{"x", GreaterThanOperator, sets.NewString("1"), true},
+		{"x", LessThanOperator, sets.NewString("0"), true},
+		{"x", GreaterThanOperator, nil, false},
+		{"x", LessThanOperator, sets.NewString("1", "2"), false},
+		{"x", GreaterThanOperator, sets.NewString("foo"), false},
 		{"1foo", InOperator, sets.NewString("bar"), true},
 		{"1234", InOperator, sets.NewString("bar"), true},
 		{strings.Repeat("a", 254), ExistsOperator, nil, false}, //breaks DNS rule that len(key) <= 253
@@ -320,6 +329,11 @@ func TestToString(t *testing.T) {
 			getRequirement("y", DoubleEqualsOperator, sets.NewString("jkl"), t),
 			getRequirement("z", NotEqualsOperator, sets.NewString("a"), t)},
 			"x=abc,y==jkl,z!=a", true},
+		{&LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("2"), t),
+			getRequirement("y", LessThanOperator, sets.NewString("8"), t),
+			getRequirement("z", DoesNotExistOperator, nil, t)},
+			"x>2,y<8,!z", true},
 	}
 	for _, ts := range toStringTests {
 		if out := ts.In.String(); out == "" && ts.Valid {
@@ -327,6 +341,14 @@ func TestToString(t *testing.T) {
 		} else if out != ts.Out {
 			t.Errorf("%+v.String() => '%v' want '%v'", ts.In, out, ts.Out)
 		}
+		if !ts.Valid {
+			continue
+		}
+		if sel, err := Parse(ts.Out); err != nil {
+			t.Errorf("Parse(%s) => %v expected no error", ts.Out, err)
+		} else if out := sel.String(); out != ts.Out {
+			t.Errorf("Parse(%s).String() => '%v' want '%v'", ts.Out, out, ts.Out)
+		}
 	}
 }
 
@@ -355,6 +377,25 @@ func TestRequirementLabelSelectorMatching(t *testing.T) {
 		{Set{"y": "baz"}, &LabelSelector{
 			getRequirement("x", InOperator, sets.NewString(""), t),
 		}, false},
+		{Set{"y": "baz"}, &LabelSelector{
+			getRequirement("x", DoesNotExistOperator, nil, t),
+		}, true},
+		{Set{"y": "baz"}, &LabelSelector{
+			getRequirement("y", DoesNotExistOperator, nil, t),
+		}, false},
+		{Set{"x": "4", "y": "4"}, &LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("3"), t),
+			getRequirement("y", LessThanOperator, sets.NewString("5"), t),
+		}, true},
+		{Set{"x": "3"}, &LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("3"), t),
+		}, false},
+		{Set{"x": "foo"}, &LabelSelector{
+			getRequirement("x", LessThanOperator, sets.NewString("3"), t),
+		}, false},
+		{Set{"y": "1"}, &LabelSelector{
+			getRequirement("x", LessThanOperator, sets.NewString("3"), t),
+		}, false},
 	}
 	for _, lsm := range labelSelectorMatchingTests {
 		if match := lsm.Sel.Matches(lsm.Set); match != lsm.Match {
@@ -445,6 +486,19 @@ func TestSetSelectorParser(t *testing.T) {
 		{"a notin(", nil, true, false},        // bad formed
 		{"a (", nil, false, false},            // cpar
 		{"(", nil, false, false},              // opar
+		{"!x", LabelSelector{
+			getRequirement("x", DoesNotExistOperator, nil, t),
+		}, true, true},
+		{"x>1,y < 2, !z", LabelSelector{
+			getRequirement("x", GreaterThanOperator, sets.NewString("1"), t),
+			getRequirement("y", LessThanOperator, sets.NewString("2"), t),
+			getRequirement("z", DoesNotExistOperator, nil, t),
+		}, true, true},
+		{"!x=a", nil, true, false},
+		{"!x in (a)", nil, true, false},
+		{"!", nil, true, false},
+		{"x>a", nil, true, false},
+		{"x<", nil, true, false},
 	}
 
 	for _, ssp := range setSelectorParserTests {
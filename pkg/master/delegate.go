@@ -0,0 +1,101 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/latest"
+	"k8s.io/kubernetes/pkg/apiserver"
+)
+
+// InstallAPIDelegate proxies all requests for the API group version groupVersion to the API
+// server at location, and lists the group in the master's discovery documents so clients find
+// it like any other group.
+//
+// For example, if you delegate "company.com/v1" to https://10.0.0.5, then requests for
+//   http://<host>/apis/company.com/v1/...
+// are served by https://10.0.0.5/apis/company.com/v1/...
+// Only one version of a group can be delegated, and groups served by the master itself can't be.
+func (m *Master) InstallAPIDelegate(groupVersion string, location *url.URL) error {
+	parts := strings.Split(groupVersion, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return fmt.Errorf("expected <group>/<version>, got %q", groupVersion)
+	}
+	group, version := parts[0], parts[1]
+	if location == nil || len(location.Host) == 0 {
+		return fmt.Errorf("a location with a host is required for %s", groupVersion)
+	}
+	if _, err := latest.Group(group); err == nil {
+		return fmt.Errorf("group %q is served by the master", group)
+	}
+	m.thirdPartyResourcesLock.RLock()
+	_, installed := m.thirdPartyResources[makeThirdPartyPath(group)]
+	m.thirdPartyResourcesLock.RUnlock()
+	if installed {
+		return fmt.Errorf("group %q is served by a third party resource", group)
+	}
+
+	m.apiDelegatesLock.Lock()
+	defer m.apiDelegatesLock.Unlock()
+	if existing, found := m.apiDelegates[group]; found {
+		return fmt.Errorf("group %q is already delegated for %s", group, existing.PreferredVersion.GroupVersion)
+	}
+
+	handler := apiserver.NewDelegateHandler(location, m.apiDelegateTransport, m.requestContextMapper, latest.GroupOrDie("").Codec)
+	path := m.apiGroupPrefix + "/" + groupVersion
+	m.muxHelper.Handle(path, handler)
+	m.muxHelper.Handle(path+"/", handler)
+
+	apiGroupVersion := api.GroupVersion{GroupVersion: groupVersion, Version: version}
+	apiGroup := api.APIGroup{
+		Name:             group,
+		Versions:         []api.GroupVersion{apiGroupVersion},
+		PreferredVersion: apiGroupVersion,
+	}
+	apiserver.AddGroupWebService(m.handlerContainer, m.apiGroupPrefix+"/"+group+"/", apiGroup)
+	m.apiDelegates[group] = apiGroup
+	return nil
+}
+
+// listAPIDelegates returns the delegated groups sorted by name.
+func (m *Master) listAPIDelegates() []api.APIGroup {
+	m.apiDelegatesLock.RLock()
+	defer m.apiDelegatesLock.RUnlock()
+	names := make([]string, 0, len(m.apiDelegates))
+	for name := range m.apiDelegates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	groups := make([]api.APIGroup, 0, len(names))
+	for _, name := range names {
+		groups = append(groups, m.apiDelegates[name])
+	}
+	return groups
+}
+
+// isAPIDelegate returns true if group is delegated to another API server.
+func (m *Master) isAPIDelegate(group string) bool {
+	m.apiDelegatesLock.RLock()
+	defer m.apiDelegatesLock.RUnlock()
+	_, found := m.apiDelegates[group]
+	return found
+}
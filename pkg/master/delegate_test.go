@@ -0,0 +1,86 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+func TestInstallAPIDelegate(t *testing.T) {
+	var delegatedPath string
+	delegate := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		delegatedPath = req.URL.RequestURI()
+		w.Write([]byte(`{"kind":"FooList"}`))
+	}))
+	defer delegate.Close()
+	location, _ := url.Parse(delegate.URL)
+
+	_, config, assert := setUp(t)
+	config.KubeletClient = client.FakeKubeletClient{}
+	config.APIGroupPrefix = "/apis"
+	config.APIDelegates = map[string]*url.URL{"company.com/v1": location}
+	master := New(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos?limit=1")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal(`{"kind":"FooList"}`, string(body))
+	assert.Equal("/apis/company.com/v1/namespaces/default/foos?limit=1", delegatedPath)
+
+	resp, err = http.Get(server.URL + "/apis")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	groups := api.APIGroupList{}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&groups))
+	resp.Body.Close()
+	expected := api.APIGroup{
+		Name:             "company.com",
+		Versions:         []api.GroupVersion{{GroupVersion: "company.com/v1", Version: "v1"}},
+		PreferredVersion: api.GroupVersion{GroupVersion: "company.com/v1", Version: "v1"},
+	}
+	assert.Equal([]api.APIGroup{expected}, groups.Groups)
+
+	resp, err = http.Get(server.URL + "/apis/company.com/")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	group := api.APIGroup{}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&group))
+	resp.Body.Close()
+	assert.Equal(expected, group)
+
+	for _, groupVersion := range []string{"company.com/v2", "experimental/v1", "company.com", "/v1"} {
+		if err := master.InstallAPIDelegate(groupVersion, location); err == nil {
+			t.Errorf("expected an error delegating %s", groupVersion)
+		}
+	}
+}
@@ -39,15 +39,21 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1"
 	expapi "k8s.io/kubernetes/pkg/apis/experimental"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/apiserver/audit"
 	"k8s.io/kubernetes/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/authorizer/rbac"
 	"k8s.io/kubernetes/pkg/auth/handlers"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/healthz"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/master/ports"
+	"k8s.io/kubernetes/pkg/registry/clusterrole"
+	clusterroleetcd "k8s.io/kubernetes/pkg/registry/clusterrole/etcd"
+	clusterrolebindingetcd "k8s.io/kubernetes/pkg/registry/clusterrolebinding/etcd"
 	"k8s.io/kubernetes/pkg/registry/componentstatus"
+	configmapetcd "k8s.io/kubernetes/pkg/registry/configmap/etcd"
 	controlleretcd "k8s.io/kubernetes/pkg/registry/controller/etcd"
 	deploymentetcd "k8s.io/kubernetes/pkg/registry/deployment/etcd"
 	"k8s.io/kubernetes/pkg/registry/endpoint"
@@ -64,7 +70,11 @@ import (
 	pvcetcd "k8s.io/kubernetes/pkg/registry/persistentvolumeclaim/etcd"
 	podetcd "k8s.io/kubernetes/pkg/registry/pod/etcd"
 	podtemplateetcd "k8s.io/kubernetes/pkg/registry/podtemplate/etcd"
+	priorityclassetcd "k8s.io/kubernetes/pkg/registry/priorityclass/etcd"
 	resourcequotaetcd "k8s.io/kubernetes/pkg/registry/resourcequota/etcd"
+	"k8s.io/kubernetes/pkg/registry/role"
+	roleetcd "k8s.io/kubernetes/pkg/registry/role/etcd"
+	rolebindingetcd "k8s.io/kubernetes/pkg/registry/rolebinding/etcd"
 	secretetcd "k8s.io/kubernetes/pkg/registry/secret/etcd"
 	"k8s.io/kubernetes/pkg/registry/service"
 	etcdallocator "k8s.io/kubernetes/pkg/registry/service/allocator/etcd"
@@ -74,8 +84,10 @@ import (
 	thirdpartyresourceetcd "k8s.io/kubernetes/pkg/registry/thirdpartyresource/etcd"
 	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
 	thirdpartyresourcedataetcd "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
 	"k8s.io/kubernetes/pkg/storage"
 	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/kv"
 	"k8s.io/kubernetes/pkg/tools"
 	"k8s.io/kubernetes/pkg/ui"
 	"k8s.io/kubernetes/pkg/util"
@@ -100,6 +112,9 @@ const (
 type Config struct {
 	DatabaseStorage    storage.Interface
 	ExpDatabaseStorage storage.Interface
+	// ResourceStorage holds the storage of individual resources, keyed by resource name, which
+	// is used instead of DatabaseStorage or ExpDatabaseStorage, e.g. to encrypt them.
+	ResourceStorage map[string]storage.Interface
 	// StorageVersions is a map between groups and their storage versions
 	StorageVersions map[string]string
 	EventTTL        time.Duration
@@ -128,6 +143,23 @@ type Config struct {
 	AdmissionControl       admission.Interface
 	MasterServiceNamespace string
 
+	// If true, Authorizer includes RBAC, and users may only write roles and bindings
+	// which grant permissions they hold themselves.
+	AuthorizationRBAC bool
+
+	// If specified, an audit event is recorded to this backend for every request served on the secure port.
+	AuditBackend audit.Backend
+
+	// If specified, requests on the secure port are served within the limits of their priority bucket.
+	RequestLimiter *apiserver.PriorityLimiter
+
+	// APIDelegates maps API group versions, e.g. "company.com/v1", to the location of the
+	// external API server which serves them. Requests for those group versions are proxied.
+	APIDelegates map[string]*url.URL
+	// APIDelegateTransport is used to reach the API servers of APIDelegates, e.g. to verify
+	// their certificates and present a client certificate. If nil, http.DefaultTransport is used.
+	APIDelegateTransport http.RoundTripper
+
 	// Map requests to contexts. Exported so downstream consumers can provider their own mappers
 	RequestContextMapper api.RequestContextMapper
 
@@ -201,6 +233,7 @@ type Master struct {
 	corsAllowedOriginList []string
 	authenticator         authenticator.Request
 	authorizer            authorizer.Authorizer
+	authorizationRBAC     bool
 	admissionControl      admission.Interface
 	masterCount           int
 	v1                    bool
@@ -246,18 +279,42 @@ type Master struct {
 	thirdPartyStorage storage.Interface
 	// map from api path to storage for those objects
 	thirdPartyResources map[string]*thirdpartyresourcedataetcd.REST
-	// protects the map
+	// map from api path to the kind of the objects served there
+	thirdPartyKinds map[string]thirdPartyKind
+	// protects the maps
 	thirdPartyResourcesLock sync.RWMutex
+
+	// map from group to the discovery information of the API groups delegated to other API servers
+	apiDelegates map[string]api.APIGroup
+	// used to reach the API servers of delegated groups
+	apiDelegateTransport http.RoundTripper
+	// protects the map
+	apiDelegatesLock sync.RWMutex
 }
 
 // NewEtcdStorage returns a storage.Interface for the provided arguments or an error if the version
 // is incorrect.
 func NewEtcdStorage(client tools.EtcdClient, interfacesFunc meta.VersionInterfacesFunc, version, prefix string) (etcdStorage storage.Interface, err error) {
+	return NewTransformingEtcdStorage(client, interfacesFunc, version, prefix, nil)
+}
+
+// NewTransformingEtcdStorage is like NewEtcdStorage, but passes every value through transformer
+// on its way to and from etcd.
+func NewTransformingEtcdStorage(client tools.EtcdClient, interfacesFunc meta.VersionInterfacesFunc, version, prefix string, transformer storage.ValueTransformer) (etcdStorage storage.Interface, err error) {
 	versionInterfaces, err := interfacesFunc(version)
 	if err != nil {
 		return etcdStorage, err
 	}
-	return etcdstorage.NewEtcdStorage(client, versionInterfaces.Codec, prefix), nil
+	return etcdstorage.NewTransformingEtcdStorage(client, versionInterfaces.Codec, prefix, transformer), nil
+}
+
+// NewKVStorage returns a storage.Interface for the provided arguments, backed by a transactional key-value store.
+func NewKVStorage(client kv.KV, interfacesFunc meta.VersionInterfacesFunc, version, prefix string) (kvStorage storage.Interface, err error) {
+	versionInterfaces, err := interfacesFunc(version)
+	if err != nil {
+		return kvStorage, err
+	}
+	return kv.NewStorage(client, versionInterfaces.Codec, prefix), nil
 }
 
 // setDefaults fills in any fields not set that are required to have valid data.
@@ -364,6 +421,7 @@ func New(c *Config) *Master {
 		corsAllowedOriginList: c.CorsAllowedOriginList,
 		authenticator:         c.Authenticator,
 		authorizer:            c.Authorizer,
+		authorizationRBAC:     c.AuthorizationRBAC,
 		admissionControl:      c.AdmissionControl,
 		v1:                    !c.DisableV1,
 		exp:                   c.EnableExp,
@@ -429,33 +487,43 @@ func NewHandlerContainer(mux *http.ServeMux) *restful.Container {
 	return container
 }
 
+// storageFor returns the storage of resource, or defaultStorage if it has none of its own.
+func (c *Config) storageFor(resource string, defaultStorage storage.Interface) storage.Interface {
+	if s, found := c.ResourceStorage[resource]; found {
+		return s
+	}
+	return defaultStorage
+}
+
 // init initializes master.
 func (m *Master) init(c *Config) {
 	healthzChecks := []healthz.HealthzChecker{}
 	m.clock = util.RealClock{}
-	podStorage := podetcd.NewStorage(c.DatabaseStorage, c.EnableWatchCache, c.KubeletClient)
+	podStorage := podetcd.NewStorage(c.storageFor("pods", c.DatabaseStorage), c.EnableWatchCache, c.KubeletClient)
 
-	podTemplateStorage := podtemplateetcd.NewREST(c.DatabaseStorage)
+	podTemplateStorage := podtemplateetcd.NewREST(c.storageFor("podtemplates", c.DatabaseStorage))
 
-	eventStorage := eventetcd.NewREST(c.DatabaseStorage, uint64(c.EventTTL.Seconds()))
-	limitRangeStorage := limitrangeetcd.NewREST(c.DatabaseStorage)
+	eventStorage := eventetcd.NewREST(c.storageFor("events", c.DatabaseStorage), uint64(c.EventTTL.Seconds()))
+	limitRangeStorage := limitrangeetcd.NewREST(c.storageFor("limitranges", c.DatabaseStorage))
 
-	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewREST(c.DatabaseStorage)
-	secretStorage := secretetcd.NewREST(c.DatabaseStorage)
-	serviceAccountStorage := serviceaccountetcd.NewREST(c.DatabaseStorage)
-	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewREST(c.DatabaseStorage)
-	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewREST(c.DatabaseStorage)
+	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewREST(c.storageFor("resourcequotas", c.DatabaseStorage))
+	secretStorage := secretetcd.NewREST(c.storageFor("secrets", c.DatabaseStorage))
+	configMapStorage := configmapetcd.NewREST(c.storageFor("configmaps", c.DatabaseStorage))
+	priorityClassStorage := priorityclassetcd.NewREST(c.storageFor("priorityclasses", c.DatabaseStorage))
+	serviceAccountStorage := serviceaccountetcd.NewREST(c.storageFor("serviceaccounts", c.DatabaseStorage))
+	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewREST(c.storageFor("persistentvolumes", c.DatabaseStorage))
+	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewREST(c.storageFor("persistentvolumeclaims", c.DatabaseStorage))
 
-	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewREST(c.DatabaseStorage)
+	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewREST(c.storageFor("namespaces", c.DatabaseStorage))
 	m.namespaceRegistry = namespace.NewRegistry(namespaceStorage)
 
-	endpointsStorage := endpointsetcd.NewREST(c.DatabaseStorage, c.EnableWatchCache)
+	endpointsStorage := endpointsetcd.NewREST(c.storageFor("endpoints", c.DatabaseStorage), c.EnableWatchCache)
 	m.endpointRegistry = endpoint.NewRegistry(endpointsStorage)
 
-	nodeStorage, nodeStatusStorage := nodeetcd.NewREST(c.DatabaseStorage, c.EnableWatchCache, c.KubeletClient)
+	nodeStorage, nodeStatusStorage := nodeetcd.NewREST(c.storageFor("nodes", c.DatabaseStorage), c.EnableWatchCache, c.KubeletClient)
 	m.nodeRegistry = node.NewRegistry(nodeStorage)
 
-	serviceStorage := serviceetcd.NewREST(c.DatabaseStorage)
+	serviceStorage := serviceetcd.NewREST(c.storageFor("services", c.DatabaseStorage))
 	m.serviceRegistry = service.NewRegistry(serviceStorage)
 
 	var serviceClusterIPRegistry service.RangeRegistry
@@ -476,7 +544,7 @@ func (m *Master) init(c *Config) {
 	})
 	m.serviceNodePortAllocator = serviceNodePortRegistry
 
-	controllerStorage := controlleretcd.NewREST(c.DatabaseStorage)
+	controllerStorage := controlleretcd.NewREST(c.storageFor("replicationcontrollers", c.DatabaseStorage))
 
 	// TODO: Factor out the core API registration
 	m.storage = map[string]rest.Storage{
@@ -506,6 +574,8 @@ func (m *Master) init(c *Config) {
 		"namespaces/status":             namespaceStatusStorage,
 		"namespaces/finalize":           namespaceFinalizeStorage,
 		"secrets":                       secretStorage,
+		"configMaps":                    configMapStorage,
+		"priorityClasses":               priorityClassStorage,
 		"serviceAccounts":               serviceAccountStorage,
 		"persistentVolumes":             persistentVolumeStorage,
 		"persistentVolumes/status":      persistentVolumeStatusStorage,
@@ -581,6 +651,7 @@ func (m *Master) init(c *Config) {
 	if m.exp {
 		m.thirdPartyStorage = c.ExpDatabaseStorage
 		m.thirdPartyResources = map[string]*thirdpartyresourcedataetcd.REST{}
+		m.thirdPartyKinds = map[string]thirdPartyKind{}
 
 		expVersion := m.experimental(c)
 
@@ -614,7 +685,17 @@ func (m *Master) init(c *Config) {
 
 	// This should be done after all groups are registered
 	// TODO: replace the hardcoded "apis".
-	apiserver.AddApisWebService(m.handlerContainer, "/apis", allGroups)
+	apiserver.AddApisWebService(m.handlerContainer, "/apis", func() []api.APIGroup {
+		return append(allGroups[:len(allGroups):len(allGroups)], m.listAPIDelegates()...)
+	})
+
+	m.apiDelegates = map[string]api.APIGroup{}
+	m.apiDelegateTransport = c.APIDelegateTransport
+	for groupVersion, location := range c.APIDelegates {
+		if err := m.InstallAPIDelegate(groupVersion, location); err != nil {
+			glog.Fatalf("Unable to delegate api %s: %v", groupVersion, err)
+		}
+	}
 
 	// Register root handler.
 	// We do not register this using restful Webservice since we do not want to surface this in api docs.
@@ -652,9 +733,17 @@ func (m *Master) init(c *Config) {
 
 	m.InsecureHandler = handler
 
-	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, latest.GroupOrDie("").RESTMapper, "api")
+	authRequestInfoResolver := &apiserver.APIRequestInfoResolver{
+		APIPrefixes:      sets.NewString(strings.TrimPrefix(m.apiPrefix, "/")),
+		APIGroupPrefixes: sets.NewString(strings.TrimPrefix(m.apiGroupPrefix, "/")),
+		RestMapper:       latest.GroupOrDie("").RESTMapper,
+	}
+	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, authRequestInfoResolver)
 	handler = apiserver.WithAuthorizationCheck(handler, attributeGetter, m.authorizer)
 
+	// Limit requests inside the authenticator so they can be bucketed by user.
+	handler = apiserver.WithPriorityLimit(handler, m.requestContextMapper, authRequestInfoResolver, c.RequestLimiter)
+
 	// Install Authenticator
 	if c.Authenticator != nil {
 		authenticatedHandler, err := handlers.NewRequestAuthenticator(m.requestContextMapper, c.Authenticator, handlers.Unauthorized(c.SupportsBasicAuth), handler)
@@ -664,6 +753,12 @@ func (m *Master) init(c *Config) {
 		handler = authenticatedHandler
 	}
 
+	// Limit the requests being authenticated, so that failing or slow authentication cannot exhaust the server.
+	handler = apiserver.WithTotalLimit(handler, authRequestInfoResolver, c.RequestLimiter)
+
+	// Audit outside the authenticator so requests it rejects are recorded as well.
+	handler = audit.WithAudit(handler, m.requestContextMapper, authRequestInfoResolver, c.AuditBackend)
+
 	// Install root web services
 	m.handlerContainer.Add(m.rootWebService)
 
@@ -672,6 +767,7 @@ func (m *Master) init(c *Config) {
 
 	if m.enableSwaggerSupport {
 		m.InstallSwaggerAPI()
+		m.InstallOpenAPI()
 	}
 
 	// After all wrapping is done, put a context filter around both handlers
@@ -807,10 +903,12 @@ func (m *Master) api_v1() *apiserver.APIGroupVersion {
 	version.Storage = storage
 	version.Version = "v1"
 	version.Codec = v1.Codec
+	version.ProtobufCodec = protobuf.NewCodec(api.Scheme, "v1")
 	return version
 }
 
-// HasThirdPartyResource returns true if a particular third party resource currently installed.
+// HasThirdPartyResource returns true if a particular third party resource currently installed,
+// with the same versions, schemas and conversion hooks.
 func (m *Master) HasThirdPartyResource(rsrc *expapi.ThirdPartyResource) (bool, error) {
 	_, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
 	if err != nil {
@@ -820,7 +918,10 @@ func (m *Master) HasThirdPartyResource(rsrc *expapi.ThirdPartyResource) (bool, e
 	services := m.handlerContainer.RegisteredWebServices()
 	for ix := range services {
 		if services[ix].RootPath() == path {
-			return true, nil
+			m.thirdPartyResourcesLock.RLock()
+			defer m.thirdPartyResourcesLock.RUnlock()
+			kind, found := m.thirdPartyKinds[path]
+			return !found || api.Semantic.DeepEqual(kind.versions, rsrc.Versions), nil
 		}
 	}
 	return false, nil
@@ -835,6 +936,7 @@ func (m *Master) removeThirdPartyStorage(path string) error {
 			return err
 		}
 		delete(m.thirdPartyResources, path)
+		delete(m.thirdPartyKinds, path)
 	}
 	return nil
 }
@@ -885,10 +987,11 @@ func (m *Master) ListThirdPartyResources() []string {
 	return result
 }
 
-func (m *Master) addThirdPartyResourceStorage(path string, storage *thirdpartyresourcedataetcd.REST) {
+func (m *Master) addThirdPartyResourceStorage(path string, storage *thirdpartyresourcedataetcd.REST, kind thirdPartyKind) {
 	m.thirdPartyResourcesLock.Lock()
 	defer m.thirdPartyResourcesLock.Unlock()
 	m.thirdPartyResources[path] = storage
+	m.thirdPartyKinds[path] = kind
 }
 
 // InstallThirdPartyResource installs a third party resource specified by 'rsrc'.  When a resource is
@@ -898,12 +1001,20 @@ func (m *Master) addThirdPartyResourceStorage(path string, storage *thirdpartyre
 // For example, if you install a resource ThirdPartyResource{ Name: "foo.company.com", Versions: {"v1"} }
 // then the following RESTful resource is created on the server:
 //   http://<host>/apis/company.com/v1/foos/...
+// If the resource is already installed, its schemas and conversion hooks are updated. Its kind and
+// served version can't change.
 func (m *Master) InstallThirdPartyResource(rsrc *expapi.ThirdPartyResource) error {
 	kind, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
 	if err != nil {
 		return err
 	}
-	thirdparty := m.thirdpartyapi(group, kind, rsrc.Versions[0].Name)
+	if m.isAPIDelegate(group) {
+		return fmt.Errorf("group %q is delegated to another API server", group)
+	}
+	if updated, err := m.updateThirdPartyResource(rsrc, group, kind); updated || err != nil {
+		return err
+	}
+	thirdparty := m.thirdpartyapi(group, kind, rsrc.Versions)
 	if err := thirdparty.InstallREST(m.handlerContainer); err != nil {
 		glog.Fatalf("Unable to setup thirdparty api: %v", err)
 	}
@@ -917,14 +1028,34 @@ func (m *Master) InstallThirdPartyResource(rsrc *expapi.ThirdPartyResource) erro
 		Versions: []api.GroupVersion{groupVersion},
 	}
 	apiserver.AddGroupWebService(m.handlerContainer, path, apiGroup)
-	m.addThirdPartyResourceStorage(path, thirdparty.Storage[strings.ToLower(kind)+"s"].(*thirdpartyresourcedataetcd.REST))
+	m.addThirdPartyResourceStorage(path, thirdparty.Storage[strings.ToLower(kind)+"s"].(*thirdpartyresourcedataetcd.REST), thirdPartyKind{group: group, kind: kind, version: rsrc.Versions[0], versions: rsrc.Versions})
 	thirdPartyRequestInfoResolver := &apiserver.APIRequestInfoResolver{APIPrefixes: sets.NewString(strings.TrimPrefix(group, "/")), RestMapper: thirdparty.Mapper}
 	apiserver.InstallServiceErrorHandler(m.handlerContainer, thirdPartyRequestInfoResolver, []string{thirdparty.Version})
 	return nil
 }
 
-func (m *Master) thirdpartyapi(group, kind, version string) *apiserver.APIGroupVersion {
-	resourceStorage := thirdpartyresourcedataetcd.NewREST(m.thirdPartyStorage, group, kind)
+// updateThirdPartyResource updates the versions of rsrc if it is already installed, and returns
+// true if it is.
+func (m *Master) updateThirdPartyResource(rsrc *expapi.ThirdPartyResource, group, kind string) (bool, error) {
+	path := makeThirdPartyPath(group)
+	m.thirdPartyResourcesLock.Lock()
+	defer m.thirdPartyResourcesLock.Unlock()
+	storage, found := m.thirdPartyResources[path]
+	if !found {
+		return false, nil
+	}
+	installed := m.thirdPartyKinds[path]
+	if installed.kind != kind || installed.version.Name != rsrc.Versions[0].Name {
+		return true, fmt.Errorf("%s is installed as %s/%s, its kind and served version can't change", rsrc.Name, installed.version.Name, installed.kind)
+	}
+	storage.SetVersions(rsrc.Versions)
+	m.thirdPartyKinds[path] = thirdPartyKind{group: group, kind: kind, version: rsrc.Versions[0], versions: rsrc.Versions}
+	return true, nil
+}
+
+func (m *Master) thirdpartyapi(group, kind string, versions []expapi.APIVersion) *apiserver.APIGroupVersion {
+	version := versions[0].Name
+	resourceStorage := thirdpartyresourcedataetcd.NewREST(m.thirdPartyStorage, group, kind, versions)
 
 	apiRoot := makeThirdPartyPath(group)
 
@@ -955,12 +1086,26 @@ func (m *Master) thirdpartyapi(group, kind, version string) *apiserver.APIGroupV
 
 // experimental returns the resources and codec for the experimental api
 func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
-	controllerStorage := expcontrolleretcd.NewStorage(c.DatabaseStorage)
-	autoscalerStorage := horizontalpodautoscaleretcd.NewREST(c.ExpDatabaseStorage)
-	thirdPartyResourceStorage := thirdpartyresourceetcd.NewREST(c.ExpDatabaseStorage)
-	daemonSetStorage, daemonSetStatusStorage := daemonetcd.NewREST(c.ExpDatabaseStorage)
-	deploymentStorage := deploymentetcd.NewStorage(c.ExpDatabaseStorage)
-	jobStorage, jobStatusStorage := jobetcd.NewREST(c.ExpDatabaseStorage)
+	controllerStorage := expcontrolleretcd.NewStorage(c.storageFor("replicationcontrollers", c.DatabaseStorage))
+	autoscalerStorage := horizontalpodautoscaleretcd.NewREST(c.storageFor("horizontalpodautoscalers", c.ExpDatabaseStorage))
+	thirdPartyResourceStorage := thirdpartyresourceetcd.NewREST(c.storageFor("thirdpartyresources", c.ExpDatabaseStorage))
+	daemonSetStorage, daemonSetStatusStorage := daemonetcd.NewREST(c.storageFor("daemonsets", c.ExpDatabaseStorage))
+	deploymentStorage := deploymentetcd.NewStorage(c.storageFor("deployments", c.ExpDatabaseStorage))
+	jobStorage, jobStatusStorage := jobetcd.NewREST(c.storageFor("jobs", c.ExpDatabaseStorage))
+	roleStorage := roleetcd.NewREST(c.storageFor("roles", c.ExpDatabaseStorage))
+	roleBindingStorage := rolebindingetcd.NewREST(c.storageFor("rolebindings", c.ExpDatabaseStorage))
+	clusterRoleStorage := clusterroleetcd.NewREST(c.storageFor("clusterroles", c.ExpDatabaseStorage))
+	clusterRoleBindingStorage := clusterrolebindingetcd.NewREST(c.storageFor("clusterrolebindings", c.ExpDatabaseStorage))
+	var roles, roleBindings, clusterRoles, clusterRoleBindings rest.Storage = roleStorage, roleBindingStorage, clusterRoleStorage, clusterRoleBindingStorage
+	if m.authorizationRBAC {
+		// Users may only write roles and bindings which grant permissions they hold themselves.
+		roleRegistry := role.NewRegistry(roleStorage)
+		clusterRoleRegistry := clusterrole.NewRegistry(clusterRoleStorage)
+		roles = rbac.NewEscalationCheckingStorage(roleStorage.Etcd, "role", m.authorizer, rbac.RoleRules)
+		roleBindings = rbac.NewEscalationCheckingStorage(roleBindingStorage.Etcd, "roleBinding", m.authorizer, rbac.RoleBindingRules(roleRegistry, clusterRoleRegistry))
+		clusterRoles = rbac.NewEscalationCheckingStorage(clusterRoleStorage.Etcd, "clusterRole", m.authorizer, rbac.ClusterRoleRules)
+		clusterRoleBindings = rbac.NewEscalationCheckingStorage(clusterRoleBindingStorage.Etcd, "clusterRoleBinding", m.authorizer, rbac.ClusterRoleBindingRules(clusterRoleRegistry))
+	}
 
 	thirdPartyControl := ThirdPartyController{
 		master: m,
@@ -984,6 +1129,10 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		strings.ToLower("deployments/scale"):            deploymentStorage.Scale,
 		strings.ToLower("jobs"):                         jobStorage,
 		strings.ToLower("jobs/status"):                  jobStatusStorage,
+		strings.ToLower("roles"):                        roles,
+		strings.ToLower("roleBindings"):                 roleBindings,
+		strings.ToLower("clusterRoles"):                 clusterRoles,
+		strings.ToLower("clusterRoleBindings"):          clusterRoleBindings,
 	}
 
 	expMeta := latest.GroupOrDie("experimental")
@@ -997,6 +1146,7 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 
 		Mapper:        expMeta.RESTMapper,
 		Codec:         expMeta.Codec,
+		ProtobufCodec: protobuf.NewCodec(api.Scheme, expMeta.GroupVersion),
 		Linker:        expMeta.SelfLinker,
 		Storage:       storage,
 		Version:       expMeta.GroupVersion,
@@ -40,6 +40,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/apis/experimental"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/authorizer/rbac"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -296,6 +297,26 @@ func TestExpapi(t *testing.T) {
 	assert.Equal(expAPIGroup.Version, latest.GroupOrDie("experimental").GroupVersion)
 }
 
+// TestExpapiEscalationChecking verifies that roles and bindings are only checked
+// for privilege escalation when the RBAC authorizer is enabled.
+func TestExpapiEscalationChecking(t *testing.T) {
+	master, config, assert := setUp(t)
+	master.authorizer = apiserver.NewAlwaysAllowAuthorizer()
+
+	expAPIGroup := master.experimental(&config)
+	for _, resource := range []string{"roles", "rolebindings", "clusterroles", "clusterrolebindings"} {
+		_, checked := expAPIGroup.Storage[resource].(*rbac.EscalationCheckingStorage)
+		assert.False(checked, resource)
+	}
+
+	master.authorizationRBAC = true
+	expAPIGroup = master.experimental(&config)
+	for _, resource := range []string{"roles", "rolebindings", "clusterroles", "clusterrolebindings"} {
+		_, checked := expAPIGroup.Storage[resource].(*rbac.EscalationCheckingStorage)
+		assert.True(checked, resource)
+	}
+}
+
 // TestSecondsSinceSync verifies that proper results are returned
 // when checking the time between syncs
 func TestSecondsSinceSync(t *testing.T) {
@@ -450,6 +471,7 @@ type FooList struct {
 func initThirdParty(t *testing.T, version string) (*Master, *tools.FakeEtcdClient, *httptest.Server, *assert.Assertions) {
 	master, _, assert := setUp(t)
 	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.thirdPartyKinds = map[string]thirdPartyKind{}
 	api := &experimental.ThirdPartyResource{
 		ObjectMeta: api.ObjectMeta{
 			Name: "foo.company.com",
@@ -890,3 +912,42 @@ func testInstallThirdPartyResourceRemove(t *testing.T, version string) {
 		}
 	}
 }
+
+func TestInstallThirdPartyResourceUpdate(t *testing.T) {
+	version := versionsToTest[0]
+	master, fakeClient, server, assert := initThirdParty(t, version)
+	defer server.Close()
+
+	rsrc := &experimental.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []experimental.APIVersion{{APIGroup: "group", Name: version}},
+	}
+	hasResource, err := master.HasThirdPartyResource(rsrc)
+	assert.NoError(err)
+	assert.True(hasResource)
+
+	rsrc.Versions[0].Schema = &experimental.JSONSchemaProps{Type: "object", Required: []string{"size"}, AdditionalProperties: true}
+	hasResource, err = master.HasThirdPartyResource(rsrc)
+	assert.NoError(err)
+	assert.False(hasResource, "expected a resource with another schema not to be installed")
+
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	hasResource, err = master.HasThirdPartyResource(rsrc)
+	assert.NoError(err)
+	assert.True(hasResource)
+
+	// objects are validated against the new schema
+	data, _ := json.Marshal(Foo{ObjectMeta: api.ObjectMeta{Name: "test"}, TypeMeta: unversioned.TypeMeta{Kind: "Foo", APIVersion: version}})
+	resp, err := http.Post(server.URL+"/apis/company.com/"+version+"/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(422, resp.StatusCode)
+	assert.Empty(fakeClient.DeletedKeys, "expected the objects of the resource to be kept")
+
+	rsrc.Versions[0].Name = "v9"
+	assert.Error(master.InstallThirdPartyResource(rsrc), "expected the served version not to change")
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	expapi "k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/apiserver/openapi"
+	"k8s.io/kubernetes/pkg/version"
+)
+
+// thirdPartyKind describes the objects of a third party resource, as served by the master.
+type thirdPartyKind struct {
+	group   string
+	kind    string
+	version expapi.APIVersion
+	// versions of the resource when it was installed, the first of which is version
+	versions []expapi.APIVersion
+}
+
+// definitionName returns the name of the OpenAPI definition of the objects of kind.
+func (k thirdPartyKind) definitionName() string {
+	return k.group + "." + k.version.Name + "." + k.kind
+}
+
+// InstallOpenAPI installs the /swagger.json endpoint, which serves an OpenAPI 2.0 document
+// describing every API group served by the master, including third party resources.
+// The document is built when requested, so it covers web services installed later.
+func (m *Master) InstallOpenAPI() {
+	config := openapi.Config{
+		Info: openapi.Info{
+			Title:   "Kubernetes",
+			Version: version.Get().GitVersion,
+		},
+		WebServices: func() []*restful.WebService {
+			return m.handlerContainer.RegisteredWebServices()
+		},
+		IgnorePrefixes:   []string{"/swaggerapi"},
+		PostBuildHandler: m.addThirdPartyDefinitions,
+	}
+	m.muxHelper.Handle("/swagger.json", openapi.NewHandler(config))
+}
+
+// addThirdPartyDefinitions replaces the generic definitions referred to by the paths of
+// third party resources with definitions of their objects, built from their schemas.
+func (m *Master) addThirdPartyDefinitions(spec *openapi.Swagger) {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	for path, kind := range m.thirdPartyKinds {
+		name := kind.definitionName()
+		spec.Definitions[name] = thirdPartyObjectSchema(kind)
+		spec.Definitions[name+"List"] = thirdPartyListSchema(name)
+
+		for specPath, item := range spec.Paths {
+			if !strings.HasPrefix(specPath, path+"/") {
+				continue
+			}
+			for _, op := range *item {
+				for i := range op.Parameters {
+					op.Parameters[i].Schema = replaceThirdPartyRef(op.Parameters[i].Schema, name)
+				}
+				for code, response := range op.Responses {
+					response.Schema = replaceThirdPartyRef(response.Schema, name)
+					op.Responses[code] = response
+				}
+			}
+		}
+	}
+}
+
+// replaceThirdPartyRef returns a reference to the named definition, or its list, if schema
+// refers to the generic definitions of third party objects, and schema otherwise.
+func replaceThirdPartyRef(schema *openapi.Schema, name string) *openapi.Schema {
+	switch {
+	case schema == nil:
+		return nil
+	case strings.HasSuffix(schema.Ref, ".ThirdPartyResourceData"):
+		return openapi.DefinitionRef(name)
+	case strings.HasSuffix(schema.Ref, ".ThirdPartyResourceDataList"):
+		return openapi.DefinitionRef(name + "List")
+	}
+	return schema
+}
+
+func thirdPartyObjectSchema(kind thirdPartyKind) *openapi.Schema {
+	schema := &openapi.Schema{
+		Type:       "object",
+		Properties: map[string]*openapi.Schema{},
+	}
+	if kind.version.Schema != nil {
+		schema = convertJSONSchemaProps(kind.version.Schema)
+		if schema.Properties == nil {
+			schema.Properties = map[string]*openapi.Schema{}
+		}
+	}
+	if len(schema.Description) == 0 {
+		schema.Description = kind.kind + " is a third party object of the " + kind.group + " API group."
+	}
+	schema.Properties["apiVersion"] = &openapi.Schema{Type: "string"}
+	schema.Properties["kind"] = &openapi.Schema{Type: "string"}
+	schema.Properties["metadata"] = openapi.DefinitionRef("v1.ObjectMeta")
+	return schema
+}
+
+func thirdPartyListSchema(name string) *openapi.Schema {
+	return &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   openapi.DefinitionRef("unversioned.ListMeta"),
+			"items":      {Type: "array", Items: openapi.DefinitionRef(name)},
+		},
+		Required: []string{"items"},
+	}
+}
+
+// convertJSONSchemaProps returns the OpenAPI schema equivalent to the schema of a third
+// party resource.
+func convertJSONSchemaProps(props *expapi.JSONSchemaProps) *openapi.Schema {
+	schema := &openapi.Schema{
+		Description: props.Description,
+		Type:        props.Type,
+		Required:    props.Required,
+		Enum:        props.Enum,
+		Pattern:     props.Pattern,
+		Minimum:     props.Minimum,
+		Maximum:     props.Maximum,
+		MaxLength:   props.MaxLength,
+	}
+	if len(props.Properties) > 0 {
+		schema.Properties = map[string]*openapi.Schema{}
+		for name := range props.Properties {
+			property := props.Properties[name]
+			schema.Properties[name] = convertJSONSchemaProps(&property)
+		}
+	}
+	if props.AdditionalProperties {
+		schema.AdditionalProperties = &openapi.Schema{}
+	}
+	if props.Items != nil {
+		schema.Items = convertJSONSchemaProps(props.Items)
+	}
+	return schema
+}
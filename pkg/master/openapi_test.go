@@ -0,0 +1,115 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/apiserver/openapi"
+)
+
+func TestInstallOpenAPI(t *testing.T) {
+	master, _, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	master.muxHelper = &apiserver.MuxHelper{Mux: master.handlerContainer.ServeMux}
+	master.InstallOpenAPI()
+
+	resp, err := http.Get(server.URL + "/swagger.json")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status: %v", resp)
+	}
+	spec := openapi.Swagger{}
+	if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	object, found := spec.Definitions["company.com.v1.Foo"]
+	if !found {
+		t.Fatalf("expected a definition of Foo, got %#v", spec.Definitions)
+	}
+	if !reflect.DeepEqual(object.Properties["metadata"], openapi.DefinitionRef("v1.ObjectMeta")) {
+		t.Errorf("unexpected metadata: %#v", object.Properties["metadata"])
+	}
+	if _, found := spec.Definitions["company.com.v1.FooList"]; !found {
+		t.Errorf("expected a definition of FooList, got %#v", spec.Definitions)
+	}
+
+	item, found := spec.Paths["/apis/company.com/v1/namespaces/{namespace}/foos/{name}"]
+	if !found {
+		t.Fatalf("expected a path for foos, got %#v", spec.Paths)
+	}
+	get := (*item)["get"]
+	if get == nil {
+		t.Fatalf("expected a get operation, got %#v", item)
+	}
+	if schema := get.Responses["200"].Schema; !reflect.DeepEqual(schema, openapi.DefinitionRef("company.com.v1.Foo")) {
+		t.Errorf("unexpected response schema: %#v", schema)
+	}
+	list := (*spec.Paths["/apis/company.com/v1/namespaces/{namespace}/foos"])["get"]
+	if schema := list.Responses["200"].Schema; !reflect.DeepEqual(schema, openapi.DefinitionRef("company.com.v1.FooList")) {
+		t.Errorf("unexpected list response schema: %#v", schema)
+	}
+}
+
+func TestThirdPartyObjectSchema(t *testing.T) {
+	maxLength := int64(10)
+	kind := thirdPartyKind{
+		group: "company.com",
+		kind:  "Foo",
+		version: experimental.APIVersion{
+			Name: "v1",
+			Schema: &experimental.JSONSchemaProps{
+				Description: "Foo is a foo.",
+				Type:        "object",
+				Properties: map[string]experimental.JSONSchemaProps{
+					"someField": {Type: "string", MaxLength: &maxLength},
+					"tags": {
+						Type:  "array",
+						Items: &experimental.JSONSchemaProps{Type: "string", Enum: []string{"a", "b"}},
+					},
+					"extra": {Type: "object", AdditionalProperties: true},
+				},
+				Required: []string{"someField"},
+			},
+		},
+	}
+	expected := &openapi.Schema{
+		Description: "Foo is a foo.",
+		Type:        "object",
+		Properties: map[string]*openapi.Schema{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   openapi.DefinitionRef("v1.ObjectMeta"),
+			"someField":  {Type: "string", MaxLength: &maxLength},
+			"tags":       {Type: "array", Items: &openapi.Schema{Type: "string", Enum: []string{"a", "b"}}},
+			"extra":      {Type: "object", AdditionalProperties: &openapi.Schema{}},
+		},
+		Required: []string{"someField"},
+	}
+	if schema := thirdPartyObjectSchema(kind); !reflect.DeepEqual(schema, expected) {
+		t.Errorf("expected %#v, got %#v", expected, schema)
+	}
+}
@@ -43,7 +43,7 @@ type resourceInterface interface {
 	RemoveThirdPartyResource(path string) error
 	// Install a third party resource described by 'rsrc'
 	InstallThirdPartyResource(rsrc *expapi.ThirdPartyResource) error
-	// Is a particular third party resource currently installed?
+	// Is a particular third party resource currently installed, as described by 'rsrc'?
 	HasThirdPartyResource(rsrc *expapi.ThirdPartyResource) (bool, error)
 	// List all currently installed third party resources
 	ListThirdPartyResources() []string
@@ -58,9 +58,7 @@ type ThirdPartyController struct {
 
 // Synchronize a single resource with RESTful resources on the master
 func (t *ThirdPartyController) SyncOneResource(rsrc *expapi.ThirdPartyResource) error {
-	// TODO: we also need to test if the existing installed resource matches the resource we are sync-ing.
-	// Currently, if there is an older, incompatible resource installed, we won't remove it.  We should detect
-	// older, incompatible resources and remove them before testing if the resource exists.
+	// A resource installed with other schemas or conversion hooks is installed again to update them.
 	hasResource, err := t.master.HasThirdPartyResource(rsrc)
 	if err != nil {
 		return err
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrolebinding
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store ClusterRoleBinding objects.
+type Registry interface {
+	// ListClusterRoleBindings obtains a list of ClusterRoleBindings having labels which match selector.
+	ListClusterRoleBindings(ctx api.Context, selector labels.Selector) (*experimental.ClusterRoleBindingList, error)
+	// Watch for new/changed/deleted ClusterRoleBindings
+	WatchClusterRoleBindings(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific ClusterRoleBinding
+	GetClusterRoleBinding(ctx api.Context, name string) (*experimental.ClusterRoleBinding, error)
+	// Create a ClusterRoleBinding based on a specification.
+	CreateClusterRoleBinding(ctx api.Context, clusterRoleBinding *experimental.ClusterRoleBinding) (*experimental.ClusterRoleBinding, error)
+	// Update an existing ClusterRoleBinding
+	UpdateClusterRoleBinding(ctx api.Context, clusterRoleBinding *experimental.ClusterRoleBinding) (*experimental.ClusterRoleBinding, error)
+	// Delete an existing ClusterRoleBinding
+	DeleteClusterRoleBinding(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListClusterRoleBindings(ctx api.Context, label labels.Selector) (*experimental.ClusterRoleBindingList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.ClusterRoleBindingList), nil
+}
+
+func (s *storage) WatchClusterRoleBindings(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetClusterRoleBinding(ctx api.Context, name string) (*experimental.ClusterRoleBinding, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.ClusterRoleBinding), nil
+}
+
+func (s *storage) CreateClusterRoleBinding(ctx api.Context, clusterRoleBinding *experimental.ClusterRoleBinding) (*experimental.ClusterRoleBinding, error) {
+	obj, err := s.Create(ctx, clusterRoleBinding)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.ClusterRoleBinding), nil
+}
+
+func (s *storage) UpdateClusterRoleBinding(ctx api.Context, clusterRoleBinding *experimental.ClusterRoleBinding) (*experimental.ClusterRoleBinding, error) {
+	obj, _, err := s.Update(ctx, clusterRoleBinding)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.ClusterRoleBinding), nil
+}
+
+func (s *storage) DeleteClusterRoleBinding(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store ConfigMap objects.
+type Registry interface {
+	// ListConfigMaps obtains a list of ConfigMaps having labels which match selector.
+	ListConfigMaps(ctx api.Context, selector labels.Selector) (*api.ConfigMapList, error)
+	// Watch for new/changed/deleted config maps
+	WatchConfigMaps(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific ConfigMap
+	GetConfigMap(ctx api.Context, name string) (*api.ConfigMap, error)
+	// Create a ConfigMap based on a specification.
+	CreateConfigMap(ctx api.Context, cfg *api.ConfigMap) (*api.ConfigMap, error)
+	// Update an existing ConfigMap
+	UpdateConfigMap(ctx api.Context, cfg *api.ConfigMap) (*api.ConfigMap, error)
+	// Delete an existing ConfigMap
+	DeleteConfigMap(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListConfigMaps(ctx api.Context, label labels.Selector) (*api.ConfigMapList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ConfigMapList), nil
+}
+
+func (s *storage) WatchConfigMaps(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetConfigMap(ctx api.Context, name string) (*api.ConfigMap, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ConfigMap), nil
+}
+
+func (s *storage) CreateConfigMap(ctx api.Context, cfg *api.ConfigMap) (*api.ConfigMap, error) {
+	obj, err := s.Create(ctx, cfg)
+	return obj.(*api.ConfigMap), err
+}
+
+func (s *storage) UpdateConfigMap(ctx api.Context, cfg *api.ConfigMap) (*api.ConfigMap, error) {
+	obj, _, err := s.Update(ctx, cfg)
+	return obj.(*api.ConfigMap), err
+}
+
+func (s *storage) DeleteConfigMap(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -19,6 +19,7 @@ package etcd
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	"k8s.io/kubernetes/pkg/api"
@@ -147,8 +148,40 @@ func (e *Etcd) List(ctx api.Context, label labels.Selector, field fields.Selecto
 	return e.ListPredicate(ctx, e.PredicateFunc(label, field))
 }
 
+// ListPage returns a single page of the items matching the selectors of options.
+// If options set a resource version but no limit and the storage can serve lists
+// from memory, every item as of that resource version or later is returned from
+// memory instead. Limited lists are always paged from storage.
+func (e *Etcd) ListPage(ctx api.Context, options *api.ListOptions) (runtime.Object, error) {
+	label, field := options.LabelSelector, options.FieldSelector
+	if label == nil {
+		label = labels.Everything()
+	}
+	if field == nil {
+		field = fields.Everything()
+	}
+	m := e.PredicateFunc(label, field)
+	if lister, ok := e.Storage.(storage.IndexLister); ok && len(options.ResourceVersion) > 0 && options.Limit == 0 && len(options.Continue) == 0 {
+		resourceVersion, err := strconv.ParseUint(options.ResourceVersion, 10, 64)
+		if err != nil {
+			return nil, kubeerr.NewBadRequest(fmt.Sprintf("invalid resource version %q: %v", options.ResourceVersion, err))
+		}
+		list := e.NewListFunc()
+		if err := lister.ListFromIndex(e.KeyRootFunc(ctx), resourceVersion, field, e.filterAndDecorateFunction(m), list); err != nil {
+			return nil, etcderr.InterpretListError(err, e.EndpointName)
+		}
+		return list, nil
+	}
+	return e.listPredicate(ctx, m, storage.ListPage{Limit: options.Limit, Continue: options.Continue})
+}
+
 // ListPredicate returns a list of all the items matching m.
 func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object, error) {
+	return e.listPredicate(ctx, m, storage.ListPage{})
+}
+
+// listPredicate returns the page of the list of items matching m selected by page.
+func (e *Etcd) listPredicate(ctx api.Context, m generic.Matcher, page storage.ListPage) (runtime.Object, error) {
 	list := e.NewListFunc()
 	trace := util.NewTrace("List " + reflect.TypeOf(list).String())
 	filterFunc := e.filterAndDecorateFunction(m)
@@ -167,10 +200,10 @@ func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object
 	}
 
 	trace.Step("About to list directory")
-	err := e.Storage.List(e.KeyRootFunc(ctx), filterFunc, list)
+	err := e.Storage.List(e.KeyRootFunc(ctx), page, filterFunc, list)
 	trace.Step("List extracted")
 	if err != nil {
-		return nil, err
+		return nil, etcderr.InterpretListError(err, e.EndpointName)
 	}
 	return list, nil
 }
@@ -194,6 +227,9 @@ func (e *Etcd) Create(ctx api.Context, obj runtime.Object) (runtime.Object, erro
 	if err != nil {
 		return nil, err
 	}
+	if api.IsDryRun(ctx) {
+		return e.dryRunCreate(key, name, obj)
+	}
 	trace.Step("About to create object")
 	out := e.NewFunc()
 	if err := e.Storage.Create(key, obj, out, ttl); err != nil {
@@ -239,8 +275,10 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	doUnconditionalUpdate := resourceVersion == 0 && e.UpdateStrategy.AllowUnconditionalUpdate()
 	// TODO: expose TTL
 	creating := false
-	out := e.NewFunc()
-	err = e.Storage.GuaranteedUpdate(key, out, true, func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+	// finalizing is true if the stored object was already marked for deletion
+	// and waiting on finalizers when the update was applied
+	finalizing := false
+	tryUpdate := func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
 		if err != nil {
 			return nil, nil, err
@@ -261,6 +299,7 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 		}
 
 		creating = false
+		finalizing = hasPendingFinalizers(existing)
 		if doUnconditionalUpdate {
 			// Update the object's resource version to match the latest etcd object's resource version.
 			err = e.Storage.Versioner().UpdateObject(obj, res.Expiration, res.ResourceVersion)
@@ -288,8 +327,13 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 			return obj, &ttl, nil
 		}
 		return obj, nil, nil
-	})
-
+	}
+	if api.IsDryRun(ctx) {
+		out, err := e.dryRunUpdate(key, name, tryUpdate)
+		return out, creating, err
+	}
+	out := e.NewFunc()
+	err = e.Storage.GuaranteedUpdate(key, out, true, tryUpdate)
 	if err != nil {
 		if creating {
 			err = etcderr.InterpretCreateError(err, e.EndpointName, name)
@@ -311,6 +355,20 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 				return nil, false, err
 			}
 		}
+		// the update removed the last finalizer of an object marked for deletion
+		if finalizing && isFinalized(out) {
+			trace.Step("About to delete finalized object")
+			deleted := e.NewFunc()
+			if err := e.Storage.Delete(key, deleted); err != nil {
+				return nil, false, etcderr.InterpretDeleteError(err, e.EndpointName, name)
+			}
+			if e.AfterDelete != nil {
+				if err := e.AfterDelete(deleted); err != nil {
+					return nil, false, err
+				}
+			}
+			out = deleted
+		}
 	}
 	if e.Decorator != nil {
 		if err := e.Decorator(obj); err != nil {
@@ -320,6 +378,56 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	return out, creating, nil
 }
 
+// SupportsDryRun implements rest.DryRunner.
+func (e *Etcd) SupportsDryRun() bool {
+	return true
+}
+
+// dryRunCreate returns obj as it would have been created at key, failing if an
+// object already exists there.
+func (e *Etcd) dryRunCreate(key, name string, obj runtime.Object) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(key, existing, true); err != nil {
+		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 {
+		return nil, kubeerr.NewAlreadyExists(e.EndpointName, name)
+	}
+	if e.Decorator != nil {
+		if err := e.Decorator(obj); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// dryRunUpdate applies tryUpdate to the object currently stored at key and returns
+// the result without writing it back.
+func (e *Etcd) dryRunUpdate(key, name string, tryUpdate storage.UpdateFunc) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(key, existing, true); err != nil {
+		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := tryUpdate(existing, storage.ResponseMeta{ResourceVersion: version})
+	if err != nil {
+		return nil, err
+	}
+	if e.Decorator != nil {
+		if err := e.Decorator(out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 // Get retrieves the item from etcd.
 func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 	obj := e.NewFunc()
@@ -370,7 +478,7 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	if err != nil {
 		return nil, err
 	}
-	if pendingGraceful {
+	if pendingGraceful || api.IsDryRun(ctx) {
 		return e.finalizeDelete(obj, false)
 	}
 	if graceful {
@@ -410,6 +518,21 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 		}
 	}
 
+	// objects with finalizers are only marked for deletion, and are removed by
+	// the update which clears the last finalizer
+	if objectMeta, err := api.ObjectMetaFor(obj); err == nil && len(objectMeta.Finalizers) != 0 {
+		trace.Step("Marking object for finalization")
+		out, err := e.markForFinalization(key)
+		switch err {
+		case nil:
+			return out, nil
+		case errDeleteNow:
+			// the finalizers were cleared since the object was read
+		default:
+			return nil, etcderr.InterpretUpdateError(err, e.EndpointName, name)
+		}
+	}
+
 	// delete immediately, or no graceful deletion supported
 	out := e.NewFunc()
 	trace.Step("About to delete object")
@@ -419,6 +542,54 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	return e.finalizeDelete(out, true)
 }
 
+// markForFinalization sets the deletion timestamp of the object at key, and a
+// deletion grace period of zero, so that it is removed as soon as its last
+// finalizer is cleared. errDeleteNow is returned if it has no finalizers.
+func (e *Etcd) markForFinalization(key string) (runtime.Object, error) {
+	out := e.NewFunc()
+	err := e.Storage.GuaranteedUpdate(
+		key, out, false,
+		storage.SimpleUpdate(func(existing runtime.Object) (runtime.Object, error) {
+			objectMeta, err := api.ObjectMetaFor(existing)
+			if err != nil {
+				return nil, err
+			}
+			if len(objectMeta.Finalizers) == 0 {
+				return nil, errDeleteNow
+			}
+			if objectMeta.DeletionTimestamp == nil {
+				now := unversioned.Now()
+				objectMeta.DeletionTimestamp = &now
+			}
+			period := int64(0)
+			objectMeta.DeletionGracePeriodSeconds = &period
+			return existing, nil
+		}),
+	)
+	return out, err
+}
+
+// isFinalized returns true if obj has been marked for deletion and has no
+// finalizers left, so it may be removed from storage.
+func isFinalized(obj runtime.Object) bool {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return false
+	}
+	return objectMeta.DeletionTimestamp != nil && len(objectMeta.Finalizers) == 0 &&
+		objectMeta.DeletionGracePeriodSeconds != nil && *objectMeta.DeletionGracePeriodSeconds == 0
+}
+
+// hasPendingFinalizers returns true if obj has been marked for deletion and
+// still has finalizers that must be removed before it is deleted.
+func hasPendingFinalizers(obj runtime.Object) bool {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return false
+	}
+	return objectMeta.DeletionTimestamp != nil && len(objectMeta.Finalizers) > 0
+}
+
 func (e *Etcd) finalizeDelete(obj runtime.Object, runHooks bool) (runtime.Object, error) {
 	if runHooks && e.AfterDelete != nil {
 		if err := e.AfterDelete(obj); err != nil {
@@ -24,8 +24,12 @@ import (
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
 	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
 	"k8s.io/kubernetes/pkg/tools"
 	"k8s.io/kubernetes/pkg/tools/etcdtest"
@@ -253,6 +257,91 @@ func TestEtcdList(t *testing.T) {
 	}
 }
 
+func TestEtcdListPage(t *testing.T) {
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	fakeClient, registry := NewTestGenericEtcdRegistry(t)
+	registry.PredicateFunc = func(label labels.Selector, field fields.Selector) generic.Matcher {
+		return everythingMatcher{}
+	}
+	key := etcdtest.AddPrefix(registry.KeyRootFunc(testContext))
+	nodes := []*etcd.Node{}
+	for i, name := range []string{"bar", "foo"} {
+		pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: name, Namespace: "test"}}
+		nodes = append(nodes, &etcd.Node{Key: key + "/" + name, Value: runtime.EncodeOrDie(testapi.Default.Codec(), pod), ModifiedIndex: uint64(i + 1)})
+	}
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{EtcdIndex: 2, Node: &etcd.Node{Dir: true, Nodes: nodes}},
+	}
+
+	first, err := registry.ListPage(testContext, &api.ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	firstList := first.(*api.PodList)
+	if len(firstList.Items) != 1 || firstList.Items[0].Name != "bar" || len(firstList.Continue) == 0 {
+		t.Fatalf("unexpected page: %#v", firstList)
+	}
+	second, err := registry.ListPage(testContext, &api.ListOptions{Limit: 1, Continue: firstList.Continue})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secondList := second.(*api.PodList)
+	if len(secondList.Items) != 1 || secondList.Items[0].Name != "foo" || len(secondList.Continue) != 0 {
+		t.Errorf("unexpected page: %#v", secondList)
+	}
+
+	if _, err := registry.ListPage(testContext, &api.ListOptions{Limit: 1, Continue: "invalid"}); !errors.IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+}
+
+// fakeIndexLister is a storage which serves lists from memory.
+type fakeIndexLister struct {
+	storage.Interface
+	resourceVersion uint64
+	field           fields.Selector
+}
+
+func (f *fakeIndexLister) ListFromIndex(key string, resourceVersion uint64, field fields.Selector, filter storage.FilterFunc, listObj runtime.Object) error {
+	f.resourceVersion, f.field = resourceVersion, field
+	listObj.(*api.PodList).Items = []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "cached"}}}
+	return nil
+}
+
+func TestEtcdListPageFromIndex(t *testing.T) {
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	_, registry := NewTestGenericEtcdRegistry(t)
+	registry.PredicateFunc = func(label labels.Selector, field fields.Selector) generic.Matcher {
+		return everythingMatcher{}
+	}
+	lister := &fakeIndexLister{Interface: registry.Storage}
+	registry.Storage = lister
+
+	field := fields.OneTermEqualSelector("spec.nodeName", "machine")
+	list, err := registry.ListPage(testContext, &api.ListOptions{FieldSelector: field, ResourceVersion: "5"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items := list.(*api.PodList).Items; len(items) != 1 || items[0].Name != "cached" {
+		t.Errorf("expected the list to be served from memory, got %#v", items)
+	}
+	if lister.resourceVersion != 5 || lister.field.String() != field.String() {
+		t.Errorf("unexpected list from memory at %d with %v", lister.resourceVersion, lister.field)
+	}
+
+	lister.resourceVersion = 0
+	if _, err := registry.ListPage(testContext, &api.ListOptions{ResourceVersion: "5", Limit: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lister.resourceVersion != 0 {
+		t.Errorf("expected a limited list to be paged from storage")
+	}
+
+	if _, err := registry.ListPage(testContext, &api.ListOptions{ResourceVersion: "invalid"}); !errors.IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+}
+
 func TestEtcdCreate(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
@@ -589,6 +678,215 @@ func TestEtcdDelete(t *testing.T) {
 	}
 }
 
+func TestEtcdDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", ResourceVersion: "1"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	podB := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine2"},
+	}
+
+	nodeWithPodA := tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Value:         runtime.EncodeOrDie(testapi.Default.Codec(), podA),
+				ModifiedIndex: 1,
+				CreatedIndex:  1,
+			},
+		},
+		E: nil,
+	}
+
+	emptyNode := tools.EtcdResponseWithError{
+		R: &etcd.Response{},
+		E: tools.EtcdErrorNotFound,
+	}
+
+	testContext := api.WithDryRun(api.WithNamespace(api.NewContext(), "test"))
+
+	table := map[string]struct {
+		existing tools.EtcdResponseWithError
+		op       func(registry *Etcd) (runtime.Object, error)
+		errOK    func(error) bool
+	}{
+		"create": {
+			existing: emptyNode,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				return registry.Create(testContext, podB)
+			},
+			errOK: func(err error) bool { return err == nil },
+		},
+		"createPreExisting": {
+			existing: nodeWithPodA,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				return registry.Create(testContext, podB)
+			},
+			errOK: errors.IsAlreadyExists,
+		},
+		"update": {
+			existing: nodeWithPodA,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				obj, _, err := registry.Update(testContext, podB)
+				return obj, err
+			},
+			errOK: func(err error) bool { return err == nil },
+		},
+		"updateNotExisting": {
+			existing: emptyNode,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				obj, _, err := registry.Update(testContext, podB)
+				return obj, err
+			},
+			errOK: errors.IsNotFound,
+		},
+		"delete": {
+			existing: nodeWithPodA,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				return registry.Delete(testContext, "foo", nil)
+			},
+			errOK: func(err error) bool { return err == nil },
+		},
+		"deleteNotExisting": {
+			existing: emptyNode,
+			op: func(registry *Etcd) (runtime.Object, error) {
+				return registry.Delete(testContext, "foo", nil)
+			},
+			errOK: errors.IsNotFound,
+		},
+	}
+
+	for name, item := range table {
+		fakeClient, registry := NewTestGenericEtcdRegistry(t)
+		path := etcdtest.AddPrefix("pods/foo")
+		fakeClient.Data[path] = item.existing
+		obj, err := item.op(registry)
+		if !item.errOK(err) {
+			t.Errorf("%v: unexpected error: %v (%#v)", name, err, obj)
+		}
+		if e, a := item.existing, fakeClient.Data[path]; !api.Semantic.DeepEqual(e, a) {
+			t.Errorf("%v: dry run modified storage:\n%s", name, util.ObjectDiff(e, a))
+		}
+	}
+}
+
+func TestEtcdDeleteWithFinalizers(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", Finalizers: []string{"example.com/cleanup"}},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	fakeClient, registry := NewTestGenericEtcdRegistry(t)
+	path := etcdtest.AddPrefix("pods/foo")
+	fakeClient.Data[path] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Value:         runtime.EncodeOrDie(testapi.Default.Codec(), podA),
+				ModifiedIndex: 1,
+				CreatedIndex:  1,
+			},
+		},
+	}
+
+	// Deleting only marks the object.
+	obj, err := registry.Delete(testContext, "foo", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	marked, ok := obj.(*api.Pod)
+	if !ok {
+		t.Fatalf("expected the marked pod to be returned, got %#v", obj)
+	}
+	if marked.DeletionTimestamp == nil || marked.DeletionGracePeriodSeconds == nil || *marked.DeletionGracePeriodSeconds != 0 {
+		t.Errorf("expected the pod to be marked for deletion, got %#v", marked.ObjectMeta)
+	}
+	if _, err := registry.Get(testContext, "foo"); err != nil {
+		t.Fatalf("expected the pod to still exist: %v", err)
+	}
+
+	// Deleting again leaves the mark in place.
+	if _, err := registry.Delete(testContext, "foo", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(testContext, "foo"); err != nil {
+		t.Fatalf("expected the pod to still exist: %v", err)
+	}
+
+	// An update which keeps a finalizer leaves the object in place.
+	stored, err := registry.Get(testContext, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := stored.(*api.Pod)
+	pod.Labels = map[string]string{"updated": "true"}
+	if _, _, err := registry.Update(testContext, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(testContext, "foo"); err != nil {
+		t.Fatalf("expected the pod to still exist: %v", err)
+	}
+
+	// Clearing the last finalizer removes the object.
+	stored, err = registry.Get(testContext, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod = stored.(*api.Pod)
+	pod.Finalizers = nil
+	if _, _, err := registry.Update(testContext, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(testContext, "foo"); !errors.IsNotFound(err) {
+		t.Errorf("expected the pod to be deleted, got %v", err)
+	}
+}
+
+func TestEtcdUpdateDoesNotDeleteLiveObjects(t *testing.T) {
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	_, registry := NewTestGenericEtcdRegistry(t)
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test", Finalizers: []string{"example.com/cleanup"}},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	created, err := registry.Create(testContext, pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod = created.(*api.Pod)
+	pod.Finalizers = nil
+	if _, _, err := registry.Update(testContext, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(testContext, "foo"); err != nil {
+		t.Errorf("expected an object which is not being deleted to be kept: %v", err)
+	}
+}
+
+func TestEtcdUpdateDoesNotDeleteObjectsMarkedByUpdate(t *testing.T) {
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	_, registry := NewTestGenericEtcdRegistry(t)
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+	created, err := registry.Create(testContext, pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod = created.(*api.Pod)
+	now := unversioned.Now()
+	period := int64(0)
+	pod.DeletionTimestamp = &now
+	pod.DeletionGracePeriodSeconds = &period
+	if _, _, err := registry.Update(testContext, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(testContext, "foo"); err != nil {
+		t.Errorf("expected an object marked for deletion by an update to be kept: %v", err)
+	}
+}
+
 func TestEtcdWatch(t *testing.T) {
 	testContext := api.WithNamespace(api.NewContext(), "test")
 	noNamespaceContext := api.NewContext()
@@ -17,6 +17,11 @@ limitations under the License.
 package generic
 
 import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -34,8 +39,9 @@ type SelectionPredicate struct {
 }
 
 // Matches returns true if the given object's labels and fields (as
-// returned by s.GetAttrs) match s.Label and s.Field. An error is
-// returned if s.GetAttrs fails.
+// returned by s.GetAttrs) match s.Label and s.Field. Fields which
+// s.GetAttrs does not return are looked up in the object itself by
+// their JSON path. An error is returned if s.GetAttrs fails.
 func (s *SelectionPredicate) Matches(obj runtime.Object) (bool, error) {
 	if s.Label.Empty() && s.Field.Empty() {
 		return true, nil
@@ -44,7 +50,7 @@ func (s *SelectionPredicate) Matches(obj runtime.Object) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	return s.Label.Matches(labels) && s.Field.Matches(fields), nil
+	return s.Label.Matches(labels) && s.Field.Matches(attrFields{fields, ObjectFields(obj)}), nil
 }
 
 // MatchesSingle will return (name, true) if and only if s.Field matches on the object's
@@ -112,6 +118,198 @@ func (m matchKey) MatchesSingle() (string, bool) {
 	return m.key, true
 }
 
+// attrFields prefers the fields computed by an AttrFunc, which may not be
+// part of the object, over the fields of the object itself.
+type attrFields struct {
+	attrs  fields.Set
+	object fields.Fields
+}
+
+func (a attrFields) Has(field string) bool {
+	return a.attrs.Has(field) || a.object.Has(field)
+}
+
+func (a attrFields) Get(field string) string {
+	if a.attrs.Has(field) {
+		return a.attrs.Get(field)
+	}
+	return a.object.Get(field)
+}
+
+// ObjectFields returns the fields of obj addressed by their JSON path, e.g.
+// "spec.nodeName" or "metadata.labels.app". Map values are addressed by their
+// key and slice elements by their index. Only paths ending in a string, a
+// boolean, a number or a type with a String method have a value.
+func ObjectFields(obj runtime.Object) fields.Fields {
+	return objectFields{reflect.ValueOf(obj)}
+}
+
+// IsObjectField returns true if field is a JSON path that ObjectFields can
+// return a value for on objects of the same type as obj.
+func IsObjectField(obj runtime.Object, field string) bool {
+	t := reflect.TypeOf(obj)
+	for _, name := range strings.Split(field, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			index, ok := jsonFieldIndex(t, name)
+			if !ok {
+				return false
+			}
+			t = t.FieldByIndex(index).Type
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return false
+			}
+			t = t.Elem()
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.ParseUint(name, 10, 0); err != nil {
+				return false
+			}
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	if reflect.PtrTo(t).Implements(stringerType) {
+		return true
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+type objectFields struct {
+	value reflect.Value
+}
+
+func (o objectFields) Has(field string) bool {
+	_, found := lookupField(o.value, strings.Split(field, "."))
+	return found
+}
+
+func (o objectFields) Get(field string) string {
+	value, _ := lookupField(o.value, strings.Split(field, "."))
+	return value
+}
+
+// lookupField follows path from v and formats the value it ends at.
+func lookupField(v reflect.Value, path []string) (string, bool) {
+	for ; len(path) > 0; path = path[1:] {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return "", false
+			}
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Struct:
+			index, ok := jsonFieldIndex(v.Type(), path[0])
+			if !ok {
+				return "", false
+			}
+			v = v.FieldByIndex(index)
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return "", false
+			}
+			v = v.MapIndex(reflect.ValueOf(path[0]).Convert(v.Type().Key()))
+			if !v.IsValid() {
+				return "", false
+			}
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(path[0])
+			if err != nil || i < 0 || i >= v.Len() {
+				return "", false
+			}
+			v = v.Index(i)
+		default:
+			return "", false
+		}
+	}
+	return formatField(v)
+}
+
+// formatField formats a scalar value the way it is written in a field selector.
+func formatField(v reflect.Value) (string, bool) {
+	if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface && reflect.PtrTo(v.Type()).Implements(stringerType) {
+		// Copy the value so that String methods with pointer receivers, like
+		// the one of resource.Quantity, can be called on map values too.
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		return ptr.Interface().(fmt.Stringer).String(), true
+	}
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return stringer.String(), true
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// jsonFieldIndex returns the index of the field of struct type t which is
+// serialized under name, looking into inlined and embedded structs.
+func jsonFieldIndex(t reflect.Type, name string) ([]int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName := strings.Split(tag, ",")[0]
+		if (f.Anonymous && tagName == "") || strings.Contains(tag, ",inline") {
+			if f.Type.Kind() != reflect.Struct {
+				continue
+			}
+			if index, ok := jsonFieldIndex(f.Type, name); ok {
+				return append([]int{i}, index...), true
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if tagName == "" {
+			tagName = f.Name
+		}
+		if tagName == name {
+			return []int{i}, true
+		}
+	}
+	return nil, false
+}
+
 var (
 	// Assert implementations match the interface.
 	_ = Matcher(matchKey{})
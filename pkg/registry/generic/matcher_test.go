@@ -20,6 +20,9 @@ import (
 	"errors"
 	"testing"
 
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -127,3 +130,110 @@ func TestSingleMatch(t *testing.T) {
 		t.Errorf("Expected %#v, got %#v", e, a)
 	}
 }
+
+func testPod() *api.Pod {
+	grace := int64(30)
+	return &api.Pod{
+		TypeMeta:   unversioned.TypeMeta{Kind: "Pod"},
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "ns", Labels: map[string]string{"app": "web"}},
+		Spec: api.PodSpec{
+			NodeName:                      "node-1",
+			HostNetwork:                   true,
+			TerminationGracePeriodSeconds: &grace,
+			Containers: []api.Container{{
+				Name:  "web",
+				Image: "nginx",
+				Resources: api.ResourceRequirements{
+					Limits: api.ResourceList{api.ResourceCPU: resource.MustParse("100m")},
+				},
+			}},
+		},
+		Status: api.PodStatus{Phase: api.PodRunning},
+	}
+}
+
+func TestObjectFields(t *testing.T) {
+	table := map[string]struct {
+		value string
+		found bool
+	}{
+		"kind":                                   {"Pod", true},
+		"metadata.name":                          {"foo", true},
+		"metadata.labels.app":                    {"web", true},
+		"metadata.labels.missing":                {"", false},
+		"spec.nodeName":                          {"node-1", true},
+		"spec.hostNetwork":                       {"true", true},
+		"spec.terminationGracePeriodSeconds":     {"30", true},
+		"spec.activeDeadlineSeconds":             {"", false},
+		"spec.containers.0.image":                {"nginx", true},
+		"spec.containers.1.image":                {"", false},
+		"spec.containers.x.image":                {"", false},
+		"spec.containers.0.resources.limits.cpu": {"100m", true},
+		"status.phase":                           {"Running", true},
+		"spec":                                   {"", false},
+		"spec.bogus":                             {"", false},
+	}
+	fields := ObjectFields(testPod())
+	for field, item := range table {
+		if e, a := item.found, fields.Has(field); e != a {
+			t.Errorf("%v: expected found %v, got %v", field, e, a)
+		}
+		if e, a := item.value, fields.Get(field); e != a {
+			t.Errorf("%v: expected %q, got %q", field, e, a)
+		}
+	}
+}
+
+func TestIsObjectField(t *testing.T) {
+	table := map[string]bool{
+		"kind":                                   true,
+		"metadata.name":                          true,
+		"metadata.labels.anything":               true,
+		"spec.activeDeadlineSeconds":             true,
+		"spec.containers.3.image":                true,
+		"spec.containers.0.resources.limits.cpu": true,
+		"spec":                                   false,
+		"spec.containers":                        false,
+		"spec.containers.x.image":                false,
+		"spec.bogus":                             false,
+		"metadata.name.extra":                    false,
+	}
+	for field, expected := range table {
+		if e, a := expected, IsObjectField(&api.Pod{}, field); e != a {
+			t.Errorf("%v: expected %v, got %v", field, e, a)
+		}
+	}
+}
+
+func TestSelectionPredicateObjectFields(t *testing.T) {
+	table := map[string]bool{
+		"spec.nodeName=node-1":                          true,
+		"spec.nodeName in (node-1,node-2)":              true,
+		"spec.nodeName notin (node-1,node-2)":           false,
+		"spec.containers.0.image=nginx,status.phase=up": true,
+		"spec.containers.0.image!=nginx":                false,
+		"metadata.labels.app in (web)":                  true,
+	}
+	for selector, shouldMatch := range table {
+		parsedField, err := fields.ParseSelector(selector)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", selector, err)
+		}
+		sp := &SelectionPredicate{
+			Label: labels.Everything(),
+			Field: parsedField,
+			GetAttrs: func(runtime.Object) (label labels.Set, field fields.Set, err error) {
+				// Fields returned by GetAttrs take precedence over the object.
+				return labels.Set{}, fields.Set{"status.phase": "up"}, nil
+			},
+		}
+		got, err := sp.Matches(testPod())
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", selector, err)
+			continue
+		}
+		if e, a := shouldMatch, got; e != a {
+			t.Errorf("%v: expected %v, got %v", selector, e, a)
+		}
+	}
+}
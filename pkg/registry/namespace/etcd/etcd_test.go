@@ -170,3 +170,29 @@ func TestDeleteNamespaceWithCompleteFinalizers(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestDeleteActiveNamespace(t *testing.T) {
+	storage, fakeClient := newStorage(t)
+	key := etcdtest.AddPrefix("namespaces/foo")
+	ctx := api.NewContext()
+	namespace := &api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name: "foo",
+		},
+		Spec: api.NamespaceSpec{
+			Finalizers: []api.FinalizerName{api.FinalizerKubernetes},
+		},
+		Status: api.NamespaceStatus{Phase: api.NamespaceActive},
+	}
+	if _, err := fakeClient.Set(key, runtime.EncodeOrDie(testapi.Default.Codec(), namespace), 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := storage.Delete(ctx, "foo", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deleted := obj.(*api.Namespace)
+	if deleted.DeletionTimestamp.IsZero() || deleted.Status.Phase != api.NamespaceTerminating {
+		t.Errorf("expected the namespace to be terminating, got %#v", deleted)
+	}
+}
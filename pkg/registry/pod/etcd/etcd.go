@@ -73,6 +73,10 @@ func NewStorage(s storage.Interface, useCacher bool, k client.ConnectionInfoGett
 				return storage.NamespaceKeyFunc(prefix, obj)
 			},
 			NewListFunc: func() runtime.Object { return &api.PodList{} },
+			// Kubelets select the pods bound to their node.
+			IndexedFields: map[string]func(runtime.Object) string{
+				"spec.nodeName": func(obj runtime.Object) string { return obj.(*api.Pod).Spec.NodeName },
+			},
 		}
 		storageInterface = storage.NewCacher(config)
 	}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorityclass
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store PriorityClass objects.
+type Registry interface {
+	// ListPriorityClasses obtains a list of PriorityClasses having labels which match selector.
+	ListPriorityClasses(ctx api.Context, selector labels.Selector) (*api.PriorityClassList, error)
+	// Watch for new/changed/deleted priority classes
+	WatchPriorityClasses(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific PriorityClass
+	GetPriorityClass(ctx api.Context, name string) (*api.PriorityClass, error)
+	// Create a PriorityClass based on a specification.
+	CreatePriorityClass(ctx api.Context, pc *api.PriorityClass) (*api.PriorityClass, error)
+	// Update an existing PriorityClass
+	UpdatePriorityClass(ctx api.Context, pc *api.PriorityClass) (*api.PriorityClass, error)
+	// Delete an existing PriorityClass
+	DeletePriorityClass(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListPriorityClasses(ctx api.Context, label labels.Selector) (*api.PriorityClassList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.PriorityClassList), nil
+}
+
+func (s *storage) WatchPriorityClasses(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetPriorityClass(ctx api.Context, name string) (*api.PriorityClass, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.PriorityClass), nil
+}
+
+func (s *storage) CreatePriorityClass(ctx api.Context, pc *api.PriorityClass) (*api.PriorityClass, error) {
+	obj, err := s.Create(ctx, pc)
+	return obj.(*api.PriorityClass), err
+}
+
+func (s *storage) UpdatePriorityClass(ctx api.Context, pc *api.PriorityClass) (*api.PriorityClass, error) {
+	obj, _, err := s.Update(ctx, pc)
+	return obj.(*api.PriorityClass), err
+}
+
+func (s *storage) DeletePriorityClass(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package role
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store Role objects.
+type Registry interface {
+	// ListRoles obtains a list of Roles having labels which match selector.
+	ListRoles(ctx api.Context, selector labels.Selector) (*experimental.RoleList, error)
+	// Watch for new/changed/deleted Roles
+	WatchRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific Role
+	GetRole(ctx api.Context, name string) (*experimental.Role, error)
+	// Create a Role based on a specification.
+	CreateRole(ctx api.Context, role *experimental.Role) (*experimental.Role, error)
+	// Update an existing Role
+	UpdateRole(ctx api.Context, role *experimental.Role) (*experimental.Role, error)
+	// Delete an existing Role
+	DeleteRole(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListRoles(ctx api.Context, label labels.Selector) (*experimental.RoleList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.RoleList), nil
+}
+
+func (s *storage) WatchRoles(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetRole(ctx api.Context, name string) (*experimental.Role, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.Role), nil
+}
+
+func (s *storage) CreateRole(ctx api.Context, role *experimental.Role) (*experimental.Role, error) {
+	obj, err := s.Create(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.Role), nil
+}
+
+func (s *storage) UpdateRole(ctx api.Context, role *experimental.Role) (*experimental.Role, error) {
+	obj, _, err := s.Update(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*experimental.Role), nil
+}
+
+func (s *storage) DeleteRole(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/apis/experimental/validation"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// maxHookResponseBytes is the size of the largest response of a conversion hook which is read.
+const maxHookResponseBytes = 3 * 1024 * 1024
+
+// Converter converts the data of third party objects to the version a ThirdPartyResource is
+// served in, which is its first version. Objects written in another version are sent to the
+// conversion hook of that version.
+type Converter struct {
+	version string
+	hooks   map[string]string
+	client  *http.Client
+}
+
+// NewConverter returns a Converter for a ThirdPartyResource with the given versions. Hooks are
+// not called on loopback, link-local or unspecified addresses, whatever their host name
+// resolves to, and redirects are not followed.
+func NewConverter(versions []experimental.APIVersion) *Converter {
+	c := &Converter{
+		hooks: map[string]string{},
+		client: &http.Client{
+			Transport: &http.Transport{
+				Dial:                dialHook,
+				TLSHandshakeTimeout: 10 * time.Second,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return errors.New("conversion hooks may not redirect")
+			},
+			Timeout: 30 * time.Second,
+		},
+	}
+	if len(versions) > 0 {
+		c.version = versions[0].Name
+	}
+	for _, version := range versions {
+		if len(version.ConversionHook) > 0 {
+			c.hooks[version.Name] = version.ConversionHook
+		}
+	}
+	return c
+}
+
+// dialHook connects to the address of a conversion hook, unless it resolves to a forbidden IP.
+func dialHook(network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		if validation.IsForbiddenConversionHookIP(ip) {
+			return nil, fmt.Errorf("conversion hook address %s resolves to forbidden IP %s", host, ip)
+		}
+	}
+	return net.DialTimeout(network, net.JoinHostPort(ips[0].String(), port), 30*time.Second)
+}
+
+// dataVersion returns the version of data, without the API group if it has one.
+func dataVersion(data []byte) (string, error) {
+	typeMeta := unversioned.TypeMeta{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return "", err
+	}
+	version := typeMeta.APIVersion
+	if i := strings.LastIndex(version, "/"); i != -1 {
+		version = version[i+1:]
+	}
+	return version, nil
+}
+
+// Convert converts obj, a *ThirdPartyResourceData, to the served version. Objects already in the
+// served version, or in a version without a conversion hook, are left unchanged. The errors
+// returned are API errors.
+func (c *Converter) Convert(obj runtime.Object) error {
+	thirdParty, ok := obj.(*experimental.ThirdPartyResourceData)
+	if !ok {
+		return fmt.Errorf("unexpected object: %#v", obj)
+	}
+	if len(c.hooks) == 0 {
+		return nil
+	}
+	version, err := dataVersion(thirdParty.Data)
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("unable to read the version of %s: %v", thirdParty.Name, err))
+	}
+	hook, found := c.hooks[version]
+	if version == c.version || !found {
+		return nil
+	}
+
+	resp, err := c.client.Post(hook, "application/json", bytes.NewReader(thirdParty.Data))
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("unable to convert %s from %s to %s: %v", thirdParty.Name, version, c.version, err))
+	}
+	defer resp.Body.Close()
+	// Read one byte more than allowed to tell a response of the maximum size from a larger one.
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHookResponseBytes+1))
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(data) > maxHookResponseBytes {
+		return apierrors.NewInternalError(fmt.Errorf("conversion hook %s for %s returned more than %d bytes", hook, thirdParty.Name, maxHookResponseBytes))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return apierrors.NewInternalError(fmt.Errorf("conversion hook %s for %s returned %d: %s", hook, thirdParty.Name, resp.StatusCode, string(data)))
+	}
+	converted, err := dataVersion(data)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("conversion hook %s for %s returned an invalid object: %v", hook, thirdParty.Name, err))
+	}
+	if converted != c.version {
+		return apierrors.NewInternalError(fmt.Errorf("conversion hook %s for %s returned version %q, expected %q", hook, thirdParty.Name, converted, c.version))
+	}
+	thirdParty.Data = data
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+)
+
+func TestConverter(t *testing.T) {
+	var received string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		received = string(body)
+		w.Write([]byte(strings.Replace(strings.Replace(received, `v1"`, `v2"`, 1), "someField", "newField", 1)))
+	}))
+	defer hook.Close()
+
+	converter := NewConverter([]experimental.APIVersion{
+		{Name: "v2"},
+		{Name: "v1", ConversionHook: hook.URL},
+	})
+	// the test server listens on a loopback address
+	converter.client = http.DefaultClient
+	tests := []struct {
+		data      string
+		converted string
+	}{
+		{
+			data:      `{"kind":"Foo","apiVersion":"v1","someField":"a"}`,
+			converted: `{"kind":"Foo","apiVersion":"v2","newField":"a"}`,
+		},
+		{
+			data:      `{"kind":"Foo","apiVersion":"company.com/v1","someField":"a"}`,
+			converted: `{"kind":"Foo","apiVersion":"company.com/v2","newField":"a"}`,
+		},
+		{
+			data:      `{"kind":"Foo","apiVersion":"v2","newField":"a"}`,
+			converted: `{"kind":"Foo","apiVersion":"v2","newField":"a"}`,
+		},
+		{
+			data:      `{"kind":"Foo","apiVersion":"v0","oldField":"a"}`,
+			converted: `{"kind":"Foo","apiVersion":"v0","oldField":"a"}`,
+		},
+	}
+	for _, test := range tests {
+		received = ""
+		obj := &experimental.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "foo"}, Data: []byte(test.data)}
+		if err := converter.Convert(obj); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.data, err)
+			continue
+		}
+		if e, a := test.converted, string(obj.Data); e != a {
+			t.Errorf("%s: expected %s, got %s", test.data, e, a)
+		}
+		if test.data != test.converted && received != test.data {
+			t.Errorf("%s: hook received %s", test.data, received)
+		}
+	}
+}
+
+func TestConverterRejectsWrongVersion(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"kind":"Foo","apiVersion":"v1"}`))
+	}))
+	defer hook.Close()
+
+	converter := NewConverter([]experimental.APIVersion{
+		{Name: "v2"},
+		{Name: "v1", ConversionHook: hook.URL},
+	})
+	// the test server listens on a loopback address
+	converter.client = http.DefaultClient
+	obj := &experimental.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "foo"}, Data: []byte(`{"kind":"Foo","apiVersion":"v1"}`)}
+	if err := converter.Convert(obj); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestConverterRejectsLargeResponses(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"kind":"Foo","apiVersion":"v2","data":"`))
+		w.Write([]byte(strings.Repeat("a", maxHookResponseBytes)))
+		w.Write([]byte(`"}`))
+	}))
+	defer hook.Close()
+
+	converter := NewConverter([]experimental.APIVersion{
+		{Name: "v2"},
+		{Name: "v1", ConversionHook: hook.URL},
+	})
+	// the test server listens on a loopback address
+	converter.client = http.DefaultClient
+	obj := &experimental.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "foo"}, Data: []byte(`{"kind":"Foo","apiVersion":"v1"}`)}
+	if err := converter.Convert(obj); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestConverterRejectsForbiddenHooks(t *testing.T) {
+	called := false
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+		w.Write([]byte(`{"kind":"Foo","apiVersion":"v2"}`))
+	}))
+	defer hook.Close()
+
+	converter := NewConverter([]experimental.APIVersion{
+		{Name: "v2"},
+		{Name: "v1", ConversionHook: hook.URL},
+	})
+	obj := &experimental.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "foo"}, Data: []byte(`{"kind":"Foo","apiVersion":"v1"}`)}
+	if err := converter.Convert(obj); err == nil {
+		t.Errorf("expected an error")
+	}
+	if called {
+		t.Errorf("expected the hook on a loopback address not to be called")
+	}
+}
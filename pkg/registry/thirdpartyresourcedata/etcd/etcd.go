@@ -18,6 +18,7 @@ package etcd
 
 import (
 	"strings"
+	"sync"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/experimental"
@@ -33,12 +34,20 @@ import (
 // REST implements a RESTStorage for ThirdPartyResourceDatas against etcd
 type REST struct {
 	*etcdgeneric.Etcd
+
+	// lock guards converter and the strategies of Etcd, which change with the versions of the
+	// resource
+	lock      sync.RWMutex
+	converter *thirdpartyresourcedata.Converter
 }
 
-// NewREST returns a registry which will store ThirdPartyResourceData in the given helper
-func NewREST(s storage.Interface, group, kind string) *REST {
+// NewREST returns a registry which will store ThirdPartyResourceData in the given helper.
+// Objects are validated against the schema of the first of versions, the served version, and
+// objects written in other versions are converted to it before they are validated and stored.
+func NewREST(s storage.Interface, group, kind string, versions []experimental.APIVersion) *REST {
 	prefix := "/ThirdPartyResourceData/" + group + "/" + strings.ToLower(kind) + "s"
 
+	strategy := thirdpartyresourcedata.NewStrategy(servedSchema(versions))
 	store := &etcdgeneric.Etcd{
 		NewFunc:     func() runtime.Object { return &experimental.ThirdPartyResourceData{} },
 		NewListFunc: func() runtime.Object { return &experimental.ThirdPartyResourceDataList{} },
@@ -55,11 +64,52 @@ func NewREST(s storage.Interface, group, kind string) *REST {
 			return thirdpartyresourcedata.Matcher(label, field)
 		},
 		EndpointName:   "thirdpartyresourcedata",
-		CreateStrategy: thirdpartyresourcedata.Strategy,
-		UpdateStrategy: thirdpartyresourcedata.Strategy,
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
 
 		Storage: s,
 	}
 
-	return &REST{store}
+	return &REST{Etcd: store, converter: thirdpartyresourcedata.NewConverter(versions)}
+}
+
+// servedSchema returns the schema of the served version, the first of versions, if it has one.
+func servedSchema(versions []experimental.APIVersion) *experimental.JSONSchemaProps {
+	if len(versions) > 0 {
+		return versions[0].Schema
+	}
+	return nil
+}
+
+// SetVersions changes the schema and the conversion hooks objects are validated and converted
+// with. The served version, the first of versions, must stay the same.
+func (r *REST) SetVersions(versions []experimental.APIVersion) {
+	strategy := thirdpartyresourcedata.NewStrategy(servedSchema(versions))
+	converter := thirdpartyresourcedata.NewConverter(versions)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.CreateStrategy = strategy
+	r.UpdateStrategy = strategy
+	r.converter = converter
+}
+
+// Create converts obj to the served version and stores it.
+func (r *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if err := r.converter.Convert(obj); err != nil {
+		return nil, err
+	}
+	return r.Etcd.Create(ctx, obj)
+}
+
+// Update converts obj to the served version and stores it.
+func (r *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if err := r.converter.Convert(obj); err != nil {
+		return nil, false, err
+	}
+	return r.Etcd.Update(ctx, obj)
 }
@@ -32,7 +32,7 @@ import (
 
 func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient) {
 	etcdStorage, fakeClient := registrytest.NewEtcdStorage(t, "experimental")
-	return NewREST(etcdStorage, "foo", "bar"), fakeClient
+	return NewREST(etcdStorage, "foo", "bar", nil), fakeClient
 }
 
 func validNewThirdPartyResourceData(name string) *experimental.ThirdPartyResourceData {
@@ -111,3 +111,19 @@ func TestWatch(t *testing.T) {
 		},
 	)
 }
+
+func TestCreateConversionError(t *testing.T) {
+	etcdStorage, fakeClient := registrytest.NewEtcdStorage(t, "experimental")
+	storage := NewREST(etcdStorage, "foo", "bar", []experimental.APIVersion{
+		{Name: "v2"},
+		{Name: "v1", ConversionHook: "https://127.0.0.1/v1"},
+	})
+	obj := validNewThirdPartyResourceData("foo")
+	obj.Data = []byte(`{"kind":"Bar","apiVersion":"foo/v1"}`)
+	if _, err := storage.Create(api.NewDefaultContext(), obj); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(fakeClient.Data) != 0 {
+		t.Errorf("expected nothing to be stored, got %v", fakeClient.Data)
+	}
+}
@@ -34,11 +34,20 @@ import (
 type strategy struct {
 	runtime.ObjectTyper
 	api.NameGenerator
+
+	// schema, if set, is enforced on the data of created and updated objects
+	schema *experimental.JSONSchemaProps
 }
 
 // Strategy is the default logic that applies when creating and updating ThirdPartyResource
 // objects via the REST API.
-var Strategy = strategy{api.Scheme, api.SimpleNameGenerator}
+var Strategy = strategy{api.Scheme, api.SimpleNameGenerator, nil}
+
+// NewStrategy returns the logic that applies when creating and updating objects of a
+// ThirdPartyResource whose served version is described by schema, which may be nil.
+func NewStrategy(schema *experimental.JSONSchemaProps) strategy {
+	return strategy{api.Scheme, api.SimpleNameGenerator, schema}
+}
 
 var _ = rest.RESTCreateStrategy(Strategy)
 
@@ -51,8 +60,13 @@ func (strategy) NamespaceScoped() bool {
 func (strategy) PrepareForCreate(obj runtime.Object) {
 }
 
-func (strategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
-	return validation.ValidateThirdPartyResourceData(obj.(*experimental.ThirdPartyResourceData))
+func (s strategy) Validate(ctx api.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	data := obj.(*experimental.ThirdPartyResourceData)
+	allErrs := validation.ValidateThirdPartyResourceData(data)
+	if s.schema != nil {
+		allErrs = append(allErrs, validation.ValidateThirdPartyResourceDataSchema(data, s.schema)...)
+	}
+	return allErrs
 }
 
 func (strategy) AllowCreateOnUpdate() bool {
@@ -62,8 +76,13 @@ func (strategy) AllowCreateOnUpdate() bool {
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
 }
 
-func (strategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
-	return validation.ValidateThirdPartyResourceDataUpdate(old.(*experimental.ThirdPartyResourceData), obj.(*experimental.ThirdPartyResourceData))
+func (s strategy) ValidateUpdate(ctx api.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	data := obj.(*experimental.ThirdPartyResourceData)
+	allErrs := validation.ValidateThirdPartyResourceDataUpdate(old.(*experimental.ThirdPartyResourceData), data)
+	if s.schema != nil {
+		allErrs = append(allErrs, validation.ValidateThirdPartyResourceDataSchema(data, s.schema)...)
+	}
+	return allErrs
 }
 
 func (strategy) AllowUnconditionalUpdate() bool {
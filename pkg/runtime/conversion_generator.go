@@ -162,6 +162,8 @@ func (g *conversionGenerator) generateConversionsBetween(inType, outType reflect
 		// TODO(wojtek-t): Currently we don't support converting interfaces.
 		return fmt.Errorf("interfaces are not supported")
 	case reflect.Struct:
+		// Record the pair before visiting the fields, so self-referencing types terminate.
+		g.convertibles[inType] = outType
 		inErr := g.generateConversionsForStruct(inType, outType)
 		outErr := g.generateConversionsForStruct(outType, inType)
 		if !existingConversion && (inErr != nil || outErr != nil) {
@@ -170,7 +172,6 @@ func (g *conversionGenerator) generateConversionsBetween(inType, outType reflect
 		if existingConversion {
 			g.overridden[inType] = true
 		}
-		g.convertibles[inType] = outType
 		return nil
 	default:
 		// All simple types should be handled correctly with default conversion.
@@ -176,13 +176,14 @@ func (g *deepCopyGenerator) addAllRecursiveTypes(inType reflect.Type) error {
 		if !found {
 			return nil
 		}
+		// Mark the type before visiting its fields, so self-referencing types terminate.
+		g.copyables[inType] = true
 		for i := 0; i < inType.NumField(); i++ {
 			inField := inType.Field(i)
 			if err := g.addAllRecursiveTypes(inField.Type); err != nil {
 				return err
 			}
 		}
-		g.copyables[inType] = true
 	default:
 		// Simple types should be copied automatically.
 	}
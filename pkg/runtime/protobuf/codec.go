@@ -0,0 +1,190 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// ContentType is the media type of API objects encoded by this package.
+const ContentType = "application/vnd.kubernetes.protobuf"
+
+// magic prefixes every encoded object. It is not valid at the start of a JSON or
+// YAML document, so encoded objects can be told apart from those.
+var magic = []byte("k8s\x00")
+
+// envelope is the message following the magic number, identifying the encoded object.
+type envelope struct {
+	APIVersion string `protobuf:"1"`
+	Kind       string `protobuf:"2"`
+	Raw        []byte `protobuf:"3"`
+}
+
+// IsProtobuf returns true if data holds an object encoded by this package.
+func IsProtobuf(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// codec encodes objects of a scheme to a single version in the wire format and decodes
+// both the wire format and JSON.
+type codec struct {
+	scheme  *runtime.Scheme
+	version string
+}
+
+var _ runtime.Codec = &codec{}
+
+// NewCodec returns a Codec which encodes objects as version in the Protocol Buffers
+// wire format. The returned codec decodes both that format and JSON, falling back to
+// the JSON decoding of scheme for any data which isn't protobuf.
+func NewCodec(scheme *runtime.Scheme, version string) runtime.Codec {
+	return &codec{scheme: scheme, version: version}
+}
+
+// Encode implements runtime.Encoder.
+func (c *codec) Encode(obj runtime.Object) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := c.EncodeToStream(obj, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeToStream implements runtime.Encoder.
+func (c *codec) EncodeToStream(obj runtime.Object, stream io.Writer) error {
+	version, kind, err := c.scheme.ObjectVersionAndKind(obj)
+	if err != nil {
+		return err
+	}
+	out := obj
+	if isUnversioned(obj) {
+		// Objects of the unversioned package are the same in every version and are
+		// encoded as they are, as in JSON.
+		version = ""
+	} else {
+		if out, err = c.scheme.ConvertToVersion(obj, c.version); err != nil {
+			return err
+		}
+		version = c.version
+		// The version and kind are carried by the envelope.
+		if err := c.scheme.Raw().SetVersionAndKind("", "", out); err != nil {
+			return err
+		}
+	}
+	raw, err := marshal(out)
+	if err != nil {
+		return err
+	}
+	data, err := marshal(&envelope{APIVersion: version, Kind: kind, Raw: raw})
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Write(magic); err != nil {
+		return err
+	}
+	_, err = stream.Write(data)
+	return err
+}
+
+// isUnversioned returns true if obj is defined in the unversioned package.
+func isUnversioned(obj runtime.Object) bool {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return path.Base(t.PkgPath()) == "unversioned"
+}
+
+// decodeEnvelope returns the versioned object encoded in data.
+func (c *codec) decodeEnvelope(data []byte) (obj runtime.Object, version, kind string, err error) {
+	env := &envelope{}
+	if err := unmarshal(data[len(magic):], env); err != nil {
+		return nil, "", "", err
+	}
+	if len(env.Kind) == 0 {
+		return nil, "", "", errors.New("kind not set in protobuf envelope")
+	}
+	obj, err = c.scheme.New(env.APIVersion, env.Kind)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if err := unmarshal(env.Raw, obj); err != nil {
+		return nil, "", "", fmt.Errorf("unable to decode %s %s: %v", env.APIVersion, env.Kind, err)
+	}
+	return obj, env.APIVersion, env.Kind, nil
+}
+
+// Decode implements runtime.Decoder.
+func (c *codec) Decode(data []byte) (runtime.Object, error) {
+	return c.DecodeToVersion(data, "")
+}
+
+// DecodeToVersion implements runtime.Decoder.
+func (c *codec) DecodeToVersion(data []byte, version string) (runtime.Object, error) {
+	if !IsProtobuf(data) {
+		return c.scheme.DecodeToVersion(data, version)
+	}
+	obj, dataVersion, kind, err := c.decodeEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+	if dataVersion == version || isUnversioned(obj) {
+		return obj, nil
+	}
+	out, err := c.scheme.New(version, kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.scheme.Convert(obj, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DecodeInto implements runtime.Decoder.
+func (c *codec) DecodeInto(data []byte, obj runtime.Object) error {
+	return c.DecodeIntoWithSpecifiedVersionKind(data, obj, "", "")
+}
+
+// DecodeIntoWithSpecifiedVersionKind implements runtime.Decoder.
+func (c *codec) DecodeIntoWithSpecifiedVersionKind(data []byte, obj runtime.Object, version, kind string) error {
+	if !IsProtobuf(data) {
+		return c.scheme.DecodeIntoWithSpecifiedVersionKind(data, obj, version, kind)
+	}
+	decoded, dataVersion, dataKind, err := c.decodeEnvelope(data)
+	if err != nil {
+		return err
+	}
+	if len(version) > 0 && !isUnversioned(decoded) && dataVersion != version {
+		return fmt.Errorf("The apiVersion in the data (%s) does not match the specified apiVersion(%s)", dataVersion, version)
+	}
+	if len(kind) > 0 && dataKind != kind {
+		return fmt.Errorf("The kind in the data (%s) does not match the specified kind(%s)", dataKind, kind)
+	}
+	if err := c.scheme.Convert(decoded, obj); err != nil {
+		return err
+	}
+	// Version and Kind should be blank in memory.
+	return c.scheme.Raw().SetVersionAndKind("", "", obj)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf_test
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime/protobuf"
+)
+
+func testPod() *api.Pod {
+	grace := int64(30)
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar", ResourceVersion: "10", Labels: map[string]string{"a": "b"}},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{
+				Name:  "ctr",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Limits:   api.ResourceList{api.ResourceCPU: resource.MustParse("100m")},
+					Requests: api.ResourceList{api.ResourceCPU: resource.MustParse("100m")},
+				},
+				ImagePullPolicy:        api.PullIfNotPresent,
+				TerminationMessagePath: api.TerminationMessagePathDefault,
+			}},
+			RestartPolicy:                 api.RestartPolicyAlways,
+			TerminationGracePeriodSeconds: &grace,
+			DNSPolicy:                     api.DNSClusterFirst,
+		},
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	codec := protobuf.NewCodec(api.Scheme, testapi.Default.Version())
+	pod := testPod()
+	data, err := codec.Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !protobuf.IsProtobuf(data) {
+		t.Fatalf("expected protobuf, got %q", string(data))
+	}
+	jsonData, err := testapi.Default.Codec().Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) >= len(jsonData) {
+		t.Errorf("expected protobuf (%d bytes) to be smaller than JSON (%d bytes)", len(data), len(jsonData))
+	}
+
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(pod, obj) {
+		t.Errorf("expected:\n%#v\ngot:\n%#v", pod, obj)
+	}
+
+	into := &api.Pod{}
+	if err := codec.DecodeIntoWithSpecifiedVersionKind(data, into, testapi.Default.Version(), "Pod"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(pod, into) {
+		t.Errorf("expected:\n%#v\ngot:\n%#v", pod, into)
+	}
+	if err := codec.DecodeIntoWithSpecifiedVersionKind(data, &api.Pod{}, testapi.Default.Version(), "Service"); err == nil {
+		t.Errorf("expected an error for a mismatched kind")
+	}
+}
+
+func TestCodecDecodesJSON(t *testing.T) {
+	codec := protobuf.NewCodec(api.Scheme, testapi.Default.Version())
+	pod := testPod()
+	data, err := testapi.Default.Codec().Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(pod, obj) {
+		t.Errorf("expected:\n%#v\ngot:\n%#v", pod, obj)
+	}
+}
+
+func TestCodecUnversionedObjects(t *testing.T) {
+	codec := protobuf.NewCodec(api.Scheme, testapi.Default.Version())
+	status := &unversioned.Status{Status: unversioned.StatusFailure, Code: 404, Reason: unversioned.StatusReasonNotFound, Message: "not found"}
+	data, err := codec.Encode(status)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(status, obj) {
+		t.Errorf("expected:\n%#v\ngot:\n%#v", status, obj)
+	}
+}
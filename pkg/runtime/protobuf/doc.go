@@ -0,0 +1,43 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protobuf implements a runtime.Codec which encodes API objects in the
+// Protocol Buffers wire format.
+//
+// API objects are Go structs without generated message types, so the encoding
+// is derived from the struct definitions by reflection:
+//
+//  * Every field is numbered by a hash of its JSON name, so the binary encoding
+//    offers the same compatibility guarantees as the JSON encoding: fields may be
+//    added, reordered or removed without renumbering the others. A field may set
+//    its number explicitly with a `protobuf:"N"` struct tag, which is also how a
+//    hash collision reported by the codec is resolved.
+//  * Inline structs are flattened into the enclosing message, as in JSON.
+//  * Signed integers are zigzag encoded (sint32/sint64), unsigned integers and
+//    booleans are varints, floats are fixed32/fixed64, strings and []byte are
+//    length delimited, structs are nested messages, slices are repeated fields
+//    and maps are repeated entry messages with the key in field 1 and the value
+//    in field 2.
+//  * Types which customize their JSON encoding, such as unversioned.Time or
+//    resource.Quantity, are carried as their JSON representation in a length
+//    delimited field.
+//  * Zero scalars, empty collections and nil pointers are omitted.
+//
+// Encoded objects start with a magic number followed by an envelope message
+// carrying the apiVersion and kind of the object, which allows a decoder to
+// distinguish protobuf from JSON input. The codec decodes both, so it can be
+// used anywhere a JSON codec is.
+package protobuf
@@ -0,0 +1,594 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Protocol Buffers wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+const (
+	// maxFieldNumber is the largest field number allowed by the wire format.
+	maxFieldNumber = 1<<29 - 1
+	// firstReservedFieldNumber and lastReservedFieldNumber bound the range reserved
+	// for the Protocol Buffers implementation.
+	firstReservedFieldNumber = 19000
+	lastReservedFieldNumber  = 19999
+)
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	jsonNull            = []byte("null")
+)
+
+// field describes how a struct field is encoded.
+type field struct {
+	name   string
+	number int
+	// index is the index sequence of the field for reflect.Value.FieldByIndex,
+	// which is longer than one for fields of inline structs.
+	index []int
+}
+
+// structInfo holds the fields of a struct type.
+type structInfo struct {
+	fields   []field
+	byNumber map[int]*field
+}
+
+var (
+	structInfoLock sync.RWMutex
+	structInfos    = map[reflect.Type]*structInfo{}
+)
+
+// getStructInfo returns the fields of the struct type t, analyzing it on first use.
+func getStructInfo(t reflect.Type) (*structInfo, error) {
+	structInfoLock.RLock()
+	info, ok := structInfos[t]
+	structInfoLock.RUnlock()
+	if ok {
+		return info, nil
+	}
+
+	info = &structInfo{byNumber: map[int]*field{}}
+	if err := addFields(info, t, nil); err != nil {
+		return nil, err
+	}
+	for i := range info.fields {
+		f := &info.fields[i]
+		if other, ok := info.byNumber[f.number]; ok {
+			return nil, fmt.Errorf("fields %q and %q of %v have the same protobuf field number %d, one of them must set it with a protobuf struct tag", other.name, f.name, t, f.number)
+		}
+		info.byNumber[f.number] = f
+	}
+
+	structInfoLock.Lock()
+	defer structInfoLock.Unlock()
+	structInfos[t] = info
+	return info, nil
+}
+
+// addFields adds the encoded fields of the struct type t to info, flattening inline
+// structs the same way encoding/json does.
+func addFields(info *structInfo, t reflect.Type, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if len(sf.PkgPath) > 0 && !sf.Anonymous {
+			// unexported
+			continue
+		}
+		name, inline := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+		if inline {
+			if err := addFields(info, sf.Type, fieldIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(sf.PkgPath) > 0 {
+			// unexported embedded non-struct
+			continue
+		}
+		number, err := fieldNumber(sf, name)
+		if err != nil {
+			return fmt.Errorf("field %s of %v: %v", sf.Name, t, err)
+		}
+		info.fields = append(info.fields, field{name: name, number: number, index: fieldIndex})
+	}
+	return nil
+}
+
+// jsonName returns the JSON name of the struct field, and whether the field is an
+// embedded struct whose fields are encoded as if they belonged to the outer struct.
+func jsonName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	name := tag
+	if i := strings.Index(tag, ","); i != -1 {
+		name = tag[:i]
+	}
+	if sf.Anonymous && len(name) == 0 && sf.Type.Kind() == reflect.Struct {
+		return "", true
+	}
+	if len(name) == 0 {
+		name = sf.Name
+	}
+	return name, false
+}
+
+// fieldNumber returns the number of the field in the wire format: the value of its
+// protobuf struct tag, or else a hash of its JSON name.
+func fieldNumber(sf reflect.StructField, name string) (int, error) {
+	if tag := sf.Tag.Get("protobuf"); len(tag) > 0 {
+		number, err := strconv.Atoi(tag)
+		if err != nil || number < 1 || number > maxFieldNumber || (number >= firstReservedFieldNumber && number <= lastReservedFieldNumber) {
+			return 0, fmt.Errorf("invalid protobuf field number %q", tag)
+		}
+		return number, nil
+	}
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	number := int(h.Sum32() & maxFieldNumber)
+	if number == 0 {
+		number = 1
+	}
+	if number >= firstReservedFieldNumber && number <= lastReservedFieldNumber {
+		number += lastReservedFieldNumber - firstReservedFieldNumber + 1
+	}
+	return number, nil
+}
+
+// customJSON returns true if values of type t are encoded as their JSON representation.
+func customJSON(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType)
+}
+
+// marshal returns the wire format encoding of the struct pointed to by obj.
+func marshal(obj interface{}) ([]byte, error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a pointer to a struct, got %T", obj)
+	}
+	buf := proto.NewBuffer(nil)
+	if err := marshalStruct(buf, v.Elem()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalStruct(buf *proto.Buffer, v reflect.Value) error {
+	info, err := getStructInfo(v.Type())
+	if err != nil {
+		return err
+	}
+	for i := range info.fields {
+		f := &info.fields[i]
+		if err := marshalField(buf, f.number, v.FieldByIndex(f.index), false); err != nil {
+			return fmt.Errorf("%s: %v", f.name, err)
+		}
+	}
+	return nil
+}
+
+// marshalField appends the field with the given number holding v to buf. Zero values
+// are omitted unless always is set, which is the case for elements of repeated fields
+// and map entries.
+func marshalField(buf *proto.Buffer, number int, v reflect.Value, always bool) error {
+	t := v.Type()
+	if customJSON(t) {
+		if !always && reflect.DeepEqual(v.Interface(), reflect.Zero(t).Interface()) {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil
+		}
+		data, err := json.Marshal(addressable(v).Interface())
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(data, jsonNull) && !always {
+			return nil
+		}
+		return appendBytes(buf, number, data)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return marshalField(buf, number, v.Elem(), true)
+	case reflect.Struct:
+		nested := proto.NewBuffer(nil)
+		if err := marshalStruct(nested, v); err != nil {
+			return err
+		}
+		if len(nested.Bytes()) == 0 && !always {
+			return nil
+		}
+		return appendBytes(buf, number, nested.Bytes())
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if v.Len() == 0 && !always {
+				return nil
+			}
+			return appendBytes(buf, number, v.Bytes())
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := marshalElement(buf, number, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Sort(byString(keys))
+		for _, key := range keys {
+			entry := proto.NewBuffer(nil)
+			if err := marshalField(entry, 1, key, true); err != nil {
+				return err
+			}
+			if err := marshalElement(entry, 2, v.MapIndex(key)); err != nil {
+				return err
+			}
+			if err := appendBytes(buf, number, entry.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.String:
+		if v.Len() == 0 && !always {
+			return nil
+		}
+		return appendBytes(buf, number, []byte(v.String()))
+	case reflect.Bool:
+		if !v.Bool() && !always {
+			return nil
+		}
+		x := uint64(0)
+		if v.Bool() {
+			x = 1
+		}
+		appendTag(buf, number, wireVarint)
+		return buf.EncodeVarint(x)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() == 0 && !always {
+			return nil
+		}
+		appendTag(buf, number, wireVarint)
+		return buf.EncodeZigzag64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() == 0 && !always {
+			return nil
+		}
+		appendTag(buf, number, wireVarint)
+		return buf.EncodeVarint(v.Uint())
+	case reflect.Float32:
+		if v.Float() == 0 && !always {
+			return nil
+		}
+		appendTag(buf, number, wireFixed32)
+		return buf.EncodeFixed32(uint64(math.Float32bits(float32(v.Float()))))
+	case reflect.Float64:
+		if v.Float() == 0 && !always {
+			return nil
+		}
+		appendTag(buf, number, wireFixed64)
+		return buf.EncodeFixed64(math.Float64bits(v.Float()))
+	default:
+		return fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+// marshalElement appends an element of a repeated field or the value of a map entry.
+// Elements which are collections themselves are wrapped in a message holding them in
+// field 1, since repeated fields cannot be nested directly.
+func marshalElement(buf *proto.Buffer, number int, v reflect.Value) error {
+	if !isCollection(v.Type()) {
+		return marshalField(buf, number, v, true)
+	}
+	nested := proto.NewBuffer(nil)
+	if err := marshalField(nested, 1, v, false); err != nil {
+		return err
+	}
+	return appendBytes(buf, number, nested.Bytes())
+}
+
+func isCollection(t reflect.Type) bool {
+	if customJSON(t) {
+		return false
+	}
+	return t.Kind() == reflect.Map || (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8)
+}
+
+func appendTag(buf *proto.Buffer, number, wireType int) {
+	buf.EncodeVarint(uint64(number)<<3 | uint64(wireType))
+}
+
+func appendBytes(buf *proto.Buffer, number int, data []byte) error {
+	appendTag(buf, number, wireBytes)
+	return buf.EncodeRawBytes(data)
+}
+
+// addressable returns a pointer to v if it is addressable, so that methods with
+// pointer receivers are found, and v otherwise.
+func addressable(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Ptr && v.CanAddr() {
+		return v.Addr()
+	}
+	return v
+}
+
+// byString sorts map keys by their string representation, so that maps are encoded
+// deterministically.
+type byString []reflect.Value
+
+func (s byString) Len() int      { return len(s) }
+func (s byString) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byString) Less(i, j int) bool {
+	return fmt.Sprint(s[i].Interface()) < fmt.Sprint(s[j].Interface())
+}
+
+// unmarshal decodes the wire format encoding in data into the struct pointed to by obj.
+// Fields which are unknown to the struct are skipped.
+func unmarshal(data []byte, obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to a struct, got %T", obj)
+	}
+	return unmarshalStruct(data, v.Elem())
+}
+
+func unmarshalStruct(data []byte, v reflect.Value) error {
+	info, err := getStructInfo(v.Type())
+	if err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		number, wireType, value, rest, err := nextField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		f, ok := info.byNumber[number]
+		if !ok {
+			continue
+		}
+		if err := unmarshalField(wireType, value, fieldByIndex(v, f.index)); err != nil {
+			return fmt.Errorf("%s: %v", f.name, err)
+		}
+	}
+	return nil
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but never walks through pointers.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		v = v.Field(i)
+	}
+	return v
+}
+
+// nextField splits the first field off data. For varint and fixed width fields value
+// holds the raw number, for length delimited fields the contents.
+func nextField(data []byte) (number, wireType int, value []byte, rest []byte, err error) {
+	tag, n := proto.DecodeVarint(data)
+	if n == 0 {
+		return 0, 0, nil, nil, fmt.Errorf("invalid field tag")
+	}
+	data = data[n:]
+	number, wireType = int(tag>>3), int(tag&7)
+	switch wireType {
+	case wireVarint:
+		_, n = proto.DecodeVarint(data)
+		if n == 0 {
+			return 0, 0, nil, nil, fmt.Errorf("invalid varint in field %d", number)
+		}
+	case wireFixed64:
+		n = 8
+	case wireFixed32:
+		n = 4
+	case wireBytes:
+		length, m := proto.DecodeVarint(data)
+		if m == 0 || uint64(len(data)-m) < length {
+			return 0, 0, nil, nil, fmt.Errorf("invalid length in field %d", number)
+		}
+		data = data[m:]
+		n = int(length)
+	default:
+		return 0, 0, nil, nil, fmt.Errorf("unsupported wire type %d in field %d", wireType, number)
+	}
+	if len(data) < n {
+		return 0, 0, nil, nil, fmt.Errorf("field %d is truncated", number)
+	}
+	return number, wireType, data[:n], data[n:], nil
+}
+
+// unmarshalField decodes a field into v, appending to v if it is a repeated field.
+func unmarshalField(wireType int, value []byte, v reflect.Value) error {
+	t := v.Type()
+	if customJSON(t) {
+		if wireType != wireBytes {
+			return wireTypeError(wireType, t)
+		}
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(t.Elem()))
+			}
+			return v.Interface().(json.Unmarshaler).UnmarshalJSON(value)
+		}
+		if !reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+			return fmt.Errorf("%v cannot be decoded from JSON", t)
+		}
+		return v.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(value)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return unmarshalField(wireType, value, v.Elem())
+	case reflect.Struct:
+		if wireType != wireBytes {
+			return wireTypeError(wireType, t)
+		}
+		return unmarshalStruct(value, v)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if wireType != wireBytes {
+				return wireTypeError(wireType, t)
+			}
+			v.SetBytes(append([]byte{}, value...))
+			return nil
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		if err := unmarshalElement(wireType, value, elem); err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, elem))
+		return nil
+	case reflect.Map:
+		if wireType != wireBytes {
+			return wireTypeError(wireType, t)
+		}
+		key := reflect.New(t.Key()).Elem()
+		elem := reflect.New(t.Elem()).Elem()
+		for len(value) > 0 {
+			number, entryWireType, entryValue, rest, err := nextField(value)
+			if err != nil {
+				return err
+			}
+			value = rest
+			switch number {
+			case 1:
+				err = unmarshalField(entryWireType, entryValue, key)
+			case 2:
+				err = unmarshalElement(entryWireType, entryValue, elem)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		v.SetMapIndex(key, elem)
+		return nil
+	case reflect.String:
+		if wireType != wireBytes {
+			return wireTypeError(wireType, t)
+		}
+		v.SetString(string(value))
+		return nil
+	case reflect.Bool:
+		if wireType != wireVarint {
+			return wireTypeError(wireType, t)
+		}
+		x, _ := proto.DecodeVarint(value)
+		v.SetBool(x != 0)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if wireType != wireVarint {
+			return wireTypeError(wireType, t)
+		}
+		x, _ := proto.DecodeVarint(value)
+		v.SetInt(int64(x>>1) ^ -int64(x&1))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if wireType != wireVarint {
+			return wireTypeError(wireType, t)
+		}
+		x, _ := proto.DecodeVarint(value)
+		v.SetUint(x)
+		return nil
+	case reflect.Float32:
+		if wireType != wireFixed32 {
+			return wireTypeError(wireType, t)
+		}
+		x, err := proto.NewBuffer(value).DecodeFixed32()
+		if err != nil {
+			return err
+		}
+		v.SetFloat(float64(math.Float32frombits(uint32(x))))
+		return nil
+	case reflect.Float64:
+		if wireType != wireFixed64 {
+			return wireTypeError(wireType, t)
+		}
+		x, err := proto.NewBuffer(value).DecodeFixed64()
+		if err != nil {
+			return err
+		}
+		v.SetFloat(math.Float64frombits(x))
+		return nil
+	default:
+		return fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+// unmarshalElement decodes an element of a repeated field or the value of a map entry
+// encoded by marshalElement.
+func unmarshalElement(wireType int, value []byte, v reflect.Value) error {
+	if !isCollection(v.Type()) {
+		return unmarshalField(wireType, value, v)
+	}
+	if wireType != wireBytes {
+		return wireTypeError(wireType, v.Type())
+	}
+	for len(value) > 0 {
+		number, nestedWireType, nestedValue, rest, err := nextField(value)
+		if err != nil {
+			return err
+		}
+		value = rest
+		if number != 1 {
+			continue
+		}
+		if err := unmarshalField(nestedWireType, nestedValue, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func wireTypeError(wireType int, t reflect.Type) error {
+	return fmt.Errorf("wire type %d cannot be decoded into %v", wireType, t)
+}
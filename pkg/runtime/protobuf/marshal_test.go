@@ -0,0 +1,173 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+type Inline struct {
+	Name string `json:"name"`
+}
+
+type Nested struct {
+	Value  int64    `json:"value"`
+	Values []string `json:"values"`
+}
+
+type Internal struct {
+	Inline   `json:",inline"`
+	Int      int                          `json:"int"`
+	Uint     uint32                       `json:"uint"`
+	Bool     bool                         `json:"bool"`
+	Float    float64                      `json:"float"`
+	Float32  float32                      `json:"float32"`
+	Bytes    []byte                       `json:"bytes"`
+	Ignored  string                       `json:"-"`
+	Nested   Nested                       `json:"nested"`
+	Ptr      *Nested                      `json:"ptr"`
+	IntPtr   *int                         `json:"intPtr"`
+	Items    []Nested                     `json:"items"`
+	Map      map[string]string            `json:"map"`
+	Lists    map[string][]string          `json:"lists"`
+	Matrix   [][]int                      `json:"matrix"`
+	Time     unversioned.Time             `json:"time"`
+	TimePtr  *unversioned.Time            `json:"timePtr"`
+	Quantity resource.Quantity            `json:"quantity"`
+	Limits   map[string]resource.Quantity `json:"limits"`
+	IntStr   util.IntOrString             `json:"intStr"`
+	private  string
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	zero := 0
+	now := unversioned.NewTime(time.Unix(1000, 0))
+	in := &Internal{
+		Inline:   Inline{Name: "foo"},
+		Int:      -5,
+		Uint:     7,
+		Bool:     true,
+		Float:    1.5,
+		Float32:  -2.25,
+		Bytes:    []byte{0, 1, 2},
+		Nested:   Nested{Value: 1, Values: []string{"a", "", "b"}},
+		Ptr:      &Nested{},
+		IntPtr:   &zero,
+		Items:    []Nested{{Value: 2}, {}, {Values: []string{"c"}}},
+		Map:      map[string]string{"a": "1", "b": ""},
+		Lists:    map[string][]string{"a": {"1", "2"}, "b": {}},
+		Matrix:   [][]int{{1, 2}, {}, {3}},
+		Time:     now,
+		TimePtr:  &now,
+		Quantity: resource.MustParse("100m"),
+		Limits:   map[string]resource.Quantity{"cpu": resource.MustParse("2")},
+		IntStr:   util.NewIntOrStringFromString("http"),
+	}
+	data, err := marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := &Internal{}
+	if err := unmarshal(data, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Empty collections are omitted, so they are decoded as nil.
+	in.Lists["b"] = nil
+	in.Matrix[1] = nil
+	inLimit, outLimit := in.Limits["cpu"], out.Limits["cpu"]
+	if in.Quantity.String() != out.Quantity.String() || inLimit.String() != outLimit.String() {
+		t.Errorf("expected quantities to round trip, got %v and %v", out.Quantity, out.Limits)
+	}
+	in.Quantity, out.Quantity, in.Limits, out.Limits = resource.Quantity{}, resource.Quantity{}, nil, nil
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("expected:\n%#v\ngot:\n%#v", in, out)
+	}
+}
+
+func TestMarshalOmitsZeroValues(t *testing.T) {
+	data, err := marshal(&Internal{Ignored: "x", private: "y"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 0 {
+		t.Errorf("expected an empty message, got %v", data)
+	}
+}
+
+type Old struct {
+	Name string `json:"name"`
+}
+
+type New struct {
+	Added int64  `json:"added"`
+	Name  string `json:"name"`
+	Other Nested `json:"other"`
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	data, err := marshal(&New{Added: 5, Name: "foo", Other: Nested{Value: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := &Old{}
+	if err := unmarshal(data, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Name != "foo" {
+		t.Errorf("expected the field to be found independent of its position, got %#v", out)
+	}
+}
+
+type Colliding struct {
+	A string `json:"a" protobuf:"7"`
+	B string `json:"b" protobuf:"7"`
+}
+
+type Reserved struct {
+	A string `json:"a" protobuf:"19000"`
+}
+
+func TestInvalidFieldNumbers(t *testing.T) {
+	if _, err := marshal(&Colliding{}); err == nil {
+		t.Errorf("expected an error for colliding field numbers")
+	}
+	if _, err := marshal(&Reserved{}); err == nil {
+		t.Errorf("expected an error for a reserved field number")
+	}
+}
+
+func TestUnmarshalInvalidData(t *testing.T) {
+	valid, err := marshal(&Nested{Values: []string{"abc"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 1; i < len(valid); i++ {
+		if err := unmarshal(valid[:i], &Nested{}); err == nil {
+			t.Errorf("expected an error for data truncated to %d bytes", i)
+		}
+	}
+	if err := unmarshal(valid, &Old{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -22,12 +22,16 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/conversion"
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/golang/glog"
@@ -56,6 +60,11 @@ type CacherConfig struct {
 	// objects of type Type.
 	NewListFunc func() runtime.Object
 
+	// IndexedFields maps the field labels most commonly used in exact-match
+	// field selectors to functions returning their value for an object, so
+	// that ListFromIndex can serve them from an index instead of a scan.
+	IndexedFields map[string]func(runtime.Object) string
+
 	// Cacher will be stopped when the StopChannel will be closed.
 	StopChannel <-chan struct{}
 }
@@ -98,13 +107,32 @@ type Cacher struct {
 
 	// keyFunc is used to get a key in the underyling storage for a given object.
 	keyFunc func(runtime.Object) (string, error)
+
+	// indexed are the field labels the watchCache is indexed by.
+	indexed sets.String
+}
+
+// fieldIndexers returns indexers of the watchCache named after the field labels they index.
+func fieldIndexers(fields map[string]func(runtime.Object) string) cache.Indexers {
+	indexers := cache.Indexers{}
+	for field, fieldFunc := range fields {
+		fieldFunc := fieldFunc
+		indexers[field] = func(obj interface{}) ([]string, error) {
+			object, ok := obj.(runtime.Object)
+			if !ok {
+				return nil, fmt.Errorf("non runtime.Object in the cache: %v", obj)
+			}
+			return []string{fieldFunc(object)}, nil
+		}
+	}
+	return indexers
 }
 
 // Create a new Cacher responsible from service WATCH and LIST requests from its
 // internal cache and updating its cache in the background based on the given
 // configuration.
 func NewCacher(config CacherConfig) *Cacher {
-	watchCache := newWatchCache(config.CacheCapacity)
+	watchCache := newWatchCache(config.CacheCapacity, fieldIndexers(config.IndexedFields))
 	listerWatcher := newCacherListerWatcher(config.Storage, config.ResourcePrefix, config.NewListFunc)
 
 	cacher := &Cacher{
@@ -116,6 +144,10 @@ func NewCacher(config CacherConfig) *Cacher {
 		watchers:   make(map[int]*cacheWatcher),
 		versioner:  config.Versioner,
 		keyFunc:    config.KeyFunc,
+		indexed:    sets.NewString(),
+	}
+	for field := range config.IndexedFields {
+		cacher.indexed.Insert(field)
 	}
 	cacher.usable.Lock()
 	// See startCaching method for why explanation on it.
@@ -178,6 +210,18 @@ func (c *Cacher) Delete(key string, out runtime.Object) error {
 
 // Implements storage.Interface.
 func (c *Cacher) Watch(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	return c.watch(key, resourceVersion, filter, c.storage.Watch)
+}
+
+// Implements storage.Interface.
+func (c *Cacher) WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
+	return c.watch(key, resourceVersion, filter, c.storage.WatchList)
+}
+
+// watch serves a watch from the watchCache, or, if it starts before the
+// watchCache was listed, replays the changes the watchCache does not hold with
+// storageWatch and then continues from the watchCache.
+func (c *Cacher) watch(key string, resourceVersion uint64, filter FilterFunc, storageWatch func(string, uint64, FilterFunc) (watch.Interface, error)) (watch.Interface, error) {
 	// Do NOT allow Watch to start when the underlying structures are not propagated.
 	c.usable.RLock()
 	defer c.usable.RUnlock()
@@ -189,6 +233,17 @@ func (c *Cacher) Watch(key string, resourceVersion uint64, filter FilterFunc) (w
 	// underlying watchCache is calling processEvent under its lock.
 	c.watchCache.RLock()
 	defer c.watchCache.RUnlock()
+	if c.watchCache.IsBeforeListThreadUnsafe(resourceVersion) {
+		// The watch is resumed from a resource version the cache never saw,
+		// e.g. one a client got from a bookmark before the apiserver restarted.
+		// Let the underlying storage replay the changes rather than forcing
+		// the client to list everything again.
+		storageWatcher, err := storageWatch(key, resourceVersion, filter)
+		if err != nil {
+			return nil, err
+		}
+		return newReplayWatcher(c, key, filter, storageWatch, storageWatcher, c.watchCache.listResourceVersion), nil
+	}
 	initEvents, err := c.watchCache.GetAllEventsSinceThreadUnsafe(resourceVersion)
 	if err != nil {
 		return nil, err
@@ -196,17 +251,12 @@ func (c *Cacher) Watch(key string, resourceVersion uint64, filter FilterFunc) (w
 
 	c.Lock()
 	defer c.Unlock()
-	watcher := newCacheWatcher(initEvents, filterFunction(key, c.keyFunc, filter), forgetWatcher(c, c.watcherIdx))
+	watcher := newCacheWatcher(initEvents, filterFunction(key, c.keyFunc, filter), c.versioner, forgetWatcher(c, c.watcherIdx))
 	c.watchers[c.watcherIdx] = watcher
 	c.watcherIdx++
 	return watcher, nil
 }
 
-// Implements storage.Interface.
-func (c *Cacher) WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
-	return c.Watch(key, resourceVersion, filter)
-}
-
 // Implements storage.Interface.
 func (c *Cacher) Get(key string, objPtr runtime.Object, ignoreNotFound bool) error {
 	return c.storage.Get(key, objPtr, ignoreNotFound)
@@ -218,8 +268,8 @@ func (c *Cacher) GetToList(key string, filter FilterFunc, listObj runtime.Object
 }
 
 // Implements storage.Interface.
-func (c *Cacher) List(key string, filter FilterFunc, listObj runtime.Object) error {
-	return c.storage.List(key, filter, listObj)
+func (c *Cacher) List(key string, page ListPage, filter FilterFunc, listObj runtime.Object) error {
+	return c.storage.List(key, page, filter, listObj)
 }
 
 // ListFromMemory implements list operation (the same signature as List method)
@@ -234,6 +284,34 @@ func (c *Cacher) ListFromMemory(key string, listObj runtime.Object) error {
 	c.usable.RLock()
 	defer c.usable.RUnlock()
 
+	objs, resourceVersion := c.watchCache.ListWithVersion()
+	return c.listFromMemory(key, objs, resourceVersion, Everything, listObj)
+}
+
+// Implements storage.IndexLister. If field requires an exact match on one of
+// the configured IndexedFields, only the objects with that value are
+// considered instead of scanning the whole cache.
+func (c *Cacher) ListFromIndex(key string, resourceVersion uint64, field fields.Selector, filter FilterFunc, listObj runtime.Object) error {
+	if err := c.watchCache.waitUntilFresh(resourceVersion); err != nil {
+		return err
+	}
+	c.usable.RLock()
+	defer c.usable.RUnlock()
+
+	for _, indexName := range c.indexed.List() {
+		if value, found := field.RequiresExactMatch(indexName); found {
+			objs, resourceVersion, err := c.watchCache.ByIndexWithVersion(indexName, value)
+			if err != nil {
+				return err
+			}
+			return c.listFromMemory(key, objs, resourceVersion, filter, listObj)
+		}
+	}
+	objs, resourceVersion := c.watchCache.ListWithVersion()
+	return c.listFromMemory(key, objs, resourceVersion, filter, listObj)
+}
+
+func (c *Cacher) listFromMemory(key string, objs []interface{}, resourceVersion uint64, filter FilterFunc, listObj runtime.Object) error {
 	listPtr, err := runtime.GetItemsPtr(listObj)
 	if err != nil {
 		return err
@@ -242,9 +320,8 @@ func (c *Cacher) ListFromMemory(key string, listObj runtime.Object) error {
 	if err != nil || listVal.Kind() != reflect.Slice {
 		return fmt.Errorf("need a pointer to slice, got %v", listVal.Kind())
 	}
-	filter := filterFunction(key, c.keyFunc, Everything)
+	filter = filterFunction(key, c.keyFunc, filter)
 
-	objs, resourceVersion := c.watchCache.ListWithVersion()
 	for _, obj := range objs {
 		object, ok := obj.(runtime.Object)
 		if !ok {
@@ -255,7 +332,7 @@ func (c *Cacher) ListFromMemory(key string, listObj runtime.Object) error {
 		}
 	}
 	if c.versioner != nil {
-		if err := c.versioner.UpdateList(listObj, resourceVersion); err != nil {
+		if err := c.versioner.UpdateList(listObj, resourceVersion, ""); err != nil {
 			return err
 		}
 	}
@@ -343,7 +420,7 @@ func newCacherListerWatcher(storage Interface, resourcePrefix string, newListFun
 // Implements cache.ListerWatcher interface.
 func (lw *cacherListerWatcher) List() (runtime.Object, error) {
 	list := lw.newListFunc()
-	if err := lw.storage.List(lw.resourcePrefix, Everything, list); err != nil {
+	if err := lw.storage.List(lw.resourcePrefix, ListPage{}, Everything, list); err != nil {
 		return nil, err
 	}
 	return list, nil
@@ -358,6 +435,119 @@ func (lw *cacherListerWatcher) Watch(resourceVersion string) (watch.Interface, e
 	return lw.storage.WatchList(lw.resourcePrefix, version, Everything)
 }
 
+// replayWatcher implements watch.Interface for watches which start before the
+// watchCache was listed. It passes on the changes replayed by the underlying
+// storage until one of them is at or after the resource version the
+// watchCache was listed at, and then continues from the watchCache, so that
+// the watch does not stay on the underlying storage for its whole lifetime.
+// A watch whose key does not change after it starts stays on the underlying
+// storage until it ends.
+type replayWatcher struct {
+	cacher       *Cacher
+	key          string
+	filter       FilterFunc
+	storageWatch func(string, uint64, FilterFunc) (watch.Interface, error)
+	// listResourceVersion is the resource version the watchCache was listed
+	// at when the watch started.
+	listResourceVersion uint64
+
+	result   chan watch.Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newReplayWatcher(c *Cacher, key string, filter FilterFunc, storageWatch func(string, uint64, FilterFunc) (watch.Interface, error), storageWatcher watch.Interface, listResourceVersion uint64) *replayWatcher {
+	w := &replayWatcher{
+		cacher:              c,
+		key:                 key,
+		filter:              filter,
+		storageWatch:        storageWatch,
+		listResourceVersion: listResourceVersion,
+		result:              make(chan watch.Event),
+		stopCh:              make(chan struct{}),
+	}
+	go w.run(storageWatcher)
+	return w
+}
+
+// Implements watch.Interface.
+func (w *replayWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+// Implements watch.Interface.
+func (w *replayWatcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stopCh) })
+}
+
+func (w *replayWatcher) run(storageWatcher watch.Interface) {
+	defer close(w.result)
+	defer util.HandleCrash()
+
+	resourceVersion, ok := w.replay(storageWatcher)
+	storageWatcher.Stop()
+	if !ok {
+		return
+	}
+	// Continue after the last replayed change once the watchCache holds it.
+	if err := w.cacher.watchCache.waitUntilFresh(resourceVersion); err != nil {
+		w.send(watch.Event{Type: watch.Error, Object: &unversioned.Status{Status: unversioned.StatusFailure, Message: err.Error()}})
+		return
+	}
+	cacheWatcher, err := w.cacher.watch(w.key, resourceVersion+1, w.filter, w.storageWatch)
+	if err != nil {
+		w.send(watch.Event{Type: watch.Error, Object: &unversioned.Status{Status: unversioned.StatusFailure, Message: err.Error()}})
+		return
+	}
+	defer cacheWatcher.Stop()
+	for {
+		select {
+		case event, ok := <-cacheWatcher.ResultChan():
+			if !ok || !w.send(event) {
+				return
+			}
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// replay passes on the events of storageWatcher until one is at or after
+// listResourceVersion, and returns its resource version. It returns false if
+// the watch ended first.
+func (w *replayWatcher) replay(storageWatcher watch.Interface) (uint64, bool) {
+	versioner := w.cacher.storage.Versioner()
+	for {
+		select {
+		case event, ok := <-storageWatcher.ResultChan():
+			if !ok || !w.send(event) {
+				return 0, false
+			}
+			if event.Type == watch.Error {
+				continue
+			}
+			// Bookmarks count too, since every change before them was passed on.
+			resourceVersion, err := versioner.ObjectResourceVersion(event.Object)
+			if err == nil && resourceVersion >= w.listResourceVersion {
+				return resourceVersion, true
+			}
+		case <-w.stopCh:
+			return 0, false
+		}
+	}
+}
+
+// send passes event on to the client, and returns false if the watch was
+// stopped first.
+func (w *replayWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.stopCh:
+		return false
+	}
+}
+
 // cacherWatch implements watch.Interface
 type cacheWatcher struct {
 	sync.Mutex
@@ -366,15 +556,24 @@ type cacheWatcher struct {
 	filter  FilterFunc
 	stopped bool
 	forget  func()
+
+	// versioner creates bookmarks, which are not sent if it is nil.
+	versioner Versioner
+	// lastEvent is the last event processed by the watcher, whether or not it
+	// passed the filter, and bookmarkVersion the resource version of the
+	// last bookmark sent.
+	lastEvent       *watchCacheEvent
+	bookmarkVersion uint64
 }
 
-func newCacheWatcher(initEvents []watchCacheEvent, filter FilterFunc, forget func()) *cacheWatcher {
+func newCacheWatcher(initEvents []watchCacheEvent, filter FilterFunc, versioner Versioner, forget func()) *cacheWatcher {
 	watcher := &cacheWatcher{
-		input:   make(chan watchCacheEvent, 10),
-		result:  make(chan watch.Event, 10),
-		filter:  filter,
-		stopped: false,
-		forget:  forget,
+		input:     make(chan watchCacheEvent, 10),
+		result:    make(chan watch.Event, 10),
+		filter:    filter,
+		stopped:   false,
+		forget:    forget,
+		versioner: versioner,
 	}
 	go watcher.process(initEvents)
 	return watcher
@@ -405,6 +604,7 @@ func (c *cacheWatcher) add(event watchCacheEvent) {
 }
 
 func (c *cacheWatcher) sendWatchCacheEvent(event watchCacheEvent) {
+	c.lastEvent = &event
 	curObjPasses := event.Type != watch.Deleted && c.filter(event.Object)
 	oldObjPasses := false
 	if event.PrevObject != nil {
@@ -430,17 +630,39 @@ func (c *cacheWatcher) sendWatchCacheEvent(event watchCacheEvent) {
 	}
 }
 
+// sendBookmark sends a bookmark if the watcher processed events since the
+// last one, so that the client can resume from them even if none of them
+// passed the filter.
+func (c *cacheWatcher) sendBookmark() {
+	if c.versioner == nil || c.lastEvent == nil || c.lastEvent.ResourceVersion <= c.bookmarkVersion {
+		return
+	}
+	bookmark, err := NewBookmark(c.versioner, c.lastEvent.Object, c.lastEvent.ResourceVersion)
+	if err != nil {
+		glog.Errorf("unexpected bookmark error: %v", err)
+		return
+	}
+	c.bookmarkVersion = c.lastEvent.ResourceVersion
+	c.result <- watch.Event{Type: watch.Bookmark, Object: bookmark}
+}
+
 func (c *cacheWatcher) process(initEvents []watchCacheEvent) {
 	for _, event := range initEvents {
 		c.sendWatchCacheEvent(event)
 	}
 	defer close(c.result)
 	defer c.Stop()
+	bookmarks := time.NewTicker(BookmarkInterval)
+	defer bookmarks.Stop()
 	for {
-		event, ok := <-c.input
-		if !ok {
-			return
+		select {
+		case event, ok := <-c.input:
+			if !ok {
+				return
+			}
+			c.sendWatchCacheEvent(event)
+		case <-bookmarks.C:
+			c.sendBookmark()
 		}
-		c.sendWatchCacheEvent(event)
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/testapi"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
@@ -50,7 +51,10 @@ func newTestCacher(client tools.EtcdClient) *storage.Cacher {
 		ResourcePrefix: prefix,
 		KeyFunc:        func(obj runtime.Object) (string, error) { return storage.NamespaceKeyFunc(prefix, obj) },
 		NewListFunc:    func() runtime.Object { return &api.PodList{} },
-		StopChannel:    util.NeverStop,
+		IndexedFields: map[string]func(runtime.Object) string{
+			"spec.nodeName": func(obj runtime.Object) string { return obj.(*api.Pod).Spec.NodeName },
+		},
+		StopChannel: util.NeverStop,
 	}
 	return storage.NewCacher(config)
 }
@@ -191,6 +195,97 @@ func TestListFromMemory(t *testing.T) {
 	close(fakeClient.WatchResponse)
 }
 
+func TestListFromIndex(t *testing.T) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	prefixedKey := etcdtest.AddPrefix("pods")
+	fakeClient.ExpectNotFoundGet(prefixedKey)
+	cacher := newTestCacher(fakeClient)
+	fakeClient.WaitForWatchCompletion()
+
+	podFoo := makeTestPod("foo")
+	podFoo.Spec.NodeName = "node-1"
+	podBar := makeTestPod("bar")
+	podBar.Spec.NodeName = "node-2"
+	podBaz := makeTestPod("baz")
+	podBaz.Spec.NodeName = "node-1"
+	podBaz.Spec.RestartPolicy = api.RestartPolicyNever
+
+	for i, pod := range []*api.Pod{podFoo, podBar, podBaz} {
+		fakeClient.WatchResponse <- &etcd.Response{
+			Action: "create",
+			Node: &etcd.Node{
+				Value:         string(runtime.EncodeOrDie(testapi.Default.Codec(), pod)),
+				CreatedIndex:  uint64(i + 1),
+				ModifiedIndex: uint64(i + 1),
+			},
+		}
+	}
+	if err := waitForUpToDateCache(cacher, 3); err != nil {
+		t.Errorf("watch cache didn't propagated correctly: %v", err)
+	}
+
+	testCases := []struct {
+		field    string
+		filter   storage.FilterFunc
+		expected sets.String
+	}{
+		{"spec.nodeName=node-1", storage.Everything, sets.NewString("foo", "baz")},
+		{"spec.nodeName=node-2", storage.Everything, sets.NewString("bar")},
+		{"spec.nodeName=node-3", storage.Everything, sets.NewString()},
+		{
+			"spec.nodeName=node-1",
+			func(obj runtime.Object) bool { return obj.(*api.Pod).Spec.RestartPolicy == api.RestartPolicyNever },
+			sets.NewString("baz"),
+		},
+		// Selectors on fields without an index scan the whole cache.
+		{"status.phase=Running", storage.Everything, sets.NewString("foo", "bar", "baz")},
+	}
+	for _, test := range testCases {
+		field, err := fields.ParseSelector(test.field)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.field, err)
+		}
+		result := &api.PodList{}
+		if err := cacher.ListFromIndex("pods/ns", 3, field, test.filter, result); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.field, err)
+			continue
+		}
+		if result.ListMeta.ResourceVersion != "3" {
+			t.Errorf("%s: incorrect resource version: %v", test.field, result.ListMeta.ResourceVersion)
+		}
+		keys := sets.String{}
+		for _, item := range result.Items {
+			keys.Insert(item.ObjectMeta.Name)
+		}
+		if !keys.Equal(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.field, test.expected.List(), keys.List())
+		}
+	}
+
+	// A list at a newer resource version waits for the cache to observe it.
+	podQux := makeTestPod("qux")
+	podQux.Spec.NodeName = "node-2"
+	go func() {
+		fakeClient.WatchResponse <- &etcd.Response{
+			Action: "create",
+			Node: &etcd.Node{
+				Value:         string(runtime.EncodeOrDie(testapi.Default.Codec(), podQux)),
+				CreatedIndex:  4,
+				ModifiedIndex: 4,
+			},
+		}
+	}()
+	result := &api.PodList{}
+	if err := cacher.ListFromIndex("pods/ns", 4, fields.OneTermEqualSelector("spec.nodeName", "node-2"), storage.Everything, result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Items) != 2 || result.ListMeta.ResourceVersion != "4" {
+		t.Errorf("expected bar and qux at resource version 4, got %#v", result)
+	}
+
+	close(fakeClient.WatchResponse)
+}
+
 func TestWatch(t *testing.T) {
 	fakeClient := tools.NewFakeEtcdClient(t)
 	prefixedKey := etcdtest.AddPrefix("pods")
@@ -514,3 +609,118 @@ func TestStorageError(t *testing.T) {
 		t.Errorf("unexpected event")
 	}
 }
+
+func TestWatchBookmarks(t *testing.T) {
+	defer func(interval time.Duration) { storage.BookmarkInterval = interval }(storage.BookmarkInterval)
+	storage.BookmarkInterval = 10 * time.Millisecond
+
+	fakeClient := tools.NewFakeEtcdClient(t)
+	prefixedKey := etcdtest.AddPrefix("pods")
+	fakeClient.ExpectNotFoundGet(prefixedKey)
+	cacher := newTestCacher(fakeClient)
+	fakeClient.WaitForWatchCompletion()
+
+	// Set up Watch for object "podFoo" and change "podBar", which it filters out.
+	watcher, err := cacher.Watch("pods/ns/foo", 1, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watcher.Stop()
+	fakeClient.WatchResponse <- &etcd.Response{
+		Action: "create",
+		Node: &etcd.Node{
+			Value:         string(runtime.EncodeOrDie(testapi.Default.Codec(), makeTestPod("bar"))),
+			CreatedIndex:  4,
+			ModifiedIndex: 4,
+		},
+	}
+
+	select {
+	case event := <-watcher.ResultChan():
+		if event.Type != watch.Bookmark {
+			t.Fatalf("expected a bookmark, got %#v", event)
+		}
+		pod, ok := event.Object.(*api.Pod)
+		if !ok {
+			t.Fatalf("expected the bookmark of a pod, got %#v", event.Object)
+		}
+		if e, a := (&api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "4"}}), pod; !reflect.DeepEqual(e, a) {
+			t.Errorf("expected: %#v, got: %#v", e, a)
+		}
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("no bookmark was sent")
+	}
+
+	// Nothing changed since, so no more bookmarks are sent.
+	select {
+	case event := <-watcher.ResultChan():
+		t.Errorf("unexpected event: %#v", event)
+	case <-time.After(10 * storage.BookmarkInterval):
+	}
+}
+
+func TestWatchBeforeList(t *testing.T) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	fakeClient.ChangeIndex = 5
+	prefixedKey := etcdtest.AddPrefix("pods")
+	fakeClient.ExpectNotFoundGet(prefixedKey)
+	cacher := newTestCacher(fakeClient)
+	fakeClient.WaitForWatchCompletion()
+
+	cacheResponse := fakeClient.WatchResponse
+
+	// The cache was listed at 5, so the changes from 3 are replayed by etcd.
+	watcher, err := cacher.Watch("pods/ns/foo", 3, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watcher.Stop()
+	fakeClient.WaitForWatchCompletion()
+	if e, a := uint64(3), fakeClient.WatchIndex; e != a {
+		t.Errorf("expected etcd to be watched from %d, got %d", e, a)
+	}
+	replayResponse := fakeClient.WatchResponse
+
+	podFoo := makeTestPod("foo")
+	expectEvent := func(resourceVersion string) {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				t.Fatalf("watch closed unexpectedly")
+			}
+			if a := event.Object.(*api.Pod).ResourceVersion; event.Type != watch.Modified || a != resourceVersion {
+				t.Fatalf("expected a modification at %s, got %#v", resourceVersion, event)
+			}
+		case <-time.After(util.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for the modification at %s", resourceVersion)
+		}
+	}
+	modification := func(index uint64) *etcd.Response {
+		return &etcd.Response{
+			Action:   "set",
+			Node:     &etcd.Node{Value: string(runtime.EncodeOrDie(testapi.Default.Codec(), podFoo)), CreatedIndex: 1, ModifiedIndex: index},
+			PrevNode: &etcd.Node{Value: string(runtime.EncodeOrDie(testapi.Default.Codec(), podFoo)), CreatedIndex: 1, ModifiedIndex: 1},
+		}
+	}
+
+	// A change the cache does not hold is replayed by etcd.
+	replayResponse <- modification(4)
+	expectEvent("4")
+	// Once a change the cache also holds is replayed, the watch moves to the cache.
+	cacheResponse <- modification(6)
+	replayResponse <- modification(6)
+	expectEvent("6")
+	if err := waitForUpToDateCache(cacher, 6); err != nil {
+		t.Fatalf("watch cache didn't propagated correctly: %v", err)
+	}
+	select {
+	case _, ok := <-replayResponse:
+		if ok {
+			t.Fatalf("expected the etcd watch to be stopped")
+		}
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("expected the etcd watch to be stopped")
+	}
+	cacheResponse <- modification(7)
+	expectEvent("7")
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/storage"
+)
+
+// aesGCMPrefix marks values encrypted by the AES-GCM transformer. It is followed by the
+// name of the key, a colon, and the nonce and sealed data in base64, since etcd values
+// must be valid UTF-8.
+const aesGCMPrefix = "k8s:enc:aesgcm:v1:"
+
+// Key is a named encryption key.
+type Key struct {
+	Name   string
+	Secret []byte
+}
+
+// LoadKeys reads keys from a file with one key per line in the form <name>:<base64 encoded key>.
+// Blank lines and lines starting with # are ignored.
+func LoadKeys(path string) ([]Key, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	keys := []Key{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+		parts := strings.SplitN(text, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected <name>:<base64 encoded key>", path, line)
+		}
+		secret, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		keys = append(keys, Key{Name: parts[0], Secret: secret})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+type aesGCM struct {
+	// primary is the name of the key values are encrypted with.
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewAESGCMTransformer returns a transformer which encrypts values with AES-GCM using the first
+// of keys, and decrypts values encrypted with any of them. Keys must be 16, 24 or 32 bytes long
+// to select AES-128, AES-192 or AES-256. Values which are not encrypted, or are encrypted with
+// a key other than the first, are reported stale.
+//
+// To rotate keys, add the new key after the current one on every apiserver, then move it to
+// the front, rewrite the stored values with kube-reencrypt, and finally remove the old key.
+func NewAESGCMTransformer(keys []Key) (storage.ValueTransformer, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+	t := &aesGCM{
+		primary: keys[0].Name,
+		aeads:   map[string]cipher.AEAD{},
+	}
+	for _, key := range keys {
+		if len(key.Name) == 0 || strings.Contains(key.Name, ":") {
+			return nil, fmt.Errorf("key name %q must be non-empty and may not contain ':'", key.Name)
+		}
+		if _, found := t.aeads[key.Name]; found {
+			return nil, fmt.Errorf("key name %q is not unique", key.Name)
+		}
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", key.Name, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", key.Name, err)
+		}
+		t.aeads[key.Name] = aead
+	}
+	return t, nil
+}
+
+// TransformFromStorage implements storage.ValueTransformer.
+func (t *aesGCM) TransformFromStorage(value []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(value, []byte(aesGCMPrefix)) {
+		return value, true, nil
+	}
+	value = value[len(aesGCMPrefix):]
+	i := bytes.IndexByte(value, ':')
+	if i < 0 {
+		return nil, false, fmt.Errorf("encrypted value has no key name")
+	}
+	name := string(value[:i])
+	aead, found := t.aeads[name]
+	if !found {
+		return nil, false, fmt.Errorf("value is encrypted with unknown key %q", name)
+	}
+	sealed := make([]byte, base64.StdEncoding.DecodedLen(len(value)-i-1))
+	n, err := base64.StdEncoding.Decode(sealed, value[i+1:])
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to decode encrypted value: %v", err)
+	}
+	sealed = sealed[:n]
+	if len(sealed) < aead.NonceSize() {
+		return nil, false, fmt.Errorf("encrypted value is too short")
+	}
+	data, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to decrypt value with key %q: %v", name, err)
+	}
+	return data, name != t.primary, nil
+}
+
+// TransformToStorage implements storage.ValueTransformer.
+func (t *aesGCM) TransformToStorage(data []byte) ([]byte, error) {
+	aead := t.aeads[t.primary]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nonce, nonce, data, nil)
+	return []byte(aesGCMPrefix + t.primary + ":" + base64.StdEncoding.EncodeToString(sealed)), nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestLoadKeys(t *testing.T) {
+	file, err := ioutil.TempFile("", "keys")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("# current key\nkey2:MTIzNDU2Nzg5MDEyMzQ1Ng==\n\nkey1:YWJjZGVmZ2hpamtsbW5vcA==\n")
+	file.Close()
+
+	keys, err := LoadKeys(file.Name())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Key{
+		{Name: "key2", Secret: []byte("1234567890123456")},
+		{Name: "key1", Secret: []byte("abcdefghijklmnop")},
+	}
+	if !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+}
+
+func TestNewAESGCMTransformerValidation(t *testing.T) {
+	secret := []byte("1234567890123456")
+	testCases := map[string][]Key{
+		"no keys":        {},
+		"no name":        {{Secret: secret}},
+		"colon in name":  {{Name: "a:b", Secret: secret}},
+		"duplicate name": {{Name: "a", Secret: secret}, {Name: "a", Secret: secret}},
+		"bad key size":   {{Name: "a", Secret: []byte("short")}},
+	}
+	for name, keys := range testCases {
+		if _, err := NewAESGCMTransformer(keys); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestAESGCMTransformer(t *testing.T) {
+	key1 := Key{Name: "key1", Secret: []byte("abcdefghijklmnop")}
+	key2 := Key{Name: "key2", Secret: []byte("12345678901234567890123456789012")}
+	data := []byte(`{"kind":"Secret","data":{"password":"c2VjcmV0"}}`)
+
+	old, err := NewAESGCMTransformer([]Key{key1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	value, err := old.TransformToStorage(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Contains(value, []byte("c2VjcmV0")) {
+		t.Errorf("expected the value to be encrypted, got %s", value)
+	}
+	if again, _ := old.TransformToStorage(data); bytes.Equal(value, again) {
+		t.Errorf("expected every encryption to use a new nonce")
+	}
+	out, stale, err := old.TransformFromStorage(value)
+	if err != nil || stale || !bytes.Equal(data, out) {
+		t.Errorf("expected %s, got %s, stale %t, error %v", data, out, stale, err)
+	}
+
+	// After rotation, values encrypted with the old key can still be read, but are stale.
+	rotated, err := NewAESGCMTransformer([]Key{key2, key1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, stale, err = rotated.TransformFromStorage(value)
+	if err != nil || !stale || !bytes.Equal(data, out) {
+		t.Errorf("expected stale %s, got %s, stale %t, error %v", data, out, stale, err)
+	}
+	// Values which were never encrypted are stale.
+	out, stale, err = rotated.TransformFromStorage(data)
+	if err != nil || !stale || !bytes.Equal(data, out) {
+		t.Errorf("expected stale %s, got %s, stale %t, error %v", data, out, stale, err)
+	}
+
+	// Values encrypted with a key which has been removed can't be read.
+	value, err = rotated.TransformToStorage(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := old.TransformFromStorage(value); err == nil {
+		t.Errorf("expected an error reading a value encrypted with an unknown key")
+	}
+
+	// Tampered values can't be read.
+	value[len(value)-2] ^= 1
+	if _, _, err := rotated.TransformFromStorage(value); err == nil {
+		t.Errorf("expected an error reading a tampered value")
+	}
+}
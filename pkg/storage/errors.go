@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+)
+
+const (
+	ErrCodeKeyNotFound int = iota + 1
+	ErrCodeKeyExists
+	ErrCodeResourceVersionConflicts
+	ErrCodeInvalidContinue
+)
+
+var errCodeToMessage = map[int]string{
+	ErrCodeKeyNotFound:              "key not found",
+	ErrCodeKeyExists:                "key exists",
+	ErrCodeResourceVersionConflicts: "resource version conflicts",
+	ErrCodeInvalidContinue:          "invalid continue token",
+}
+
+// StorageError is returned by implementations of Interface which are not
+// backed by etcd v2, whose errors are interpreted by the etcd package, and by
+// every implementation for errors which are not specific to a backend.
+type StorageError struct {
+	Code            int
+	Key             string
+	ResourceVersion uint64
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("StorageError: %s, Code: %d, Key: %s, ResourceVersion: %d",
+		errCodeToMessage[e.Code], e.Code, e.Key, e.ResourceVersion)
+}
+
+// NewKeyNotFoundError returns an error for a key which does not exist at
+// resourceVersion.
+func NewKeyNotFoundError(key string, resourceVersion uint64) *StorageError {
+	return &StorageError{Code: ErrCodeKeyNotFound, Key: key, ResourceVersion: resourceVersion}
+}
+
+// NewKeyExistsError returns an error for a key which was expected to be
+// absent, but exists at resourceVersion.
+func NewKeyExistsError(key string, resourceVersion uint64) *StorageError {
+	return &StorageError{Code: ErrCodeKeyExists, Key: key, ResourceVersion: resourceVersion}
+}
+
+// NewResourceVersionConflictsError returns an error for a conditional write
+// which expected key to be at resourceVersion, but found it modified since.
+func NewResourceVersionConflictsError(key string, resourceVersion uint64) *StorageError {
+	return &StorageError{Code: ErrCodeResourceVersionConflicts, Key: key, ResourceVersion: resourceVersion}
+}
+
+// NewInvalidContinueError returns an error for a continue token which is not
+// valid for a list of key.
+func NewInvalidContinueError(key string) *StorageError {
+	return &StorageError{Code: ErrCodeInvalidContinue, Key: key}
+}
+
+// IsNotFound returns true if and only if err is a key not found error.
+func IsNotFound(err error) bool {
+	return isErrCode(err, ErrCodeKeyNotFound)
+}
+
+// IsNodeExist returns true if and only if err is a key exists error.
+func IsNodeExist(err error) bool {
+	return isErrCode(err, ErrCodeKeyExists)
+}
+
+// IsTestFailed returns true if and only if err is a write conflict.
+func IsTestFailed(err error) bool {
+	return isErrCode(err, ErrCodeResourceVersionConflicts)
+}
+
+// IsInvalidContinue returns true if and only if err is an invalid continue token error.
+func IsInvalidContinue(err error) bool {
+	return isErrCode(err, ErrCodeInvalidContinue)
+}
+
+func isErrCode(err error, code int) bool {
+	storageErr, ok := err.(*StorageError)
+	return ok && storageErr != nil && storageErr.Code == code
+}
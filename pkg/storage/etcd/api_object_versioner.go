@@ -48,7 +48,7 @@ func (a APIObjectVersioner) UpdateObject(obj runtime.Object, expiration *time.Ti
 }
 
 // UpdateList implements Versioner
-func (a APIObjectVersioner) UpdateList(obj runtime.Object, resourceVersion uint64) error {
+func (a APIObjectVersioner) UpdateList(obj runtime.Object, resourceVersion uint64, continueValue string) error {
 	listMeta, err := api.ListMetaFor(obj)
 	if err != nil || listMeta == nil {
 		return err
@@ -58,6 +58,7 @@ func (a APIObjectVersioner) UpdateList(obj runtime.Object, resourceVersion uint6
 		versionString = strconv.FormatUint(resourceVersion, 10)
 	}
 	listMeta.ResourceVersion = versionString
+	listMeta.Continue = continueValue
 	return nil
 }
 
@@ -17,10 +17,12 @@ limitations under the License.
 package etcd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,13 +40,21 @@ import (
 )
 
 func NewEtcdStorage(client tools.EtcdClient, codec runtime.Codec, prefix string) storage.Interface {
+	return NewTransformingEtcdStorage(client, codec, prefix, nil)
+}
+
+// NewTransformingEtcdStorage returns a storage which passes every value through transformer
+// on its way to and from etcd, for example to encrypt it. If transformer is nil, values are
+// stored as they are serialized by codec.
+func NewTransformingEtcdStorage(client tools.EtcdClient, codec runtime.Codec, prefix string, transformer storage.ValueTransformer) storage.Interface {
 	return &etcdHelper{
-		client:     client,
-		codec:      codec,
-		versioner:  APIObjectVersioner{},
-		copier:     api.Scheme,
-		pathPrefix: prefix,
-		cache:      util.NewCache(maxEtcdCacheEntries),
+		client:      client,
+		codec:       codec,
+		transformer: transformer,
+		versioner:   APIObjectVersioner{},
+		copier:      api.Scheme,
+		pathPrefix:  prefix,
+		cache:       util.NewCache(maxEtcdCacheEntries),
 	}
 }
 
@@ -53,6 +63,8 @@ type etcdHelper struct {
 	client tools.EtcdClient
 	codec  runtime.Codec
 	copier runtime.ObjectCopier
+	// optional, transforms values on their way to and from etcd
+	transformer storage.ValueTransformer
 	// optional, has to be set to perform any atomic operations
 	versioner storage.Versioner
 	// prefix for all etcd keys
@@ -90,7 +102,7 @@ func (h *etcdHelper) Versioner() storage.Versioner {
 // Implements storage.Interface.
 func (h *etcdHelper) Create(key string, obj, out runtime.Object, ttl uint64) error {
 	key = h.prefixEtcdKey(key)
-	data, err := h.codec.Encode(obj)
+	data, err := h.encode(obj)
 	if err != nil {
 		return err
 	}
@@ -118,7 +130,7 @@ func (h *etcdHelper) Create(key string, obj, out runtime.Object, ttl uint64) err
 // Implements storage.Interface.
 func (h *etcdHelper) Set(key string, obj, out runtime.Object, ttl uint64) error {
 	var response *etcd.Response
-	data, err := h.codec.Encode(obj)
+	data, err := h.encode(obj)
 	if err != nil {
 		return err
 	}
@@ -179,6 +191,7 @@ func (h *etcdHelper) Delete(key string, out runtime.Object) error {
 func (h *etcdHelper) Watch(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
 	key = h.prefixEtcdKey(key)
 	w := newEtcdWatcher(false, nil, filter, h.codec, h.versioner, nil, h)
+	w.valueTransformer = h.transformer
 	go w.etcdWatch(h.client, key, resourceVersion)
 	return w, nil
 }
@@ -187,6 +200,7 @@ func (h *etcdHelper) Watch(key string, resourceVersion uint64, filter storage.Fi
 func (h *etcdHelper) WatchList(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
 	key = h.prefixEtcdKey(key)
 	w := newEtcdWatcher(true, exceptKey(key), filter, h.codec, h.versioner, nil, h)
+	w.valueTransformer = h.transformer
 	go w.etcdWatch(h.client, key, resourceVersion)
 	return w, nil
 }
@@ -234,7 +248,7 @@ func (h *etcdHelper) extractObj(response *etcd.Response, inErr error, objPtr run
 		return "", nil, fmt.Errorf("unable to locate a value on the response: %#v", response)
 	}
 	body = node.Value
-	err = h.codec.DecodeInto([]byte(body), objPtr)
+	err = h.decodeInto([]byte(body), objPtr)
 	if h.versioner != nil {
 		_ = h.versioner.UpdateObject(objPtr, node.Expiration, node.ModifiedIndex)
 		// being unable to set the version does not prevent the object from being extracted
@@ -270,7 +284,7 @@ func (h *etcdHelper) GetToList(key string, filter storage.FilterFunc, listObj ru
 	}
 	trace.Step("Object decoded")
 	if h.versioner != nil {
-		if err := h.versioner.UpdateList(listObj, response.EtcdIndex); err != nil {
+		if err := h.versioner.UpdateList(listObj, response.EtcdIndex, ""); err != nil {
 			return err
 		}
 	}
@@ -301,7 +315,7 @@ func (h *etcdHelper) decodeNodeList(nodes []*etcd.Node, filter storage.FilterFun
 			}
 		} else {
 			obj := reflect.New(v.Type().Elem())
-			if err := h.codec.DecodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
+			if err := h.decodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
 				return err
 			}
 			if h.versioner != nil {
@@ -321,7 +335,7 @@ func (h *etcdHelper) decodeNodeList(nodes []*etcd.Node, filter storage.FilterFun
 }
 
 // Implements storage.Interface.
-func (h *etcdHelper) List(key string, filter storage.FilterFunc, listObj runtime.Object) error {
+func (h *etcdHelper) List(key string, page storage.ListPage, filter storage.FilterFunc, listObj runtime.Object) error {
 	trace := util.NewTrace("List " + getTypeName(listObj))
 	defer trace.LogIfLong(time.Second)
 	listPtr, err := runtime.GetItemsPtr(listObj)
@@ -329,6 +343,9 @@ func (h *etcdHelper) List(key string, filter storage.FilterFunc, listObj runtime
 		return err
 	}
 	key = h.prefixEtcdKey(key)
+	if page.Limit > 0 || len(page.Continue) > 0 {
+		return h.listPage(key, page, filter, listObj, listPtr, trace)
+	}
 	startTime := time.Now()
 	trace.Step("About to list etcd node")
 	nodes, index, err := h.listEtcdNode(key)
@@ -342,13 +359,130 @@ func (h *etcdHelper) List(key string, filter storage.FilterFunc, listObj runtime
 	}
 	trace.Step("Node list decoded")
 	if h.versioner != nil {
-		if err := h.versioner.UpdateList(listObj, index); err != nil {
+		if err := h.versioner.UpdateList(listObj, index, ""); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// listPage decodes the single page of the objects below key selected by page. etcd cannot
+// read a range of keys, so the page is read one directory at a time, and only the
+// directories which hold objects of the page are fetched. etcd cannot read past versions
+// either, so every page reflects the current contents of key, but reports the resource
+// version of the first page so that a watch started from it observes every change made
+// while paging.
+func (h *etcdHelper) listPage(key string, page storage.ListPage, filter storage.FilterFunc, listObj runtime.Object, listPtr interface{}, trace *util.Trace) error {
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		// This should not happen at runtime.
+		panic("need ptr to slice")
+	}
+	keyPrefix := key + "/"
+	fromKey := ""
+	index := uint64(0)
+	if len(page.Continue) > 0 {
+		if fromKey, index, err = storage.DecodeContinue(page.Continue, keyPrefix); err != nil {
+			return err
+		}
+	}
+	startTime := time.Now()
+	trace.Step("About to list etcd page")
+	lastKey, more, currentIndex, err := h.decodeDirPage(key, fromKey, page.Limit, filter, v)
+	metrics.RecordEtcdRequestLatency("list", getTypeName(listPtr), startTime)
+	trace.Step("Etcd page decoded")
+	if err != nil {
+		return err
+	}
+	if index == 0 {
+		index = currentIndex
+	}
+	continueValue := ""
+	if more {
+		if continueValue, err = storage.EncodeContinue(lastKey, keyPrefix, index); err != nil {
+			return err
+		}
+	}
+	if h.versioner != nil {
+		if err := h.versioner.UpdateList(listObj, index, continueValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeDirPage appends to v, in key order, the objects below the directory key whose
+// keys sort after fromKey, until v holds limit objects, or every object if limit is
+// zero. Subdirectories are read only once the page reaches them. It returns the key of
+// the last object read, whether objects remain beyond it, and the etcd index of the read
+// of key.
+func (h *etcdHelper) decodeDirPage(key, fromKey string, limit int64, filter storage.FilterFunc, v reflect.Value) (string, bool, uint64, error) {
+	result, err := h.client.Get(key, false, false)
+	if err != nil {
+		index, _ := etcdErrorIndex(err)
+		if IsEtcdNotFound(err) {
+			return "", false, index, nil
+		}
+		return "", false, index, err
+	}
+	// every key within a directory sorts after the directory key followed by a slash
+	children := append([]*etcd.Node{}, result.Node.Nodes...)
+	sort.Sort(nodesByListKey(children))
+	lastKey := ""
+	for _, node := range children {
+		if listKey(node) <= fromKey && !(node.Dir && strings.HasPrefix(fromKey, listKey(node))) {
+			continue
+		}
+		if node.Dir {
+			dirLastKey, more, _, err := h.decodeDirPage(node.Key, fromKey, limit, filter, v)
+			if err != nil {
+				return "", false, 0, err
+			}
+			if len(dirLastKey) > 0 {
+				lastKey = dirLastKey
+			}
+			if more {
+				return lastKey, true, result.EtcdIndex, nil
+			}
+			continue
+		}
+		if limit > 0 && int64(v.Len()) >= limit {
+			return lastKey, true, result.EtcdIndex, nil
+		}
+		if err := h.decodeNodeList([]*etcd.Node{node}, filter, v.Addr().Interface()); err != nil {
+			return "", false, 0, err
+		}
+		lastKey = node.Key
+	}
+	return lastKey, false, result.EtcdIndex, nil
+}
+
+// flattenNodes appends every node which is not a directory within nodes to leaves.
+func flattenNodes(nodes []*etcd.Node, leaves []*etcd.Node) []*etcd.Node {
+	for _, node := range nodes {
+		if node.Dir {
+			leaves = flattenNodes(node.Nodes, leaves)
+			continue
+		}
+		leaves = append(leaves, node)
+	}
+	return leaves
+}
+
+// listKey returns the key by which node is ordered among its siblings when paging.
+func listKey(node *etcd.Node) string {
+	if node.Dir {
+		return node.Key + "/"
+	}
+	return node.Key
+}
+
+type nodesByListKey []*etcd.Node
+
+func (n nodesByListKey) Len() int           { return len(n) }
+func (n nodesByListKey) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodesByListKey) Less(i, j int) bool { return listKey(n[i]) < listKey(n[j]) }
+
 func (h *etcdHelper) listEtcdNode(key string) ([]*etcd.Node, uint64, error) {
 	result, err := h.client.Get(key, true, true)
 	if err != nil {
@@ -420,6 +554,12 @@ func (h *etcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 		if err != nil {
 			return err
 		}
+		unchanged := h.unchanged(data, origBody)
+		if h.transformer != nil {
+			if data, err = h.transformer.TransformToStorage(data); err != nil {
+				return err
+			}
+		}
 
 		// First time this key has been used, try creating new value.
 		if index == 0 {
@@ -433,7 +573,7 @@ func (h *etcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 			return err
 		}
 
-		if string(data) == origBody {
+		if unchanged {
 			return nil
 		}
 
@@ -450,6 +590,37 @@ func (h *etcdHelper) GuaranteedUpdate(key string, ptrToType runtime.Object, igno
 	}
 }
 
+// encode serializes obj and transforms it into the value written to etcd.
+func (h *etcdHelper) encode(obj runtime.Object) ([]byte, error) {
+	data, err := h.codec.Encode(obj)
+	if err != nil || h.transformer == nil {
+		return data, err
+	}
+	return h.transformer.TransformToStorage(data)
+}
+
+// decodeInto transforms value, read from etcd, and deserializes it into objPtr.
+func (h *etcdHelper) decodeInto(value []byte, objPtr runtime.Object) error {
+	if h.transformer != nil {
+		data, _, err := h.transformer.TransformFromStorage(value)
+		if err != nil {
+			return err
+		}
+		value = data
+	}
+	return h.codec.DecodeInto(value, objPtr)
+}
+
+// unchanged returns true if origBody, read from etcd, holds the serialized object data
+// and does not need to be rewritten.
+func (h *etcdHelper) unchanged(data []byte, origBody string) bool {
+	if h.transformer == nil {
+		return string(data) == origBody
+	}
+	orig, stale, err := h.transformer.TransformFromStorage([]byte(origBody))
+	return err == nil && !stale && bytes.Equal(data, orig)
+}
+
 func (h *etcdHelper) prefixEtcdKey(key string) string {
 	if strings.HasPrefix(key, path.Join("/", h.pathPrefix)) {
 		return key
@@ -17,6 +17,7 @@ limitations under the License.
 package etcd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -26,6 +27,7 @@ import (
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -156,7 +158,7 @@ func TestList(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.List("/some/key", storage.Everything, &got)
+	err := helper.List("/some/key", storage.ListPage{}, storage.Everything, &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -218,7 +220,7 @@ func TestListFiltered(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.List("/some/key", filter, &got)
+	err := helper.List("/some/key", storage.ListPage{}, filter, &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -306,7 +308,7 @@ func TestListAcrossDirectories(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.List("/some/key", storage.Everything, &got)
+	err := helper.List("/some/key", storage.ListPage{}, storage.Everything, &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -315,6 +317,78 @@ func TestListAcrossDirectories(t *testing.T) {
 	}
 }
 
+// TestListPaged ensures that pages are ordered by full key across directories, resume
+// after the last object returned, and only read the directories they span.
+func TestListPaged(t *testing.T) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	helper := newEtcdHelper(fakeClient, testapi.Default.Codec(), etcdtest.PathPrefix())
+	key := etcdtest.AddPrefix("/some/key")
+	node := func(name string, index uint64) *etcd.Node {
+		return &etcd.Node{Key: key + "/" + name, Value: getEncodedPod(path.Base(name)), ModifiedIndex: index}
+	}
+	dir := func(name string, nodes ...*etcd.Node) tools.EtcdResponseWithError {
+		return tools.EtcdResponseWithError{
+			R: &etcd.Response{EtcdIndex: 10, Node: &etcd.Node{Key: key + "/" + name, Dir: true, Nodes: nodes}},
+		}
+	}
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 10,
+			Node: &etcd.Node{
+				Dir: true,
+				Nodes: []*etcd.Node{
+					{Key: key + "/ns", Dir: true},
+					{Key: key + "/ns-a", Dir: true},
+					{Key: key + "/ns-b", Dir: true},
+				},
+			},
+		},
+	}
+	fakeClient.Data[key+"/ns"] = dir("ns", node("ns/foo", 1))
+	fakeClient.Data[key+"/ns-a"] = dir("ns-a", node("ns-a/bar", 2), node("ns-a/baz", 3))
+
+	pages := [][]string{}
+	continueValue := ""
+	for i := 0; i < 3; i++ {
+		var got api.PodList
+		if err := helper.List("/some/key", storage.ListPage{Limit: 2, Continue: continueValue}, storage.Everything, &got); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if got.ResourceVersion != "10" {
+			t.Errorf("Expected every page at resource version 10, got %q", got.ResourceVersion)
+		}
+		names := []string{}
+		for _, pod := range got.Items {
+			names = append(names, pod.Name)
+		}
+		pages = append(pages, names)
+		// a later page reports the resource version of the first
+		fakeClient.Data[key].R.EtcdIndex++
+		continueValue = got.Continue
+		if len(continueValue) == 0 {
+			break
+		}
+	}
+	if e, a := [][]string{{"bar", "baz"}, {"foo"}}, pages; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected pages %v, got %v", e, a)
+	}
+
+	// a page which ends within a directory does not read the directories after it
+	fakeClient.Data[key+"/ns"] = tools.EtcdResponseWithError{R: &etcd.Response{}, E: errors.New("unexpected read")}
+	var got api.PodList
+	if err := helper.List("/some/key", storage.ListPage{Limit: 1}, storage.Everything, &got); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(got.Items) != 1 || got.Items[0].Name != "bar" || len(got.Continue) == 0 {
+		t.Errorf("Expected a page holding bar, got %#v", got)
+	}
+
+	got = api.PodList{}
+	if err := helper.List("/some/key", storage.ListPage{Limit: 1, Continue: "invalid"}, storage.Everything, &got); !storage.IsInvalidContinue(err) {
+		t.Errorf("Expected an invalid continue error, got %v", err)
+	}
+}
+
 func TestListExcludesDirectories(t *testing.T) {
 	fakeClient := tools.NewFakeEtcdClient(t)
 	helper := newEtcdHelper(fakeClient, testapi.Default.Codec(), etcdtest.PathPrefix())
@@ -381,7 +455,7 @@ func TestListExcludesDirectories(t *testing.T) {
 	}
 
 	var got api.PodList
-	err := helper.List("/some/key", storage.Everything, &got)
+	err := helper.List("/some/key", storage.ListPage{}, storage.Everything, &got)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -873,6 +947,105 @@ func TestGuaranteedUpdate_CreateCollision(t *testing.T) {
 	}
 }
 
+// prefixTransformer marks the values it writes with a prefix, and reports unmarked values stale.
+type prefixTransformer struct {
+	prefix string
+}
+
+func (p prefixTransformer) TransformFromStorage(value []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(value, []byte(p.prefix)) {
+		return value, true, nil
+	}
+	return value[len(p.prefix):], false, nil
+}
+
+func (p prefixTransformer) TransformToStorage(data []byte) ([]byte, error) {
+	return append([]byte(p.prefix), data...), nil
+}
+
+func TestTransformingStorage(t *testing.T) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	fakeClient.TestIndex = true
+	helper := *NewTransformingEtcdStorage(fakeClient, codec, etcdtest.PathPrefix(), prefixTransformer{"enc:"}).(*etcdHelper)
+	key := etcdtest.AddPrefix("/some/key")
+
+	obj := &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}
+	if err := helper.Create("/some/key", obj, nil, 0); err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	data, err := codec.Encode(obj)
+	if err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if e, a := "enc:"+string(data), fakeClient.Data[key].R.Node.Value; e != a {
+		t.Errorf("Wanted %v, got %v", e, a)
+	}
+	got := &TestResource{}
+	if err := helper.Get("/some/key", got, false); err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if got.Name != "foo" || got.Value != 1 {
+		t.Errorf("Unexpected object %#v", got)
+	}
+
+	// An unchanged object is rewritten if its stored value is stale.
+	fakeClient.Set(key, string(data), 0)
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, false, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+		return &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}, nil
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if value := fakeClient.Data[key].R.Node.Value; !strings.HasPrefix(value, "enc:") {
+		t.Errorf("Expected the stale value to be rewritten, got %v", value)
+	}
+
+	// An unchanged object whose stored value is current is not rewritten.
+	err = helper.GuaranteedUpdate("/some/key", &TestResource{}, false, storage.SimpleUpdate(func(in runtime.Object) (runtime.Object, error) {
+		fakeClient.Err = errors.New("should not be called")
+		return &TestResource{ObjectMeta: api.ObjectMeta{Name: "foo"}, Value: 1}, nil
+	}))
+	if err != nil {
+		t.Errorf("Unexpected error %#v", err)
+	}
+}
+
+func TestRewriteStaleValues(t *testing.T) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	fakeClient.TestIndex = true
+	key := etcdtest.AddPrefix("/secrets")
+	nodes := []*etcd.Node{
+		{Key: key + "/default/current", Value: "enc:current", ModifiedIndex: 1},
+		{Key: key + "/default/stale", Value: "stale", ModifiedIndex: 2},
+	}
+	for _, node := range nodes {
+		copied := *node
+		fakeClient.Data[node.Key] = tools.EtcdResponseWithError{R: &etcd.Response{Node: &copied}}
+	}
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Dir:   true,
+				Nodes: []*etcd.Node{{Key: key + "/default", Dir: true, Nodes: nodes}},
+			},
+		},
+	}
+
+	rewritten, err := RewriteStaleValues(fakeClient, key, prefixTransformer{"enc:"})
+	if err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	if rewritten != 1 {
+		t.Errorf("Expected 1 value rewritten, got %d", rewritten)
+	}
+	if e, a := "enc:stale", fakeClient.Data[key+"/default/stale"].R.Node.Value; e != a {
+		t.Errorf("Wanted %v, got %v", e, a)
+	}
+	if e, a := "enc:current", fakeClient.Data[key+"/default/current"].R.Node.Value; e != a {
+		t.Errorf("Wanted %v, got %v", e, a)
+	}
+}
+
 func TestGetEtcdVersion_ValidVersion(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, validEtcdVersion)
@@ -0,0 +1,64 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+
+	"github.com/coreos/go-etcd/etcd"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/tools"
+)
+
+// RewriteStaleValues rewrites every value below key which transformer reports as stale,
+// for example to encrypt it with a new key, and returns the number of values rewritten.
+// Values which change while they are being rewritten are skipped.
+func RewriteStaleValues(client tools.EtcdClient, key string, transformer storage.ValueTransformer) (int, error) {
+	response, err := client.Get(key, false, true)
+	if err != nil {
+		if IsEtcdNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	rewritten := 0
+	for _, node := range flattenNodes([]*etcd.Node{response.Node}, []*etcd.Node{}) {
+		data, stale, err := transformer.TransformFromStorage([]byte(node.Value))
+		if err != nil {
+			return rewritten, fmt.Errorf("unable to read %s: %v", node.Key, err)
+		}
+		if !stale {
+			continue
+		}
+		value, err := transformer.TransformToStorage(data)
+		if err != nil {
+			return rewritten, fmt.Errorf("unable to rewrite %s: %v", node.Key, err)
+		}
+		ttl := uint64(0)
+		if node.TTL > 0 {
+			ttl = uint64(node.TTL)
+		}
+		if _, err := client.CompareAndSwap(node.Key, string(value), ttl, "", node.ModifiedIndex); err != nil {
+			if IsEtcdTestFailed(err) || IsEtcdNotFound(err) {
+				continue
+			}
+			return rewritten, err
+		}
+		rewritten++
+	}
+	return rewritten, nil
+}
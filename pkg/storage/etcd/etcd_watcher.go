@@ -59,6 +59,8 @@ type etcdWatcher struct {
 	encoding  runtime.Codec
 	versioner storage.Versioner
 	transform TransformFunc
+	// optional, transforms node values before they are decoded
+	valueTransformer storage.ValueTransformer
 
 	list    bool // If we're doing a recursive watch, should be true.
 	include includeFunc
@@ -78,6 +80,13 @@ type etcdWatcher struct {
 	emit func(watch.Event)
 
 	cache etcdCache
+
+	// lastIndex is the etcd index of the last response received, whether or
+	// not it passed the filter, and lastObject the last object decoded, whose
+	// type bookmarks take. bookmarkIndex is the index of the last bookmark sent.
+	lastIndex     uint64
+	lastObject    runtime.Object
+	bookmarkIndex uint64
 }
 
 // watchWaitDuration is the amount of time to wait for an error from watch.
@@ -177,8 +186,12 @@ func (w *etcdWatcher) translate() {
 	defer close(w.outgoing)
 	defer util.HandleCrash()
 
+	bookmarks := time.NewTicker(storage.BookmarkInterval)
+	defer bookmarks.Stop()
 	for {
 		select {
+		case <-bookmarks.C:
+			w.sendBookmark()
 		case err := <-w.etcdError:
 			if err != nil {
 				w.emit(watch.Event{
@@ -212,7 +225,14 @@ func (w *etcdWatcher) decodeObject(node *etcd.Node) (runtime.Object, error) {
 		return obj, nil
 	}
 
-	obj, err := w.encoding.Decode([]byte(node.Value))
+	data := []byte(node.Value)
+	if w.valueTransformer != nil {
+		var err error
+		if data, _, err = w.valueTransformer.TransformFromStorage(data); err != nil {
+			return nil, err
+		}
+	}
+	obj, err := w.encoding.Decode(data)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +256,7 @@ func (w *etcdWatcher) decodeObject(node *etcd.Node) (runtime.Object, error) {
 	if node.ModifiedIndex != 0 {
 		w.cache.addToCache(node.ModifiedIndex, obj)
 	}
+	w.lastObject = obj
 	return obj, nil
 }
 
@@ -359,6 +380,35 @@ func (w *etcdWatcher) sendResult(res *etcd.Response) {
 	default:
 		glog.Errorf("unknown action: %v", res.Action)
 	}
+
+	// The initial state is read at the index of the whole response, while
+	// watch responses are at the index of their change.
+	index := res.EtcdIndex
+	if res.Action != EtcdGet && res.Node != nil {
+		index = res.Node.ModifiedIndex
+	}
+	if index > w.lastIndex {
+		w.lastIndex = index
+	}
+}
+
+// sendBookmark sends a bookmark if responses were received since the last
+// one, so that the client can resume from them even if none of them passed
+// the filter. Nothing is sent until an object of the watched type is decoded.
+func (w *etcdWatcher) sendBookmark() {
+	if w.versioner == nil || w.lastObject == nil || w.lastIndex <= w.bookmarkIndex {
+		return
+	}
+	bookmark, err := storage.NewBookmark(w.versioner, w.lastObject, w.lastIndex)
+	if err != nil {
+		glog.Errorf("failure to create bookmark: %v", err)
+		return
+	}
+	w.bookmarkIndex = w.lastIndex
+	w.emit(watch.Event{
+		Type:   watch.Bookmark,
+		Object: bookmark,
+	})
 }
 
 // ResultChan implements watch.Interface.
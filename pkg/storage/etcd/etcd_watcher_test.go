@@ -216,6 +216,75 @@ func TestWatchInterpretation_ResponseBadData(t *testing.T) {
 	}
 }
 
+func TestWatchTransformedValues(t *testing.T) {
+	codec := testapi.Default.Codec()
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	data, err := codec.Encode(pod)
+	if err != nil {
+		t.Fatalf("Unexpected error %#v", err)
+	}
+	w := newEtcdWatcher(false, nil, storage.Everything, codec, versioner, nil, &fakeEtcdCache{})
+	w.valueTransformer = prefixTransformer{"enc:"}
+	var got *watch.Event
+	w.emit = func(e watch.Event) {
+		got = &e
+	}
+	w.sendResult(&etcd.Response{
+		Action: "create",
+		Node: &etcd.Node{
+			Value:         "enc:" + string(data),
+			CreatedIndex:  1,
+			ModifiedIndex: 1,
+		},
+	})
+	w.Stop()
+	if got == nil || got.Type != watch.Added || got.Object.(*api.Pod).Name != "foo" {
+		t.Errorf("Expected pod foo to be added, got %#v", got)
+	}
+}
+
+func TestWatchBookmarks(t *testing.T) {
+	codec := testapi.Default.Codec()
+	firstLetterIsB := func(obj runtime.Object) bool {
+		return obj.(*api.Pod).Name[0] == 'b'
+	}
+	w := newEtcdWatcher(true, nil, firstLetterIsB, codec, versioner, nil, &fakeEtcdCache{})
+	var events []watch.Event
+	w.emit = func(e watch.Event) {
+		events = append(events, e)
+	}
+
+	// Nothing was received yet.
+	w.sendBookmark()
+	if len(events) != 0 {
+		t.Fatalf("unexpected events: %#v", events)
+	}
+
+	// A change filtered out still advances the bookmark.
+	w.sendResult(&etcd.Response{
+		Action: "create",
+		Node: &etcd.Node{
+			Value:         runtime.EncodeOrDie(codec, &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}),
+			CreatedIndex:  7,
+			ModifiedIndex: 7,
+		},
+	})
+	w.sendBookmark()
+	if len(events) != 1 || events[0].Type != watch.Bookmark {
+		t.Fatalf("expected a single bookmark, got %#v", events)
+	}
+	if e, a := (&api.Pod{ObjectMeta: api.ObjectMeta{ResourceVersion: "7"}}), events[0].Object; !api.Semantic.DeepEqual(e, a) {
+		t.Errorf("expected %#v, got %#v", e, a)
+	}
+
+	// Nothing changed since the last bookmark.
+	w.sendBookmark()
+	if len(events) != 1 {
+		t.Errorf("unexpected events: %#v", events[1:])
+	}
+	w.Stop()
+}
+
 func TestWatchEtcdError(t *testing.T) {
 	codec := testapi.Default.Codec()
 	fakeClient := tools.NewFakeEtcdClient(t)
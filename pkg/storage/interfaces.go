@@ -19,6 +19,7 @@ package storage
 import (
 	"time"
 
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/watch"
 )
@@ -30,10 +31,10 @@ type Versioner interface {
 	// cannot be updated correctly. May return nil if the requested object does not need metadata
 	// from database.
 	UpdateObject(obj runtime.Object, expiration *time.Time, resourceVersion uint64) error
-	// UpdateList sets the resource version into an API list object. Returns an error if the object
-	// cannot be updated correctly. May return nil if the requested object does not need metadata
-	// from database.
-	UpdateList(obj runtime.Object, resourceVersion uint64) error
+	// UpdateList sets the resource version and continue token into an API list object. Returns an
+	// error if the object cannot be updated correctly. May return nil if the requested object does
+	// not need metadata from database.
+	UpdateList(obj runtime.Object, resourceVersion uint64, continueValue string) error
 	// ObjectResourceVersion returns the resource version (for persistence) of the specified object.
 	// Should return an error if the specified object does not have a persistable version.
 	ObjectResourceVersion(obj runtime.Object) (uint64, error)
@@ -63,6 +64,25 @@ func Everything(runtime.Object) bool {
 	return true
 }
 
+// ValueTransformer transforms serialized objects on their way to and from the database,
+// for example to encrypt them.
+type ValueTransformer interface {
+	// TransformFromStorage returns the serialized object held in a value read from the database.
+	// stale is true if the value should be rewritten, for example because it was encrypted
+	// with a key which is being retired.
+	TransformFromStorage(value []byte) (data []byte, stale bool, err error)
+	// TransformToStorage returns the value to write to the database for a serialized object.
+	TransformToStorage(data []byte) (value []byte, err error)
+}
+
+// ListPage selects a single page of the results of a List.
+type ListPage struct {
+	// Limit is the maximum number of objects to return, or zero to return every object.
+	Limit int64
+	// Continue is the continue token of the previous page, or empty for the first page.
+	Continue string
+}
+
 // Pass an UpdateFunc to Interface.GuaranteedUpdate to make an update
 // that is guaranteed to succeed.
 // See the comment for GuaranteedUpdate for more details.
@@ -115,7 +135,9 @@ type Interface interface {
 
 	// List unmarshalls jsons found at directory defined by key and opaque them
 	// into *List api object (an object that satisfies runtime.IsList definition).
-	List(key string, filter FilterFunc, listObj runtime.Object) error
+	// If page has a limit, at most that many objects are returned and a continue
+	// token is set on the list if more may remain.
+	List(key string, page ListPage, filter FilterFunc, listObj runtime.Object) error
 
 	// GuaranteedUpdate keeps calling 'tryUpdate()' to update key 'key' (of type 'ptrToType')
 	// retrying the update until success if there is index conflict.
@@ -146,3 +168,12 @@ type Interface interface {
 	// Codec provides access to the underlying codec being used by the implementation.
 	Codec() runtime.Codec
 }
+
+// IndexLister is implemented by storages which can serve lists from memory,
+// using indexes on the fields of the objects.
+type IndexLister interface {
+	// ListFromIndex lists the objects below key matching filter, as of a
+	// resource version at least as recent as resourceVersion, into listObj.
+	// The exact-match terms of field select an index to read, if one exists.
+	ListFromIndex(key string, resourceVersion uint64, field fields.Selector, filter FilterFunc, listObj runtime.Object) error
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kv implements storage.Interface on top of a transactional,
+// multi-version key-value store modelled on etcd v3: every write is a
+// transaction that is applied atomically, at a single store-wide revision,
+// only if all of its compares hold. Because compares and writes may span
+// several keys, compare-and-swap is available across keys rather than just
+// on the key being written.
+//
+// The package provides an in-process implementation of the key-value store
+// which keeps its contents in memory.
+package kv
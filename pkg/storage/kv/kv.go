@@ -0,0 +1,135 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrCompacted is returned when watching from a revision whose events are no
+// longer retained by the store.
+var ErrCompacted = errors.New("required revision has been compacted")
+
+// KeyValue is the state of a single key.
+type KeyValue struct {
+	Key   string
+	Value []byte
+	// CreateRevision is the revision of the transaction which created the key.
+	CreateRevision int64
+	// ModRevision is the revision of the transaction which last wrote the key.
+	ModRevision int64
+	// Expiration is the time at which the key is removed, or nil if it does not expire.
+	Expiration *time.Time
+}
+
+// EventType is the kind of change an Event describes.
+type EventType int
+
+const (
+	EventTypePut EventType = iota
+	EventTypeDelete
+)
+
+// Event is a change to a single key.
+type Event struct {
+	Type EventType
+	// KV is the new state of the key. For deletions only the key and the
+	// ModRevision, which is the revision of the deletion, are set.
+	KV *KeyValue
+	// PrevKV is the state of the key before the change, or nil if it did not exist.
+	PrevKV *KeyValue
+}
+
+// Compare is a condition on the state of a key when a transaction starts.
+type Compare struct {
+	Key string
+	// ModRevision is the revision the key must have last been written at.
+	// Zero requires that the key does not exist.
+	ModRevision int64
+}
+
+// OpType is the kind of write an Op performs.
+type OpType int
+
+const (
+	OpTypePut OpType = iota
+	OpTypeDelete
+)
+
+// Op is a single write within a transaction.
+type Op struct {
+	Type  OpType
+	Key   string
+	Value []byte
+	// TTL is the number of seconds after which a put key expires, or zero if it never does.
+	TTL uint64
+}
+
+// Put returns an Op which sets key to value.
+func Put(key string, value []byte, ttl uint64) Op {
+	return Op{Type: OpTypePut, Key: key, Value: value, TTL: ttl}
+}
+
+// Delete returns an Op which removes key.
+func Delete(key string) Op {
+	return Op{Type: OpTypeDelete, Key: key}
+}
+
+// TxnResponse is the result of a transaction.
+type TxnResponse struct {
+	// Succeeded is true if every compare held and the ops were applied.
+	Succeeded bool
+	// Revision is the revision of the store after the transaction.
+	Revision int64
+	// Results holds one entry per op if the transaction succeeded: the new
+	// state of the key for puts, and the removed state of the key, or nil if
+	// it did not exist, for deletes.
+	Results []*KeyValue
+}
+
+// Watcher delivers the events of a watch in revision order.
+type Watcher interface {
+	// ResultChan returns the channel events are delivered on. It is closed
+	// once the watcher is stopped.
+	ResultChan() <-chan Event
+	// Stop ends the watch.
+	Stop()
+}
+
+// KV is a transactional, multi-version key-value store. Implementations must
+// be safe for concurrent use.
+type KV interface {
+	// Get returns the state of key, or nil if it does not exist, along with
+	// the current revision of the store.
+	Get(key string) (*KeyValue, int64, error)
+	// List returns the state of every key with the given prefix, sorted by
+	// key, along with the current revision of the store.
+	List(prefix string) ([]*KeyValue, int64, error)
+	// ListRange returns, sorted by key, the state of at most limit keys with
+	// the given prefix which sort after fromKey, whether more such keys
+	// remain, and the current revision of the store. A limit of zero returns
+	// every such key.
+	ListRange(prefix, fromKey string, limit int64) ([]*KeyValue, bool, int64, error)
+	// Txn applies ops atomically, at a single new revision, if every compare
+	// holds. Otherwise it changes nothing and reports Succeeded as false.
+	Txn(compares []Compare, ops []Op) (*TxnResponse, error)
+	// Watch delivers events for keys with the given prefix, starting with
+	// those at revision fromRevision. If fromRevision is zero, only changes
+	// after the current revision are delivered.
+	Watch(prefix string, fromRevision int64) (Watcher, error)
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// defaultHistorySize is the number of events retained for watches which
+// start from a past revision.
+const defaultHistorySize = 10000
+
+// memoryKV is a KV which holds its contents in memory. Expired keys are
+// removed, and their deletion recorded, by the first operation after they
+// expire.
+type memoryKV struct {
+	clock       util.Clock
+	historySize int
+
+	lock     sync.Mutex
+	revision int64
+	data     map[string]*KeyValue
+	// keys holds every key in data, sorted.
+	keys []string
+	// expiring holds the expiration time of every key which has one.
+	expiring map[string]time.Time
+	// history holds the most recent events, oldest first.
+	history []Event
+	// compacted is the newest revision whose events are no longer retained.
+	compacted int64
+	watchers  map[*memoryWatcher]struct{}
+}
+
+// NewMemory returns a KV which keeps its contents in memory. Its contents are
+// lost when the process exits.
+func NewMemory() KV {
+	return newMemory(util.RealClock{}, defaultHistorySize)
+}
+
+func newMemory(clock util.Clock, historySize int) *memoryKV {
+	return &memoryKV{
+		clock:       clock,
+		historySize: historySize,
+		data:        map[string]*KeyValue{},
+		expiring:    map[string]time.Time{},
+		watchers:    map[*memoryWatcher]struct{}{},
+	}
+}
+
+func copyKeyValue(kv *KeyValue) *KeyValue {
+	if kv == nil {
+		return nil
+	}
+	copied := *kv
+	return &copied
+}
+
+// Get implements KV.
+func (m *memoryKV) Get(key string) (*KeyValue, int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.expireLocked()
+	return copyKeyValue(m.data[key]), m.revision, nil
+}
+
+// List implements KV.
+func (m *memoryKV) List(prefix string) ([]*KeyValue, int64, error) {
+	kvs, _, revision, err := m.ListRange(prefix, "", 0)
+	return kvs, revision, err
+}
+
+// ListRange implements KV.
+func (m *memoryKV) ListRange(prefix, fromKey string, limit int64) ([]*KeyValue, bool, int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.expireLocked()
+	start := prefix
+	if len(fromKey) > 0 && fromKey >= start {
+		// the first key after fromKey
+		start = fromKey + "\x00"
+	}
+	kvs := []*KeyValue{}
+	for i := sort.SearchStrings(m.keys, start); i < len(m.keys) && strings.HasPrefix(m.keys[i], prefix); i++ {
+		if limit > 0 && int64(len(kvs)) >= limit {
+			return kvs, true, m.revision, nil
+		}
+		kvs = append(kvs, copyKeyValue(m.data[m.keys[i]]))
+	}
+	return kvs, false, m.revision, nil
+}
+
+// Txn implements KV.
+func (m *memoryKV) Txn(compares []Compare, ops []Op) (*TxnResponse, error) {
+	seen := map[string]bool{}
+	for _, op := range ops {
+		if op.Type != OpTypePut && op.Type != OpTypeDelete {
+			return nil, fmt.Errorf("unknown operation type %d on key %q", op.Type, op.Key)
+		}
+		if seen[op.Key] {
+			return nil, fmt.Errorf("key %q may only be written once in a transaction", op.Key)
+		}
+		seen[op.Key] = true
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.expireLocked()
+	for _, c := range compares {
+		current := m.data[c.Key]
+		if c.ModRevision == 0 && current != nil ||
+			c.ModRevision != 0 && (current == nil || current.ModRevision != c.ModRevision) {
+			return &TxnResponse{Succeeded: false, Revision: m.revision}, nil
+		}
+	}
+
+	revision := m.revision + 1
+	now := m.clock.Now()
+	events := []Event{}
+	results := make([]*KeyValue, 0, len(ops))
+	for _, op := range ops {
+		prev := m.data[op.Key]
+		switch op.Type {
+		case OpTypePut:
+			kv := &KeyValue{Key: op.Key, Value: op.Value, CreateRevision: revision, ModRevision: revision}
+			if prev != nil {
+				kv.CreateRevision = prev.CreateRevision
+			}
+			if op.TTL > 0 {
+				expiration := now.Add(time.Duration(op.TTL) * time.Second)
+				kv.Expiration = &expiration
+				m.expiring[op.Key] = expiration
+			} else {
+				delete(m.expiring, op.Key)
+			}
+			if prev == nil {
+				m.insertKeyLocked(op.Key)
+			}
+			m.data[op.Key] = kv
+			events = append(events, Event{Type: EventTypePut, KV: copyKeyValue(kv), PrevKV: copyKeyValue(prev)})
+			results = append(results, copyKeyValue(kv))
+		case OpTypeDelete:
+			if prev != nil {
+				m.removeKeyLocked(op.Key)
+				delete(m.data, op.Key)
+				delete(m.expiring, op.Key)
+				events = append(events, Event{Type: EventTypeDelete, KV: &KeyValue{Key: op.Key, ModRevision: revision}, PrevKV: copyKeyValue(prev)})
+			}
+			results = append(results, copyKeyValue(prev))
+		}
+	}
+	// the revision only advances if something changed
+	if len(events) != 0 {
+		m.revision = revision
+		m.recordLocked(events)
+	}
+	return &TxnResponse{Succeeded: true, Revision: m.revision, Results: results}, nil
+}
+
+// Watch implements KV.
+func (m *memoryKV) Watch(prefix string, fromRevision int64) (Watcher, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.expireLocked()
+	if fromRevision == 0 {
+		fromRevision = m.revision + 1
+	}
+	if fromRevision <= m.compacted {
+		return nil, ErrCompacted
+	}
+	w := &memoryWatcher{
+		store:  m,
+		prefix: prefix,
+		result: make(chan Event),
+		stopCh: make(chan struct{}),
+	}
+	w.cond = sync.NewCond(&w.lock)
+	past := []Event{}
+	for _, e := range m.history {
+		if e.KV.ModRevision >= fromRevision {
+			past = append(past, e)
+		}
+	}
+	w.enqueue(past)
+	m.watchers[w] = struct{}{}
+	go w.run()
+	return w, nil
+}
+
+// expireLocked deletes every key whose expiration has passed, at a single
+// new revision. Must be called with the lock held.
+func (m *memoryKV) expireLocked() {
+	now := m.clock.Now()
+	expired := []string{}
+	for key, expiration := range m.expiring {
+		if !expiration.After(now) {
+			expired = append(expired, key)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	sort.Strings(expired)
+	m.revision++
+	events := make([]Event, 0, len(expired))
+	for _, key := range expired {
+		events = append(events, Event{Type: EventTypeDelete, KV: &KeyValue{Key: key, ModRevision: m.revision}, PrevKV: m.data[key]})
+		m.removeKeyLocked(key)
+		delete(m.data, key)
+		delete(m.expiring, key)
+	}
+	m.recordLocked(events)
+}
+
+// insertKeyLocked adds key, which must not be in data, to the sorted keys.
+// Must be called with the lock held.
+func (m *memoryKV) insertKeyLocked(key string) {
+	i := sort.SearchStrings(m.keys, key)
+	m.keys = append(m.keys, "")
+	copy(m.keys[i+1:], m.keys[i:])
+	m.keys[i] = key
+}
+
+// removeKeyLocked removes key, which must be in data, from the sorted keys.
+// Must be called with the lock held.
+func (m *memoryKV) removeKeyLocked(key string) {
+	i := sort.SearchStrings(m.keys, key)
+	m.keys = append(m.keys[:i], m.keys[i+1:]...)
+}
+
+// recordLocked appends events to the history, discarding the oldest events
+// beyond historySize, and delivers them to watchers. Must be called with the
+// lock held.
+func (m *memoryKV) recordLocked(events []Event) {
+	m.history = append(m.history, events...)
+	if overflow := len(m.history) - m.historySize; overflow > 0 {
+		m.compacted = m.history[overflow-1].KV.ModRevision
+		m.history = append([]Event(nil), m.history[overflow:]...)
+	}
+	for w := range m.watchers {
+		w.enqueue(events)
+	}
+}
+
+func (m *memoryKV) removeWatcher(w *memoryWatcher) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.watchers, w)
+}
+
+// memoryWatcher queues the events of a memoryKV without bound, so that slow
+// watchers never block writers.
+type memoryWatcher struct {
+	store  *memoryKV
+	prefix string
+
+	lock    sync.Mutex
+	cond    *sync.Cond
+	pending []Event
+	stopped bool
+
+	result chan Event
+	stopCh chan struct{}
+}
+
+func (w *memoryWatcher) enqueue(events []Event) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, e := range events {
+		if strings.HasPrefix(e.KV.Key, w.prefix) {
+			w.pending = append(w.pending, e)
+		}
+	}
+	w.cond.Signal()
+}
+
+func (w *memoryWatcher) run() {
+	defer close(w.result)
+	for {
+		w.lock.Lock()
+		for len(w.pending) == 0 && !w.stopped {
+			w.cond.Wait()
+		}
+		if w.stopped {
+			w.lock.Unlock()
+			return
+		}
+		e := w.pending[0]
+		w.pending = w.pending[1:]
+		w.lock.Unlock()
+
+		select {
+		case w.result <- e:
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// ResultChan implements Watcher.
+func (w *memoryWatcher) ResultChan() <-chan Event {
+	return w.result
+}
+
+// Stop implements Watcher.
+func (w *memoryWatcher) Stop() {
+	w.store.removeWatcher(w)
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.stopCh)
+		w.cond.Broadcast()
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util"
+)
+
+func nextEvent(t *testing.T, w Watcher) Event {
+	select {
+	case e, ok := <-w.ResultChan():
+		if !ok {
+			t.Fatalf("watch closed unexpectedly")
+		}
+		return e
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for event")
+	}
+	return Event{}
+}
+
+func mustTxn(t *testing.T, m KV, compares []Compare, ops ...Op) *TxnResponse {
+	resp, err := m.Txn(compares, ops)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp
+}
+
+func TestTxnComparesAcrossKeys(t *testing.T) {
+	m := NewMemory()
+	created := mustTxn(t, m, []Compare{{Key: "/a"}, {Key: "/b"}}, Put("/a", []byte("1"), 0), Put("/b", []byte("1"), 0))
+	if !created.Succeeded || created.Revision != 1 {
+		t.Fatalf("unexpected response: %#v", created)
+	}
+	if created.Results[0].ModRevision != 1 || created.Results[1].ModRevision != 1 {
+		t.Errorf("expected both keys to be written at revision 1: %#v", created.Results)
+	}
+
+	updated := mustTxn(t, m, []Compare{{Key: "/a", ModRevision: 1}, {Key: "/b", ModRevision: 1}}, Put("/a", []byte("2"), 0), Delete("/b"))
+	if !updated.Succeeded || updated.Revision != 2 {
+		t.Fatalf("unexpected response: %#v", updated)
+	}
+	if updated.Results[0].CreateRevision != 1 || updated.Results[0].ModRevision != 2 {
+		t.Errorf("unexpected result for put: %#v", updated.Results[0])
+	}
+	if string(updated.Results[1].Value) != "1" {
+		t.Errorf("expected the removed value for delete, got %#v", updated.Results[1])
+	}
+
+	// a stale compare on one key prevents writes to every key
+	stale := mustTxn(t, m, []Compare{{Key: "/a", ModRevision: 2}, {Key: "/b", ModRevision: 1}}, Put("/a", []byte("3"), 0), Put("/b", []byte("3"), 0))
+	if stale.Succeeded || stale.Revision != 2 {
+		t.Fatalf("unexpected response: %#v", stale)
+	}
+	a, revision, _ := m.Get("/a")
+	if string(a.Value) != "2" || revision != 2 {
+		t.Errorf("unexpected state after failed transaction: %#v at %d", a, revision)
+	}
+	if b, _, _ := m.Get("/b"); b != nil {
+		t.Errorf("unexpected state after failed transaction: %#v", b)
+	}
+}
+
+func TestTxnWithoutChanges(t *testing.T) {
+	m := NewMemory()
+	resp := mustTxn(t, m, nil, Delete("/missing"))
+	if !resp.Succeeded || resp.Revision != 0 || resp.Results[0] != nil {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	if _, err := m.Txn(nil, []Op{Put("/a", nil, 0), Delete("/a")}); err == nil {
+		t.Errorf("expected writing a key twice in one transaction to fail")
+	}
+}
+
+func TestMemoryList(t *testing.T) {
+	m := NewMemory()
+	mustTxn(t, m, nil, Put("/pods/b", nil, 0), Put("/pods/a", nil, 0), Put("/podsx", nil, 0))
+	kvs, revision, err := m.List("/pods/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kvs) != 2 || kvs[0].Key != "/pods/a" || kvs[1].Key != "/pods/b" || revision != 1 {
+		t.Errorf("unexpected list: %#v at %d", kvs, revision)
+	}
+}
+
+func TestMemoryListRange(t *testing.T) {
+	clock := &util.FakeClock{Time: time.Now()}
+	m := newMemory(clock, defaultHistorySize)
+	mustTxn(t, m, nil, Put("/pods/c", nil, 0), Put("/pods/a", nil, 0), Put("/pods/d", nil, 1), Put("/pods/b", nil, 0), Put("/podsx", nil, 0))
+	mustTxn(t, m, nil, Delete("/pods/c"))
+	keys := func(kvs []*KeyValue) []string {
+		keys := []string{}
+		for _, kv := range kvs {
+			keys = append(keys, kv.Key)
+		}
+		return keys
+	}
+
+	kvs, more, revision, err := m.ListRange("/pods/", "", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"/pods/a", "/pods/b"}, keys(kvs); !reflect.DeepEqual(e, a) || !more || revision != 2 {
+		t.Errorf("expected %v with more at revision 2, got %v, %t at %d", e, a, more, revision)
+	}
+	kvs, more, _, err = m.ListRange("/pods/", "/pods/b", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"/pods/d"}, keys(kvs); !reflect.DeepEqual(e, a) || more {
+		t.Errorf("expected %v without more, got %v, %t", e, a, more)
+	}
+
+	// expired keys are no longer listed
+	clock.Step(time.Second)
+	kvs, more, _, err = m.ListRange("/pods/", "/pods/a", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"/pods/b"}, keys(kvs); !reflect.DeepEqual(e, a) || more {
+		t.Errorf("expected %v without more, got %v, %t", e, a, more)
+	}
+}
+
+func TestMemoryExpiration(t *testing.T) {
+	clock := &util.FakeClock{Time: time.Now()}
+	m := newMemory(clock, defaultHistorySize)
+	mustTxn(t, m, nil, Put("/a", []byte("1"), 10), Put("/b", []byte("1"), 0))
+	w, err := m.Watch("/", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+
+	clock.Step(9 * time.Second)
+	if a, _, _ := m.Get("/a"); a == nil || a.Expiration == nil {
+		t.Fatalf("expected an expiring key, got %#v", a)
+	}
+	clock.Step(time.Second)
+	a, revision, _ := m.Get("/a")
+	if a != nil || revision != 2 {
+		t.Errorf("expected the key to be removed at revision 2, got %#v at %d", a, revision)
+	}
+	if b, _, _ := m.Get("/b"); b == nil {
+		t.Errorf("expected the key without a TTL to remain")
+	}
+	e := nextEvent(t, w)
+	if e.Type != EventTypeDelete || e.KV.Key != "/a" || e.KV.ModRevision != 2 || e.PrevKV == nil {
+		t.Errorf("unexpected event: %#v", e)
+	}
+
+	// rewriting a key without a TTL stops it expiring
+	mustTxn(t, m, nil, Put("/c", nil, 1))
+	mustTxn(t, m, nil, Put("/c", nil, 0))
+	clock.Step(time.Minute)
+	if c, _, _ := m.Get("/c"); c == nil || c.Expiration != nil {
+		t.Errorf("expected the key not to expire, got %#v", c)
+	}
+}
+
+func TestMemoryWatch(t *testing.T) {
+	m := NewMemory()
+	mustTxn(t, m, nil, Put("/pods/a", []byte("1"), 0))
+	w, err := m.Watch("/pods/", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mustTxn(t, m, nil, Put("/services/a", []byte("1"), 0))
+	mustTxn(t, m, nil, Put("/pods/a", []byte("2"), 0))
+	mustTxn(t, m, nil, Delete("/pods/a"))
+
+	e := nextEvent(t, w)
+	if e.Type != EventTypePut || e.KV.ModRevision != 3 || string(e.PrevKV.Value) != "1" {
+		t.Errorf("unexpected event: %#v", e)
+	}
+	e = nextEvent(t, w)
+	if e.Type != EventTypeDelete || e.KV.ModRevision != 4 || string(e.PrevKV.Value) != "2" {
+		t.Errorf("unexpected event: %#v", e)
+	}
+
+	w.Stop()
+	select {
+	case _, ok := <-w.ResultChan():
+		if ok {
+			t.Errorf("unexpected event after stop")
+		}
+	case <-time.After(util.ForeverTestTimeout):
+		t.Errorf("timed out waiting for the watch to close")
+	}
+}
+
+func TestMemoryWatchFromRevision(t *testing.T) {
+	m := newMemory(util.RealClock{}, 2)
+	for i := 0; i < 3; i++ {
+		mustTxn(t, m, nil, Put("/a", []byte{byte(i)}, 0))
+	}
+	if _, err := m.Watch("/", 1); err != ErrCompacted {
+		t.Errorf("expected %v, got %v", ErrCompacted, err)
+	}
+	w, err := m.Watch("/", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	for _, revision := range []int64{2, 3} {
+		if e := nextEvent(t, w); e.KV.ModRevision != revision {
+			t.Errorf("expected revision %d, got %#v", revision, e)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"path"
+	"reflect"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/conversion"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// NewStorage returns a storage.Interface which keeps objects encoded with
+// codec in client, under keys beginning with prefix.
+func NewStorage(client KV, codec runtime.Codec, prefix string) storage.Interface {
+	return &kvStorage{
+		client:     client,
+		codec:      codec,
+		versioner:  APIObjectVersioner{},
+		pathPrefix: prefix,
+		clock:      util.RealClock{},
+	}
+}
+
+// kvStorage implements storage.Interface on top of a KV. Resource versions are
+// store revisions, and every conditional write is a single transaction.
+type kvStorage struct {
+	client    KV
+	codec     runtime.Codec
+	versioner storage.Versioner
+	// prefix for all keys
+	pathPrefix string
+	// clock is used to compute the remaining time-to-live of expiring keys.
+	clock util.Clock
+}
+
+// Codec provides access to the underlying codec being used by the implementation.
+func (s *kvStorage) Codec() runtime.Codec {
+	return s.codec
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Backends() []string {
+	return nil
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Versioner() storage.Versioner {
+	return s.versioner
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Create(key string, obj, out runtime.Object, ttl uint64) error {
+	key = s.prefixKey(key)
+	if version, err := s.versioner.ObjectResourceVersion(obj); err == nil && version != 0 {
+		return errors.New("resourceVersion may not be set on objects to be created")
+	}
+	data, err := s.codec.Encode(obj)
+	if err != nil {
+		return err
+	}
+	return s.create(key, data, out, ttl)
+}
+
+func (s *kvStorage) create(key string, data []byte, out runtime.Object, ttl uint64) error {
+	resp, err := s.client.Txn([]Compare{{Key: key}}, []Op{Put(key, data, ttl)})
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return storage.NewKeyExistsError(key, uint64(resp.Revision))
+	}
+	if out != nil {
+		return s.decode(resp.Results[0], out)
+	}
+	return nil
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Set(key string, obj, out runtime.Object, ttl uint64) error {
+	key = s.prefixKey(key)
+	data, err := s.codec.Encode(obj)
+	if err != nil {
+		return err
+	}
+	version, err := s.versioner.ObjectResourceVersion(obj)
+	if err != nil || version == 0 {
+		// Create will fail if a key already exists.
+		return s.create(key, data, out, ttl)
+	}
+
+	resp, err := s.client.Txn([]Compare{{Key: key, ModRevision: int64(version)}}, []Op{Put(key, data, ttl)})
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		current, revision, err := s.client.Get(key)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return storage.NewKeyNotFoundError(key, uint64(revision))
+		}
+		return storage.NewResourceVersionConflictsError(key, version)
+	}
+	if out != nil {
+		return s.decode(resp.Results[0], out)
+	}
+	return nil
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Delete(key string, out runtime.Object) error {
+	key = s.prefixKey(key)
+	if _, err := conversion.EnforcePtr(out); err != nil {
+		panic("unable to convert output object to pointer")
+	}
+	resp, err := s.client.Txn(nil, []Op{Delete(key)})
+	if err != nil {
+		return err
+	}
+	prev := resp.Results[0]
+	if prev == nil {
+		return storage.NewKeyNotFoundError(key, uint64(resp.Revision))
+	}
+	return s.decode(prev, out)
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Watch(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
+	key = s.prefixKey(key)
+	w := newKVWatcher(onlyKey(key), filter, s.codec, s.versioner)
+	w.start(s.client, key, resourceVersion)
+	return w, nil
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) WatchList(key string, resourceVersion uint64, filter storage.FilterFunc) (watch.Interface, error) {
+	key = s.prefixKey(key)
+	w := newKVWatcher(nil, filter, s.codec, s.versioner)
+	w.start(s.client, key+"/", resourceVersion)
+	return w, nil
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) Get(key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	key = s.prefixKey(key)
+	current, revision, err := s.client.Get(key)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		if ignoreNotFound {
+			return setZero(objPtr)
+		}
+		return storage.NewKeyNotFoundError(key, uint64(revision))
+	}
+	return s.decode(current, objPtr)
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) GetToList(key string, filter storage.FilterFunc, listObj runtime.Object) error {
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	key = s.prefixKey(key)
+	current, revision, err := s.client.Get(key)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	if err := s.decodeList([]*KeyValue{current}, filter, listPtr); err != nil {
+		return err
+	}
+	return s.versioner.UpdateList(listObj, uint64(revision), "")
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) List(key string, page storage.ListPage, filter storage.FilterFunc, listObj runtime.Object) error {
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return err
+	}
+	keyPrefix := s.prefixKey(key) + "/"
+	if page.Limit == 0 && len(page.Continue) == 0 {
+		kvs, revision, err := s.client.List(keyPrefix)
+		if err != nil {
+			return err
+		}
+		if err := s.decodeList(kvs, filter, listPtr); err != nil {
+			return err
+		}
+		return s.versioner.UpdateList(listObj, uint64(revision), "")
+	}
+
+	// Every page reflects the current contents of the store, but reports the
+	// revision of the first page so that a watch started from it observes
+	// every change made while paging.
+	resourceVersion := uint64(0)
+	fromKey := ""
+	if len(page.Continue) > 0 {
+		if fromKey, resourceVersion, err = storage.DecodeContinue(page.Continue, keyPrefix); err != nil {
+			return err
+		}
+	}
+	v, _ := conversion.EnforcePtr(listPtr)
+	// read the page range from the store, reading further while the filter
+	// leaves the page short
+	for {
+		limit := int64(0)
+		if page.Limit > 0 {
+			limit = page.Limit - int64(v.Len())
+		}
+		kvs, more, revision, err := s.client.ListRange(keyPrefix, fromKey, limit)
+		if err != nil {
+			return err
+		}
+		if resourceVersion == 0 {
+			resourceVersion = uint64(revision)
+		}
+		if err := s.decodeList(kvs, filter, listPtr); err != nil {
+			return err
+		}
+		if !more {
+			return s.versioner.UpdateList(listObj, resourceVersion, "")
+		}
+		fromKey = kvs[len(kvs)-1].Key
+		if int64(v.Len()) >= page.Limit {
+			continueValue, err := storage.EncodeContinue(fromKey, keyPrefix, resourceVersion)
+			if err != nil {
+				return err
+			}
+			return s.versioner.UpdateList(listObj, resourceVersion, continueValue)
+		}
+	}
+}
+
+// Implements storage.Interface.
+func (s *kvStorage) GuaranteedUpdate(key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
+	v, err := conversion.EnforcePtr(ptrToType)
+	if err != nil {
+		// Panic is appropriate, because this is a programming error.
+		panic("need ptr to type")
+	}
+	key = s.prefixKey(key)
+	for {
+		obj := reflect.New(v.Type()).Interface().(runtime.Object)
+		current, revision, err := s.client.Get(key)
+		if err != nil {
+			return err
+		}
+		meta := storage.ResponseMeta{}
+		ttl := uint64(0)
+		if current == nil {
+			if !ignoreNotFound {
+				return storage.NewKeyNotFoundError(key, uint64(revision))
+			}
+		} else {
+			if err := s.decode(current, obj); err != nil {
+				return err
+			}
+			meta.ResourceVersion = uint64(current.ModRevision)
+			if current.Expiration != nil {
+				meta.Expiration = current.Expiration
+				meta.TTL = int64(math.Ceil(current.Expiration.Sub(s.clock.Now()).Seconds()))
+				// keep the key expiring even if its time is already up
+				ttl = 1
+				if meta.TTL > 0 {
+					ttl = uint64(meta.TTL)
+				}
+			}
+		}
+
+		// Get the object to be written by calling tryUpdate.
+		ret, newTTL, err := tryUpdate(obj, meta)
+		if err != nil {
+			return err
+		}
+		if newTTL != nil {
+			ttl = *newTTL
+		}
+		// the resource version is implied by the revision, and is not stored
+		_ = s.versioner.UpdateObject(ret, nil, 0)
+		data, err := s.codec.Encode(ret)
+		if err != nil {
+			return err
+		}
+
+		compare := Compare{Key: key}
+		if current != nil {
+			if bytes.Equal(data, current.Value) {
+				return s.decode(current, ptrToType)
+			}
+			compare.ModRevision = current.ModRevision
+		}
+		resp, err := s.client.Txn([]Compare{compare}, []Op{Put(key, data, ttl)})
+		if err != nil {
+			return err
+		}
+		if !resp.Succeeded {
+			// Try again.
+			continue
+		}
+		return s.decode(resp.Results[0], ptrToType)
+	}
+}
+
+func (s *kvStorage) prefixKey(key string) string {
+	if strings.HasPrefix(key, path.Join("/", s.pathPrefix)) {
+		return key
+	}
+	return path.Join("/", s.pathPrefix, key)
+}
+
+// decode decodes the value of kv into objPtr and sets its resource version.
+func (s *kvStorage) decode(kv *KeyValue, objPtr runtime.Object) error {
+	if err := s.codec.DecodeInto(kv.Value, objPtr); err != nil {
+		return err
+	}
+	// being unable to set the version does not prevent the object from being extracted
+	_ = s.versioner.UpdateObject(objPtr, kv.Expiration, uint64(kv.ModRevision))
+	return nil
+}
+
+// decodeList decodes each of kvs and appends those passing filter to the slice at slicePtr.
+func (s *kvStorage) decodeList(kvs []*KeyValue, filter storage.FilterFunc, slicePtr interface{}) error {
+	v, err := conversion.EnforcePtr(slicePtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		// This should not happen at runtime.
+		panic("need ptr to slice")
+	}
+	for _, kv := range kvs {
+		obj := reflect.New(v.Type().Elem())
+		if err := s.decode(kv, obj.Interface().(runtime.Object)); err != nil {
+			return err
+		}
+		if filter(obj.Interface().(runtime.Object)) {
+			v.Set(reflect.Append(v, obj.Elem()))
+		}
+	}
+	return nil
+}
+
+func setZero(objPtr runtime.Object) error {
+	v, err := conversion.EnforcePtr(objPtr)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.Zero(v.Type()))
+	return nil
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+func newTestStorage(client KV) *kvStorage {
+	return NewStorage(client, testapi.Default.Codec(), "/registry").(*kvStorage)
+}
+
+func newPod(name string) *api.Pod {
+	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}}
+}
+
+func TestCreateGetDelete(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	out := &api.Pod{}
+	if err := s.Create("/pods/foo", newPod("foo"), out, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Name != "foo" || out.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+	if err := s.Create("/pods/foo", newPod("foo"), nil, 0); !storage.IsNodeExist(err) {
+		t.Errorf("expected a key exists error, got %v", err)
+	}
+	if err := s.Create("/pods/bar", out, nil, 0); err == nil {
+		t.Errorf("expected creating an object with a resourceVersion to fail")
+	}
+
+	got := &api.Pod{}
+	if err := s.Get("/pods/foo", got, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "foo" || got.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", got)
+	}
+	if err := s.Get("/pods/bar", got, false); !storage.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if err := s.Get("/pods/bar", got, true); err != nil || got.Name != "" {
+		t.Errorf("expected a zero object, got %#v: %v", got, err)
+	}
+
+	deleted := &api.Pod{}
+	if err := s.Delete("/pods/foo", deleted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted.Name != "foo" || deleted.ResourceVersion != "1" {
+		t.Errorf("unexpected object: %#v", deleted)
+	}
+	if err := s.Delete("/pods/foo", deleted); !storage.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	created := &api.Pod{}
+	if err := s.Set("/pods/foo", newPod("foo"), created, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := &api.Pod{}
+	created.Labels = map[string]string{"a": "b"}
+	if err := s.Set("/pods/foo", created, updated, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.ResourceVersion != "2" || updated.Labels["a"] != "b" {
+		t.Errorf("unexpected object: %#v", updated)
+	}
+	// created is now stale
+	if err := s.Set("/pods/foo", created, nil, 0); !storage.IsTestFailed(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+	stale := newPod("bar")
+	stale.ResourceVersion = "1"
+	if err := s.Set("/pods/bar", stale, nil, 0); !storage.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	for _, name := range []string{"foo", "bar"} {
+		if err := s.Create("/pods/"+name, newPod(name), nil, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := s.Create("/podsfoo", newPod("baz"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	list := &api.PodList{}
+	if err := s.List("/pods", storage.ListPage{}, storage.Everything, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Name != "bar" || list.Items[1].Name != "foo" || list.ResourceVersion != "3" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+
+	list = &api.PodList{}
+	filter := func(obj runtime.Object) bool { return obj.(*api.Pod).Name == "foo" }
+	if err := s.List("/pods", storage.ListPage{}, filter, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "foo" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+
+	list = &api.PodList{}
+	if err := s.GetToList("/pods/foo", storage.Everything, list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].ResourceVersion != "1" {
+		t.Errorf("unexpected list: %#v", list)
+	}
+}
+
+func TestListPaged(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if err := s.Create("/pods/"+name, newPod(name), nil, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	notC := func(obj runtime.Object) bool { return obj.(*api.Pod).Name != "c" }
+
+	first := &api.PodList{}
+	if err := s.List("/pods", storage.ListPage{Limit: 2}, notC, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Items) != 2 || first.Items[1].Name != "b" || first.ResourceVersion != "5" || first.Continue == "" {
+		t.Fatalf("unexpected page: %#v", first)
+	}
+	// changes after the first page are visible, but the page is reported at its revision
+	if err := s.Delete("/pods/e", &api.Pod{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second := &api.PodList{}
+	if err := s.List("/pods", storage.ListPage{Limit: 2, Continue: first.Continue}, notC, second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second.Items) != 1 || second.Items[0].Name != "d" || second.ResourceVersion != "5" || second.Continue != "" {
+		t.Errorf("unexpected page: %#v", second)
+	}
+
+	// a malformed token is rejected
+	if err := s.List("/pods", storage.ListPage{Limit: 2, Continue: "x" + first.Continue}, storage.Everything, &api.PodList{}); !storage.IsInvalidContinue(err) {
+		t.Errorf("expected an invalid continue error, got %v", err)
+	}
+}
+
+func TestGuaranteedUpdate(t *testing.T) {
+	clock := &util.FakeClock{Time: time.Now()}
+	client := newMemory(clock, defaultHistorySize)
+	s := newTestStorage(client)
+	s.clock = clock
+
+	setLabel := func(value string, ttl *uint64) storage.UpdateFunc {
+		return func(obj runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+			pod := obj.(*api.Pod)
+			pod.Name = "foo"
+			pod.Labels = map[string]string{"a": value}
+			return pod, ttl, nil
+		}
+	}
+	out := &api.Pod{}
+	if err := s.GuaranteedUpdate("/pods/foo", out, false, setLabel("1", nil)); !storage.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	ttl := uint64(30)
+	if err := s.GuaranteedUpdate("/pods/foo", out, true, setLabel("1", &ttl)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ResourceVersion != "1" || out.Labels["a"] != "1" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+
+	// the remaining TTL is kept unless a new one is returned
+	clock.Step(10 * time.Second)
+	var seenTTL int64
+	err := s.GuaranteedUpdate("/pods/foo", out, false, func(obj runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		seenTTL = res.TTL
+		return setLabel("2", nil)(obj, res)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if seenTTL != 20 || out.ResourceVersion != "2" || out.Labels["a"] != "2" {
+		t.Errorf("unexpected object with TTL %d: %#v", seenTTL, out)
+	}
+	clock.Step(19 * time.Second)
+	if err := s.Get("/pods/foo", out, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// an update which changes nothing is not written
+	if err := s.GuaranteedUpdate("/pods/foo", out, false, setLabel("2", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ResourceVersion != "2" || out.Labels["a"] != "2" {
+		t.Errorf("unexpected object: %#v", out)
+	}
+
+	// a conflicting write is retried against the new state
+	attempts := 0
+	err = s.GuaranteedUpdate("/pods/foo", out, false, func(obj runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		attempts++
+		if attempts == 1 {
+			if err := s.Set("/pods/foo", obj, nil, 0); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return setLabel("3", nil)(obj, res)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 || out.ResourceVersion != "4" || out.Labels["a"] != "3" {
+		t.Errorf("unexpected object after %d attempts: %#v", attempts, out)
+	}
+}
+
+func nextWatchEvent(t *testing.T, w watch.Interface) watch.Event {
+	select {
+	case e, ok := <-w.ResultChan():
+		if !ok {
+			t.Fatalf("watch closed unexpectedly")
+		}
+		return e
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for event")
+	}
+	return watch.Event{}
+}
+
+func TestWatchList(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	if err := s.Create("/pods/foo", newPod("foo"), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	filter := func(obj runtime.Object) bool { return obj.(*api.Pod).Labels["a"] != "hidden" }
+	w, err := s.WatchList("/pods", 0, filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+
+	bar := &api.Pod{}
+	if err := s.Create("/pods/bar", newPod("bar"), bar, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Create("/services/foo", &api.Service{ObjectMeta: api.ObjectMeta{Name: "foo"}}, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bar.Labels = map[string]string{"a": "hidden"}
+	if err := s.Set("/pods/bar", bar, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Delete("/pods/foo", &api.Pod{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		eventType       watch.EventType
+		name            string
+		resourceVersion string
+	}{
+		{watch.Added, "foo", "1"},
+		{watch.Added, "bar", "2"},
+		// no longer passes the filter
+		{watch.Deleted, "bar", "2"},
+		{watch.Deleted, "foo", "5"},
+	}
+	for _, e := range expected {
+		got := nextWatchEvent(t, w)
+		pod := got.Object.(*api.Pod)
+		if got.Type != e.eventType || pod.Name != e.name || pod.ResourceVersion != e.resourceVersion {
+			t.Errorf("expected %s %s at %s, got %s %s at %s", e.eventType, e.name, e.resourceVersion, got.Type, pod.Name, pod.ResourceVersion)
+		}
+	}
+}
+
+func TestWatch(t *testing.T) {
+	s := newTestStorage(NewMemory())
+	for _, name := range []string{"foo", "foobar"} {
+		if err := s.Create("/pods/"+name, newPod(name), nil, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// resume from the creation of foo
+	w, err := s.Watch("/pods/foo", 1, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	foo := &api.Pod{}
+	if err := s.Get("/pods/foo", foo, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	foo.Labels = map[string]string{"a": "b"}
+	if err := s.Set("/pods/foo", foo, nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e := nextWatchEvent(t, w); e.Type != watch.Added || e.Object.(*api.Pod).ResourceVersion != "1" {
+		t.Errorf("unexpected event: %#v", e)
+	}
+	if e := nextWatchEvent(t, w); e.Type != watch.Modified || e.Object.(*api.Pod).ResourceVersion != "3" {
+		t.Errorf("unexpected event: %#v", e)
+	}
+}
+
+func TestWatchCompacted(t *testing.T) {
+	s := newTestStorage(newMemory(util.RealClock{}, 1))
+	for _, name := range []string{"foo", "bar"} {
+		if err := s.Create("/pods/"+name, newPod(name), nil, 0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	w, err := s.WatchList("/pods", 1, storage.Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	if e := nextWatchEvent(t, w); e.Type != watch.Error {
+		t.Errorf("expected an error event, got %#v", e)
+	}
+	if _, ok := <-w.ResultChan(); ok {
+		t.Errorf("expected the watch to close")
+	}
+}
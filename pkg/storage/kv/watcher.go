@@ -0,0 +1,224 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kv
+
+import (
+	"sync"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+// includeFunc returns true if the given key should be considered part of a watch
+type includeFunc func(key string) bool
+
+// onlyKey is an includeFunc that returns true only for the watched key
+func onlyKey(only string) includeFunc {
+	return func(key string) bool {
+		return key == only
+	}
+}
+
+// kvWatcher converts the events of a KV watch to a watch.Interface.
+type kvWatcher struct {
+	codec     runtime.Codec
+	versioner storage.Versioner
+	include   includeFunc
+	filter    storage.FilterFunc
+
+	outgoing chan watch.Event
+	userStop chan struct{}
+	stopped  bool
+	stopLock sync.Mutex
+}
+
+func newKVWatcher(include includeFunc, filter storage.FilterFunc, codec runtime.Codec, versioner storage.Versioner) *kvWatcher {
+	return &kvWatcher{
+		codec:     codec,
+		versioner: versioner,
+		include:   include,
+		filter:    filter,
+		outgoing:  make(chan watch.Event),
+		userStop:  make(chan struct{}),
+	}
+}
+
+// start begins watching the keys beginning with prefix from resourceVersion,
+// or from their current state if resourceVersion is zero, and delivers the
+// events from a new goroutine. The starting state is captured before start
+// returns so that no change made after the call is missed.
+func (w *kvWatcher) start(client KV, prefix string, resourceVersion uint64) {
+	var initial []*KeyValue
+	fromRevision := int64(resourceVersion)
+	if resourceVersion == 0 {
+		kvs, revision, err := client.List(prefix)
+		if err != nil {
+			go w.fail(err)
+			return
+		}
+		initial = kvs
+		fromRevision = revision + 1
+	}
+	kvWatch, err := client.Watch(prefix, fromRevision)
+	if err != nil {
+		go w.fail(err)
+		return
+	}
+	go w.run(initial, kvWatch)
+}
+
+// fail sends err as the only event of the watch. Meant to be called as a goroutine.
+func (w *kvWatcher) fail(err error) {
+	defer close(w.outgoing)
+	w.sendError(err)
+}
+
+// run sends the initial state followed by the events of kvWatch. Meant to be
+// called as a goroutine.
+func (w *kvWatcher) run(initial []*KeyValue, kvWatch Watcher) {
+	defer close(w.outgoing)
+	defer util.HandleCrash()
+	defer kvWatch.Stop()
+
+	for _, kv := range initial {
+		w.sendPut(kv, nil)
+	}
+	for {
+		select {
+		case <-w.userStop:
+			return
+		case e, ok := <-kvWatch.ResultChan():
+			if !ok {
+				return
+			}
+			switch e.Type {
+			case EventTypePut:
+				w.sendPut(e.KV, e.PrevKV)
+			case EventTypeDelete:
+				w.sendDelete(e.KV, e.PrevKV)
+			default:
+				glog.Errorf("unknown event type: %v", e.Type)
+			}
+		}
+	}
+}
+
+// emit sends e unless the watcher has been stopped.
+func (w *kvWatcher) emit(e watch.Event) {
+	select {
+	case w.outgoing <- e:
+	case <-w.userStop:
+	}
+}
+
+func (w *kvWatcher) sendError(err error) {
+	w.emit(watch.Event{
+		Type: watch.Error,
+		Object: &unversioned.Status{
+			Status:  unversioned.StatusFailure,
+			Message: err.Error(),
+		},
+	})
+}
+
+func (w *kvWatcher) decodeObject(kv *KeyValue) (runtime.Object, error) {
+	obj, err := w.codec.Decode(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.versioner.UpdateObject(obj, kv.Expiration, uint64(kv.ModRevision)); err != nil {
+		glog.Errorf("failure to version api object (%d) %#v: %v", kv.ModRevision, obj, err)
+	}
+	return obj, nil
+}
+
+func (w *kvWatcher) sendPut(kv, prev *KeyValue) {
+	if w.include != nil && !w.include(kv.Key) {
+		return
+	}
+	curObj, err := w.decodeObject(kv)
+	if err != nil {
+		// Ignore this value. If we stop the watch on a bad value, a client that uses
+		// the resourceVersion to resume will never be able to get past a bad value.
+		glog.Errorf("failure to decode api object: %q at revision %d: %v", kv.Key, kv.ModRevision, err)
+		return
+	}
+	curObjPasses := w.filter(curObj)
+	oldObjPasses := false
+	var oldObj runtime.Object
+	if prev != nil {
+		// Ignore problems reading the old object.
+		if oldObj, err = w.decodeObject(prev); err == nil {
+			oldObjPasses = w.filter(oldObj)
+		}
+	}
+	// Some changes to an object may cause it to start or stop matching a filter.
+	// We need to report those as adds/deletes. So we have to check both the previous
+	// and current value of the object.
+	switch {
+	case curObjPasses && oldObjPasses:
+		w.emit(watch.Event{Type: watch.Modified, Object: curObj})
+	case curObjPasses && !oldObjPasses:
+		w.emit(watch.Event{Type: watch.Added, Object: curObj})
+	case !curObjPasses && oldObjPasses:
+		w.emit(watch.Event{Type: watch.Deleted, Object: oldObj})
+	}
+}
+
+func (w *kvWatcher) sendDelete(kv, prev *KeyValue) {
+	if prev == nil {
+		glog.Errorf("unexpected deletion of absent key %q", kv.Key)
+		return
+	}
+	if w.include != nil && !w.include(kv.Key) {
+		return
+	}
+	// Send the *old* object with the revision at which it was deleted, so that
+	// users can restart the watch at the right revision.
+	deleted := *prev
+	deleted.ModRevision = kv.ModRevision
+	obj, err := w.decodeObject(&deleted)
+	if err != nil {
+		glog.Errorf("failure to decode api object: %q at revision %d: %v", prev.Key, prev.ModRevision, err)
+		return
+	}
+	if !w.filter(obj) {
+		return
+	}
+	w.emit(watch.Event{Type: watch.Deleted, Object: obj})
+}
+
+// ResultChan implements watch.Interface.
+func (w *kvWatcher) ResultChan() <-chan watch.Event {
+	return w.outgoing
+}
+
+// Stop implements watch.Interface.
+func (w *kvWatcher) Stop() {
+	w.stopLock.Lock()
+	defer w.stopLock.Unlock()
+	// Prevent double channel closes.
+	if !w.stopped {
+		w.stopped = true
+		close(w.userStop)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// continueToken is the decoded form of ListMeta.Continue.
+type continueToken struct {
+	// ResourceVersion is the resource version of the first page of the list.
+	ResourceVersion uint64 `json:"rv"`
+	// StartKey is the key of the last object returned, relative to the listed directory.
+	StartKey string `json:"start"`
+}
+
+// EncodeContinue returns a continue token for a list of the keys beginning
+// with keyPrefix which resumes after key, and whose pages are reported at
+// resourceVersion.
+func EncodeContinue(key, keyPrefix string, resourceVersion uint64) (string, error) {
+	data, err := json.Marshal(continueToken{ResourceVersion: resourceVersion, StartKey: strings.TrimPrefix(key, keyPrefix)})
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+// DecodeContinue returns the key after which a list of the keys beginning
+// with keyPrefix resumes, and the resource version its pages are reported at.
+func DecodeContinue(continueValue, keyPrefix string) (fromKey string, resourceVersion uint64, err error) {
+	data, err := base64.URLEncoding.DecodeString(continueValue)
+	if err != nil {
+		return "", 0, NewInvalidContinueError(keyPrefix)
+	}
+	token := continueToken{}
+	if err := json.Unmarshal(data, &token); err != nil || len(token.StartKey) == 0 || token.ResourceVersion == 0 {
+		return "", 0, NewInvalidContinueError(keyPrefix)
+	}
+	return keyPrefix + token.StartKey, token.ResourceVersion, nil
+}
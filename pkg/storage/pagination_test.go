@@ -0,0 +1,49 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+)
+
+func TestContinueRoundTrip(t *testing.T) {
+	continueValue, err := EncodeContinue("/registry/pods/ns/foo", "/registry/pods/", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fromKey, resourceVersion, err := DecodeContinue(continueValue, "/registry/pods/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fromKey != "/registry/pods/ns/foo" || resourceVersion != 10 {
+		t.Errorf("unexpected key %q at %d", fromKey, resourceVersion)
+	}
+	// the token is relative to the listed directory
+	if fromKey, _, _ := DecodeContinue(continueValue, "/registry/services/"); fromKey != "/registry/services/ns/foo" {
+		t.Errorf("unexpected key %q", fromKey)
+	}
+}
+
+func TestDecodeInvalidContinue(t *testing.T) {
+	emptyKey, _ := EncodeContinue("/registry/pods/", "/registry/pods/", 10)
+	noVersion, _ := EncodeContinue("/registry/pods/foo", "/registry/pods/", 0)
+	for _, continueValue := range []string{"not base64!", "bm90IGpzb24", emptyKey, noVersion} {
+		if _, _, err := DecodeContinue(continueValue, "/registry/pods/"); !IsInvalidContinue(err) {
+			t.Errorf("%q: expected an invalid continue error, got %v", continueValue, err)
+		}
+	}
+}
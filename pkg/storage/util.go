@@ -17,7 +17,9 @@ limitations under the License.
 package storage
 
 import (
+	"reflect"
 	"strconv"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/meta"
@@ -25,6 +27,20 @@ import (
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 )
 
+// BookmarkInterval is how often watches send a watch.Bookmark event when the
+// resource version they observed advanced since their last bookmark.
+var BookmarkInterval = time.Minute
+
+// NewBookmark returns the object of a watch.Bookmark event: an empty object
+// of the same type as obj, with only its resource version set.
+func NewBookmark(versioner Versioner, obj runtime.Object, resourceVersion uint64) (runtime.Object, error) {
+	bookmark := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	if err := versioner.UpdateObject(bookmark, nil, resourceVersion); err != nil {
+		return nil, err
+	}
+	return bookmark, nil
+}
+
 type SimpleUpdateFunc func(runtime.Object) (runtime.Object, error)
 
 // SimpleUpdateFunc converts SimpleUpdateFunc into UpdateFunc
@@ -21,6 +21,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/client/cache"
@@ -31,11 +32,12 @@ import (
 // watchCacheEvent is a single "watch event" that is send to users of
 // watchCache. Additionally to a typical "watch.Event" it contains
 // the previous value of the object to enable proper filtering in the
-// upper layers.
+// upper layers, and the resource version of the change.
 type watchCacheEvent struct {
-	Type       watch.EventType
-	Object     runtime.Object
-	PrevObject runtime.Object
+	Type            watch.EventType
+	Object          runtime.Object
+	PrevObject      runtime.Object
+	ResourceVersion uint64
 }
 
 // watchCacheElement is a single "watch event" stored in a cache.
@@ -69,11 +71,19 @@ type watchCache struct {
 	// store will effectively support LIST operation from the "end of cache
 	// history" i.e. from the moment just after the newest cached watched event.
 	// It is necessary to effectively allow clients to start watching at now.
-	store cache.Store
+	// It is additionally indexed by the fields the Cacher was configured with.
+	store cache.Indexer
 
 	// ResourceVersion up to which the watchCache is propagated.
 	resourceVersion uint64
 
+	// cond is broadcast whenever resourceVersion advances.
+	cond *sync.Cond
+
+	// ResourceVersion at which the watchCache was last listed. The cache
+	// knows nothing about the changes at or before it.
+	listResourceVersion uint64
+
 	// This handler is run at the end of every successful Replace() method.
 	onReplace func()
 
@@ -82,15 +92,21 @@ type watchCache struct {
 	onEvent func(watchCacheEvent)
 }
 
-func newWatchCache(capacity int) *watchCache {
-	return &watchCache{
+// blockTimeout is how long waitUntilFresh waits for the watchCache to be
+// propagated to a resource version.
+const blockTimeout = 3 * time.Second
+
+func newWatchCache(capacity int, indexers cache.Indexers) *watchCache {
+	wc := &watchCache{
 		capacity:        capacity,
 		cache:           make([]watchCacheElement, capacity),
 		startIndex:      0,
 		endIndex:        0,
-		store:           cache.NewStore(cache.MetaNamespaceKeyFunc),
+		store:           cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers),
 		resourceVersion: 0,
 	}
+	wc.cond = sync.NewCond(wc.RLocker())
+	return wc
 }
 
 func (w *watchCache) Add(obj interface{}) error {
@@ -162,12 +178,13 @@ func (w *watchCache) processEvent(event watch.Event, resourceVersion uint64, upd
 	} else {
 		prevObject = nil
 	}
-	watchCacheEvent := watchCacheEvent{event.Type, event.Object, prevObject}
+	watchCacheEvent := watchCacheEvent{event.Type, event.Object, prevObject, resourceVersion}
 	if w.onEvent != nil {
 		w.onEvent(watchCacheEvent)
 	}
 	w.updateCache(resourceVersion, watchCacheEvent)
 	w.resourceVersion = resourceVersion
+	defer w.cond.Broadcast()
 	return updateFunc(event.Object)
 }
 
@@ -193,6 +210,38 @@ func (w *watchCache) ListWithVersion() ([]interface{}, uint64) {
 	return w.store.List(), w.resourceVersion
 }
 
+// ByIndexWithVersion returns the objects whose value of the given index is
+// indexKey, along with the resource version the cache is propagated to.
+func (w *watchCache) ByIndexWithVersion(indexName, indexKey string) ([]interface{}, uint64, error) {
+	w.RLock()
+	defer w.RUnlock()
+	objs, err := w.store.ByIndex(indexName, indexKey)
+	return objs, w.resourceVersion, err
+}
+
+// waitUntilFresh blocks until the watchCache is propagated to at least
+// resourceVersion, and fails if that takes longer than blockTimeout.
+func (w *watchCache) waitUntilFresh(resourceVersion uint64) error {
+	w.RLock()
+	defer w.RUnlock()
+	if w.resourceVersion >= resourceVersion {
+		return nil
+	}
+	startTime := time.Now()
+	go func() {
+		// Wake up the waiter below so that it notices the timeout.
+		time.Sleep(blockTimeout)
+		w.cond.Broadcast()
+	}()
+	for w.resourceVersion < resourceVersion {
+		if time.Since(startTime) >= blockTimeout {
+			return fmt.Errorf("too large resource version: %d, current: %d", resourceVersion, w.resourceVersion)
+		}
+		w.cond.Wait()
+	}
+	return nil
+}
+
 func (w *watchCache) ListKeys() []string {
 	w.RLock()
 	defer w.RUnlock()
@@ -226,6 +275,8 @@ func (w *watchCache) Replace(objs []interface{}, resourceVersion string) error {
 		return err
 	}
 	w.resourceVersion = version
+	w.listResourceVersion = version
+	w.cond.Broadcast()
 	if w.onReplace != nil {
 		w.onReplace()
 	}
@@ -260,7 +311,7 @@ func (w *watchCache) GetAllEventsSinceThreadUnsafe(resourceVersion uint64) ([]wa
 		allItems := w.store.List()
 		result := make([]watchCacheEvent, len(allItems))
 		for i, item := range allItems {
-			result[i] = watchCacheEvent{Type: watch.Added, Object: item.(runtime.Object)}
+			result[i] = watchCacheEvent{Type: watch.Added, Object: item.(runtime.Object), ResourceVersion: w.resourceVersion}
 		}
 		return result, nil
 	}
@@ -281,6 +332,17 @@ func (w *watchCache) GetAllEventsSinceThreadUnsafe(resourceVersion uint64) ([]wa
 	return result, nil
 }
 
+// IsBeforeListThreadUnsafe returns true if some of the events since
+// resourceVersion happened before the watchCache was last listed, e.g. when
+// a client resumes its watch after an apiserver restart. Only the underlying
+// storage may be able to serve them.
+func (w *watchCache) IsBeforeListThreadUnsafe(resourceVersion uint64) bool {
+	// resourceVersion is the first version to be delivered, so the watch
+	// starts from resourceVersion-1, and the watchCache holds every change
+	// after listResourceVersion.
+	return resourceVersion != 0 && resourceVersion-1 < w.listResourceVersion
+}
+
 func (w *watchCache) GetAllEventsSince(resourceVersion uint64) ([]watchCacheEvent, error) {
 	w.RLock()
 	defer w.RUnlock()
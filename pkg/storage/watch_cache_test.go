@@ -40,7 +40,7 @@ func makeTestPod(name string, resourceVersion uint64) *api.Pod {
 }
 
 func TestWatchCacheBasic(t *testing.T) {
-	store := newWatchCache(2)
+	store := newWatchCache(2, cache.Indexers{})
 
 	// Test Add/Update/Delete.
 	pod1 := makeTestPod("pod", 1)
@@ -110,7 +110,7 @@ func TestWatchCacheBasic(t *testing.T) {
 }
 
 func TestEvents(t *testing.T) {
-	store := newWatchCache(5)
+	store := newWatchCache(5, cache.Indexers{})
 
 	store.Add(makeTestPod("pod", 2))
 
@@ -226,6 +226,19 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+func TestIsBeforeList(t *testing.T) {
+	store := newWatchCache(5, cache.Indexers{})
+	if err := store.Replace([]interface{}{makeTestPod("pod", 5)}, "5"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Watches deliver the changes from the given resource version on.
+	for resourceVersion, expected := range map[uint64]bool{0: false, 1: true, 5: true, 6: false, 7: false} {
+		if a := store.IsBeforeListThreadUnsafe(resourceVersion); a != expected {
+			t.Errorf("%d: expected %t, got %t", resourceVersion, expected, a)
+		}
+	}
+}
+
 type testLW struct {
 	ListFunc  func() (runtime.Object, error)
 	WatchFunc func(resourceVersion string) (watch.Interface, error)
@@ -237,7 +250,7 @@ func (t *testLW) Watch(resourceVersion string) (watch.Interface, error) {
 }
 
 func TestReflectorForWatchCache(t *testing.T) {
-	store := newWatchCache(5)
+	store := newWatchCache(5, cache.Indexers{})
 
 	{
 		_, version := store.ListWithVersion()
@@ -0,0 +1,73 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache contains caches shared by the apiserver and its plugins.
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// LRUExpireCache is a least recently used cache whose entries also expire after a per-entry TTL.
+// It is safe for concurrent use.
+type LRUExpireCache struct {
+	clock util.Clock
+
+	lock  sync.Mutex
+	cache *lru.Cache
+}
+
+type cacheEntry struct {
+	value      interface{}
+	expireTime time.Time
+}
+
+// NewLRUExpireCache returns a cache holding at most maxSize entries.
+func NewLRUExpireCache(maxSize int) *LRUExpireCache {
+	return NewLRUExpireCacheWithClock(maxSize, util.RealClock{})
+}
+
+// NewLRUExpireCacheWithClock returns a cache holding at most maxSize entries which uses clock to expire them.
+func NewLRUExpireCacheWithClock(maxSize int, clock util.Clock) *LRUExpireCache {
+	return &LRUExpireCache{clock: clock, cache: lru.New(maxSize)}
+}
+
+// Add stores value under key until ttl has passed or the entry is evicted.
+func (c *LRUExpireCache) Add(key lru.Key, value interface{}, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(key, &cacheEntry{value, c.clock.Now().Add(ttl)})
+}
+
+// Get returns the value stored under key, if it is present and has not expired.
+func (c *LRUExpireCache) Get(key lru.Key) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := e.(*cacheEntry)
+	if c.clock.Now().After(entry.expireTime) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+	return entry.value, true
+}
@@ -71,8 +71,66 @@ func CreateStrategicMergePatch(original, modified []byte, dataStruct interface{}
 	return json.Marshal(patchMap)
 }
 
+// CreateThreeWayMergePatch reconciles a modified configuration with a current configuration,
+// using the original configuration that was last applied to decide which fields to delete.
+// Fields that are present in current but were never part of original, such as those set by
+// controllers, are left untouched. The three documents must be passed to the method as json
+// encoded content. It will return a patch that can be passed to StrategicMergePatch to yield
+// the reconciled document, or an error if any of the documents is invalid.
+func CreateThreeWayMergePatch(original, modified, current []byte, dataStruct interface{}) ([]byte, error) {
+	originalMap := map[string]interface{}{}
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &originalMap); err != nil {
+			return nil, errBadJSONDoc
+		}
+	}
+
+	modifiedMap := map[string]interface{}{}
+	if len(modified) > 0 {
+		if err := json.Unmarshal(modified, &modifiedMap); err != nil {
+			return nil, errBadJSONDoc
+		}
+	}
+
+	currentMap := map[string]interface{}{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &currentMap); err != nil {
+			return nil, errBadJSONDoc
+		}
+	}
+
+	t, err := getTagStructType(dataStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	// The additions and changes are computed against current, so that fields which
+	// already hold the desired value are not included in the patch, but nothing which
+	// is only present in current is deleted.
+	deltaMap, err := diffMaps(currentMap, modifiedMap, t, false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deletions are computed against original, so that only the fields which
+	// were previously applied and have since been removed are deleted.
+	deletionsMap, err := diffMaps(originalMap, modifiedMap, t, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	patchMap, err := mergeMap(deletionsMap, deltaMap, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(patchMap)
+}
+
 // Returns a (recursive) strategic merge patch that yields modified when applied to original.
-func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreAdditions, ignoreChangesAndDeletions bool) (map[string]interface{}, error) {
+// If ignoreChangesAndAdditions is set, the patch only contains deletions. If ignoreDeletions
+// is set, the patch only contains changes and additions.
+func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreChangesAndAdditions, ignoreDeletions bool) (map[string]interface{}, error) {
 	patch := map[string]interface{}{}
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
@@ -82,7 +140,7 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 		originalValue, ok := original[key]
 		// value was added
 		if !ok {
-			if !ignoreAdditions {
+			if !ignoreChangesAndAdditions {
 				patch[key] = modifiedValue
 			}
 
@@ -100,19 +158,20 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 				return nil, fmt.Errorf("invalid value for special key: %s", specialKey)
 			}
 
-			if modifiedString != originalString {
+			if modifiedString != originalString && !ignoreChangesAndAdditions {
 				patch[key] = modifiedValue
 			}
 
 			continue
 		}
 
-		if !ignoreChangesAndDeletions {
-			// If types have changed, replace completely
-			if reflect.TypeOf(originalValue) != reflect.TypeOf(modifiedValue) {
+		// If types have changed, replace completely
+		if reflect.TypeOf(originalValue) != reflect.TypeOf(modifiedValue) {
+			if !ignoreChangesAndAdditions {
 				patch[key] = modifiedValue
-				continue
 			}
+
+			continue
 		}
 
 		// Types are the same, compare values
@@ -124,7 +183,7 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 				return nil, err
 			}
 
-			patchValue, err := diffMaps(originalValueTyped, modifiedValueTyped, fieldType, ignoreAdditions, ignoreChangesAndDeletions)
+			patchValue, err := diffMaps(originalValueTyped, modifiedValueTyped, fieldType, ignoreChangesAndAdditions, ignoreDeletions)
 			if err != nil {
 				return nil, err
 			}
@@ -142,7 +201,7 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 			}
 
 			if fieldPatchStrategy == "merge" {
-				patchValue, err := diffLists(originalValueTyped, modifiedValueTyped, fieldType.Elem(), fieldPatchMergeKey, ignoreAdditions, ignoreChangesAndDeletions)
+				patchValue, err := diffLists(originalValueTyped, modifiedValueTyped, fieldType.Elem(), fieldPatchMergeKey, ignoreChangesAndAdditions, ignoreDeletions)
 				if err != nil {
 					return nil, err
 				}
@@ -155,14 +214,14 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 			}
 		}
 
-		if !ignoreChangesAndDeletions {
+		if !ignoreChangesAndAdditions {
 			if !reflect.DeepEqual(originalValue, modifiedValue) {
 				patch[key] = modifiedValue
 			}
 		}
 	}
 
-	if !ignoreChangesAndDeletions {
+	if !ignoreDeletions {
 		// Now add all deleted values as nil
 		for key := range original {
 			_, found := modified[key]
@@ -177,9 +236,9 @@ func diffMaps(original, modified map[string]interface{}, t reflect.Type, ignoreA
 
 // Returns a (recursive) strategic merge patch that yields modified when applied to original,
 // for a pair of lists with merge semantics.
-func diffLists(original, modified []interface{}, t reflect.Type, mergeKey string, ignoreAdditions, ignoreChangesAndDeletions bool) ([]interface{}, error) {
+func diffLists(original, modified []interface{}, t reflect.Type, mergeKey string, ignoreChangesAndAdditions, ignoreDeletions bool) ([]interface{}, error) {
 	if len(original) == 0 {
-		if len(modified) == 0 || ignoreAdditions {
+		if len(modified) == 0 || ignoreChangesAndAdditions {
 			return nil, nil
 		}
 
@@ -195,9 +254,9 @@ func diffLists(original, modified []interface{}, t reflect.Type, mergeKey string
 
 	// If the elements are not maps...
 	if elementType.Kind() == reflect.Map {
-		patch, err = diffListsOfMaps(original, modified, t, mergeKey, ignoreAdditions, ignoreChangesAndDeletions)
-	} else {
-		patch, err = diffListsOfScalars(original, modified, ignoreAdditions)
+		patch, err = diffListsOfMaps(original, modified, t, mergeKey, ignoreChangesAndAdditions, ignoreDeletions)
+	} else if !ignoreChangesAndAdditions {
+		patch, err = diffListsOfScalars(original, modified)
 	}
 
 	if err != nil {
@@ -209,7 +268,7 @@ func diffLists(original, modified []interface{}, t reflect.Type, mergeKey string
 
 // Returns a (recursive) strategic merge patch that yields modified when applied to original,
 // for a pair of lists of scalars with merge semantics.
-func diffListsOfScalars(original, modified []interface{}, ignoreAdditions bool) ([]interface{}, error) {
+func diffListsOfScalars(original, modified []interface{}) ([]interface{}, error) {
 	if len(modified) == 0 {
 		// There is no need to check the length of original because there is no way to create
 		// a patch that deletes a scalar from a list of scalars with merge semantics.
@@ -229,9 +288,7 @@ loopB:
 			modifiedString := fmt.Sprintf("%v", modified[modifiedIndex])
 			if originalString >= modifiedString {
 				if originalString != modifiedString {
-					if !ignoreAdditions {
-						patch = append(patch, modified[modifiedIndex])
-					}
+					patch = append(patch, modified[modifiedIndex])
 				}
 
 				continue loopB
@@ -243,11 +300,9 @@ loopB:
 		break
 	}
 
-	if !ignoreAdditions {
-		// Add any remaining items found only in modified
-		for ; modifiedIndex < len(modifiedScalars); modifiedIndex++ {
-			patch = append(patch, modified[modifiedIndex])
-		}
+	// Add any remaining items found only in modified
+	for ; modifiedIndex < len(modifiedScalars); modifiedIndex++ {
+		patch = append(patch, modified[modifiedIndex])
 	}
 
 	return patch, nil
@@ -258,7 +313,7 @@ var errBadArgTypeFmt = "expected a %s, but received a %t"
 
 // Returns a (recursive) strategic merge patch that yields modified when applied to original,
 // for a pair of lists of maps with merge semantics.
-func diffListsOfMaps(original, modified []interface{}, t reflect.Type, mergeKey string, ignoreAdditions, ignoreChangesAndDeletions bool) ([]interface{}, error) {
+func diffListsOfMaps(original, modified []interface{}, t reflect.Type, mergeKey string, ignoreChangesAndAdditions, ignoreDeletions bool) ([]interface{}, error) {
 	patch := make([]interface{}, 0)
 
 	originalSorted, err := sortMergeListsByNameArray(original, t, mergeKey, false)
@@ -301,7 +356,7 @@ loopB:
 			modifiedString := fmt.Sprintf("%v", modifiedValue)
 			if originalString >= modifiedString {
 				if originalString == modifiedString {
-					patchValue, err := diffMaps(originalMap, modifiedMap, t, ignoreAdditions, ignoreChangesAndDeletions)
+					patchValue, err := diffMaps(originalMap, modifiedMap, t, ignoreChangesAndAdditions, ignoreDeletions)
 					if err != nil {
 						return nil, err
 					}
@@ -311,14 +366,14 @@ loopB:
 						patchValue[mergeKey] = modifiedValue
 						patch = append(patch, patchValue)
 					}
-				} else if !ignoreAdditions {
+				} else if !ignoreChangesAndAdditions {
 					patch = append(patch, modifiedMap)
 				}
 
 				continue loopB
 			}
 
-			if !ignoreChangesAndDeletions {
+			if !ignoreDeletions {
 				patch = append(patch, map[string]interface{}{mergeKey: originalValue, specialKey: specialValue})
 			}
 		}
@@ -326,7 +381,7 @@ loopB:
 		break
 	}
 
-	if !ignoreChangesAndDeletions {
+	if !ignoreDeletions {
 		// Delete any remaining items found only in original
 		for ; originalIndex < len(originalSorted); originalIndex++ {
 			originalMap, ok := originalSorted[originalIndex].(map[string]interface{})
@@ -343,7 +398,7 @@ loopB:
 		}
 	}
 
-	if !ignoreAdditions {
+	if !ignoreChangesAndAdditions {
 		// Add any remaining items found only in modified
 		for ; modifiedIndex < len(modifiedSorted); modifiedIndex++ {
 			patch = append(patch, modified[modifiedIndex])
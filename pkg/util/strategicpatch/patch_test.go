@@ -764,3 +764,176 @@ func jsonToYAML(j []byte) ([]byte, error) {
 
 	return y, nil
 }
+
+type ThreeWayMergePatchTestCases struct {
+	TestCases []ThreeWayMergePatchTestCase
+}
+
+type ThreeWayMergePatchTestCase struct {
+	Description string
+	Original    map[string]interface{}
+	Modified    map[string]interface{}
+	Current     map[string]interface{}
+	ThreeWay    map[string]interface{}
+	Result      map[string]interface{}
+}
+
+// These are test cases for CreateThreeWayMergePatch, used to assert that fields
+// set only in current are preserved, while fields removed from original are deleted.
+var createThreeWayMergePatchTestCaseData = []byte(`
+testCases:
+  - description: add field preserving field set by another writer
+    original:
+      name: 1
+    modified:
+      name: 1
+      value: 1
+    current:
+      name: 1
+      other: a
+    threeWay:
+      value: 1
+    result:
+      name: 1
+      value: 1
+      other: a
+  - description: delete field removed from the applied configuration
+    original:
+      name: 1
+      value: 1
+    modified:
+      name: 1
+    current:
+      name: 1
+      value: 1
+      other: a
+    threeWay:
+      value: null
+    result:
+      name: 1
+      other: a
+  - description: change field which was changed by another writer
+    original:
+      name: 1
+      value: 1
+    modified:
+      name: 1
+      value: 2
+    current:
+      name: 1
+      value: 3
+    threeWay:
+      value: 2
+    result:
+      name: 1
+      value: 2
+  - description: no patch when modified already matches current
+    original:
+      name: 1
+    modified:
+      name: 1
+      value: 1
+    current:
+      name: 1
+      value: 1
+      other: a
+    threeWay: {}
+    result:
+      name: 1
+      value: 1
+      other: a
+  - description: delete and change items of a merging list preserving items added by another writer
+    original:
+      mergingList:
+        - name: 1
+        - name: 2
+          value: 2
+    modified:
+      mergingList:
+        - name: 2
+          value: 3
+    current:
+      mergingList:
+        - name: 1
+        - name: 2
+          value: 2
+        - name: 3
+    threeWay:
+      mergingList:
+        - name: 1
+          $patch: delete
+        - name: 2
+          value: 3
+    result:
+      mergingList:
+        - name: 2
+          value: 3
+        - name: 3
+  - description: empty original only adds and changes fields
+    original: {}
+    modified:
+      name: 1
+      simpleMap:
+        key1: value1
+    current:
+      name: 2
+      value: 1
+      simpleMap:
+        key2: value2
+    threeWay:
+      name: 1
+      simpleMap:
+        key1: value1
+    result:
+      name: 1
+      value: 1
+      simpleMap:
+        key1: value1
+        key2: value2
+`)
+
+func TestThreeWayMergePatch(t *testing.T) {
+	tc := ThreeWayMergePatchTestCases{}
+	err := yaml.Unmarshal(createThreeWayMergePatchTestCaseData, &tc)
+	if err != nil {
+		t.Errorf("can't unmarshal test cases: %v", err)
+		return
+	}
+
+	var e MergeItem
+	for _, c := range tc.TestCases {
+		original := toJSONOrFail(c.Original, t)
+		modified := toJSONOrFail(c.Modified, t)
+		current := toJSONOrFail(c.Current, t)
+
+		patch, err := CreateThreeWayMergePatch(original, modified, current, e)
+		if err != nil {
+			t.Errorf("%s: error generating patch: %v", c.Description, err)
+			continue
+		}
+
+		patch, err = sortMergeListsByName(patch, e)
+		if err != nil {
+			t.Errorf("%s: error sorting patch object: %v", c.Description, err)
+			continue
+		}
+
+		expectedPatch, err := sortMergeListsByName(toJSONOrFail(c.ThreeWay, t), e)
+		if err != nil {
+			t.Errorf("%s: error sorting expected patch object: %v", c.Description, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(patch, expectedPatch) {
+			t.Errorf("%s: patch generation failed:\nexpected patch:\n%vgot patch:\n%v", c.Description, jsonToYAMLOrError(expectedPatch), jsonToYAMLOrError(patch))
+		}
+
+		expectedResult, err := sortMergeListsByName(toJSONOrFail(c.Result, t), e)
+		if err != nil {
+			t.Errorf("%s: error sorting expected result object: %v", c.Description, err)
+			continue
+		}
+
+		testPatchApplication(t, current, patch, expectedResult, c.Description)
+	}
+}
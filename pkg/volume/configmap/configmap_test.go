@@ -0,0 +1,331 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/empty_dir"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+func newTestHost(t *testing.T, client client.Interface) (string, volume.VolumeHost) {
+	tempDir, err := ioutil.TempDir("/tmp", "configmap_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return tempDir, volume.NewFakeVolumeHost(tempDir, client, empty_dir.ProbeVolumePlugins())
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	_, host := newTestHost(t, nil)
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != configMapPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&volume.Spec{Volume: &api.Volume{VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{LocalObjectReference: api.LocalObjectReference{Name: ""}}}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&volume.Spec{}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec = volumeSpec(testVolumeName, testName)
+		configMap  = configMap(testNamespace, testName)
+		client     = testclient.NewSimpleFake(&configMap)
+		pluginMgr  = volume.VolumePluginMgr{}
+		_, host    = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Errorf("Got a nil Builder")
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid/volumes/kubernetes.io~configmap/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	err = builder.SetUp()
+	if err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", volumePath)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+
+	doTestConfigMapDataInVolume(volumePath, configMap, t)
+	doTestCleanAndTeardown(plugin, testPodUID, testVolumeName, volumePath, t)
+}
+
+// Test the case where the 'ready' file has been created and the pod volume dir
+// is a mountpoint.  Mount should not be called.
+func TestPluginIdempotent(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid2")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec    = volumeSpec(testVolumeName, testName)
+		configMap     = configMap(testNamespace, testName)
+		client        = testclient.NewSimpleFake(&configMap)
+		pluginMgr     = volume.VolumePluginMgr{}
+		rootDir, host = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	podVolumeDir := fmt.Sprintf("%v/pods/test_pod_uid2/volumes/kubernetes.io~configmap/test_volume_name", rootDir)
+	podMetadataDir := fmt.Sprintf("%v/pods/test_pod_uid2/plugins/kubernetes.io~configmap/test_volume_name", rootDir)
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{UID: testPodUID}}
+	if err := os.MkdirAll(podVolumeDir, 0750); err != nil {
+		t.Fatalf("Failed to create the volume dir: %v", err)
+	}
+	util.SetReady(podMetadataDir)
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Errorf("Got a nil Builder")
+	}
+
+	volumePath := builder.GetPath()
+	err = builder.SetUp()
+	if err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("Unexpected calls made to the API server: %v", actions)
+	}
+
+	if _, err := os.Stat(path.Join(volumePath, "data-1")); !os.IsNotExist(err) {
+		t.Errorf("Expected the ready volume not to be written again, got: %v", err)
+	}
+}
+
+// Test that files of keys which are no longer in the configMap are removed
+// when the volume is set up again.
+func TestPluginRemovesStaleKeys(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid4")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec    = volumeSpec(testVolumeName, testName)
+		configMap     = configMap(testNamespace, testName)
+		client        = testclient.NewSimpleFake(&configMap)
+		pluginMgr     = volume.VolumePluginMgr{}
+		rootDir, host = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	podVolumeDir := fmt.Sprintf("%v/pods/test_pod_uid4/volumes/kubernetes.io~configmap/test_volume_name", rootDir)
+	if err := os.MkdirAll(podVolumeDir, 0750); err != nil {
+		t.Fatalf("Failed to create the volume dir: %v", err)
+	}
+	stalePath := path.Join(podVolumeDir, "data-0")
+	if err := ioutil.WriteFile(stalePath, []byte("value-0"), 0444); err != nil {
+		t.Fatalf("Failed to write stale data: %v", err)
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+
+	doTestConfigMapDataInVolume(builder.GetPath(), configMap, t)
+	if _, err := os.Stat(stalePath); !os.IsNotExist(err) {
+		t.Errorf("Expected stale configMap data to be removed, got: %v", err)
+	}
+}
+
+// Test the case where the plugin's ready file exists, but the volume dir does not.
+// The dir should be created and the configMap data written to it.
+func TestPluginReboot(t *testing.T) {
+	var (
+		testPodUID     = types.UID("test_pod_uid3")
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+
+		volumeSpec    = volumeSpec(testVolumeName, testName)
+		configMap     = configMap(testNamespace, testName)
+		client        = testclient.NewSimpleFake(&configMap)
+		pluginMgr     = volume.VolumePluginMgr{}
+		rootDir, host = newTestHost(t, client)
+	)
+
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), host)
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{UID: testPodUID}}
+	builder, err := plugin.NewBuilder(volume.NewSpecFromVolume(volumeSpec), pod, volume.VolumeOptions{})
+	if err != nil {
+		t.Errorf("Failed to make a new Builder: %v", err)
+	}
+	if builder == nil {
+		t.Errorf("Got a nil Builder")
+	}
+
+	podMetadataDir := fmt.Sprintf("%v/pods/test_pod_uid3/plugins/kubernetes.io~configmap/test_volume_name", rootDir)
+	util.SetReady(podMetadataDir)
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid3/volumes/kubernetes.io~configmap/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	err = builder.SetUp()
+	if err != nil {
+		t.Errorf("Failed to setup volume: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err != nil {
+		if os.IsNotExist(err) {
+			t.Errorf("SetUp() failed, volume path not created: %s", volumePath)
+		} else {
+			t.Errorf("SetUp() failed: %v", err)
+		}
+	}
+
+	doTestConfigMapDataInVolume(volumePath, configMap, t)
+	doTestCleanAndTeardown(plugin, testPodUID, testVolumeName, volumePath, t)
+}
+
+func volumeSpec(volumeName, configMapName string) *api.Volume {
+	return &api.Volume{
+		Name: volumeName,
+		VolumeSource: api.VolumeSource{
+			ConfigMap: &api.ConfigMapVolumeSource{
+				LocalObjectReference: api.LocalObjectReference{Name: configMapName},
+			},
+		},
+	}
+}
+
+func configMap(namespace, name string) api.ConfigMap {
+	return api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Data: map[string]string{
+			"data-1": "value-1",
+			"data-2": "value-2",
+			"data-3": "value-3",
+		},
+	}
+}
+
+func doTestConfigMapDataInVolume(volumePath string, configMap api.ConfigMap, t *testing.T) {
+	for key, value := range configMap.Data {
+		configMapDataHostPath := path.Join(volumePath, key)
+		if _, err := os.Stat(configMapDataHostPath); err != nil {
+			t.Fatalf("SetUp() failed, couldn't find configMap data on disk: %v", configMapDataHostPath)
+		} else {
+			actualValue, err := ioutil.ReadFile(configMapDataHostPath)
+			if err != nil {
+				t.Fatalf("Couldn't read configMap data from: %v", configMapDataHostPath)
+			}
+
+			if value != string(actualValue) {
+				t.Errorf("Unexpected value; expected %q, got %q", value, string(actualValue))
+			}
+		}
+	}
+}
+
+func doTestCleanAndTeardown(plugin volume.VolumePlugin, podUID types.UID, testVolumeName, volumePath string, t *testing.T) {
+	cleaner, err := plugin.NewCleaner(testVolumeName, podUID)
+	if err != nil {
+		t.Errorf("Failed to make a new Cleaner: %v", err)
+	}
+	if cleaner == nil {
+		t.Errorf("Got a nil Cleaner")
+	}
+
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", volumePath)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("SetUp() failed: %v", err)
+	}
+}
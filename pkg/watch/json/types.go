@@ -30,12 +30,12 @@ import (
 // TODO: move to a public, versioned object now that RawExtension conversions are possible
 // in the schema.
 type WatchEvent struct {
-	// The type of the watch event; added, modified, deleted, or error.
-	Type watch.EventType `json:"type,omitempty" description:"the type of watch event; may be ADDED, MODIFIED, DELETED, or ERROR"`
+	// The type of the watch event; added, modified, deleted, error or bookmark.
+	Type watch.EventType `json:"type,omitempty" description:"the type of watch event; may be ADDED, MODIFIED, DELETED, ERROR or BOOKMARK"`
 
 	// For added or modified objects, this is the new object; for deleted objects,
 	// it's the state of the object immediately prior to its deletion.
-	// For errors, it's an api.Status.
+	// For errors, it's an api.Status. For bookmarks, it only carries a resourceVersion.
 	Object runtime.RawExtension `json:"object,omitempty" description:"the object being watched; will match the type of the resource endpoint or be a Status object if the type is ERROR"`
 }
 
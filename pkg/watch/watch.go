@@ -42,6 +42,7 @@ const (
 	Modified EventType = "MODIFIED"
 	Deleted  EventType = "DELETED"
 	Error    EventType = "ERROR"
+	Bookmark EventType = "BOOKMARK"
 )
 
 // Event represents a single event to a watched resource.
@@ -53,6 +54,9 @@ type Event struct {
 	//  * If Type is Deleted: the state of the object immediately before deletion.
 	//  * If Type is Error: *api.Status is recommended; other types may make sense
 	//    depending on context.
+	//  * If Type is Bookmark: an empty object of the watched type with only its
+	//    resource version set. Every change up to that resource version has
+	//    been delivered, so a new watch may resume from it.
 	Object runtime.Object
 }
 
@@ -56,6 +56,7 @@ type SchedulerServer struct {
 	Kubeconfig        string
 	BindPodsQPS       float32
 	BindPodsBurst     int
+	EnablePreemption  bool
 }
 
 // NewSchedulerServer creates a new SchedulerServer with default parameters
@@ -79,6 +80,7 @@ func (s *SchedulerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization and master location information.")
 	fs.Float32Var(&s.BindPodsQPS, "bind-pods-qps", 15.0, "Number of bindings per second scheduler is allowed to continuously make")
 	fs.IntVar(&s.BindPodsBurst, "bind-pods-burst", 20, "Number of bindings per second scheduler is allowed to make during bursts")
+	fs.BoolVar(&s.EnablePreemption, "enable-pod-preemption", s.EnablePreemption, "Preempt pods of lower priority when a pod does not fit on any node. Only enable it if the Priority admission controller is enabled, since pods may set their own priority otherwise.")
 }
 
 // Run runs the specified SchedulerServer.  This should never exit.
@@ -121,6 +123,7 @@ func (s *SchedulerServer) Run(_ []string) error {
 	}()
 
 	configFactory := factory.NewConfigFactory(kubeClient, util.NewTokenBucketRateLimiter(s.BindPodsQPS, s.BindPodsBurst))
+	configFactory.EnablePreemption = s.EnablePreemption
 	config, err := s.createConfig(configFactory)
 	if err != nil {
 		glog.Fatalf("Failed to create scheduler configuration: %v", err)
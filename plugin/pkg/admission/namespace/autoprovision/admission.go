@@ -69,7 +69,7 @@ func (p *provision) Admit(a admission.Attributes) (err error) {
 	if err != nil {
 		return admission.NewForbidden(a, err)
 	}
-	if exists {
+	if exists || a.IsDryRun() {
 		return nil
 	}
 	_, err = p.client.Namespaces().Create(namespace)
@@ -0,0 +1,174 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/kubelet"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+const (
+	// PluginName is the name under which the plugin is registered.
+	PluginName = "Priority"
+
+	priorityClassesResource = "priorityclasses"
+)
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewPriority(client), nil
+	})
+}
+
+// priority is an implementation of admission.Interface.
+// It resolves the priority of new pods from their PriorityClassName, and
+// makes sure that at most one PriorityClass is the global default.
+type priority struct {
+	*admission.Handler
+	client client.Interface
+	store  cache.Store
+}
+
+var _ = admission.Interface(&priority{})
+
+// NewPriority creates a new priority admission control handler, which caches
+// the priority classes of the cluster.
+func NewPriority(c client.Interface) admission.Interface {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return c.PriorityClasses().List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return c.PriorityClasses().Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.PriorityClass{},
+		store,
+		5*time.Minute,
+	)
+	reflector.Run()
+	return newPriority(c, store)
+}
+
+func newPriority(c client.Interface, store cache.Store) *priority {
+	return &priority{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		client:  c,
+		store:   store,
+	}
+}
+
+func (p *priority) Admit(a admission.Attributes) error {
+	if len(a.GetSubresource()) != 0 {
+		return nil
+	}
+	switch a.GetResource() {
+	case string(api.ResourcePods):
+		if a.GetOperation() == admission.Create {
+			return p.admitPod(a)
+		}
+	case priorityClassesResource:
+		return p.admitPriorityClass(a)
+	}
+	return nil
+}
+
+// admitPod sets the priority of the pod to the value of its priority class. Pods without
+// a PriorityClassName get the global default class, if there is one.
+func (p *priority) admitPod(a admission.Attributes) error {
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return nil
+	}
+	// Don't modify the spec of mirror pods, the kubelet deletes them if it doesn't match.
+	if _, isMirrorPod := pod.Annotations[kubelet.ConfigMirrorAnnotationKey]; isMirrorPod {
+		return nil
+	}
+
+	value := api.DefaultPriorityWhenNoDefaultClassExists
+	if len(pod.Spec.PriorityClassName) == 0 {
+		if defaultClass := p.getDefaultPriorityClass(); defaultClass != nil {
+			pod.Spec.PriorityClassName = defaultClass.Name
+			value = defaultClass.Value
+		}
+	} else {
+		priorityClass, err := p.getPriorityClass(pod.Spec.PriorityClassName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return admission.NewForbidden(a, fmt.Errorf("no PriorityClass with name %s was found", pod.Spec.PriorityClassName))
+			}
+			return errors.NewInternalError(err)
+		}
+		value = priorityClass.Value
+	}
+
+	if pod.Spec.Priority != nil && *pod.Spec.Priority != value {
+		return admission.NewForbidden(a, fmt.Errorf("the priority of the pod must not be set directly, it is resolved from the priorityClassName"))
+	}
+	pod.Spec.Priority = &value
+	return nil
+}
+
+// admitPriorityClass rejects a global default priority class if another one exists.
+func (p *priority) admitPriorityClass(a admission.Attributes) error {
+	priorityClass, ok := a.GetObject().(*api.PriorityClass)
+	if !ok || !priorityClass.GlobalDefault {
+		return nil
+	}
+	defaultClass := p.getDefaultPriorityClass()
+	if defaultClass != nil && defaultClass.Name != priorityClass.Name {
+		return admission.NewForbidden(a, fmt.Errorf("PriorityClass %s is already the global default", defaultClass.Name))
+	}
+	return nil
+}
+
+func (p *priority) getPriorityClass(name string) (*api.PriorityClass, error) {
+	obj, exists, err := p.store.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return obj.(*api.PriorityClass), nil
+	}
+	// in case of latency in our caches, make a call direct to storage to verify that it truly exists or not
+	return p.client.PriorityClasses().Get(name)
+}
+
+// getDefaultPriorityClass returns the global default priority class, or nil if there is none.
+func (p *priority) getDefaultPriorityClass() *api.PriorityClass {
+	for _, obj := range p.store.List() {
+		priorityClass := obj.(*api.PriorityClass)
+		if priorityClass.GlobalDefault {
+			return priorityClass
+		}
+	}
+	return nil
+}
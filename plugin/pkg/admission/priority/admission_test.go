@@ -0,0 +1,228 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/kubelet"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func newPriorityClass(name string, value int, globalDefault bool) *api.PriorityClass {
+	return &api.PriorityClass{
+		ObjectMeta:    api.ObjectMeta{Name: name},
+		Value:         value,
+		GlobalDefault: globalDefault,
+	}
+}
+
+func newHandler(classes ...*api.PriorityClass) *priority {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, class := range classes {
+		store.Add(class)
+	}
+	mockClient := &testclient.Fake{}
+	mockClient.AddReactor("get", "priorityclasses", func(action testclient.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewNotFound("PriorityClass", action.(testclient.GetAction).GetName())
+	})
+	return newPriority(mockClient, store)
+}
+
+func TestPodPriority(t *testing.T) {
+	high := newPriorityClass("high", 1000, false)
+	low := newPriorityClass("low", -10, false)
+	defaultClass := newPriorityClass("default", 100, true)
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name              string
+		classes           []*api.PriorityClass
+		priorityClassName string
+		priority          *int
+		expectedClassName string
+		expectedPriority  int
+		expectsErr        bool
+	}{
+		{
+			name:             "no priority class and no default",
+			classes:          []*api.PriorityClass{high, low},
+			expectedPriority: api.DefaultPriorityWhenNoDefaultClassExists,
+		},
+		{
+			name:              "no priority class with a default",
+			classes:           []*api.PriorityClass{high, low, defaultClass},
+			expectedClassName: "default",
+			expectedPriority:  100,
+		},
+		{
+			name:              "existing priority class",
+			classes:           []*api.PriorityClass{high, low, defaultClass},
+			priorityClassName: "high",
+			expectedClassName: "high",
+			expectedPriority:  1000,
+		},
+		{
+			name:              "negative priority",
+			classes:           []*api.PriorityClass{high, low},
+			priorityClassName: "low",
+			expectedClassName: "low",
+			expectedPriority:  -10,
+		},
+		{
+			name:              "missing priority class",
+			classes:           []*api.PriorityClass{high, low},
+			priorityClassName: "missing",
+			expectsErr:        true,
+		},
+		{
+			name:              "matching priority set directly",
+			classes:           []*api.PriorityClass{high},
+			priorityClassName: "high",
+			priority:          intPtr(1000),
+			expectedClassName: "high",
+			expectedPriority:  1000,
+		},
+		{
+			name:              "different priority set directly",
+			classes:           []*api.PriorityClass{high},
+			priorityClassName: "high",
+			priority:          intPtr(2000),
+			expectsErr:        true,
+		},
+	}
+
+	for _, test := range tests {
+		pod := &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "ns"},
+			Spec: api.PodSpec{
+				PriorityClassName: test.priorityClassName,
+				Priority:          test.priority,
+			},
+		}
+		handler := admission.NewChainHandler(newHandler(test.classes...))
+		err := handler.Admit(admission.NewAttributesRecord(pod, "Pod", "ns", "pod", string(api.ResourcePods), "", admission.Create, nil))
+		if test.expectsErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if pod.Spec.PriorityClassName != test.expectedClassName {
+			t.Errorf("%s: expected priority class name %q, got %q", test.name, test.expectedClassName, pod.Spec.PriorityClassName)
+		}
+		if pod.Spec.Priority == nil || *pod.Spec.Priority != test.expectedPriority {
+			t.Errorf("%s: expected priority %d, got %v", test.name, test.expectedPriority, pod.Spec.Priority)
+		}
+	}
+}
+
+func TestIgnoresMirrorPod(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "pod",
+			Namespace: "ns",
+			Annotations: map[string]string{
+				kubelet.ConfigMirrorAnnotationKey: "true",
+			},
+		},
+		Spec: api.PodSpec{PriorityClassName: "missing"},
+	}
+	handler := newHandler(newPriorityClass("default", 100, true))
+	err := handler.Admit(admission.NewAttributesRecord(pod, "Pod", "ns", "pod", string(api.ResourcePods), "", admission.Create, nil))
+	if err != nil {
+		t.Errorf("Expected mirror pod allowed, got err: %v", err)
+	}
+	if pod.Spec.Priority != nil {
+		t.Errorf("Expected the priority of the mirror pod to be left unset, got %d", *pod.Spec.Priority)
+	}
+}
+
+func TestIgnoresPodUpdate(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "pod", Namespace: "ns"},
+		Spec:       api.PodSpec{PriorityClassName: "missing"},
+	}
+	handler := newHandler()
+	err := handler.Admit(admission.NewAttributesRecord(pod, "Pod", "ns", "pod", string(api.ResourcePods), "", admission.Update, nil))
+	if err != nil {
+		t.Errorf("Expected pod update allowed, got err: %v", err)
+	}
+}
+
+func TestGlobalDefaultPriorityClass(t *testing.T) {
+	tests := []struct {
+		name       string
+		classes    []*api.PriorityClass
+		class      *api.PriorityClass
+		operation  admission.Operation
+		expectsErr bool
+	}{
+		{
+			name:      "first global default",
+			classes:   []*api.PriorityClass{newPriorityClass("high", 1000, false)},
+			class:     newPriorityClass("default", 100, true),
+			operation: admission.Create,
+		},
+		{
+			name:       "second global default",
+			classes:    []*api.PriorityClass{newPriorityClass("default", 100, true)},
+			class:      newPriorityClass("other", 10, true),
+			operation:  admission.Create,
+			expectsErr: true,
+		},
+		{
+			name:      "non-default class with a global default",
+			classes:   []*api.PriorityClass{newPriorityClass("default", 100, true)},
+			class:     newPriorityClass("other", 10, false),
+			operation: admission.Create,
+		},
+		{
+			name:      "update of the global default",
+			classes:   []*api.PriorityClass{newPriorityClass("default", 100, true)},
+			class:     newPriorityClass("default", 100, true),
+			operation: admission.Update,
+		},
+		{
+			name:       "update to a second global default",
+			classes:    []*api.PriorityClass{newPriorityClass("default", 100, true), newPriorityClass("other", 10, false)},
+			class:      newPriorityClass("other", 10, true),
+			operation:  admission.Update,
+			expectsErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		handler := newHandler(test.classes...)
+		err := handler.Admit(admission.NewAttributesRecord(test.class, "PriorityClass", "", test.class.Name, priorityClassesResource, "", test.operation, nil))
+		if test.expectsErr && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if !test.expectsErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -132,6 +132,11 @@ func (q *quota) Admit(a admission.Attributes) (err error) {
 				return admission.NewForbidden(a, err)
 			}
 
+			// a dry run is checked against quota without consuming it
+			if dirty && a.IsDryRun() {
+				break
+			}
+
 			if dirty {
 				// construct a usage record
 				usage := api.ResourceQuota{
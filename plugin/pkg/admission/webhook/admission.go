@@ -0,0 +1,322 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an admission controller which asks remote
+// services whether requests should be admitted.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/latest"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/yaml"
+	"k8s.io/kubernetes/plugin/pkg/webhook"
+)
+
+const (
+	// PluginName is the name under which the admission controller is registered.
+	PluginName = "AdmissionWebhook"
+
+	// AdmissionReviewKind and AdmissionReviewAPIVersion identify the objects exchanged with webhooks.
+	AdmissionReviewKind       = "AdmissionReview"
+	AdmissionReviewAPIVersion = "admission.k8s.io/v1alpha1"
+)
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewFromConfig(config)
+	})
+}
+
+// FailurePolicy specifies how a request is handled when a webhook cannot be reached
+// or returns an invalid response.
+type FailurePolicy string
+
+const (
+	// Ignore admits the request as though the webhook had not been configured.
+	Ignore FailurePolicy = "Ignore"
+	// Fail rejects the request.
+	Fail FailurePolicy = "Fail"
+)
+
+// Config is the format of the file passed with --admission-control-config-file.
+type Config struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// WebhookConfig describes a single remote admission service.
+type WebhookConfig struct {
+	// Name identifies the webhook in logs and error messages.
+	Name string `json:"name"`
+	// KubeConfigFile is a kubeconfig-format file describing how to reach the webhook.
+	KubeConfigFile string `json:"kubeConfigFile"`
+	// Rules select the requests sent to the webhook. A request is sent if any rule matches.
+	// If no rules are given, every request is sent.
+	Rules []Rule `json:"rules,omitempty"`
+	// FailurePolicy is applied when the webhook cannot be called. Defaults to Ignore.
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// Rule matches requests by operation and resource. An empty list or "*" matches everything.
+type Rule struct {
+	Operations []admission.Operation `json:"operations,omitempty"`
+	Resources  []string              `json:"resources,omitempty"`
+}
+
+// AdmissionReview is sent to the webhook, which fills in the status and returns it.
+type AdmissionReview struct {
+	Kind       string                `json:"kind"`
+	APIVersion string                `json:"apiVersion"`
+	Spec       AdmissionReviewSpec   `json:"spec"`
+	Status     AdmissionReviewStatus `json:"status"`
+}
+
+// AdmissionReviewSpec describes the request being admitted.
+type AdmissionReviewSpec struct {
+	Operation   admission.Operation `json:"operation"`
+	Kind        string              `json:"kind"`
+	Namespace   string              `json:"namespace,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	Resource    string              `json:"resource"`
+	Subresource string              `json:"subresource,omitempty"`
+	// Object is the object in its preferred external version, if the request carries one.
+	Object   json.RawMessage `json:"object,omitempty"`
+	UserInfo UserInfo        `json:"userInfo"`
+	// DryRun is true if the request will not be persisted. Webhooks must not have
+	// side effects for dry run requests.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// UserInfo identifies the user making the request.
+type UserInfo struct {
+	Username string   `json:"username,omitempty"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// AdmissionReviewStatus is the decision made by the webhook.
+type AdmissionReviewStatus struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// poster is implemented by webhook.GenericWebhook.
+type poster interface {
+	Post(request, response interface{}) error
+}
+
+type hook struct {
+	name          string
+	poster        poster
+	operations    []sets.String
+	resources     []sets.String
+	failurePolicy FailurePolicy
+}
+
+// matches returns true if any of the hook's rules select the request.
+func (h *hook) matches(a admission.Attributes) bool {
+	if len(h.operations) == 0 {
+		return true
+	}
+	for i := range h.operations {
+		if matchesAny(h.operations[i], string(a.GetOperation())) && matchesAny(h.resources[i], a.GetResource()) {
+			return true
+		}
+	}
+	return false
+}
+
+// failed applies the failure policy of the webhook to an error calling it. It returns
+// nil if the error is ignored.
+func (h *hook) failed(a admission.Attributes, err error) error {
+	if h.failurePolicy == Ignore {
+		glog.Errorf("admission webhook %q failed, ignoring: %v", h.name, err)
+		return nil
+	}
+	return admission.NewForbidden(a, fmt.Errorf("admission webhook %q failed: %v", h.name, err))
+}
+
+func matchesAny(values sets.String, value string) bool {
+	return values.Len() == 0 || values.Has("*") || values.Has(value)
+}
+
+type webhookAdmission struct {
+	*admission.Handler
+	hooks []*hook
+}
+
+// NewFromConfig creates an admission controller from the configuration in config.
+func NewFromConfig(config io.Reader) (admission.Interface, error) {
+	if config == nil {
+		return nil, fmt.Errorf("%s requires --admission-control-config-file", PluginName)
+	}
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = yaml.ToJSON(data); err != nil {
+		return nil, err
+	}
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("unable to parse %s configuration: %v", PluginName, err)
+	}
+	return newWebhookAdmission(c, func(w WebhookConfig) (poster, error) {
+		return webhook.NewGenericWebhook(w.KubeConfigFile)
+	})
+}
+
+func newWebhookAdmission(c Config, newPoster func(WebhookConfig) (poster, error)) (*webhookAdmission, error) {
+	if len(c.Webhooks) == 0 {
+		return nil, fmt.Errorf("no webhooks configured for %s", PluginName)
+	}
+	operations := sets.NewString()
+	hooks := []*hook{}
+	for _, w := range c.Webhooks {
+		if len(w.Name) == 0 {
+			return nil, fmt.Errorf("webhook name must be specified")
+		}
+		h := &hook{name: w.Name, failurePolicy: w.FailurePolicy}
+		switch h.failurePolicy {
+		case "":
+			h.failurePolicy = Ignore
+		case Ignore, Fail:
+		default:
+			return nil, fmt.Errorf("webhook %q: unknown failure policy %q", w.Name, w.FailurePolicy)
+		}
+		for _, rule := range w.Rules {
+			ops := sets.NewString()
+			for _, op := range rule.Operations {
+				ops.Insert(string(op))
+			}
+			h.operations = append(h.operations, ops)
+			h.resources = append(h.resources, sets.NewString(rule.Resources...))
+		}
+		if len(h.operations) == 0 {
+			operations.Insert("*")
+		}
+		for _, ops := range h.operations {
+			if ops.Len() == 0 {
+				operations.Insert("*")
+			}
+			operations.Insert(ops.List()...)
+		}
+		p, err := newPoster(w)
+		if err != nil {
+			return nil, fmt.Errorf("webhook %q: %v", w.Name, err)
+		}
+		h.poster = p
+		hooks = append(hooks, h)
+	}
+
+	handled := []admission.Operation{}
+	for _, op := range []admission.Operation{admission.Create, admission.Update, admission.Delete, admission.Connect} {
+		if operations.Has("*") || operations.Has(string(op)) {
+			handled = append(handled, op)
+		}
+	}
+	return &webhookAdmission{
+		Handler: admission.NewHandler(handled...),
+		hooks:   hooks,
+	}, nil
+}
+
+// Admit sends the request to every matching webhook in order and rejects it as soon as one
+// of them denies it.
+func (w *webhookAdmission) Admit(a admission.Attributes) error {
+	var review *AdmissionReview
+	for _, h := range w.hooks {
+		if !h.matches(a) {
+			continue
+		}
+		if review == nil {
+			r, err := newAdmissionReview(a)
+			if err != nil {
+				if err := h.failed(a, err); err != nil {
+					return err
+				}
+				continue
+			}
+			review = r
+		}
+		request := *review
+		response := &AdmissionReview{}
+		if err := h.poster.Post(&request, response); err != nil {
+			if err := h.failed(a, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if !response.Status.Allowed {
+			reason := response.Status.Reason
+			if len(reason) == 0 {
+				reason = "no reason given"
+			}
+			return admission.NewForbidden(a, fmt.Errorf("admission webhook %q denied the request: %s", h.name, reason))
+		}
+	}
+	return nil
+}
+
+func newAdmissionReview(a admission.Attributes) (*AdmissionReview, error) {
+	review := &AdmissionReview{
+		Kind:       AdmissionReviewKind,
+		APIVersion: AdmissionReviewAPIVersion,
+		Spec: AdmissionReviewSpec{
+			Operation:   a.GetOperation(),
+			Kind:        a.GetKind(),
+			Namespace:   a.GetNamespace(),
+			Name:        a.GetName(),
+			Resource:    a.GetResource(),
+			Subresource: a.GetSubresource(),
+			DryRun:      a.IsDryRun(),
+		},
+	}
+	if u := a.GetUserInfo(); u != nil {
+		review.Spec.UserInfo = UserInfo{
+			Username: u.GetName(),
+			UID:      u.GetUID(),
+			Groups:   u.GetGroups(),
+		}
+	}
+	// Connect requests carry options rather than an API object, so only objects of the
+	// requested resource are sent.
+	if obj := a.GetObject(); obj != nil && a.GetOperation() != admission.Connect {
+		group, err := api.RESTMapper.GroupForResource(a.GetResource())
+		if err != nil {
+			return nil, err
+		}
+		groupMeta, err := latest.Group(group)
+		if err != nil {
+			return nil, err
+		}
+		data, err := groupMeta.Codec.Encode(obj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode object for admission webhooks: %v", err)
+		}
+		review.Spec.Object = data
+	}
+	return review, nil
+}
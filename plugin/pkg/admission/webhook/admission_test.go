@@ -0,0 +1,390 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/experimental"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// fakePoster answers reviews with decide and records the requests it received.
+type fakePoster struct {
+	decide  func(spec AdmissionReviewSpec) (bool, error)
+	reviews []AdmissionReviewSpec
+}
+
+func (f *fakePoster) Post(request, response interface{}) error {
+	spec := request.(*AdmissionReview).Spec
+	f.reviews = append(f.reviews, spec)
+	allowed, err := f.decide(spec)
+	if err != nil {
+		return err
+	}
+	response.(*AdmissionReview).Status = AdmissionReviewStatus{Allowed: allowed, Reason: "decided by test"}
+	return nil
+}
+
+func allow(AdmissionReviewSpec) (bool, error) { return true, nil }
+func deny(AdmissionReviewSpec) (bool, error)  { return false, nil }
+func unreachable(AdmissionReviewSpec) (bool, error) {
+	return false, fmt.Errorf("connection refused")
+}
+
+func newTestAdmission(t *testing.T, c Config, posters map[string]*fakePoster) *webhookAdmission {
+	w, err := newWebhookAdmission(c, func(w WebhookConfig) (poster, error) {
+		return posters[w.Name], nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return w
+}
+
+func podAttributes(op admission.Operation) admission.Attributes {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "ctr", Image: "image"}},
+		},
+	}
+	return admission.NewAttributesRecord(pod, "Pod", "test", "foo", "pods", "", op, &user.DefaultInfo{Name: "jane", UID: "1", Groups: []string{"dev"}})
+}
+
+func TestNewFromConfig(t *testing.T) {
+	config := `webhooks:
+- name: policy
+  kubeConfigFile: /does/not/exist
+  failurePolicy: Fail
+`
+	if _, err := NewFromConfig(strings.NewReader(config)); err == nil || !strings.Contains(err.Error(), `webhook "policy"`) {
+		t.Errorf("expected error loading webhook kubeconfig, got %v", err)
+	}
+	if _, err := NewFromConfig(nil); err == nil {
+		t.Errorf("expected error without configuration")
+	}
+	if _, err := NewFromConfig(strings.NewReader("webhooks: [")); err == nil {
+		t.Errorf("expected error for malformed configuration")
+	}
+}
+
+func TestNewWebhookAdmissionErrors(t *testing.T) {
+	testCases := map[string]Config{
+		"no webhooks":    {},
+		"missing name":   {Webhooks: []WebhookConfig{{KubeConfigFile: "config"}}},
+		"unknown policy": {Webhooks: []WebhookConfig{{Name: "a", FailurePolicy: "Sometimes"}}},
+	}
+	for name, c := range testCases {
+		if _, err := newWebhookAdmission(c, func(WebhookConfig) (poster, error) { return &fakePoster{}, nil }); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestHandles(t *testing.T) {
+	testCases := []struct {
+		rules    []Rule
+		handles  []admission.Operation
+		declines []admission.Operation
+	}{
+		{
+			rules:   nil,
+			handles: []admission.Operation{admission.Create, admission.Update, admission.Delete, admission.Connect},
+		},
+		{
+			rules:    []Rule{{Operations: []admission.Operation{admission.Create}}, {Operations: []admission.Operation{admission.Delete}}},
+			handles:  []admission.Operation{admission.Create, admission.Delete},
+			declines: []admission.Operation{admission.Update, admission.Connect},
+		},
+		{
+			rules:   []Rule{{Operations: []admission.Operation{"*"}}},
+			handles: []admission.Operation{admission.Create, admission.Update, admission.Delete, admission.Connect},
+		},
+		{
+			rules:    []Rule{{Resources: []string{"pods"}, Operations: []admission.Operation{admission.Update}}},
+			handles:  []admission.Operation{admission.Update},
+			declines: []admission.Operation{admission.Create},
+		},
+	}
+	for i, tc := range testCases {
+		w := newTestAdmission(t, Config{Webhooks: []WebhookConfig{{Name: "a", Rules: tc.rules}}}, map[string]*fakePoster{"a": {decide: allow}})
+		for _, op := range tc.handles {
+			if !w.Handles(op) {
+				t.Errorf("%d: expected to handle %s", i, op)
+			}
+		}
+		for _, op := range tc.declines {
+			if w.Handles(op) {
+				t.Errorf("%d: expected not to handle %s", i, op)
+			}
+		}
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	testCases := map[string]struct {
+		webhooks []WebhookConfig
+		decide   map[string]func(AdmissionReviewSpec) (bool, error)
+		admitted bool
+		called   []string
+	}{
+		"allowed": {
+			webhooks: []WebhookConfig{{Name: "a"}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": allow},
+			admitted: true,
+			called:   []string{"a"},
+		},
+		"denied": {
+			webhooks: []WebhookConfig{{Name: "a"}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": deny},
+			called:   []string{"a"},
+		},
+		"first denial stops the chain": {
+			webhooks: []WebhookConfig{{Name: "a"}, {Name: "b"}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": deny, "b": allow},
+			called:   []string{"a"},
+		},
+		"every webhook must allow": {
+			webhooks: []WebhookConfig{{Name: "a"}, {Name: "b"}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": allow, "b": deny},
+			called:   []string{"a", "b"},
+		},
+		"failure ignored by default": {
+			webhooks: []WebhookConfig{{Name: "a"}, {Name: "b"}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": unreachable, "b": allow},
+			admitted: true,
+			called:   []string{"a", "b"},
+		},
+		"failure rejected": {
+			webhooks: []WebhookConfig{{Name: "a", FailurePolicy: Fail}},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": unreachable},
+			called:   []string{"a"},
+		},
+		"rules select resources": {
+			webhooks: []WebhookConfig{
+				{Name: "a", Rules: []Rule{{Resources: []string{"services"}}}},
+				{Name: "b", Rules: []Rule{{Resources: []string{"*"}, Operations: []admission.Operation{admission.Create}}}},
+			},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": deny, "b": allow},
+			admitted: true,
+			called:   []string{"b"},
+		},
+		"rules select operations": {
+			webhooks: []WebhookConfig{
+				{Name: "a", Rules: []Rule{{Operations: []admission.Operation{admission.Update, admission.Delete}}}},
+			},
+			decide:   map[string]func(AdmissionReviewSpec) (bool, error){"a": deny},
+			admitted: true,
+		},
+	}
+	for name, tc := range testCases {
+		posters := map[string]*fakePoster{}
+		for n, decide := range tc.decide {
+			posters[n] = &fakePoster{decide: decide}
+		}
+		w := newTestAdmission(t, Config{Webhooks: tc.webhooks}, posters)
+		err := w.Admit(podAttributes(admission.Create))
+		if tc.admitted && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tc.admitted && !errors.IsForbidden(err) {
+			t.Errorf("%s: expected forbidden error, got %v", name, err)
+		}
+		for n, p := range posters {
+			expected := false
+			for _, c := range tc.called {
+				expected = expected || c == n
+			}
+			if called := len(p.reviews) != 0; called != expected {
+				t.Errorf("%s: expected webhook %s called=%t, got %t", name, n, expected, called)
+			}
+		}
+	}
+}
+
+func TestAdmitUnencodableObject(t *testing.T) {
+	// Objects of unknown resources cannot be sent to webhooks.
+	attributes := admission.NewAttributesRecord(&api.Pod{}, "Widget", "test", "foo", "widgets", "", admission.Create, &user.DefaultInfo{Name: "jane"})
+	for _, policy := range []FailurePolicy{Ignore, Fail} {
+		posters := map[string]*fakePoster{"a": {decide: allow}}
+		w := newTestAdmission(t, Config{Webhooks: []WebhookConfig{{Name: "a", FailurePolicy: policy}}}, posters)
+		err := w.Admit(attributes)
+		if policy == Ignore && err != nil {
+			t.Errorf("%s: unexpected error: %v", policy, err)
+		}
+		if policy == Fail && !errors.IsForbidden(err) {
+			t.Errorf("%s: expected forbidden error, got %v", policy, err)
+		}
+		if len(posters["a"].reviews) != 0 {
+			t.Errorf("%s: expected the webhook not to be called, got %v", policy, posters["a"].reviews)
+		}
+	}
+}
+
+func TestAdmissionReviewSpec(t *testing.T) {
+	p := &fakePoster{decide: allow}
+	w := newTestAdmission(t, Config{Webhooks: []WebhookConfig{{Name: "a"}}}, map[string]*fakePoster{"a": p})
+
+	if err := w.Admit(podAttributes(admission.Create)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	spec := p.reviews[0]
+	if spec.Operation != admission.Create || spec.Kind != "Pod" || spec.Namespace != "test" || spec.Name != "foo" || spec.Resource != "pods" {
+		t.Errorf("unexpected request attributes: %#v", spec)
+	}
+	if spec.UserInfo.Username != "jane" || spec.UserInfo.UID != "1" || len(spec.UserInfo.Groups) != 1 || spec.UserInfo.Groups[0] != "dev" {
+		t.Errorf("unexpected user: %#v", spec.UserInfo)
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(spec.Object, &obj); err != nil {
+		t.Fatalf("unexpected error decoding object: %v", err)
+	}
+	if obj["kind"] != "Pod" || obj["apiVersion"] != "v1" {
+		t.Errorf("expected a v1 Pod, got %s", string(spec.Object))
+	}
+
+	job := &experimental.Job{ObjectMeta: api.ObjectMeta{Name: "job", Namespace: "test"}}
+	attrs := admission.NewAttributesRecord(job, "Job", "test", "job", "jobs", "", admission.Create, nil)
+	if err := w.Admit(attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj = map[string]interface{}{}
+	if err := json.Unmarshal(p.reviews[1].Object, &obj); err != nil {
+		t.Fatalf("unexpected error decoding object: %v", err)
+	}
+	if obj["kind"] != "Job" || !strings.HasPrefix(obj["apiVersion"].(string), "experimental/") {
+		t.Errorf("expected an experimental Job, got %s", string(p.reviews[1].Object))
+	}
+
+	if err := w.Admit(admission.NewAttributesRecord(nil, "Pod", "test", "foo", "pods", "", admission.Delete, nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.reviews[2].Object) != 0 {
+		t.Errorf("expected no object on delete, got %s", string(p.reviews[2].Object))
+	}
+}
+
+// mockService is a remote admission service which rejects pods using the "latest" tag.
+type mockService struct {
+	lock    sync.Mutex
+	reviews int
+}
+
+func (m *mockService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if req.TLS != nil && req.Header.Get("Authorization") != "Bearer apiserver-token" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	review := AdmissionReview{}
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Kind != AdmissionReviewKind || review.APIVersion != AdmissionReviewAPIVersion {
+		http.Error(w, fmt.Sprintf("unexpected %s/%s", review.APIVersion, review.Kind), http.StatusBadRequest)
+		return
+	}
+	m.reviews++
+	review.Status.Allowed = !strings.Contains(string(review.Spec.Object), `"image":"image:latest"`)
+	if !review.Status.Allowed {
+		review.Status.Reason = "images must be pinned"
+	}
+	json.NewEncoder(w).Encode(review)
+}
+
+func writeConfig(t *testing.T, server *httptest.Server) (string, func()) {
+	kubeConfig, err := ioutil.TempFile("", "webhook_kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fmt.Fprintf(kubeConfig, `apiVersion: v1
+kind: Config
+clusters:
+- name: admission
+  cluster:
+    server: %s/admit
+    insecure-skip-tls-verify: %t
+users:
+- name: apiserver
+  user:
+    token: apiserver-token
+contexts:
+- name: webhook
+  context:
+    cluster: admission
+    user: apiserver
+current-context: webhook
+`, server.URL, server.TLS != nil)
+	kubeConfig.Close()
+	config := fmt.Sprintf(`webhooks:
+- name: image-policy
+  kubeConfigFile: %s
+  rules:
+  - operations: ["CREATE", "UPDATE"]
+    resources: ["pods"]
+  failurePolicy: Fail
+`, kubeConfig.Name())
+	return config, func() { os.Remove(kubeConfig.Name()) }
+}
+
+func TestWebhookService(t *testing.T) {
+	for _, newServer := range []func(http.Handler) *httptest.Server{httptest.NewServer, httptest.NewTLSServer} {
+		service := &mockService{}
+		server := newServer(service)
+		config, cleanup := writeConfig(t, server)
+
+		w, err := NewFromConfig(strings.NewReader(config))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if w.Handles(admission.Delete) {
+			t.Errorf("expected delete not to be handled")
+		}
+		attrs := podAttributes(admission.Create)
+		if err := w.Admit(attrs); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		attrs.GetObject().(*api.Pod).Spec.Containers[0].Image = "image:latest"
+		if err := w.Admit(attrs); !errors.IsForbidden(err) || !strings.Contains(err.Error(), "images must be pinned") {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+		if service.reviews != 2 {
+			t.Errorf("expected 2 reviews, got %d", service.reviews)
+		}
+
+		// With the service gone the Fail policy rejects requests.
+		server.Close()
+		if err := w.Admit(podAttributes(admission.Create)); !errors.IsForbidden(err) {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+		cleanup()
+	}
+}
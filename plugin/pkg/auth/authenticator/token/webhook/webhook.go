@@ -0,0 +1,126 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an authenticator.Token which asks a remote service
+// whether a bearer token is valid and which user it belongs to.
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/cache"
+	"k8s.io/kubernetes/plugin/pkg/webhook"
+)
+
+const (
+	// TokenReviewKind and TokenReviewAPIVersion identify the objects exchanged with the remote service.
+	TokenReviewKind       = "TokenReview"
+	TokenReviewAPIVersion = "authentication.k8s.io/v1beta1"
+
+	// maxCacheEntries bounds the number of tokens whose review is remembered.
+	maxCacheEntries = 4096
+)
+
+// TokenReview is sent to the remote service with Spec filled in and returned with Status filled in.
+type TokenReview struct {
+	Kind       string            `json:"kind"`
+	APIVersion string            `json:"apiVersion"`
+	Spec       TokenReviewSpec   `json:"spec"`
+	Status     TokenReviewStatus `json:"status"`
+}
+
+// TokenReviewSpec holds the token being reviewed.
+type TokenReviewSpec struct {
+	// Token is the opaque bearer token presented to the apiserver.
+	Token string `json:"token,omitempty"`
+}
+
+// TokenReviewStatus is the remote service's decision.
+type TokenReviewStatus struct {
+	// Authenticated is true if the token is valid.
+	Authenticated bool `json:"authenticated"`
+	// User describes the owner of the token, if it is valid.
+	User UserInfo `json:"user,omitempty"`
+}
+
+// UserInfo describes the user a token belongs to.
+type UserInfo struct {
+	Username string   `json:"username,omitempty"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// WebhookTokenAuthenticator delegates token authentication to a remote service, caching its decisions.
+type WebhookTokenAuthenticator struct {
+	*webhook.GenericWebhook
+	responseCache *cache.LRUExpireCache
+	ttl           time.Duration
+}
+
+// New creates a token authenticator which sends TokenReviews to the service described by the
+// kubeconfig-format file at kubeConfigFile, and remembers each decision for ttl.
+func New(kubeConfigFile string, ttl time.Duration) (*WebhookTokenAuthenticator, error) {
+	gw, err := webhook.NewGenericWebhook(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return newWithWebhook(gw, ttl), nil
+}
+
+func newWithWebhook(gw *webhook.GenericWebhook, ttl time.Duration) *WebhookTokenAuthenticator {
+	return &WebhookTokenAuthenticator{gw, cache.NewLRUExpireCache(maxCacheEntries), ttl}
+}
+
+// AuthenticateToken implements authenticator.Token. Failures to reach the remote
+// service are returned as errors and are not cached.
+func (w *WebhookTokenAuthenticator) AuthenticateToken(token string) (user.Info, bool, error) {
+	var status TokenReviewStatus
+	// Tokens are cached by their hash so that live credentials are not kept in memory.
+	key := tokenHash(token)
+	if entry, ok := w.responseCache.Get(key); ok {
+		status = entry.(TokenReviewStatus)
+	} else {
+		review := &TokenReview{
+			Kind:       TokenReviewKind,
+			APIVersion: TokenReviewAPIVersion,
+			Spec:       TokenReviewSpec{Token: token},
+		}
+		result := &TokenReview{}
+		if err := w.Post(review, result); err != nil {
+			return nil, false, err
+		}
+		status = result.Status
+		w.responseCache.Add(key, status, w.ttl)
+	}
+
+	if !status.Authenticated {
+		return nil, false, nil
+	}
+	return &user.DefaultInfo{
+		Name:   status.User.Username,
+		UID:    status.User.UID,
+		Groups: status.User.Groups,
+	}, true, nil
+}
+
+// tokenHash returns the hex encoded SHA-256 hash of token.
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// mockService is a remote token review service which knows a fixed set of tokens.
+type mockService struct {
+	lock     sync.Mutex
+	tokens   map[string]UserInfo
+	reviews  int
+	failWith int
+}
+
+func (m *mockService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.reviews++
+	if m.failWith != 0 {
+		http.Error(w, "internal error", m.failWith)
+		return
+	}
+	if req.TLS != nil && req.Header.Get("Authorization") != "Bearer apiserver-token" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	review := TokenReview{}
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.URL.Path != "/authenticate" {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if review.Kind != TokenReviewKind || review.APIVersion != TokenReviewAPIVersion {
+		http.Error(w, fmt.Sprintf("unexpected %s/%s", review.APIVersion, review.Kind), http.StatusBadRequest)
+		return
+	}
+	if info, ok := m.tokens[review.Spec.Token]; ok {
+		review.Status = TokenReviewStatus{Authenticated: true, User: info}
+	}
+	json.NewEncoder(w).Encode(review)
+}
+
+func (m *mockService) reviewCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.reviews
+}
+
+// writeKubeConfig writes a kubeconfig file which points at server and authenticates with a bearer token.
+func writeKubeConfig(t *testing.T, server string, insecure bool) string {
+	f, err := ioutil.TempFile("", "webhook_kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, `apiVersion: v1
+kind: Config
+clusters:
+- name: authn
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: %t
+users:
+- name: apiserver
+  user:
+    token: apiserver-token
+contexts:
+- name: webhook
+  context:
+    cluster: authn
+    user: apiserver
+current-context: webhook
+`, server+"/authenticate", insecure)
+	return f.Name()
+}
+
+func newTestAuthenticator(t *testing.T, server *httptest.Server, ttl time.Duration) *WebhookTokenAuthenticator {
+	path := writeKubeConfig(t, server.URL, server.TLS != nil)
+	defer os.Remove(path)
+	authn, err := New(path, ttl)
+	if err != nil {
+		t.Fatalf("unexpected error creating authenticator: %v", err)
+	}
+	return authn
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	service := &mockService{tokens: map[string]UserInfo{
+		"alice-token": {Username: "alice", UID: "1", Groups: []string{"admins"}},
+	}}
+	for _, server := range []*httptest.Server{httptest.NewServer(service), httptest.NewTLSServer(service)} {
+		authn := newTestAuthenticator(t, server, time.Minute)
+
+		info, ok, err := authn.AuthenticateToken("alice-token")
+		if err != nil || !ok {
+			t.Errorf("expected alice-token to be authenticated, got %v %v", ok, err)
+		}
+		expected := &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"admins"}}
+		if !reflect.DeepEqual(expected, info) {
+			t.Errorf("expected %#v, got %#v", expected, info)
+		}
+
+		info, ok, err = authn.AuthenticateToken("bogus-token")
+		if err != nil || ok || info != nil {
+			t.Errorf("expected bogus-token to be rejected, got %v %v %v", info, ok, err)
+		}
+		server.Close()
+	}
+}
+
+func TestAuthenticateTokenCache(t *testing.T) {
+	service := &mockService{tokens: map[string]UserInfo{"alice-token": {Username: "alice"}}}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	authn := newTestAuthenticator(t, server, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, ok, err := authn.AuthenticateToken("alice-token"); err != nil || !ok {
+			t.Fatalf("expected alice-token to be authenticated, got %v %v", ok, err)
+		}
+		if _, ok, err := authn.AuthenticateToken("bogus-token"); err != nil || ok {
+			t.Fatalf("expected bogus-token to be rejected, got %v %v", ok, err)
+		}
+	}
+	if count := service.reviewCount(); count != 2 {
+		t.Errorf("expected one review per token, got %d reviews", count)
+	}
+	if _, ok := authn.responseCache.Get("alice-token"); ok {
+		t.Errorf("expected the token not to be used as a cache key")
+	}
+}
+
+func TestAuthenticateTokenServiceError(t *testing.T) {
+	service := &mockService{failWith: http.StatusInternalServerError}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	authn := newTestAuthenticator(t, server, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if _, ok, err := authn.AuthenticateToken("alice-token"); err == nil || ok {
+			t.Errorf("expected an error when the service fails, got %v %v", ok, err)
+		}
+	}
+	if count := service.reviewCount(); count != 2 {
+		t.Errorf("expected failures not to be cached, got %d reviews", count)
+	}
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	if _, err := New("/does/not/exist", time.Minute); err == nil {
+		t.Errorf("expected an error for a missing config file")
+	}
+	path := writeKubeConfig(t, "localhost:8080", false)
+	defer os.Remove(path)
+	if _, err := New(path, time.Minute); err == nil {
+		t.Errorf("expected an error for a server without a scheme")
+	}
+}
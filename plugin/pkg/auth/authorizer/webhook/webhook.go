@@ -0,0 +1,167 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements an authorizer.Authorizer which asks a remote
+// service whether a request should be allowed.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/util/cache"
+	"k8s.io/kubernetes/plugin/pkg/webhook"
+)
+
+const (
+	// SubjectAccessReviewKind and SubjectAccessReviewAPIVersion identify the objects exchanged with the remote service.
+	SubjectAccessReviewKind       = "SubjectAccessReview"
+	SubjectAccessReviewAPIVersion = "authorization.k8s.io/v1beta1"
+
+	// maxCacheEntries bounds the number of distinct requests whose review is remembered.
+	maxCacheEntries = 8192
+)
+
+// SubjectAccessReview is sent to the remote service with Spec filled in and returned with Status filled in.
+type SubjectAccessReview struct {
+	Kind       string                    `json:"kind"`
+	APIVersion string                    `json:"apiVersion"`
+	Spec       SubjectAccessReviewSpec   `json:"spec"`
+	Status     SubjectAccessReviewStatus `json:"status"`
+}
+
+// SubjectAccessReviewSpec describes the request being authorized. Exactly one of
+// ResourceAttributes and NonResourceAttributes is set.
+type SubjectAccessReviewSpec struct {
+	ResourceAttributes    *ResourceAttributes    `json:"resourceAttributes,omitempty"`
+	NonResourceAttributes *NonResourceAttributes `json:"nonResourceAttributes,omitempty"`
+	// User is the user the request was authenticated as.
+	User string `json:"user,omitempty"`
+	// Groups are the groups of that user.
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ResourceAttributes describes a request for a REST object.
+type ResourceAttributes struct {
+	Namespace   string `json:"namespace,omitempty"`
+	Verb        string `json:"verb,omitempty"`
+	Group       string `json:"group,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// NonResourceAttributes describes a request to any other endpoint, such as /version.
+type NonResourceAttributes struct {
+	Path string `json:"path,omitempty"`
+	Verb string `json:"verb,omitempty"`
+}
+
+// SubjectAccessReviewStatus is the remote service's decision.
+type SubjectAccessReviewStatus struct {
+	// Allowed is true if the request should be allowed.
+	Allowed bool `json:"allowed"`
+	// Reason optionally explains the decision.
+	Reason string `json:"reason,omitempty"`
+}
+
+// WebhookAuthorizer delegates authorization decisions to a remote service, caching them.
+type WebhookAuthorizer struct {
+	*webhook.GenericWebhook
+	responseCache   *cache.LRUExpireCache
+	authorizedTTL   time.Duration
+	unauthorizedTTL time.Duration
+}
+
+// New creates an authorizer which sends SubjectAccessReviews to the service described by the
+// kubeconfig-format file at kubeConfigFile. Decisions to allow a request are remembered for
+// authorizedTTL and decisions to deny one for unauthorizedTTL.
+func New(kubeConfigFile string, authorizedTTL, unauthorizedTTL time.Duration) (*WebhookAuthorizer, error) {
+	gw, err := webhook.NewGenericWebhook(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return newWithWebhook(gw, authorizedTTL, unauthorizedTTL), nil
+}
+
+func newWithWebhook(gw *webhook.GenericWebhook, authorizedTTL, unauthorizedTTL time.Duration) *WebhookAuthorizer {
+	return &WebhookAuthorizer{
+		GenericWebhook:  gw,
+		responseCache:   cache.NewLRUExpireCache(maxCacheEntries),
+		authorizedTTL:   authorizedTTL,
+		unauthorizedTTL: unauthorizedTTL,
+	}
+}
+
+// Authorize implements authorizer.Authorizer. Failures to reach the remote
+// service deny the request and are not cached.
+func (w *WebhookAuthorizer) Authorize(attr authorizer.Attributes) error {
+	spec := SubjectAccessReviewSpec{
+		User:   attr.GetUserName(),
+		Groups: attr.GetGroups(),
+	}
+	if attr.IsResourceRequest() {
+		spec.ResourceAttributes = &ResourceAttributes{
+			Namespace:   attr.GetNamespace(),
+			Verb:        attr.GetVerb(),
+			Group:       attr.GetAPIGroup(),
+			Resource:    attr.GetResource(),
+			Subresource: attr.GetSubresource(),
+			Name:        attr.GetName(),
+		}
+	} else {
+		spec.NonResourceAttributes = &NonResourceAttributes{
+			Path: attr.GetPath(),
+			Verb: attr.GetVerb(),
+		}
+	}
+	key, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	var status SubjectAccessReviewStatus
+	if entry, ok := w.responseCache.Get(string(key)); ok {
+		status = entry.(SubjectAccessReviewStatus)
+	} else {
+		review := &SubjectAccessReview{
+			Kind:       SubjectAccessReviewKind,
+			APIVersion: SubjectAccessReviewAPIVersion,
+			Spec:       spec,
+		}
+		result := &SubjectAccessReview{}
+		if err := w.Post(review, result); err != nil {
+			return err
+		}
+		status = result.Status
+		if status.Allowed {
+			w.responseCache.Add(string(key), status, w.authorizedTTL)
+		} else {
+			w.responseCache.Add(string(key), status, w.unauthorizedTTL)
+		}
+	}
+
+	if status.Allowed {
+		return nil
+	}
+	if len(status.Reason) > 0 {
+		return fmt.Errorf("webhook authorizer denied the request: %s", status.Reason)
+	}
+	return errors.New("webhook authorizer denied the request")
+}
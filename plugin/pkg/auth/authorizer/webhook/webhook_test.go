@@ -0,0 +1,245 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// mockService is a remote authorization service which allows requests according to allow
+// and records the specs it was asked about.
+type mockService struct {
+	lock     sync.Mutex
+	allow    func(spec SubjectAccessReviewSpec) bool
+	reviews  []SubjectAccessReviewSpec
+	failWith int
+}
+
+func (m *mockService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.failWith != 0 {
+		http.Error(w, "internal error", m.failWith)
+		return
+	}
+	if req.TLS != nil && req.Header.Get("Authorization") != "Bearer apiserver-token" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	review := SubjectAccessReview{}
+	if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Kind != SubjectAccessReviewKind || review.APIVersion != SubjectAccessReviewAPIVersion {
+		http.Error(w, fmt.Sprintf("unexpected %s/%s", review.APIVersion, review.Kind), http.StatusBadRequest)
+		return
+	}
+	m.reviews = append(m.reviews, review.Spec)
+	review.Status.Allowed = m.allow(review.Spec)
+	if !review.Status.Allowed {
+		review.Status.Reason = "not on the list"
+	}
+	json.NewEncoder(w).Encode(review)
+}
+
+func (m *mockService) reviewCount() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.reviews)
+}
+
+func newTestAuthorizer(t *testing.T, server *httptest.Server, authorizedTTL, unauthorizedTTL time.Duration) *WebhookAuthorizer {
+	f, err := ioutil.TempFile("", "webhook_kubeconfig")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(f.Name())
+	fmt.Fprintf(f, `apiVersion: v1
+kind: Config
+clusters:
+- name: authz
+  cluster:
+    server: %s/authorize
+    insecure-skip-tls-verify: %t
+users:
+- name: apiserver
+  user:
+    token: apiserver-token
+contexts:
+- name: webhook
+  context:
+    cluster: authz
+    user: apiserver
+current-context: webhook
+`, server.URL, server.TLS != nil)
+	f.Close()
+
+	authz, err := New(f.Name(), authorizedTTL, unauthorizedTTL)
+	if err != nil {
+		t.Fatalf("unexpected error creating authorizer: %v", err)
+	}
+	return authz
+}
+
+func allowAlice(spec SubjectAccessReviewSpec) bool {
+	return spec.User == "alice"
+}
+
+func TestAuthorize(t *testing.T) {
+	service := &mockService{allow: allowAlice}
+	for _, server := range []*httptest.Server{httptest.NewServer(service), httptest.NewTLSServer(service)} {
+		authz := newTestAuthorizer(t, server, time.Minute, time.Minute)
+
+		alice := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+		if err := authz.Authorize(alice); err != nil {
+			t.Errorf("expected alice to be allowed, got %v", err)
+		}
+		bob := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+		if err := authz.Authorize(bob); err == nil {
+			t.Errorf("expected bob to be denied")
+		}
+		server.Close()
+	}
+}
+
+func TestAuthorizeSendsAttributes(t *testing.T) {
+	service := &mockService{allow: allowAlice}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	authz := newTestAuthorizer(t, server, time.Minute, time.Minute)
+
+	alice := &user.DefaultInfo{Name: "alice", Groups: []string{"admins"}}
+	testCases := []struct {
+		attr     authorizer.AttributesRecord
+		expected SubjectAccessReviewSpec
+	}{
+		{
+			attr: authorizer.AttributesRecord{
+				User:            alice,
+				Verb:            "create",
+				Namespace:       "ns1",
+				APIGroup:        "experimental",
+				Resource:        "jobs",
+				Subresource:     "status",
+				Name:            "foo",
+				ResourceRequest: true,
+				Path:            "/apis/experimental/v1alpha1/namespaces/ns1/jobs/foo/status",
+			},
+			expected: SubjectAccessReviewSpec{
+				User:   "alice",
+				Groups: []string{"admins"},
+				ResourceAttributes: &ResourceAttributes{
+					Namespace:   "ns1",
+					Verb:        "create",
+					Group:       "experimental",
+					Resource:    "jobs",
+					Subresource: "status",
+					Name:        "foo",
+				},
+			},
+		},
+		{
+			attr: authorizer.AttributesRecord{
+				User: alice,
+				Verb: "get",
+				Path: "/version",
+			},
+			expected: SubjectAccessReviewSpec{
+				User:                  "alice",
+				Groups:                []string{"admins"},
+				NonResourceAttributes: &NonResourceAttributes{Path: "/version", Verb: "get"},
+			},
+		},
+	}
+	for i, tc := range testCases {
+		if err := authz.Authorize(tc.attr); err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+		if !reflect.DeepEqual(tc.expected, service.reviews[i]) {
+			t.Errorf("%d: expected %#v, got %#v", i, tc.expected, service.reviews[i])
+		}
+	}
+}
+
+func TestAuthorizeCache(t *testing.T) {
+	service := &mockService{allow: allowAlice}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	authz := newTestAuthorizer(t, server, time.Hour, time.Hour)
+
+	alice := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+	bob := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+	aliceDelete := alice
+	aliceDelete.Verb = "delete"
+	for i := 0; i < 3; i++ {
+		if err := authz.Authorize(alice); err != nil {
+			t.Fatalf("expected alice to be allowed, got %v", err)
+		}
+		if err := authz.Authorize(bob); err == nil {
+			t.Fatalf("expected bob to be denied")
+		}
+		if err := authz.Authorize(aliceDelete); err != nil {
+			t.Fatalf("expected alice to be allowed, got %v", err)
+		}
+	}
+	if count := service.reviewCount(); count != 3 {
+		t.Errorf("expected one review per distinct request, got %d reviews", count)
+	}
+}
+
+func TestAuthorizeUnauthorizedTTL(t *testing.T) {
+	service := &mockService{allow: allowAlice}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	// Denials are not remembered, so every denied request is reviewed again.
+	authz := newTestAuthorizer(t, server, time.Hour, -time.Second)
+
+	bob := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "bob"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+	for i := 0; i < 2; i++ {
+		if err := authz.Authorize(bob); err == nil {
+			t.Fatalf("expected bob to be denied")
+		}
+	}
+	if count := service.reviewCount(); count != 2 {
+		t.Errorf("expected denials to expire, got %d reviews", count)
+	}
+}
+
+func TestAuthorizeServiceError(t *testing.T) {
+	service := &mockService{failWith: http.StatusInternalServerError}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	authz := newTestAuthorizer(t, server, time.Hour, time.Hour)
+
+	attr := authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get", ResourceRequest: true, Resource: "pods"}
+	if err := authz.Authorize(attr); err == nil {
+		t.Errorf("expected the request to be denied when the service fails")
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// ListScheduledPods returns the pods of the lister which are bound to a node and have not terminated,
+// and a map from the names of their nodes to the nodes. Pods whose node is not found, e.g. because
+// it was deleted, are skipped.
+func ListScheduledPods(podLister algorithm.PodLister, info NodeInfo) ([]*api.Pod, map[string]*api.Node, error) {
+	pods, err := podLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	result := []*api.Pod{}
+	nodes := map[string]*api.Node{}
+	missing := sets.String{}
+	for _, pod := range filterNonRunningPods(pods) {
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" || missing.Has(nodeName) {
+			continue
+		}
+		if _, found := nodes[nodeName]; !found {
+			node, err := info.GetNodeInfo(nodeName)
+			if err != nil {
+				glog.V(4).Infof("Ignoring the pods on node %s for inter-pod affinity: %v", nodeName, err)
+				missing.Insert(nodeName)
+				continue
+			}
+			nodes[nodeName] = node
+		}
+		result = append(result, pod)
+	}
+	return result, nodes, nil
+}
+
+// PodMatchesPodAffinityTerm checks whether candidate is selected by a pod affinity term of owner.
+// The term selects pods in its namespaces, or in the namespace of owner if it lists none.
+func PodMatchesPodAffinityTerm(owner, candidate *api.Pod, term api.PodAffinityTerm) (bool, error) {
+	namespaces := sets.NewString(term.Namespaces...)
+	if namespaces.Len() == 0 {
+		namespaces.Insert(owner.Namespace)
+	}
+	if !namespaces.Has(candidate.Namespace) {
+		return false, nil
+	}
+	selector, err := api.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(candidate.Labels)), nil
+}
+
+// NodesHaveSameTopologyKey checks whether both nodes have the topologyKey label with the same value.
+func NodesHaveSameTopologyKey(nodeA, nodeB *api.Node, topologyKey string) bool {
+	if topologyKey == "" {
+		return false
+	}
+	valueA, ok := nodeA.Labels[topologyKey]
+	if !ok {
+		return false
+	}
+	valueB, ok := nodeB.Labels[topologyKey]
+	return ok && valueA == valueB
+}
+
+// AnyPodMatchesPodAffinityTerm checks whether any of pods is selected by a pod affinity term of owner.
+// It returns whether such a pod runs in the same topology domain as node, and whether such a pod
+// runs anywhere at all. podNodes maps the names of the nodes of pods to the nodes.
+func AnyPodMatchesPodAffinityTerm(owner *api.Pod, pods []*api.Pod, podNodes map[string]*api.Node, node *api.Node, term api.PodAffinityTerm) (inTopology bool, anywhere bool, err error) {
+	for _, pod := range pods {
+		match, err := PodMatchesPodAffinityTerm(owner, pod, term)
+		if err != nil {
+			return false, false, err
+		}
+		if !match {
+			continue
+		}
+		anywhere = true
+		if NodesHaveSameTopologyKey(node, podNodes[pod.Spec.NodeName], term.TopologyKey) {
+			return true, true, nil
+		}
+	}
+	return false, anywhere, nil
+}
+
+type PodAffinityChecker struct {
+	info      NodeInfo
+	podLister algorithm.PodLister
+
+	lock sync.Mutex
+	// cycle holds the scheduled pods and their nodes for the pod the predicate was last called for
+	cycle *podAffinityCycle
+}
+
+// podAffinityCycle holds what InterPodAffinityMatches needs for every node it checks a pod against.
+type podAffinityCycle struct {
+	pod      *api.Pod
+	pods     []*api.Pod
+	podNodes map[string]*api.Node
+}
+
+func NewPodAffinityPredicate(info NodeInfo, podLister algorithm.PodLister) algorithm.FitPredicate {
+	checker := &PodAffinityChecker{
+		info:      info,
+		podLister: podLister,
+	}
+	return checker.InterPodAffinityMatches
+}
+
+// scheduledPods returns the scheduled pods and their nodes. They are listed once when the
+// predicate is called for a new pod, and reused while that pod is checked against every node
+// in the same scheduling cycle. A pod which is scheduled again is a new object, so it never
+// sees the pods of an earlier cycle.
+func (c *PodAffinityChecker) scheduledPods(pod *api.Pod) ([]*api.Pod, map[string]*api.Node, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.cycle != nil && c.cycle.pod == pod {
+		return c.cycle.pods, c.cycle.podNodes, nil
+	}
+	pods, podNodes, err := ListScheduledPods(c.podLister, c.info)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.cycle = &podAffinityCycle{pod: pod, pods: pods, podNodes: podNodes}
+	return pods, podNodes, nil
+}
+
+// InterPodAffinityMatches checks that scheduling the pod onto the node satisfies the required pod
+// affinity and anti-affinity terms of the pod, and the required anti-affinity terms of the pods
+// already scheduled.
+//
+// A required affinity term which selects no pod at all is satisfied if it selects the pod itself,
+// so that the first of a group of pods with affinity for each other can be scheduled.
+func (c *PodAffinityChecker) InterPodAffinityMatches(pod *api.Pod, existingPods []*api.Pod, nodeID string) (bool, error) {
+	node, err := c.info.GetNodeInfo(nodeID)
+	if err != nil {
+		return false, err
+	}
+	allPods, podNodes, err := c.scheduledPods(pod)
+	if err != nil {
+		return false, err
+	}
+
+	if affinity := pod.Spec.Affinity; affinity != nil {
+		if affinity.PodAffinity != nil {
+			for _, term := range affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				inTopology, anywhere, err := AnyPodMatchesPodAffinityTerm(pod, allPods, podNodes, node, term)
+				if err != nil {
+					return false, err
+				}
+				if inTopology {
+					continue
+				}
+				if anywhere {
+					return false, nil
+				}
+				if self, err := PodMatchesPodAffinityTerm(pod, pod, term); err != nil || !self {
+					return false, err
+				}
+			}
+		}
+		if affinity.PodAntiAffinity != nil {
+			for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				inTopology, _, err := AnyPodMatchesPodAffinityTerm(pod, allPods, podNodes, node, term)
+				if err != nil || inTopology {
+					return false, err
+				}
+			}
+		}
+	}
+
+	// the anti-affinity of the pods already scheduled must hold as well
+	for _, existingPod := range allPods {
+		affinity := existingPod.Spec.Affinity
+		if affinity == nil || affinity.PodAntiAffinity == nil {
+			continue
+		}
+		for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			match, err := PodMatchesPodAffinityTerm(existingPod, pod, term)
+			if err != nil {
+				return false, err
+			}
+			if match && NodesHaveSameTopologyKey(node, podNodes[existingPod.Spec.NodeName], term.TopologyKey) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func podAffinityTerm(key, value, topologyKey string) api.PodAffinityTerm {
+	return api.PodAffinityTerm{
+		LabelSelector: &api.LabelSelector{
+			MatchExpressions: []api.LabelSelectorRequirement{
+				{Key: key, Operator: api.LabelSelectorOpIn, Values: []string{value}},
+			},
+		},
+		TopologyKey: topologyKey,
+	}
+}
+
+func TestInterPodAffinityMatches(t *testing.T) {
+	labels1 := map[string]string{"kubernetes.io/hostname": "machine1", "zone": "z1"}
+	labels2 := map[string]string{"kubernetes.io/hostname": "machine2", "zone": "z1"}
+	labels3 := map[string]string{"kubernetes.io/hostname": "machine3", "zone": "z2"}
+	node1 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: labels1}}
+	node2 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: labels2}}
+	node3 := api.Node{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: labels3}}
+
+	db := map[string]string{"app": "db"}
+	web := map[string]string{"app": "web"}
+	dbOnMachine1 := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: db}, Spec: api.PodSpec{NodeName: "machine1"}}
+	dbFinished := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: db},
+		Spec:       api.PodSpec{NodeName: "machine1"},
+		Status:     api.PodStatus{Phase: api.PodSucceeded},
+	}
+	dbOtherNamespace := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "other", Labels: db}, Spec: api.PodSpec{NodeName: "machine1"}}
+	dbOnDeletedNode := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: db}, Spec: api.PodSpec{NodeName: "deleted"}}
+
+	withAffinity := func(podLabels map[string]string, affinity *api.Affinity) *api.Pod {
+		return &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: podLabels}, Spec: api.PodSpec{Affinity: affinity}}
+	}
+	requiredAffinity := func(terms ...api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAffinity: &api.PodAffinity{RequiredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	requiredAntiAffinity := func(terms ...api.PodAffinityTerm) *api.Affinity {
+		return &api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	webAvoidingDB := withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "kubernetes.io/hostname")))
+	webAvoidingDB.Spec.NodeName = "machine2"
+
+	tests := []struct {
+		pod  *api.Pod
+		pods []*api.Pod
+		node string
+		fits bool
+		test string
+	}{
+		{
+			pod:  &api.Pod{},
+			node: "machine1",
+			fits: true,
+			test: "nothing scheduled, no affinity",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "kubernetes.io/hostname"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine1",
+			fits: true,
+			test: "affinity satisfied on the same node",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "kubernetes.io/hostname"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine2",
+			fits: false,
+			test: "affinity not satisfied on another node",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "zone"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine2",
+			fits: true,
+			test: "affinity satisfied in the same zone",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "zone"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine3",
+			fits: false,
+			test: "affinity not satisfied in another zone",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "zone"))),
+			pods: []*api.Pod{dbFinished, dbOtherNamespace},
+			node: "machine1",
+			fits: false,
+			test: "affinity ignores finished pods and pods in other namespaces",
+		},
+		{
+			pod: withAffinity(web, requiredAffinity(api.PodAffinityTerm{
+				LabelSelector: &api.LabelSelector{MatchLabels: db},
+				Namespaces:    []string{"other"},
+				TopologyKey:   "zone",
+			})),
+			pods: []*api.Pod{dbOtherNamespace},
+			node: "machine2",
+			fits: true,
+			test: "affinity for pods in another namespace",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(api.PodAffinityTerm{TopologyKey: "zone"})),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine1",
+			fits: false,
+			test: "affinity term without a selector matches no pod",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "web", "zone"))),
+			node: "machine3",
+			fits: true,
+			test: "affinity for itself with no matching pod anywhere",
+		},
+		{
+			pod:  withAffinity(web, requiredAffinity(podAffinityTerm("app", "db", "zone"))),
+			node: "machine3",
+			fits: false,
+			test: "affinity for other pods with no matching pod anywhere",
+		},
+		{
+			pod:  withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "kubernetes.io/hostname"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine1",
+			fits: false,
+			test: "anti-affinity violated on the same node",
+		},
+		{
+			pod:  withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "kubernetes.io/hostname"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine2",
+			fits: true,
+			test: "anti-affinity satisfied on another node",
+		},
+		{
+			pod:  withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "zone"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine2",
+			fits: false,
+			test: "anti-affinity violated in the same zone",
+		},
+		{
+			pod:  withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "rack"))),
+			pods: []*api.Pod{dbOnMachine1},
+			node: "machine1",
+			fits: true,
+			test: "anti-affinity with a topology key no node has",
+		},
+		{
+			pod:  &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: db}},
+			pods: []*api.Pod{webAvoidingDB},
+			node: "machine2",
+			fits: false,
+			test: "anti-affinity of a scheduled pod violated",
+		},
+		{
+			pod:  &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: db}},
+			pods: []*api.Pod{webAvoidingDB},
+			node: "machine1",
+			fits: true,
+			test: "anti-affinity of a scheduled pod satisfied",
+		},
+		{
+			pod:  withAffinity(web, requiredAntiAffinity(podAffinityTerm("app", "db", "zone"))),
+			pods: []*api.Pod{dbOnDeletedNode},
+			node: "machine1",
+			fits: true,
+			test: "pods on a node which is not found are ignored",
+		},
+	}
+
+	for _, test := range tests {
+		nodes := []api.Node{node1, node2, node3}
+		fit := NewPodAffinityPredicate(FakeNodeListInfo(nodes), algorithm.FakePodLister(test.pods))
+		fits, err := fit(test.pod, []*api.Pod{}, test.node)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
+// countingPodLister counts how often the pods are listed.
+type countingPodLister struct {
+	algorithm.FakePodLister
+	lists int
+}
+
+func (c *countingPodLister) List(s labels.Selector) ([]*api.Pod, error) {
+	c.lists++
+	return c.FakePodLister.List(s)
+}
+
+func TestInterPodAffinityMatchesListsPodsOncePerPod(t *testing.T) {
+	nodes := []api.Node{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1"}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2"}},
+	}
+	podLister := &countingPodLister{FakePodLister: algorithm.FakePodLister{
+		{ObjectMeta: api.ObjectMeta{Namespace: "ns"}, Spec: api.PodSpec{NodeName: "machine1"}},
+	}}
+	fit := NewPodAffinityPredicate(FakeNodeListInfo(nodes), podLister)
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Name: "pod"}}
+	for _, node := range nodes {
+		if _, err := fit(pod, []*api.Pod{}, node.Name); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if podLister.lists != 1 {
+		t.Errorf("expected the pods to be listed once, got %d", podLister.lists)
+	}
+
+	retried := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns", Name: "pod"}}
+	if _, err := fit(retried, []*api.Pod{}, "machine1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if podLister.lists != 2 {
+		t.Errorf("expected the pods to be listed again for a new pod, got %d", podLister.lists)
+	}
+}
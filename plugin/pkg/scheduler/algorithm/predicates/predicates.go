@@ -191,12 +191,39 @@ func NewSelectorMatchPredicate(info NodeInfo) algorithm.FitPredicate {
 	return selector.PodSelectorMatches
 }
 
+// NodeMatchesNodeSelectorTerms returns true if the labels of node satisfy any of terms.
+// A term without requirements matches no node.
+func NodeMatchesNodeSelectorTerms(node *api.Node, terms []api.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 {
+			continue
+		}
+		selector, err := api.NodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil {
+			glog.V(10).Infof("Failed to parse node selector term %+v: %v", term, err)
+			continue
+		}
+		if selector.Matches(labels.Set(node.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// PodMatchesNodeLabels returns true if node satisfies both the node selector and the
+// required node affinity of pod.
 func PodMatchesNodeLabels(pod *api.Pod, node *api.Node) bool {
-	if len(pod.Spec.NodeSelector) == 0 {
+	if len(pod.Spec.NodeSelector) > 0 {
+		selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
+		if !selector.Matches(labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
 		return true
 	}
-	selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
-	return selector.Matches(labels.Set(node.Labels))
+	return NodeMatchesNodeSelectorTerms(node, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
 }
 
 type NodeSelector struct {
@@ -356,6 +383,35 @@ func (s *ServiceAffinity) CheckServiceAffinity(pod *api.Pod, existingPods []*api
 	return affinitySelector.Matches(labels.Set(node.Labels)), nil
 }
 
+type TolerationMatch struct {
+	info NodeInfo
+}
+
+func NewTolerationMatchPredicate(info NodeInfo) algorithm.FitPredicate {
+	tolerationMatch := &TolerationMatch{
+		info: info,
+	}
+	return tolerationMatch.PodToleratesNodeTaints
+}
+
+// PodToleratesNodeTaints checks that the pod tolerates every NoSchedule taint of the node.
+func (t *TolerationMatch) PodToleratesNodeTaints(pod *api.Pod, existingPods []*api.Pod, nodeID string) (bool, error) {
+	node, err := t.info.GetNodeInfo(nodeID)
+	if err != nil {
+		return false, err
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != api.TaintEffectNoSchedule {
+			continue
+		}
+		if !api.TaintToleratedByTolerations(taint, pod.Spec.Tolerations) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func PodFitsPorts(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
 	existingPorts := getUsedPorts(existingPods...)
 	wantPorts := getUsedPorts(pod)
@@ -435,6 +435,22 @@ func TestAWSDiskConflicts(t *testing.T) {
 	}
 }
 
+func podWithRequiredNodeAffinity(terms ...[]api.NodeSelectorRequirement) *api.Pod {
+	selector := &api.NodeSelector{}
+	for _, term := range terms {
+		selector.NodeSelectorTerms = append(selector.NodeSelectorTerms, api.NodeSelectorTerm{MatchExpressions: term})
+	}
+	return &api.Pod{
+		Spec: api.PodSpec{
+			Affinity: &api.Affinity{
+				NodeAffinity: &api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: selector,
+				},
+			},
+		},
+	}
+}
+
 func TestPodFitsSelector(t *testing.T) {
 	tests := []struct {
 		pod    *api.Pod
@@ -502,6 +518,108 @@ func TestPodFitsSelector(t *testing.T) {
 			fits: false,
 			test: "node labels are subset",
 		},
+		{
+			pod: podWithRequiredNodeAffinity(
+				[]api.NodeSelectorRequirement{
+					{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"bar", "value2"}},
+					{Key: "cores", Operator: api.NodeSelectorOpGt, Values: []string{"4"}},
+				},
+			),
+			labels: map[string]string{
+				"foo":   "bar",
+				"cores": "8",
+			},
+			fits: true,
+			test: "node matches every requirement of a required node affinity term",
+		},
+		{
+			pod: podWithRequiredNodeAffinity(
+				[]api.NodeSelectorRequirement{
+					{Key: "foo", Operator: api.NodeSelectorOpIn, Values: []string{"bar", "value2"}},
+					{Key: "cores", Operator: api.NodeSelectorOpGt, Values: []string{"4"}},
+				},
+			),
+			labels: map[string]string{
+				"foo":   "bar",
+				"cores": "2",
+			},
+			fits: false,
+			test: "node misses a requirement of a required node affinity term",
+		},
+		{
+			pod: podWithRequiredNodeAffinity(
+				[]api.NodeSelectorRequirement{
+					{Key: "foo", Operator: api.NodeSelectorOpNotIn, Values: []string{"bar"}},
+				},
+				[]api.NodeSelectorRequirement{
+					{Key: "gpu", Operator: api.NodeSelectorOpExists},
+				},
+			),
+			labels: map[string]string{
+				"foo": "bar",
+				"gpu": "k80",
+			},
+			fits: true,
+			test: "node matches one of the required node affinity terms",
+		},
+		{
+			pod: podWithRequiredNodeAffinity(
+				[]api.NodeSelectorRequirement{
+					{Key: "foo", Operator: api.NodeSelectorOpDoesNotExist},
+				},
+			),
+			labels: map[string]string{
+				"foo": "bar",
+			},
+			fits: false,
+			test: "node matches none of the required node affinity terms",
+		},
+		{
+			pod:  podWithRequiredNodeAffinity(),
+			fits: false,
+			test: "required node affinity without terms",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					NodeSelector: map[string]string{
+						"foo": "bar",
+					},
+					Affinity: podWithRequiredNodeAffinity(
+						[]api.NodeSelectorRequirement{
+							{Key: "gpu", Operator: api.NodeSelectorOpExists},
+						},
+					).Spec.Affinity,
+				},
+			},
+			labels: map[string]string{
+				"gpu": "k80",
+			},
+			fits: false,
+			test: "node matches the required node affinity but not the node selector",
+		},
+		{
+			pod: &api.Pod{
+				Spec: api.PodSpec{
+					Affinity: &api.Affinity{
+						NodeAffinity: &api.NodeAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []api.PreferredSchedulingTerm{
+								{
+									Weight: 1,
+									Preference: api.NodeSelectorTerm{
+										MatchExpressions: []api.NodeSelectorRequirement{
+											{Key: "foo", Operator: api.NodeSelectorOpExists},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			fits: true,
+			test: "preferred node affinity does not restrict nodes",
+		},
 	}
 	for _, test := range tests {
 		node := api.Node{ObjectMeta: api.ObjectMeta{Labels: test.labels}}
@@ -716,3 +834,75 @@ func TestServiceAffinity(t *testing.T) {
 		}
 	}
 }
+
+func TestPodToleratesNodeTaints(t *testing.T) {
+	noSchedule := api.Taint{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule}
+	preferNoSchedule := api.Taint{Key: "workload", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule}
+	tests := []struct {
+		pod    *api.Pod
+		taints []api.Taint
+		fits   bool
+		test   string
+	}{
+		{
+			pod:  &api.Pod{},
+			fits: true,
+			test: "node without taints",
+		},
+		{
+			pod:    &api.Pod{},
+			taints: []api.Taint{noSchedule},
+			fits:   false,
+			test:   "pod without tolerations, NoSchedule taint",
+		},
+		{
+			pod:    &api.Pod{},
+			taints: []api.Taint{preferNoSchedule},
+			fits:   true,
+			test:   "pod without tolerations, PreferNoSchedule taint",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule},
+			}}},
+			taints: []api.Taint{noSchedule, preferNoSchedule},
+			fits:   true,
+			test:   "pod tolerating the NoSchedule taint",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Operator: api.TolerationOpExists},
+			}}},
+			taints: []api.Taint{noSchedule},
+			fits:   true,
+			test:   "pod tolerating any value of the taint key",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "team-b", Effect: api.TaintEffectNoSchedule},
+			}}},
+			taints: []api.Taint{noSchedule},
+			fits:   false,
+			test:   "pod tolerating another value of the taint key",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectPreferNoSchedule},
+			}}},
+			taints: []api.Taint{noSchedule},
+			fits:   false,
+			test:   "pod tolerating the taint with another effect",
+		},
+	}
+	for _, test := range tests {
+		node := api.Node{Spec: api.NodeSpec{Taints: test.taints}}
+		tolerationMatch := TolerationMatch{FakeNodeInfo(node)}
+		fits, err := tolerationMatch.PodToleratesNodeTaints(test.pod, []*api.Pod{}, "machine")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
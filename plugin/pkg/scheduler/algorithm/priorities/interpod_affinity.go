@@ -0,0 +1,115 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+)
+
+type InterPodAffinity struct {
+	info predicates.NodeInfo
+}
+
+func NewInterPodAffinityPriority(info predicates.NodeInfo) algorithm.PriorityFunction {
+	interPodAffinity := &InterPodAffinity{
+		info: info,
+	}
+	return interPodAffinity.CalculateInterPodAffinityPriority
+}
+
+// CalculateInterPodAffinityPriority favors nodes co-located with the pods selected by the preferred
+// pod affinity terms of the pod, and disfavors nodes co-located with the pods selected by its
+// preferred anti-affinity terms. Each node counts the weights of the affinity terms it satisfies,
+// less the weights of the anti-affinity terms it violates, and the counts are scaled so that the
+// nodes with the lowest count score 0 and the nodes with the highest count score 10.
+func (ipa *InterPodAffinity) CalculateInterPodAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (algorithm.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var affinityTerms, antiAffinityTerms []api.WeightedPodAffinityTerm
+	if affinity := pod.Spec.Affinity; affinity != nil {
+		if affinity.PodAffinity != nil {
+			affinityTerms = affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		}
+		if affinity.PodAntiAffinity != nil {
+			antiAffinityTerms = affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		}
+	}
+
+	var allPods []*api.Pod
+	var podNodes map[string]*api.Node
+	if len(affinityTerms) > 0 || len(antiAffinityTerms) > 0 {
+		allPods, podNodes, err = predicates.ListScheduledPods(podLister, ipa.info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	counts := map[string]int{}
+	countTerms := func(node *api.Node, terms []api.WeightedPodAffinityTerm, multiplier int) error {
+		for _, term := range terms {
+			if term.Weight == 0 {
+				continue
+			}
+			inTopology, _, err := predicates.AnyPodMatchesPodAffinityTerm(pod, allPods, podNodes, node, term.PodAffinityTerm)
+			if err != nil {
+				return err
+			}
+			if inTopology {
+				counts[node.Name] += multiplier * term.Weight
+			}
+		}
+		return nil
+	}
+
+	var minCount, maxCount int
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if err := countTerms(node, affinityTerms, 1); err != nil {
+			return nil, err
+		}
+		if err := countTerms(node, antiAffinityTerms, -1); err != nil {
+			return nil, err
+		}
+		if i == 0 || counts[node.Name] < minCount {
+			minCount = counts[node.Name]
+		}
+		if i == 0 || counts[node.Name] > maxCount {
+			maxCount = counts[node.Name]
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for _, node := range nodes.Items {
+		fScore := float32(0)
+		if maxCount > minCount {
+			fScore = 10 * (float32(counts[node.Name]-minCount) / float32(maxCount-minCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof(
+			"%v -> %v: InterPodAffinityPriority, Score: (%d)", pod.Name, node.Name, int(fScore),
+		)
+	}
+	return result, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+type fakeNodeListInfo []api.Node
+
+func (nodes fakeNodeListInfo) GetNodeInfo(nodeName string) (*api.Node, error) {
+	for _, node := range nodes {
+		if node.Name == nodeName {
+			return &node, nil
+		}
+	}
+	return nil, fmt.Errorf("Unable to find node: %s", nodeName)
+}
+
+func TestInterPodAffinityPriority(t *testing.T) {
+	labels1 := map[string]string{"kubernetes.io/hostname": "machine1", "zone": "z1"}
+	labels2 := map[string]string{"kubernetes.io/hostname": "machine2", "zone": "z1"}
+	labels3 := map[string]string{"kubernetes.io/hostname": "machine3", "zone": "z2"}
+	nodes := []api.Node{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: labels1}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: labels2}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: labels3}},
+	}
+
+	term := func(app, topologyKey string, weight int) api.WeightedPodAffinityTerm {
+		return api.WeightedPodAffinityTerm{
+			Weight: weight,
+			PodAffinityTerm: api.PodAffinityTerm{
+				LabelSelector: &api.LabelSelector{MatchLabels: map[string]string{"app": app}},
+				TopologyKey:   topologyKey,
+			},
+		}
+	}
+	scheduledPod := func(app, node string) *api.Pod {
+		return &api.Pod{
+			ObjectMeta: api.ObjectMeta{Namespace: "ns", Labels: map[string]string{"app": app}},
+			Spec:       api.PodSpec{NodeName: node},
+		}
+	}
+	podWithAffinity := func(affinity *api.Affinity) *api.Pod {
+		return &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "ns"}, Spec: api.PodSpec{Affinity: affinity}}
+	}
+
+	tests := []struct {
+		pod          *api.Pod
+		pods         []*api.Pod
+		expectedList algorithm.HostPriorityList
+		test         string
+	}{
+		{
+			pod:          &api.Pod{},
+			pods:         []*api.Pod{scheduledPod("db", "machine1")},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "no affinity, all nodes score 0",
+		},
+		{
+			pod: podWithAffinity(&api.Affinity{PodAffinity: &api.PodAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{term("db", "kubernetes.io/hostname", 5)},
+			}}),
+			pods:         []*api.Pod{scheduledPod("db", "machine1")},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 0}, {"machine3", 0}},
+			test:         "affinity for the node of a matching pod",
+		},
+		{
+			pod: podWithAffinity(&api.Affinity{PodAffinity: &api.PodAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{
+					term("db", "kubernetes.io/hostname", 5),
+					term("db", "zone", 5),
+				},
+			}}),
+			pods:         []*api.Pod{scheduledPod("db", "machine1")},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 5}, {"machine3", 0}},
+			test:         "affinity for the node and the zone of a matching pod",
+		},
+		{
+			pod: podWithAffinity(&api.Affinity{PodAntiAffinity: &api.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{term("db", "zone", 3)},
+			}}),
+			pods:         []*api.Pod{scheduledPod("db", "machine1")},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 10}},
+			test:         "anti-affinity for the zone of a matching pod",
+		},
+		{
+			pod: podWithAffinity(&api.Affinity{
+				PodAffinity: &api.PodAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{term("db", "zone", 4)},
+				},
+				PodAntiAffinity: &api.PodAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{term("web", "kubernetes.io/hostname", 8)},
+				},
+			}),
+			pods:         []*api.Pod{scheduledPod("db", "machine1"), scheduledPod("web", "machine2")},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 0}, {"machine3", 5}},
+			test:         "affinity and anti-affinity combined",
+		},
+		{
+			pod: podWithAffinity(&api.Affinity{PodAffinity: &api.PodAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{term("db", "kubernetes.io/hostname", 5)},
+			}}),
+			pods:         []*api.Pod{{ObjectMeta: api.ObjectMeta{Namespace: "other", Labels: map[string]string{"app": "db"}}, Spec: api.PodSpec{NodeName: "machine1"}}},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 0}, {"machine3", 0}},
+			test:         "matching pod in another namespace",
+		},
+	}
+
+	for _, test := range tests {
+		interPodAffinity := InterPodAffinity{info: fakeNodeListInfo(nodes)}
+		list, err := interPodAffinity.CalculateInterPodAffinityPriority(test.pod, algorithm.FakePodLister(test.pods), algorithm.FakeNodeLister(api.NodeList{Items: nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
+)
+
+// NodeAffinityPriority favors nodes matching the preferred node affinity terms of the pod.
+// The score of a node is the sum of the weights of the terms it matches, scaled so that the
+// nodes with the highest sum score 10. If the pod has no preferred terms, or no node matches
+// any of them, all nodes score 0.
+func NodeAffinityPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (algorithm.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var preferredTerms []api.PreferredSchedulingTerm
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		preferredTerms = affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	}
+
+	var maxCount int
+	counts := map[string]int{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		for _, term := range preferredTerms {
+			if term.Weight == 0 {
+				continue
+			}
+			if predicates.NodeMatchesNodeSelectorTerms(node, []api.NodeSelectorTerm{term.Preference}) {
+				counts[node.Name] += term.Weight
+			}
+		}
+		if counts[node.Name] > maxCount {
+			maxCount = counts[node.Name]
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for _, node := range nodes.Items {
+		fScore := float32(0)
+		if maxCount > 0 {
+			fScore = 10 * (float32(counts[node.Name]) / float32(maxCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof(
+			"%v -> %v: NodeAffinityPriority, Score: (%d)", pod.Name, node.Name, int(fScore),
+		)
+	}
+	return result, nil
+}
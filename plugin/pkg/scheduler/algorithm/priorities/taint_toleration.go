@@ -0,0 +1,72 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+// countIntolerableTaints counts the PreferNoSchedule taints of the node which the tolerations do not match.
+func countIntolerableTaints(taints []api.Taint, tolerations []api.Toleration) int {
+	count := 0
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != api.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !api.TaintToleratedByTolerations(taint, tolerations) {
+			count++
+		}
+	}
+	return count
+}
+
+// TaintTolerationPriority favors nodes with fewer PreferNoSchedule taints that the pod does not tolerate.
+// The nodes without such taints score 10, and the nodes with the most such taints score 0.
+func TaintTolerationPriority(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (algorithm.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var maxCount int
+	counts := map[string]int{}
+	for _, node := range nodes.Items {
+		count := countIntolerableTaints(node.Spec.Taints, pod.Spec.Tolerations)
+		counts[node.Name] = count
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	result := []algorithm.HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for _, node := range nodes.Items {
+		fScore := float32(10)
+		if maxCount > 0 {
+			fScore = 10 * (1 - float32(counts[node.Name])/float32(maxCount))
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: int(fScore)})
+		glog.V(10).Infof(
+			"%v -> %v: TaintTolerationPriority, Score: (%d)", pod.Name, node.Name, int(fScore),
+		)
+	}
+	return result, nil
+}
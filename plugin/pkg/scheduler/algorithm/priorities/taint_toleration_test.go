@@ -0,0 +1,96 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorities
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+)
+
+func nodeWithTaints(name string, taints ...api.Taint) api.Node {
+	return api.Node{
+		ObjectMeta: api.ObjectMeta{Name: name},
+		Spec:       api.NodeSpec{Taints: taints},
+	}
+}
+
+func TestTaintTolerationPriority(t *testing.T) {
+	preferNoCPU := api.Taint{Key: "workload", Value: "cpu", Effect: api.TaintEffectPreferNoSchedule}
+	preferNoGPU := api.Taint{Key: "workload", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule}
+	noSchedule := api.Taint{Key: "dedicated", Value: "team-a", Effect: api.TaintEffectNoSchedule}
+
+	tests := []struct {
+		pod          *api.Pod
+		nodes        []api.Node
+		expectedList algorithm.HostPriorityList
+		test         string
+	}{
+		{
+			pod:          &api.Pod{},
+			nodes:        []api.Node{nodeWithTaints("machine1"), nodeWithTaints("machine2", noSchedule)},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "no PreferNoSchedule taints, all nodes score 10",
+		},
+		{
+			pod: &api.Pod{},
+			nodes: []api.Node{
+				nodeWithTaints("machine1"),
+				nodeWithTaints("machine2", preferNoCPU),
+				nodeWithTaints("machine3", preferNoCPU, preferNoGPU),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 5}, {"machine3", 0}},
+			test:         "nodes with more intolerable taints score lower",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{{Key: "workload", Value: "cpu"}}}},
+			nodes: []api.Node{
+				nodeWithTaints("machine1", preferNoCPU),
+				nodeWithTaints("machine2", preferNoGPU),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 10}, {"machine2", 0}},
+			test:         "tolerated taints are ignored",
+		},
+		{
+			pod: &api.Pod{Spec: api.PodSpec{Tolerations: []api.Toleration{
+				{Key: "workload", Operator: api.TolerationOpExists, Effect: api.TaintEffectNoSchedule},
+			}}},
+			nodes: []api.Node{
+				nodeWithTaints("machine1", preferNoCPU),
+				nodeWithTaints("machine2"),
+			},
+			expectedList: []algorithm.HostPriority{{"machine1", 0}, {"machine2", 10}},
+			test:         "tolerations for another effect are ignored",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := TaintTolerationPriority(test.pod, algorithm.FakePodLister([]*api.Pod{}), algorithm.FakeNodeLister(api.NodeList{Items: test.nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
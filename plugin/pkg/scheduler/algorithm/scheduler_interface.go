@@ -24,4 +24,25 @@ import (
 // onto machines.
 type ScheduleAlgorithm interface {
 	Schedule(*api.Pod, NodeLister) (selectedMachine string, err error)
+	// Preempt is called when the pod could not be scheduled because of the given error.
+	// It returns the node on which the pod would fit if the returned pods were deleted,
+	// or an empty node name if preemption cannot help.
+	Preempt(*api.Pod, NodeLister, error) (selectedMachine string, preemptedPods []*api.Pod, err error)
+}
+
+// SchedulerExtender is an interface for external processes to influence scheduling
+// decisions made by Kubernetes. This is typically needed for resources not directly
+// managed by Kubernetes.
+type SchedulerExtender interface {
+	// Filter based on extender implemented predicate functions. The filtered list is
+	// expected to be a subset of the supplied list.
+	Filter(pod *api.Pod, nodes *api.NodeList) (filteredNodes *api.NodeList, err error)
+
+	// Prioritize based on extender implemented priority functions. The returned scores and
+	// weight are used to compute the weighted score of the extender, which is added to the
+	// scores computed by the priority functions of the scheduler.
+	Prioritize(pod *api.Pod, nodes *api.NodeList) (hostPriorities *HostPriorityList, weight int, err error)
+
+	// IsIgnorable returns true if scheduling should not fail when the extender cannot filter nodes.
+	IsIgnorable() bool
 }
@@ -61,7 +61,7 @@ func defaultPredicates() sets.String {
 		),
 		// Fit is determined by non-conflicting disk volumes.
 		factory.RegisterFitPredicate("NoDiskConflict", predicates.NoDiskConflict),
-		// Fit is determined by node selector query.
+		// Fit is determined by node selector query and required node affinity.
 		factory.RegisterFitPredicateFactory(
 			"MatchNodeSelector",
 			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
@@ -70,6 +70,20 @@ func defaultPredicates() sets.String {
 		),
 		// Fit is determined by the presence of the Host parameter and a string match
 		factory.RegisterFitPredicate("HostName", predicates.PodFitsHost),
+		// Fit is determined by whether the pod tolerates the NoSchedule taints of the node.
+		factory.RegisterFitPredicateFactory(
+			"PodToleratesNodeTaints",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewTolerationMatchPredicate(args.NodeInfo)
+			},
+		),
+		// Fit is determined by the required pod affinity and anti-affinity of the pod and of the pods already scheduled.
+		factory.RegisterFitPredicateFactory(
+			"MatchInterPodAffinity",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return predicates.NewPodAffinityPredicate(args.NodeInfo, args.PodLister)
+			},
+		),
 	)
 }
 
@@ -79,6 +93,10 @@ func defaultPriorities() sets.String {
 		factory.RegisterPriorityFunction("LeastRequestedPriority", priorities.LeastRequestedPriority, 1),
 		// Prioritizes nodes to help achieve balanced resource usage
 		factory.RegisterPriorityFunction("BalancedResourceAllocation", priorities.BalancedResourceAllocation, 1),
+		// Prioritizes nodes matching the preferred node affinity terms of the pod, by the weights of the terms.
+		factory.RegisterPriorityFunction("NodeAffinityPriority", priorities.NodeAffinityPriority, 1),
+		// Prioritizes nodes with fewer PreferNoSchedule taints which the pod does not tolerate.
+		factory.RegisterPriorityFunction("TaintTolerationPriority", priorities.TaintTolerationPriority, 1),
 		// spreads pods by minimizing the number of pods (belonging to the same service or replication controller) on the same node.
 		factory.RegisterPriorityConfigFactory(
 			"SelectorSpreadPriority",
@@ -89,5 +107,16 @@ func defaultPriorities() sets.String {
 				Weight: 1,
 			},
 		),
+		// Prioritizes nodes co-located with the pods matching the preferred pod affinity terms of the pod,
+		// and away from the pods matching its preferred anti-affinity terms.
+		factory.RegisterPriorityConfigFactory(
+			"InterPodAffinityPriority",
+			factory.PriorityConfigFactory{
+				Function: func(args factory.PluginFactoryArgs) algorithm.PriorityFunction {
+					return priorities.NewInterPodAffinityPriority(args.NodeInfo)
+				},
+				Weight: 1,
+			},
+		),
 	)
 }
@@ -16,7 +16,12 @@ limitations under the License.
 
 package api
 
-import "k8s.io/kubernetes/pkg/api/unversioned"
+import (
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
 
 type Policy struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -24,6 +29,8 @@ type Policy struct {
 	Predicates []PredicatePolicy `json:"predicates"`
 	// Holds the information to configure the priority functions
 	Priorities []PriorityPolicy `json:"priorities"`
+	// Holds the information to communicate with the extender(s)
+	ExtenderConfigs []ExtenderConfig `json:"extenders"`
 }
 
 type PredicatePolicy struct {
@@ -100,3 +107,30 @@ type LabelPreference struct {
 	// If false, higher priority is given to nodes that do not have the label
 	Presence bool `json:"presence"`
 }
+
+// Holds the parameters used to communicate with an extender, an HTTP service which filters
+// and/or prioritizes the nodes for a pod after the built-in predicates and priority functions
+type ExtenderConfig struct {
+	// URL prefix at which the extender is available, with the http or https scheme
+	URLPrefix string `json:"urlPrefix"`
+	// API version of the extender, appended to the URL prefix
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Verb for the filter call, appended to the URL prefix and API version
+	// If empty, the extender does not filter nodes
+	FilterVerb string `json:"filterVerb,omitempty"`
+	// Verb for the prioritize call, appended to the URL prefix and API version
+	// If empty, the extender does not prioritize nodes
+	PrioritizeVerb string `json:"prioritizeVerb,omitempty"`
+	// The numeric multiplier for the node scores that the prioritize call generates
+	// The weight should be a positive integer
+	Weight int `json:"weight,omitempty"`
+	// TLSConfig specifies the transport layer security config for an https URL prefix
+	TLSConfig *client.TLSClientConfig `json:"tlsConfig,omitempty"`
+	// HTTPTimeout specifies the timeout duration for a call to the extender
+	// If zero, a default timeout of 5 seconds is used
+	HTTPTimeout time.Duration `json:"httpTimeout,omitempty"`
+	// Ignorable specifies whether the pods can still be scheduled when the extender cannot be
+	// reached or returns an error from the filter call
+	// If true, the extender is skipped, otherwise the pod fails to schedule
+	Ignorable bool `json:"ignorable,omitempty"`
+}
@@ -16,7 +16,13 @@ limitations under the License.
 
 package v1
 
-import "k8s.io/kubernetes/pkg/api/unversioned"
+import (
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	apiv1 "k8s.io/kubernetes/pkg/api/v1"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
 
 type Policy struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -24,6 +30,8 @@ type Policy struct {
 	Predicates []PredicatePolicy `json:"predicates"`
 	// Holds the information to configure the priority functions
 	Priorities []PriorityPolicy `json:"priorities"`
+	// Holds the information to communicate with the extender(s)
+	ExtenderConfigs []ExtenderConfig `json:"extenders"`
 }
 
 type PredicatePolicy struct {
@@ -100,3 +108,56 @@ type LabelPreference struct {
 	// If false, higher priority is given to nodes that do not have the label
 	Presence bool `json:"presence"`
 }
+
+// Holds the parameters used to communicate with an extender, an HTTP service which filters
+// and/or prioritizes the nodes for a pod after the built-in predicates and priority functions
+type ExtenderConfig struct {
+	// URL prefix at which the extender is available, with the http or https scheme
+	URLPrefix string `json:"urlPrefix"`
+	// API version of the extender, appended to the URL prefix
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Verb for the filter call, appended to the URL prefix and API version
+	// If empty, the extender does not filter nodes
+	FilterVerb string `json:"filterVerb,omitempty"`
+	// Verb for the prioritize call, appended to the URL prefix and API version
+	// If empty, the extender does not prioritize nodes
+	PrioritizeVerb string `json:"prioritizeVerb,omitempty"`
+	// The numeric multiplier for the node scores that the prioritize call generates
+	// The weight should be a positive integer
+	Weight int `json:"weight,omitempty"`
+	// TLSConfig specifies the transport layer security config for an https URL prefix
+	TLSConfig *client.TLSClientConfig `json:"tlsConfig,omitempty"`
+	// HTTPTimeout specifies the timeout duration for a call to the extender
+	// If zero, a default timeout of 5 seconds is used
+	HTTPTimeout time.Duration `json:"httpTimeout,omitempty"`
+	// Ignorable specifies whether the pods can still be scheduled when the extender cannot be
+	// reached or returns an error from the filter call
+	// If true, the extender is skipped, otherwise the pod fails to schedule
+	Ignorable bool `json:"ignorable,omitempty"`
+}
+
+// The request body of the filter and prioritize calls to an extender
+type ExtenderArgs struct {
+	// The pod being scheduled
+	Pod apiv1.Pod `json:"pod"`
+	// The candidate nodes for the pod
+	Nodes apiv1.NodeList `json:"nodes"`
+}
+
+// The response body of the filter call to an extender
+type ExtenderFilterResult struct {
+	// The nodes the pod fits on, a subset of the candidate nodes
+	Nodes apiv1.NodeList `json:"nodes,omitempty"`
+	// The error message, if the extender failed to filter the nodes
+	Error string `json:"error,omitempty"`
+}
+
+// The score of a node, an element of the response body of the prioritize call to an extender
+type HostPriority struct {
+	// Name of the node
+	Host string `json:"host"`
+	// Score associated with the node, from 0 to 10
+	Score int `json:"score"`
+}
+
+type HostPriorityList []HostPriority
@@ -34,5 +34,14 @@ func ValidatePolicy(policy schedulerapi.Policy) error {
 		}
 	}
 
+	for _, extender := range policy.ExtenderConfigs {
+		if extender.URLPrefix == "" {
+			validationErrors = append(validationErrors, fmt.Errorf("Extender should have a non-empty urlPrefix"))
+		}
+		if extender.PrioritizeVerb != "" && extender.Weight <= 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("Extender %s should have a positive weight applied to it", extender.URLPrefix))
+		}
+	}
+
 	return errors.NewAggregate(validationErrors)
 }
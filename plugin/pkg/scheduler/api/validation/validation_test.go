@@ -50,3 +50,25 @@ func TestValidatePriorityWithNegativeWeight(t *testing.T) {
 		t.Errorf("Expected error about priority weight not being positive")
 	}
 }
+
+func TestValidateExtenderWithNoURLPrefix(t *testing.T) {
+	policy := api.Policy{ExtenderConfigs: []api.ExtenderConfig{{FilterVerb: "filter"}}}
+	if ValidatePolicy(policy) == nil {
+		t.Errorf("Expected error about extender urlPrefix being empty")
+	}
+}
+
+func TestValidateExtenderWithNonPositiveWeight(t *testing.T) {
+	policy := api.Policy{ExtenderConfigs: []api.ExtenderConfig{{URLPrefix: "http://127.0.0.1:8888/", PrioritizeVerb: "prioritize", Weight: 0}}}
+	if ValidatePolicy(policy) == nil {
+		t.Errorf("Expected error about extender weight not being positive")
+	}
+}
+
+func TestValidateExtenderWithFilterOnly(t *testing.T) {
+	policy := api.Policy{ExtenderConfigs: []api.ExtenderConfig{{URLPrefix: "http://127.0.0.1:8888/", FilterVerb: "filter"}}}
+	errs := ValidatePolicy(policy)
+	if errs != nil {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+}
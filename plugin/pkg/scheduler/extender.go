@@ -0,0 +1,187 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+	schedulerapiv1 "k8s.io/kubernetes/plugin/pkg/scheduler/api/v1"
+)
+
+const (
+	// DefaultExtenderTimeout is used for calls to an extender that does not configure its own timeout.
+	DefaultExtenderTimeout = 5 * time.Second
+)
+
+// HTTPExtender implements the algorithm.SchedulerExtender interface.
+type HTTPExtender struct {
+	extenderURL    string
+	filterVerb     string
+	prioritizeVerb string
+	weight         int
+	ignorable      bool
+	client         *http.Client
+}
+
+func makeTransport(config *schedulerapi.ExtenderConfig) (http.RoundTripper, error) {
+	var cfg client.Config
+	if config.TLSConfig != nil {
+		cfg.TLSClientConfig = *config.TLSConfig
+	}
+	tlsConfig, err := client.TLSConfigFor(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		return &http.Transport{TLSClientConfig: tlsConfig}, nil
+	}
+	return http.DefaultTransport, nil
+}
+
+// NewHTTPExtender creates an extender that calls the HTTP endpoint described by config.
+func NewHTTPExtender(config *schedulerapi.ExtenderConfig) (algorithm.SchedulerExtender, error) {
+	if config.HTTPTimeout.Nanoseconds() == 0 {
+		config.HTTPTimeout = DefaultExtenderTimeout
+	}
+
+	transport, err := makeTransport(config)
+	if err != nil {
+		return nil, err
+	}
+	extenderURL := strings.TrimRight(config.URLPrefix, "/")
+	if len(config.APIVersion) > 0 {
+		extenderURL += "/" + config.APIVersion
+	}
+	return &HTTPExtender{
+		extenderURL:    extenderURL,
+		filterVerb:     config.FilterVerb,
+		prioritizeVerb: config.PrioritizeVerb,
+		weight:         config.Weight,
+		ignorable:      config.Ignorable,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.HTTPTimeout,
+		},
+	}, nil
+}
+
+// Filter based on extender implemented predicate functions. The filtered list is
+// expected to be a subset of the supplied list. If the extender does not filter,
+// the supplied list is returned.
+func (h *HTTPExtender) Filter(pod *api.Pod, nodes *api.NodeList) (*api.NodeList, error) {
+	if h.filterVerb == "" {
+		return nodes, nil
+	}
+
+	var result schedulerapiv1.ExtenderFilterResult
+	if err := h.send(h.filterVerb, pod, nodes, &result); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+
+	// the extender only needs to return the names of the nodes, the nodes themselves are
+	// taken from the supplied list
+	names := map[string]bool{}
+	for _, node := range result.Nodes.Items {
+		names[node.Name] = true
+	}
+	filtered := &api.NodeList{}
+	for _, node := range nodes.Items {
+		if names[node.Name] {
+			filtered.Items = append(filtered.Items, node)
+		}
+	}
+	return filtered, nil
+}
+
+// Prioritize based on extender implemented priority functions. Weight*priority is added
+// up for each such priority function. The returned score is added to the score computed
+// by Kubernetes scheduler. The total score is used to do the host selection.
+func (h *HTTPExtender) Prioritize(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, int, error) {
+	if h.prioritizeVerb == "" {
+		result := algorithm.HostPriorityList{}
+		for _, node := range nodes.Items {
+			result = append(result, algorithm.HostPriority{Host: node.Name, Score: 0})
+		}
+		return &result, 0, nil
+	}
+
+	var result schedulerapiv1.HostPriorityList
+	if err := h.send(h.prioritizeVerb, pod, nodes, &result); err != nil {
+		return nil, 0, err
+	}
+	hostPriorities := algorithm.HostPriorityList{}
+	for _, hostPriority := range result {
+		hostPriorities = append(hostPriorities, algorithm.HostPriority{Host: hostPriority.Host, Score: hostPriority.Score})
+	}
+	return &hostPriorities, h.weight, nil
+}
+
+// IsIgnorable returns true if scheduling should not fail when the extender cannot filter nodes.
+func (h *HTTPExtender) IsIgnorable() bool {
+	return h.ignorable
+}
+
+// send posts the pod and the nodes to the extender with the given verb, and decodes the response into result.
+func (h *HTTPExtender) send(action string, pod *api.Pod, nodes *api.NodeList, result interface{}) error {
+	var args schedulerapiv1.ExtenderArgs
+	if err := api.Scheme.Convert(pod, &args.Pod); err != nil {
+		return err
+	}
+	if err := api.Scheme.Convert(nodes, &args.Nodes); err != nil {
+		return err
+	}
+	body, err := json.Marshal(&args)
+	if err != nil {
+		return err
+	}
+
+	url := h.extenderURL + "/" + action
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed %v with extender at URL %v, code %v", action, h.extenderURL, resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
@@ -0,0 +1,463 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
+	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
+	schedulerapiv1 "k8s.io/kubernetes/plugin/pkg/scheduler/api/v1"
+)
+
+type fitPredicate func(pod *api.Pod, node *api.Node) (bool, error)
+type priorityFunc func(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, error)
+
+type priorityConfig struct {
+	function priorityFunc
+	weight   int
+}
+
+func errorPredicateExtender(pod *api.Pod, node *api.Node) (bool, error) {
+	return false, fmt.Errorf("Some error")
+}
+
+func falsePredicateExtender(pod *api.Pod, node *api.Node) (bool, error) {
+	return false, nil
+}
+
+func truePredicateExtender(pod *api.Pod, node *api.Node) (bool, error) {
+	return true, nil
+}
+
+func machine1PredicateExtender(pod *api.Pod, node *api.Node) (bool, error) {
+	return node.Name == "machine1", nil
+}
+
+func machine2PredicateExtender(pod *api.Pod, node *api.Node) (bool, error) {
+	return node.Name == "machine2", nil
+}
+
+func errorPrioritizerExtender(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, error) {
+	return &algorithm.HostPriorityList{}, fmt.Errorf("Some error")
+}
+
+func machine1PrioritizerExtender(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, error) {
+	result := algorithm.HostPriorityList{}
+	for _, node := range nodes.Items {
+		score := 1
+		if node.Name == "machine1" {
+			score = 10
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: score})
+	}
+	return &result, nil
+}
+
+func machine2PrioritizerExtender(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, error) {
+	result := algorithm.HostPriorityList{}
+	for _, node := range nodes.Items {
+		score := 1
+		if node.Name == "machine2" {
+			score = 10
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: score})
+	}
+	return &result, nil
+}
+
+func machine2Prioritizer(pod *api.Pod, podLister algorithm.PodLister, nodeLister algorithm.NodeLister) (algorithm.HostPriorityList, error) {
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return []algorithm.HostPriority{}, err
+	}
+
+	result := []algorithm.HostPriority{}
+	for _, node := range nodes.Items {
+		score := 1
+		if node.Name == "machine2" {
+			score = 10
+		}
+		result = append(result, algorithm.HostPriority{Host: node.Name, Score: score})
+	}
+	return result, nil
+}
+
+// FakeExtender implements algorithm.SchedulerExtender with in-process predicates and priorities.
+type FakeExtender struct {
+	predicates   []fitPredicate
+	prioritizers []priorityConfig
+	weight       int
+	ignorable    bool
+}
+
+func (f *FakeExtender) Filter(pod *api.Pod, nodes *api.NodeList) (*api.NodeList, error) {
+	filtered := []api.Node{}
+	for _, node := range nodes.Items {
+		fits := true
+		for _, predicate := range f.predicates {
+			fit, err := predicate(pod, &node)
+			if err != nil {
+				return &api.NodeList{}, err
+			}
+			if !fit {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			filtered = append(filtered, node)
+		}
+	}
+	return &api.NodeList{Items: filtered}, nil
+}
+
+func (f *FakeExtender) Prioritize(pod *api.Pod, nodes *api.NodeList) (*algorithm.HostPriorityList, int, error) {
+	result := algorithm.HostPriorityList{}
+	combinedScores := map[string]int{}
+	for _, prioritizer := range f.prioritizers {
+		weight := prioritizer.weight
+		if weight == 0 {
+			continue
+		}
+		prioritizedList, err := prioritizer.function(pod, nodes)
+		if err != nil {
+			return &algorithm.HostPriorityList{}, 0, err
+		}
+		for _, hostEntry := range *prioritizedList {
+			combinedScores[hostEntry.Host] += hostEntry.Score * weight
+		}
+	}
+	for host, score := range combinedScores {
+		result = append(result, algorithm.HostPriority{Host: host, Score: score})
+	}
+	return &result, f.weight, nil
+}
+
+func (f *FakeExtender) IsIgnorable() bool {
+	return f.ignorable
+}
+
+func TestGenericSchedulerWithExtenders(t *testing.T) {
+	tests := []struct {
+		name         string
+		predicates   map[string]algorithm.FitPredicate
+		prioritizers []algorithm.PriorityConfig
+		extenders    []FakeExtender
+		nodes        []string
+		expectedHost string
+		expectsErr   bool
+	}{
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{errorPredicateExtender}},
+			},
+			nodes:      []string{"machine1", "machine2"},
+			expectsErr: true,
+			name:       "test 1",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{falsePredicateExtender}},
+			},
+			nodes:      []string{"machine1", "machine2"},
+			expectsErr: true,
+			name:       "test 2",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{truePredicateExtender}},
+				{predicates: []fitPredicate{machine1PredicateExtender}},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine1",
+			name:         "test 3",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{predicates: []fitPredicate{machine2PredicateExtender}},
+				{predicates: []fitPredicate{machine1PredicateExtender}},
+			},
+			nodes:      []string{"machine1", "machine2"},
+			expectsErr: true,
+			name:       "test 4",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{errorPrioritizerExtender, 10}},
+					weight:       1,
+				},
+			},
+			nodes:        []string{"machine1"},
+			expectedHost: "machine1",
+			name:         "test 5",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{machine1PrioritizerExtender, 10}},
+					weight:       1,
+				},
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{machine2PrioritizerExtender, 10}},
+					weight:       5,
+				},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine2",
+			name:         "test 6",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: machine2Prioritizer, Weight: 20}},
+			extenders: []FakeExtender{
+				{
+					predicates:   []fitPredicate{truePredicateExtender},
+					prioritizers: []priorityConfig{{machine1PrioritizerExtender, 10}},
+					weight:       1,
+				},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine2", // machine2 has higher score
+			name:         "test 7",
+		},
+		{
+			predicates:   map[string]algorithm.FitPredicate{"true": truePredicate},
+			prioritizers: []algorithm.PriorityConfig{{Function: EqualPriority, Weight: 1}},
+			extenders: []FakeExtender{
+				{
+					predicates: []fitPredicate{errorPredicateExtender},
+					ignorable:  true,
+				},
+				{
+					predicates: []fitPredicate{machine1PredicateExtender},
+				},
+			},
+			nodes:        []string{"machine1", "machine2"},
+			expectedHost: "machine1",
+			name:         "test 8",
+		},
+	}
+
+	for _, test := range tests {
+		random := rand.New(rand.NewSource(0))
+		extenders := []algorithm.SchedulerExtender{}
+		for ii := range test.extenders {
+			extenders = append(extenders, &test.extenders[ii])
+		}
+		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, extenders, algorithm.FakePodLister([]*api.Pod{}), algorithm.FakePodLister([]*api.Pod{}), random)
+		machine, err := scheduler.Schedule(&api.Pod{}, algorithm.FakeNodeLister(makeNodeList(test.nodes)))
+		if test.expectsErr {
+			if err == nil {
+				t.Errorf("Unexpected non-error for %s", test.name)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if test.expectedHost != machine {
+				t.Errorf("Failed : %s, Expected: %s, Saw: %s", test.name, test.expectedHost, machine)
+			}
+		}
+	}
+}
+
+func TestFindFitExtenderFailure(t *testing.T) {
+	nodes := []string{"machine1", "machine2"}
+	predicates := map[string]algorithm.FitPredicate{"true": truePredicate}
+	extenders := []algorithm.SchedulerExtender{&FakeExtender{predicates: []fitPredicate{machine1PredicateExtender}}}
+	filtered, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), nil, predicates, makeNodeList(nodes), extenders)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "machine1" {
+		t.Errorf("unexpected filtered nodes: %v", filtered.Items)
+	}
+	if failures, found := predicateMap["machine2"]; !found || !failures.Has("Extender") {
+		t.Errorf("expected machine2 to fail the extender, got %v", predicateMap)
+	}
+}
+
+// newExtenderServer returns a server that answers filter requests with the nodes named
+// like the pod, and prioritize requests with a score of 10 for that node and 1 otherwise.
+func newExtenderServer(t *testing.T, delay time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		time.Sleep(delay)
+		var args schedulerapiv1.ExtenderArgs
+		if err := json.NewDecoder(req.Body).Decode(&args); err != nil {
+			t.Errorf("unexpected error decoding extender args: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var resp interface{}
+		switch req.URL.Path {
+		case "/api/v1/filter":
+			result := schedulerapiv1.ExtenderFilterResult{}
+			for _, node := range args.Nodes.Items {
+				if node.Name == args.Pod.Name {
+					result.Nodes.Items = append(result.Nodes.Items, node)
+				}
+			}
+			resp = &result
+		case "/api/v1/prioritize":
+			result := schedulerapiv1.HostPriorityList{}
+			for _, node := range args.Nodes.Items {
+				score := 1
+				if node.Name == args.Pod.Name {
+					score = 10
+				}
+				result = append(result, schedulerapiv1.HostPriority{Host: node.Name, Score: score})
+			}
+			resp = &result
+		case "/api/v1/broken":
+			resp = &schedulerapiv1.ExtenderFilterResult{Error: "broken"}
+		default:
+			http.NotFound(w, req)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("unexpected error encoding extender response: %v", err)
+		}
+	}))
+}
+
+func TestHTTPExtender(t *testing.T) {
+	server := newExtenderServer(t, 0)
+	defer server.Close()
+
+	extender, err := NewHTTPExtender(&schedulerapi.ExtenderConfig{
+		URLPrefix:      server.URL + "/api/",
+		APIVersion:     "v1",
+		FilterVerb:     "filter",
+		PrioritizeVerb: "prioritize",
+		Weight:         3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "machine2"}}
+	nodes := makeNodeList([]string{"machine1", "machine2", "machine3"})
+
+	filtered, err := extender.Filter(pod, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "machine2" {
+		t.Errorf("unexpected filtered nodes: %v", filtered.Items)
+	}
+
+	priorities, weight, err := extender.Prioritize(pod, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if weight != 3 {
+		t.Errorf("expected weight 3, got %d", weight)
+	}
+	if len(*priorities) != 3 {
+		t.Fatalf("unexpected priorities: %v", *priorities)
+	}
+	for _, hostPriority := range *priorities {
+		expected := 1
+		if hostPriority.Host == "machine2" {
+			expected = 10
+		}
+		if hostPriority.Score != expected {
+			t.Errorf("expected score %d for %s, got %d", expected, hostPriority.Host, hostPriority.Score)
+		}
+	}
+}
+
+func TestHTTPExtenderWithoutVerbs(t *testing.T) {
+	extender, err := NewHTTPExtender(&schedulerapi.ExtenderConfig{URLPrefix: "http://127.0.0.1:1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nodes := makeNodeList([]string{"machine1", "machine2"})
+
+	filtered, err := extender.Filter(&api.Pod{}, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 2 {
+		t.Errorf("expected all nodes to be returned, got %v", filtered.Items)
+	}
+	priorities, weight, err := extender.Prioritize(&api.Pod{}, &nodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if weight != 0 || len(*priorities) != 2 {
+		t.Errorf("unexpected priorities %v with weight %d", *priorities, weight)
+	}
+}
+
+func TestHTTPExtenderErrors(t *testing.T) {
+	server := newExtenderServer(t, 100*time.Millisecond)
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		config schedulerapi.ExtenderConfig
+	}{
+		{
+			name:   "error in result",
+			config: schedulerapi.ExtenderConfig{URLPrefix: server.URL + "/api", APIVersion: "v1", FilterVerb: "broken"},
+		},
+		{
+			name:   "unknown verb",
+			config: schedulerapi.ExtenderConfig{URLPrefix: server.URL + "/api", APIVersion: "v1", FilterVerb: "unknown"},
+		},
+		{
+			name:   "timeout",
+			config: schedulerapi.ExtenderConfig{URLPrefix: server.URL + "/api", APIVersion: "v1", FilterVerb: "filter", HTTPTimeout: 10 * time.Millisecond},
+		},
+	}
+	for _, test := range tests {
+		extender, err := NewHTTPExtender(&test.config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		nodes := makeNodeList([]string{"machine1"})
+		if _, err := extender.Filter(&api.Pod{}, &nodes); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
@@ -46,7 +46,7 @@ import (
 type ConfigFactory struct {
 	Client *client.Client
 	// queue for pods that need scheduling
-	PodQueue *cache.FIFO
+	PodQueue *cache.PriorityQueue
 	// a means to list all known scheduled pods.
 	ScheduledPodLister *cache.StoreToPodLister
 	// a means to list all known scheduled pods and pods assumed to have been scheduled.
@@ -62,6 +62,9 @@ type ConfigFactory struct {
 	StopEverything chan struct{}
 	// Rate limiter for binding pods
 	BindPodsRateLimiter util.RateLimiter
+	// Whether pods of lower priority are preempted when a pod does not fit on any node.
+	// It should only be enabled if the Priority admission controller sets the priorities.
+	EnablePreemption bool
 
 	scheduledPodPopulator *framework.Controller
 	modeler               scheduler.SystemModeler
@@ -71,7 +74,7 @@ type ConfigFactory struct {
 func NewConfigFactory(client *client.Client, rateLimiter util.RateLimiter) *ConfigFactory {
 	c := &ConfigFactory{
 		Client:             client,
-		PodQueue:           cache.NewFIFO(cache.MetaNamespaceKeyFunc),
+		PodQueue:           cache.NewPriorityQueue(cache.MetaNamespaceKeyFunc, podPriorityLess),
 		ScheduledPodLister: &cache.StoreToPodLister{},
 		// Only nodes in the "Ready" condition with status == "True" are schedulable
 		NodeLister:       &cache.StoreToNodeLister{Store: cache.NewStore(cache.MetaNamespaceKeyFunc)},
@@ -129,7 +132,7 @@ func (f *ConfigFactory) CreateFromProvider(providerName string) (*scheduler.Conf
 		return nil, err
 	}
 
-	return f.CreateFromKeys(provider.FitPredicateKeys, provider.PriorityFunctionKeys)
+	return f.CreateFromKeys(provider.FitPredicateKeys, provider.PriorityFunctionKeys, []algorithm.SchedulerExtender{})
 }
 
 // Creates a scheduler from the configuration file
@@ -153,11 +156,21 @@ func (f *ConfigFactory) CreateFromConfig(policy schedulerapi.Policy) (*scheduler
 		priorityKeys.Insert(RegisterCustomPriorityFunction(priority))
 	}
 
-	return f.CreateFromKeys(predicateKeys, priorityKeys)
+	extenders := make([]algorithm.SchedulerExtender, 0)
+	for ii := range policy.ExtenderConfigs {
+		glog.V(2).Infof("Creating extender with config %+v", policy.ExtenderConfigs[ii])
+		extender, err := scheduler.NewHTTPExtender(&policy.ExtenderConfigs[ii])
+		if err != nil {
+			return nil, err
+		}
+		extenders = append(extenders, extender)
+	}
+
+	return f.CreateFromKeys(predicateKeys, priorityKeys, extenders)
 }
 
-// Creates a scheduler from a set of registered fit predicate keys and priority keys.
-func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys sets.String) (*scheduler.Config, error) {
+// Creates a scheduler from a set of registered fit predicate keys and priority keys, and the extenders.
+func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys sets.String, extenders []algorithm.SchedulerExtender) (*scheduler.Config, error) {
 	glog.V(2).Infof("creating scheduler with fit predicates '%v' and priority functions '%v", predicateKeys, priorityKeys)
 	pluginArgs := PluginFactoryArgs{
 		PodLister:        f.PodLister,
@@ -199,7 +212,7 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys sets.String)
 
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	algo := scheduler.NewGenericScheduler(predicateFuncs, priorityConfigs, f.PodLister, r)
+	algo := scheduler.NewGenericScheduler(predicateFuncs, priorityConfigs, extenders, f.PodLister, &cache.StoreToPodLister{Store: f.PodQueue}, r)
 
 	podBackoff := podBackoff{
 		perPodBackoff: map[types.NamespacedName]*backoffEntry{},
@@ -209,12 +222,18 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys sets.String)
 		maxDuration:     60 * time.Second,
 	}
 
+	var preemptor scheduler.PodPreemptor
+	if f.EnablePreemption {
+		preemptor = &podPreemptor{f.Client}
+	}
+
 	return &scheduler.Config{
 		Modeler: f.modeler,
 		// The scheduler only needs to consider schedulable nodes.
-		NodeLister: f.NodeLister.NodeCondition(api.NodeReady, api.ConditionTrue),
-		Algorithm:  algo,
-		Binder:     &binder{f.Client},
+		NodeLister:   f.NodeLister.NodeCondition(api.NodeReady, api.ConditionTrue),
+		Algorithm:    algo,
+		Binder:       &binder{f.Client},
+		PodPreemptor: preemptor,
 		NextPod: func() *api.Pod {
 			pod := f.PodQueue.Pop().(*api.Pod)
 			glog.V(2).Infof("About to try and schedule pod %v", pod.Name)
@@ -265,10 +284,12 @@ func (factory *ConfigFactory) createControllerLW() *cache.ListWatch {
 	return cache.NewListWatchFromClient(factory.Client, "replicationControllers", api.NamespaceAll, parseSelectorOrDie(""))
 }
 
-func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue *cache.FIFO) func(pod *api.Pod, err error) {
+func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue *cache.PriorityQueue) func(pod *api.Pod, err error) {
 	return func(pod *api.Pod, err error) {
 		if err == scheduler.ErrNoNodesAvailable {
 			glog.V(4).Infof("Unable to schedule %v %v: no nodes are registered to the cluster; waiting", pod.Namespace, pod.Name)
+		} else if err == scheduler.ErrPreempted {
+			glog.V(2).Infof("Pods were preempted for %v %v; retrying", pod.Namespace, pod.Name)
 		} else {
 			glog.Errorf("Error scheduling %v %v: %v; retrying", pod.Namespace, pod.Name, err)
 		}
@@ -282,10 +303,14 @@ func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue
 				Name:      pod.Name,
 			}
 
-			entry := backoff.getEntry(podID)
-			if !entry.TryWait(backoff.maxDuration) {
-				glog.Warningf("Request for pod %v already in flight, abandoning", podID)
-				return
+			// A pod for which other pods were preempted is retried without backoff,
+			// so that it is not kept waiting for the room made for it.
+			if err != scheduler.ErrPreempted {
+				entry := backoff.getEntry(podID)
+				if !entry.TryWait(backoff.maxDuration) {
+					glog.Warningf("Request for pod %v already in flight, abandoning", podID)
+					return
+				}
 			}
 			// Get the pod again; it may have changed/been scheduled already.
 			pod = &api.Pod{}
@@ -334,6 +359,28 @@ func (b *binder) Bind(binding *api.Binding) error {
 	// return b.Pods(binding.Namespace).Bind(binding)
 }
 
+type podPreemptor struct {
+	*client.Client
+}
+
+// DeletePod deletes the pod with the default grace period.
+func (p *podPreemptor) DeletePod(pod *api.Pod) error {
+	return p.Pods(pod.Namespace).Delete(pod.Name, nil)
+}
+
+// SetNominatedNodeName updates the status of the pod with the nominated node.
+func (p *podPreemptor) SetNominatedNodeName(pod *api.Pod, nodeName string) error {
+	podCopy := *pod
+	podCopy.Status.NominatedNodeName = nodeName
+	_, err := p.Pods(pod.Namespace).UpdateStatus(&podCopy)
+	return err
+}
+
+// podPriorityLess orders pods that need scheduling by priority, highest first.
+func podPriorityLess(a, b interface{}) bool {
+	return api.GetPodPriority(a.(*api.Pod)) > api.GetPodPriority(b.(*api.Pod))
+}
+
 type clock interface {
 	Now() time.Time
 }
@@ -30,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/plugin/pkg/scheduler"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 	schedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api"
 	latestschedulerapi "k8s.io/kubernetes/plugin/pkg/scheduler/api/latest"
@@ -82,12 +83,18 @@ func TestCreateFromConfig(t *testing.T) {
 		"priorities" : [
 			{"name" : "RackSpread", "weight" : 3, "argument" : {"serviceAntiAffinity" : {"label" : "rack"}}},
 			{"name" : "PriorityOne", "weight" : 2},
-			{"name" : "PriorityTwo", "weight" : 1}		]
+			{"name" : "PriorityTwo", "weight" : 1}		],
+		"extenders" : [
+			{"urlPrefix" : "http://127.0.0.1:12346/scheduler", "apiVersion" : "v1beta1", "filterVerb" : "filter", "prioritizeVerb" : "prioritize", "weight" : 5}
+		]
 	}`)
 	err := latestschedulerapi.Codec.DecodeInto(configData, &policy)
 	if err != nil {
 		t.Errorf("Invalid configuration: %v", err)
 	}
+	if len(policy.ExtenderConfigs) != 1 || policy.ExtenderConfigs[0].Weight != 5 {
+		t.Errorf("Unexpected extender configuration: %+v", policy.ExtenderConfigs)
+	}
 
 	factory.CreateFromConfig(policy)
 }
@@ -141,42 +148,53 @@ func TestDefaultErrorFunc(t *testing.T) {
 			TerminationGracePeriodSeconds: &grace,
 		},
 	}
-	handler := util.FakeHandler{
-		StatusCode:   200,
-		ResponseBody: runtime.EncodeOrDie(testapi.Default.Codec(), testPod),
-		T:            t,
+	table := []struct {
+		err             error
+		defaultDuration time.Duration
+	}{
+		{err: nil, defaultDuration: 1 * time.Millisecond},
+		// A pod for which pods were preempted is retried without waiting for the backoff.
+		{err: scheduler.ErrPreempted, defaultDuration: 1 * time.Hour},
 	}
-	mux := http.NewServeMux()
 
-	// FakeHandler musn't be sent requests other than the one you want to test.
-	mux.Handle(testapi.Default.ResourcePath("pods", "bar", "foo"), &handler)
-	server := httptest.NewServer(mux)
-	defer server.Close()
-	factory := NewConfigFactory(client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Default.Version()}), nil)
-	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
-	podBackoff := podBackoff{
-		perPodBackoff:   map[types.NamespacedName]*backoffEntry{},
-		clock:           &fakeClock{},
-		defaultDuration: 1 * time.Millisecond,
-		maxDuration:     1 * time.Second,
-	}
-	errFunc := factory.makeDefaultErrorFunc(&podBackoff, queue)
-
-	errFunc(testPod, nil)
-	for {
-		// This is a terrible way to do this but I plan on replacing this
-		// whole error handling system in the future. The test will time
-		// out if something doesn't work.
-		time.Sleep(10 * time.Millisecond)
-		got, exists, _ := queue.Get(testPod)
-		if !exists {
-			continue
+	for _, item := range table {
+		handler := util.FakeHandler{
+			StatusCode:   200,
+			ResponseBody: runtime.EncodeOrDie(testapi.Default.Codec(), testPod),
+			T:            t,
 		}
-		handler.ValidateRequest(t, testapi.Default.ResourcePath("pods", "bar", "foo"), "GET", nil)
-		if e, a := testPod, got; !reflect.DeepEqual(e, a) {
-			t.Errorf("Expected %v, got %v", e, a)
+		mux := http.NewServeMux()
+
+		// FakeHandler musn't be sent requests other than the one you want to test.
+		mux.Handle(testapi.Default.ResourcePath("pods", "bar", "foo"), &handler)
+		server := httptest.NewServer(mux)
+		factory := NewConfigFactory(client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Default.Version()}), nil)
+		queue := cache.NewPriorityQueue(cache.MetaNamespaceKeyFunc, podPriorityLess)
+		podBackoff := podBackoff{
+			perPodBackoff:   map[types.NamespacedName]*backoffEntry{},
+			clock:           &fakeClock{},
+			defaultDuration: item.defaultDuration,
+			maxDuration:     1 * time.Hour,
+		}
+		errFunc := factory.makeDefaultErrorFunc(&podBackoff, queue)
+
+		errFunc(testPod, item.err)
+		for {
+			// This is a terrible way to do this but I plan on replacing this
+			// whole error handling system in the future. The test will time
+			// out if something doesn't work.
+			time.Sleep(10 * time.Millisecond)
+			got, exists, _ := queue.Get(testPod)
+			if !exists {
+				continue
+			}
+			handler.ValidateRequest(t, testapi.Default.ResourcePath("pods", "bar", "foo"), "GET", nil)
+			if e, a := testPod, got; !reflect.DeepEqual(e, a) {
+				t.Errorf("Expected %v, got %v", e, a)
+			}
+			break
 		}
-		break
+		server.Close()
 	}
 }
 
@@ -247,6 +265,68 @@ func TestBind(t *testing.T) {
 	}
 }
 
+func TestPodPreemptor(t *testing.T) {
+	testPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
+	}
+	nominatedPod := *testPod
+	nominatedPod.Status.NominatedNodeName = "machine1"
+	expectedBody := runtime.EncodeOrDie(testapi.Default.Codec(), &nominatedPod)
+
+	table := []struct {
+		preempt      func(p *podPreemptor) error
+		path         string
+		method       string
+		expectedBody *string
+	}{
+		{
+			preempt:      func(p *podPreemptor) error { return p.SetNominatedNodeName(testPod, "machine1") },
+			path:         testapi.Default.ResourcePath("pods", api.NamespaceDefault, "foo") + "/status",
+			method:       "PUT",
+			expectedBody: &expectedBody,
+		},
+		{
+			preempt: func(p *podPreemptor) error { return p.DeletePod(testPod) },
+			path:    testapi.Default.ResourcePath("pods", api.NamespaceDefault, "foo"),
+			method:  "DELETE",
+		},
+	}
+
+	for _, item := range table {
+		handler := util.FakeHandler{
+			StatusCode:   200,
+			ResponseBody: expectedBody,
+			T:            t,
+		}
+		server := httptest.NewServer(&handler)
+		defer server.Close()
+		p := &podPreemptor{client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Default.Version()})}
+
+		if err := item.preempt(p); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+			continue
+		}
+		handler.ValidateRequest(t, item.path, item.method, item.expectedBody)
+	}
+	if testPod.Status.NominatedNodeName != "" {
+		t.Errorf("Expected the pod passed in not to be modified, got %v", testPod)
+	}
+}
+
+func TestPodQueueOrdersByPriority(t *testing.T) {
+	low, high := 1, 10
+	queue := cache.NewPriorityQueue(cache.MetaNamespaceKeyFunc, podPriorityLess)
+	queue.Add(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "none", Namespace: "bar"}})
+	queue.Add(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "low", Namespace: "bar"}, Spec: api.PodSpec{Priority: &low}})
+	queue.Add(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "high", Namespace: "bar"}, Spec: api.PodSpec{Priority: &high}})
+
+	for _, expected := range []string{"high", "low", "none"} {
+		if got := queue.Pop().(*api.Pod).Name; got != expected {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	}
+}
+
 func TestBackoff(t *testing.T) {
 	clock := fakeClock{}
 	backoff := podBackoff{
@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/glog"
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm/predicates"
@@ -55,7 +56,9 @@ func (f *FitError) Error() string {
 type genericScheduler struct {
 	predicates   map[string]algorithm.FitPredicate
 	prioritizers []algorithm.PriorityConfig
+	extenders    []algorithm.SchedulerExtender
 	pods         algorithm.PodLister
+	pendingPods  algorithm.PodLister
 	random       *rand.Rand
 	randomLock   sync.Mutex
 }
@@ -69,12 +72,12 @@ func (g *genericScheduler) Schedule(pod *api.Pod, nodeLister algorithm.NodeListe
 		return "", ErrNoNodesAvailable
 	}
 
-	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, g.pods, g.predicates, nodes)
+	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, g.pods, g.pendingPods, g.predicates, nodes, g.extenders)
 	if err != nil {
 		return "", err
 	}
 
-	priorityList, err := PrioritizeNodes(pod, g.pods, g.prioritizers, algorithm.FakeNodeLister(filteredNodes))
+	priorityList, err := PrioritizeNodes(pod, g.pods, g.prioritizers, algorithm.FakeNodeLister(filteredNodes), g.extenders)
 	if err != nil {
 		return "", err
 	}
@@ -88,6 +91,188 @@ func (g *genericScheduler) Schedule(pod *api.Pod, nodeLister algorithm.NodeListe
 	return g.selectHost(priorityList)
 }
 
+// Preempt finds a node on which the pod would fit if some pods of lower priority
+// were deleted. It returns the node and the pods that would have to be deleted,
+// or an empty node name if the pod should not or cannot preempt any pods.
+// The pods are selected by running the predicates of the scheduler against the
+// pods that would remain on the node. Predicates which look pods up through the
+// pod lister (such as inter-pod affinity) still see the pods that would be deleted.
+func (g *genericScheduler) Preempt(pod *api.Pod, nodeLister algorithm.NodeLister, scheduleErr error) (string, []*api.Pod, error) {
+	fitError, ok := scheduleErr.(*FitError)
+	if !ok || fitError == nil {
+		return "", nil, nil
+	}
+	machineToPods, err := predicates.MapPodsToMachines(g.pods)
+	if err != nil {
+		return "", nil, err
+	}
+	if !podEligibleToPreemptOthers(pod, machineToPods) {
+		glog.V(5).Infof("Pod %v/%v is not eligible for more preemption", pod.Namespace, pod.Name)
+		return "", nil, nil
+	}
+	if err := addNominatedPods(pod, machineToPods, g.pendingPods); err != nil {
+		return "", nil, err
+	}
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return "", nil, err
+	}
+	if len(nodes.Items) == 0 {
+		return "", nil, ErrNoNodesAvailable
+	}
+
+	nodeToVictims := map[string][]*api.Pod{}
+	for _, node := range nodes.Items {
+		// Deleting pods does not change the mind of an extender.
+		if fitError.FailedPredicates[node.Name].Has("Extender") {
+			continue
+		}
+		victims, fits, err := selectVictimsOnNode(pod, machineToPods[node.Name], node.Name, g.predicates)
+		if err != nil {
+			return "", nil, err
+		}
+		if fits {
+			nodeToVictims[node.Name] = victims
+		}
+	}
+	candidate := pickOneNodeForPreemption(nodeToVictims)
+	if len(candidate) == 0 {
+		return "", nil, nil
+	}
+	return candidate, nodeToVictims[candidate], nil
+}
+
+// podEligibleToPreemptOthers returns false if the pod has already been nominated to
+// a node on which pods of lower priority are being deleted. Those pods are most
+// likely the victims of an earlier preemption for this pod, so there is no need to
+// preempt more pods until they are gone.
+func podEligibleToPreemptOthers(pod *api.Pod, machineToPods map[string][]*api.Pod) bool {
+	nodeName := pod.Status.NominatedNodeName
+	if len(nodeName) == 0 {
+		return true
+	}
+	podPriority := api.GetPodPriority(pod)
+	for _, p := range machineToPods[nodeName] {
+		if p.DeletionTimestamp != nil && api.GetPodPriority(p) < podPriority {
+			return false
+		}
+	}
+	return true
+}
+
+// addNominatedPods adds the pending pods which were nominated to a node by preemption to the
+// pods on that node, unless their priority is lower than that of the pod. The room made for a
+// pod by preempting others is thus kept for it, instead of being taken by pods of equal or lower
+// priority which happen to be scheduled first.
+func addNominatedPods(pod *api.Pod, machineToPods map[string][]*api.Pod, pendingPods algorithm.PodLister) error {
+	if pendingPods == nil {
+		return nil
+	}
+	pods, err := pendingPods.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, p := range pods {
+		nodeName := p.Status.NominatedNodeName
+		if len(nodeName) == 0 || len(p.Spec.NodeName) != 0 {
+			continue
+		}
+		if (p.Namespace == pod.Namespace && p.Name == pod.Name) || api.GetPodPriority(p) < api.GetPodPriority(pod) {
+			continue
+		}
+		machineToPods[nodeName] = append(machineToPods[nodeName], p)
+	}
+	return nil
+}
+
+// selectVictimsOnNode finds the pods of lower priority that have to be deleted from the
+// node for the pod to fit there. It first checks that the pod fits once all pods of lower
+// priority are gone, then keeps as many of them as possible, highest priority first.
+// It returns false if the pod does not fit even after all of them are deleted.
+func selectVictimsOnNode(pod *api.Pod, existingPods []*api.Pod, nodeName string, predicateFuncs map[string]algorithm.FitPredicate) ([]*api.Pod, bool, error) {
+	podPriority := api.GetPodPriority(pod)
+	remaining := []*api.Pod{}
+	potentialVictims := []*api.Pod{}
+	for _, p := range existingPods {
+		// Pods which are already being deleted are not counted as victims.
+		if p.DeletionTimestamp == nil && api.GetPodPriority(p) < podPriority {
+			potentialVictims = append(potentialVictims, p)
+		} else {
+			remaining = append(remaining, p)
+		}
+	}
+	if len(potentialVictims) == 0 {
+		return nil, false, nil
+	}
+	fits, err := podFitsOnNode(pod, remaining, nodeName, predicateFuncs)
+	if err != nil || !fits {
+		return nil, false, err
+	}
+
+	sort.Stable(byPriorityDescending(potentialVictims))
+	victims := []*api.Pod{}
+	for _, p := range potentialVictims {
+		fits, err := podFitsOnNode(pod, append(remaining, p), nodeName, predicateFuncs)
+		if err != nil {
+			return nil, false, err
+		}
+		if fits {
+			remaining = append(remaining, p)
+		} else {
+			victims = append(victims, p)
+		}
+	}
+	return victims, true, nil
+}
+
+// podFitsOnNode returns true if the pod passes all predicates on the node with the given pods.
+func podFitsOnNode(pod *api.Pod, existingPods []*api.Pod, nodeName string, predicateFuncs map[string]algorithm.FitPredicate) (bool, error) {
+	for _, predicate := range predicateFuncs {
+		fit, err := predicate(pod, existingPods, nodeName)
+		if err != nil || !fit {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// pickOneNodeForPreemption picks the node whose highest priority victim has the lowest
+// priority. Ties are broken by picking the node with the fewest victims, then by node name.
+func pickOneNodeForPreemption(nodeToVictims map[string][]*api.Pod) string {
+	nodeNames := []string{}
+	for nodeName := range nodeToVictims {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	var selected string
+	var selectedHighest int
+	for _, nodeName := range nodeNames {
+		victims := nodeToVictims[nodeName]
+		// A node which needs no victims is always the best choice.
+		if len(victims) == 0 {
+			return nodeName
+		}
+		// Victims are sorted by descending priority.
+		highest := api.GetPodPriority(victims[0])
+		if len(selected) == 0 || highest < selectedHighest ||
+			(highest == selectedHighest && len(victims) < len(nodeToVictims[selected])) {
+			selected = nodeName
+			selectedHighest = highest
+		}
+	}
+	return selected
+}
+
+// byPriorityDescending sorts pods from the highest priority to the lowest.
+type byPriorityDescending []*api.Pod
+
+func (s byPriorityDescending) Len() int      { return len(s) }
+func (s byPriorityDescending) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byPriorityDescending) Less(i, j int) bool {
+	return api.GetPodPriority(s[i]) > api.GetPodPriority(s[j])
+}
+
 // This method takes a prioritized list of nodes and sorts them in reverse order based on scores
 // and then picks one randomly from the nodes that had the highest score
 func (g *genericScheduler) selectHost(priorityList algorithm.HostPriorityList) (string, error) {
@@ -106,13 +291,18 @@ func (g *genericScheduler) selectHost(priorityList algorithm.HostPriorityList) (
 
 // Filters the nodes to find the ones that fit based on the given predicate functions
 // Each node is passed through the predicate functions to determine if it is a fit
-func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFuncs map[string]algorithm.FitPredicate, nodes api.NodeList) (api.NodeList, FailedPredicateMap, error) {
+// Pending pods nominated to a node count against it unless their priority is lower than the pod's
+// The nodes that fit are then passed through the extenders, which may filter them further
+func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, pendingPods algorithm.PodLister, predicateFuncs map[string]algorithm.FitPredicate, nodes api.NodeList, extenders []algorithm.SchedulerExtender) (api.NodeList, FailedPredicateMap, error) {
 	filtered := []api.Node{}
 	machineToPods, err := predicates.MapPodsToMachines(podLister)
 	failedPredicateMap := FailedPredicateMap{}
 	if err != nil {
 		return api.NodeList{}, FailedPredicateMap{}, err
 	}
+	if err := addNominatedPods(pod, machineToPods, pendingPods); err != nil {
+		return api.NodeList{}, FailedPredicateMap{}, err
+	}
 	for _, node := range nodes.Items {
 		fits := true
 		for name, predicate := range predicateFuncs {
@@ -138,6 +328,31 @@ func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFunc
 			filtered = append(filtered, node)
 		}
 	}
+	if len(filtered) > 0 && len(extenders) != 0 {
+		for _, extender := range extenders {
+			filteredList, err := extender.Filter(pod, &api.NodeList{Items: filtered})
+			if err != nil {
+				if extender.IsIgnorable() {
+					glog.Warningf("Skipping extender %v as it returned error %v and has ignorable flag set", extender, err)
+					continue
+				}
+				return api.NodeList{}, FailedPredicateMap{}, err
+			}
+			remaining := sets.NewString()
+			for _, node := range filteredList.Items {
+				remaining.Insert(node.Name)
+			}
+			for _, node := range filtered {
+				if !remaining.Has(node.Name) {
+					failedPredicateMap[node.Name] = sets.NewString("Extender")
+				}
+			}
+			filtered = filteredList.Items
+			if len(filtered) == 0 {
+				break
+			}
+		}
+	}
 	return api.NodeList{Items: filtered}, failedPredicateMap, nil
 }
 
@@ -147,16 +362,24 @@ func findNodesThatFit(pod *api.Pod, podLister algorithm.PodLister, predicateFunc
 // Each priority function can also have its own weight
 // The node scores returned by the priority function are multiplied by the weights to get weighted scores
 // All scores are finally combined (added) to get the total weighted scores of all nodes
-func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfigs []algorithm.PriorityConfig, nodeLister algorithm.NodeLister) (algorithm.HostPriorityList, error) {
+// The weighted scores of the extenders are added as well
+func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfigs []algorithm.PriorityConfig, nodeLister algorithm.NodeLister, extenders []algorithm.SchedulerExtender) (algorithm.HostPriorityList, error) {
 	result := algorithm.HostPriorityList{}
 
-	// If no priority configs are provided, then the EqualPriority function is applied
+	// If no priority configs or extenders are provided, then the EqualPriority function is applied
 	// This is required to generate the priority list in the required format
-	if len(priorityConfigs) == 0 {
+	if len(priorityConfigs) == 0 && len(extenders) == 0 {
 		return EqualPriority(pod, podLister, nodeLister)
 	}
 
+	nodes, err := nodeLister.List()
+	if err != nil {
+		return algorithm.HostPriorityList{}, err
+	}
 	combinedScores := map[string]int{}
+	for _, node := range nodes.Items {
+		combinedScores[node.Name] = 0
+	}
 	for _, priorityConfig := range priorityConfigs {
 		weight := priorityConfig.Weight
 		// skip the priority function if the weight is specified as 0
@@ -172,6 +395,22 @@ func PrioritizeNodes(pod *api.Pod, podLister algorithm.PodLister, priorityConfig
 			combinedScores[hostEntry.Host] += hostEntry.Score * weight
 		}
 	}
+	if len(nodes.Items) > 0 {
+		for _, extender := range extenders {
+			prioritizedList, weight, err := extender.Prioritize(pod, &nodes)
+			if err != nil {
+				// prioritization errors from an extender are ignored, the other priority
+				// functions and extenders still determine the scores
+				glog.Warningf("Skipping extender %v as it returned error %v while prioritizing", extender, err)
+				continue
+			}
+			for _, hostEntry := range *prioritizedList {
+				if _, found := combinedScores[hostEntry.Host]; found {
+					combinedScores[hostEntry.Host] += hostEntry.Score * weight
+				}
+			}
+		}
+	}
 	for host, score := range combinedScores {
 		glog.V(10).Infof("Host %s Score %d", host, score)
 		result = append(result, algorithm.HostPriority{Host: host, Score: score})
@@ -209,11 +448,16 @@ func EqualPriority(_ *api.Pod, podLister algorithm.PodLister, nodeLister algorit
 	return result, nil
 }
 
-func NewGenericScheduler(predicates map[string]algorithm.FitPredicate, prioritizers []algorithm.PriorityConfig, pods algorithm.PodLister, random *rand.Rand) algorithm.ScheduleAlgorithm {
+// NewGenericScheduler returns a scheduler which runs the predicates and prioritizers against the
+// scheduled pods listed by pods. pendingPods lists the pods waiting to be scheduled; those which
+// were nominated to a node by preemption are counted against it.
+func NewGenericScheduler(predicates map[string]algorithm.FitPredicate, prioritizers []algorithm.PriorityConfig, extenders []algorithm.SchedulerExtender, pods algorithm.PodLister, pendingPods algorithm.PodLister, random *rand.Rand) algorithm.ScheduleAlgorithm {
 	return &genericScheduler{
 		predicates:   predicates,
 		prioritizers: prioritizers,
+		extenders:    extenders,
 		pods:         pods,
+		pendingPods:  pendingPods,
 		random:       random,
 	}
 }
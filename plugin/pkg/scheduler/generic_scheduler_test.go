@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/plugin/pkg/scheduler/algorithm"
 )
@@ -287,7 +288,7 @@ func TestGenericScheduler(t *testing.T) {
 
 	for _, test := range tests {
 		random := rand.New(rand.NewSource(0))
-		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, algorithm.FakePodLister(test.pods), random)
+		scheduler := NewGenericScheduler(test.predicates, test.prioritizers, []algorithm.SchedulerExtender{}, algorithm.FakePodLister(test.pods), algorithm.FakePodLister([]*api.Pod{}), random)
 		machine, err := scheduler.Schedule(test.pod, algorithm.FakeNodeLister(makeNodeList(test.nodes)))
 		if test.expectsErr {
 			if err == nil {
@@ -307,7 +308,7 @@ func TestGenericScheduler(t *testing.T) {
 func TestFindFitAllError(t *testing.T) {
 	nodes := []string{"3", "2", "1"}
 	predicates := map[string]algorithm.FitPredicate{"true": truePredicate, "false": falsePredicate}
-	_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes))
+	_, predicateMap, err := findNodesThatFit(&api.Pod{}, algorithm.FakePodLister([]*api.Pod{}), nil, predicates, makeNodeList(nodes), nil)
 
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -332,7 +333,7 @@ func TestFindFitSomeError(t *testing.T) {
 	nodes := []string{"3", "2", "1"}
 	predicates := map[string]algorithm.FitPredicate{"true": truePredicate, "match": matchesPredicate}
 	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "1"}}
-	_, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), predicates, makeNodeList(nodes))
+	_, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), nil, predicates, makeNodeList(nodes), nil)
 
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
@@ -355,3 +356,161 @@ func TestFindFitSomeError(t *testing.T) {
 		}
 	}
 }
+
+func TestFindFitNominatedPods(t *testing.T) {
+	nodes := []string{"1", "2", "3"}
+	predicates := map[string]algorithm.FitPredicate{"noPods": hasNoPodsPredicate}
+	pod := makePriorityPod("foo", "", 10)
+	nominatedFoo := makePriorityPod("foo", "", 10)
+	nominatedFoo.Status.NominatedNodeName = "3"
+	nominatedHigh := makePriorityPod("high", "", 20)
+	nominatedHigh.Status.NominatedNodeName = "1"
+	nominatedSame := makePriorityPod("same", "", 10)
+	nominatedSame.Status.NominatedNodeName = "2"
+	nominatedLow := makePriorityPod("low", "", 1)
+	nominatedLow.Status.NominatedNodeName = "3"
+	pendingPods := algorithm.FakePodLister([]*api.Pod{nominatedFoo, nominatedHigh, nominatedSame, nominatedLow})
+
+	filtered, predicateMap, err := findNodesThatFit(pod, algorithm.FakePodLister([]*api.Pod{}), pendingPods, predicates, makeNodeList(nodes), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filtered.Items) != 1 || filtered.Items[0].Name != "3" {
+		t.Errorf("expected the pod to fit on node 3 only, got %v", filtered.Items)
+	}
+	for _, node := range []string{"1", "2"} {
+		if !predicateMap[node].Has("noPods") {
+			t.Errorf("expected node %s to be taken by a nominated pod, got %v", node, predicateMap)
+		}
+	}
+}
+
+func atMostOnePodPredicate(pod *api.Pod, existingPods []*api.Pod, node string) (bool, error) {
+	return len(existingPods) <= 1, nil
+}
+
+func makePriorityPod(name, nodeName string, priority int) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec:       api.PodSpec{NodeName: nodeName, Priority: &priority},
+	}
+}
+
+func TestPreempt(t *testing.T) {
+	deleted := unversioned.Now()
+	terminating := makePriorityPod("terminating", "1", 1)
+	terminating.DeletionTimestamp = &deleted
+	nominated := makePriorityPod("foo", "", 10)
+	nominated.Status.NominatedNodeName = "1"
+	nominatedHigh := makePriorityPod("high", "", 20)
+	nominatedHigh.Status.NominatedNodeName = "1"
+
+	tests := []struct {
+		name            string
+		pod             *api.Pod
+		pods            []*api.Pod
+		pendingPods     []*api.Pod
+		nodes           []string
+		scheduleErr     error
+		expectedNode    string
+		expectedVictims sets.String
+	}{
+		{
+			name:        "only fit errors are handled",
+			pod:         makePriorityPod("foo", "", 10),
+			pods:        []*api.Pod{makePriorityPod("low", "1", 1), makePriorityPod("low2", "1", 1)},
+			nodes:       []string{"1"},
+			scheduleErr: ErrNoNodesAvailable,
+		},
+		{
+			name: "picks the node with the lowest priority victims",
+			pod:  makePriorityPod("foo", "", 10),
+			pods: []*api.Pod{
+				makePriorityPod("low", "1", 1), makePriorityPod("mid", "1", 5),
+				makePriorityPod("mid2", "2", 5), makePriorityPod("mid3", "2", 5),
+			},
+			nodes:           []string{"1", "2"},
+			expectedNode:    "1",
+			expectedVictims: sets.NewString("low"),
+		},
+		{
+			name: "picks the node with the fewest victims",
+			pod:  makePriorityPod("foo", "", 10),
+			pods: []*api.Pod{
+				makePriorityPod("low", "1", 1), makePriorityPod("low2", "1", 1), makePriorityPod("low3", "1", 1),
+				makePriorityPod("low4", "2", 1), makePriorityPod("high", "2", 20),
+			},
+			nodes:           []string{"1", "2"},
+			expectedNode:    "2",
+			expectedVictims: sets.NewString("low4"),
+		},
+		{
+			name:  "pods of equal or higher priority are not preempted",
+			pod:   makePriorityPod("foo", "", 10),
+			pods:  []*api.Pod{makePriorityPod("same", "1", 10), makePriorityPod("high", "1", 20)},
+			nodes: []string{"1"},
+		},
+		{
+			name:  "pods already being deleted are not preempted",
+			pod:   makePriorityPod("foo", "", 10),
+			pods:  []*api.Pod{terminating, makePriorityPod("high", "1", 20)},
+			nodes: []string{"1"},
+		},
+		{
+			name:  "no more preemption while victims on the nominated node are terminating",
+			pod:   nominated,
+			pods:  []*api.Pod{terminating, makePriorityPod("low", "1", 1), makePriorityPod("low2", "2", 1), makePriorityPod("low3", "2", 1)},
+			nodes: []string{"1", "2"},
+		},
+		{
+			name: "room nominated to a pending pod of higher priority is kept",
+			pod:  makePriorityPod("foo", "", 10),
+			pods: []*api.Pod{
+				makePriorityPod("mid", "1", 5),
+				makePriorityPod("low", "2", 1), makePriorityPod("low2", "2", 1),
+			},
+			pendingPods:     []*api.Pod{nominatedHigh},
+			nodes:           []string{"1", "2"},
+			expectedNode:    "2",
+			expectedVictims: sets.NewString("low2"),
+		},
+		{
+			name:  "nodes rejected by an extender are skipped",
+			pod:   makePriorityPod("foo", "", 10),
+			pods:  []*api.Pod{makePriorityPod("low", "1", 1), makePriorityPod("low2", "1", 1)},
+			nodes: []string{"1"},
+			scheduleErr: &FitError{
+				FailedPredicates: FailedPredicateMap{"1": sets.NewString("Extender")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		scheduler := NewGenericScheduler(
+			map[string]algorithm.FitPredicate{"atMostOnePod": atMostOnePodPredicate},
+			[]algorithm.PriorityConfig{}, nil,
+			algorithm.FakePodLister(test.pods), algorithm.FakePodLister(test.pendingPods), rand.New(rand.NewSource(0)))
+		scheduleErr := test.scheduleErr
+		if scheduleErr == nil {
+			scheduleErr = &FitError{Pod: test.pod, FailedPredicates: FailedPredicateMap{}}
+		}
+		node, victims, err := scheduler.Preempt(test.pod, algorithm.FakeNodeLister(makeNodeList(test.nodes)), scheduleErr)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if node != test.expectedNode {
+			t.Errorf("%s: expected node %q, got %q", test.name, test.expectedNode, node)
+		}
+		victimNames := sets.NewString()
+		for _, victim := range victims {
+			victimNames.Insert(victim.Name)
+		}
+		if test.expectedVictims == nil {
+			test.expectedVictims = sets.NewString()
+		}
+		if !victimNames.Equal(test.expectedVictims) {
+			t.Errorf("%s: expected victims %v, got %v", test.name, test.expectedVictims.List(), victimNames.List())
+		}
+	}
+}
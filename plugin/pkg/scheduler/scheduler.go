@@ -20,6 +20,7 @@ package scheduler
 // contrib/mesos/pkg/scheduler/.
 
 import (
+	"fmt"
 	"time"
 
 	"k8s.io/kubernetes/pkg/api"
@@ -31,11 +32,23 @@ import (
 	"github.com/golang/glog"
 )
 
+// ErrPreempted is passed to Config.Error instead of the scheduling error of a pod for which
+// pods of lower priority were preempted, so that the pod is scheduled again without backoff.
+var ErrPreempted = fmt.Errorf("pods of lower priority were preempted")
+
 // Binder knows how to write a binding.
 type Binder interface {
 	Bind(binding *api.Binding) error
 }
 
+// PodPreemptor knows how to make room for a pod by deleting pods of lower priority.
+type PodPreemptor interface {
+	// DeletePod gracefully deletes a pod which was preempted.
+	DeletePod(pod *api.Pod) error
+	// SetNominatedNodeName records the node on which pods were preempted for the pod.
+	SetNominatedNodeName(pod *api.Pod, nodeName string) error
+}
+
 // SystemModeler can help scheduler produce a model of the system that
 // anticipates reality. For example, if scheduler has pods A and B both
 // using hostPort 80, when it binds A to machine M it should not bind B
@@ -76,6 +89,10 @@ type Config struct {
 	Algorithm  algorithm.ScheduleAlgorithm
 	Binder     Binder
 
+	// PodPreemptor is used to delete pods of lower priority when a pod does not fit
+	// on any node. Preemption is disabled if it is nil.
+	PodPreemptor PodPreemptor
+
 	// Rate at which we can create pods
 	BindPodsRateLimiter util.RateLimiter
 
@@ -126,6 +143,9 @@ func (s *Scheduler) scheduleOne() {
 	if err != nil {
 		glog.V(1).Infof("Failed to schedule: %+v", pod)
 		s.config.Recorder.Eventf(pod, "FailedScheduling", "%v", err)
+		if _, ok := err.(*FitError); ok && s.config.PodPreemptor != nil && s.preempt(pod, err) {
+			err = ErrPreempted
+		}
 		s.config.Error(pod, err)
 		return
 	}
@@ -156,3 +176,30 @@ func (s *Scheduler) scheduleOne() {
 		s.config.Modeler.AssumePod(&assumed)
 	})
 }
+
+// preempt deletes pods of lower priority to make room for a pod which did not fit on
+// any node, and nominates the node for the pod. The pod itself is scheduled again
+// through the error path. It returns true if the node was nominated and all victims were
+// deleted, so that the pod can be retried without backoff.
+func (s *Scheduler) preempt(pod *api.Pod, scheduleErr error) bool {
+	node, victims, err := s.config.Algorithm.Preempt(pod, s.config.NodeLister, scheduleErr)
+	if err != nil {
+		glog.Errorf("Error preempting pods for %v/%v: %v", pod.Namespace, pod.Name, err)
+		return false
+	}
+	if len(node) == 0 {
+		return false
+	}
+	if err := s.config.PodPreemptor.SetNominatedNodeName(pod, node); err != nil {
+		glog.Errorf("Error setting the nominated node %v of pod %v/%v: %v", node, pod.Namespace, pod.Name, err)
+		return false
+	}
+	for _, victim := range victims {
+		if err := s.config.PodPreemptor.DeletePod(victim); err != nil {
+			glog.Errorf("Error preempting pod %v/%v: %v", victim.Namespace, victim.Name, err)
+			return false
+		}
+		s.config.Recorder.Eventf(victim, "Preempted", "Preempted by %v/%v on node %v", pod.Namespace, pod.Name, node)
+	}
+	return true
+}
@@ -64,6 +64,10 @@ func (es mockScheduler) Schedule(pod *api.Pod, ml algorithm.NodeLister) (string,
 	return es.machine, es.err
 }
 
+func (es mockScheduler) Preempt(pod *api.Pod, ml algorithm.NodeLister, scheduleErr error) (string, []*api.Pod, error) {
+	return "", nil, nil
+}
+
 func TestScheduler(t *testing.T) {
 	eventBroadcaster := record.NewBroadcaster()
 	defer eventBroadcaster.StartLogging(t.Logf).Stop()
@@ -157,6 +161,128 @@ func TestScheduler(t *testing.T) {
 	}
 }
 
+type preemptingScheduler struct {
+	mockScheduler
+	node    string
+	victims []*api.Pod
+}
+
+func (ps preemptingScheduler) Preempt(pod *api.Pod, ml algorithm.NodeLister, scheduleErr error) (string, []*api.Pod, error) {
+	return ps.node, ps.victims, nil
+}
+
+type fakePodPreemptor struct {
+	deleted   []string
+	nominated map[string]string
+	deleteErr error
+}
+
+func (fp *fakePodPreemptor) DeletePod(pod *api.Pod) error {
+	if fp.deleteErr != nil {
+		return fp.deleteErr
+	}
+	fp.deleted = append(fp.deleted, pod.Name)
+	return nil
+}
+
+func (fp *fakePodPreemptor) SetNominatedNodeName(pod *api.Pod, nodeName string) error {
+	fp.nominated[pod.Name] = nodeName
+	return nil
+}
+
+func TestSchedulerPreempt(t *testing.T) {
+	eventBroadcaster := record.NewBroadcaster()
+	defer eventBroadcaster.StartLogging(t.Logf).Stop()
+	fitErr := &FitError{Pod: podWithID("foo", ""), FailedPredicates: FailedPredicateMap{}}
+	schedulerErr := errors.New("scheduler")
+	victims := []*api.Pod{podWithID("bar", "machine1"), podWithID("baz", "machine1")}
+
+	table := []struct {
+		algo              algorithm.ScheduleAlgorithm
+		deleteErr         error
+		expectDeleted     []string
+		expectNominated   map[string]string
+		expectError       error
+		expectEventCounts map[string]int
+	}{
+		{
+			algo:              preemptingScheduler{mockScheduler{"", fitErr}, "machine1", victims},
+			expectDeleted:     []string{"bar", "baz"},
+			expectNominated:   map[string]string{"foo": "machine1"},
+			expectError:       ErrPreempted,
+			expectEventCounts: map[string]int{"FailedScheduling": 1, "Preempted": 2},
+		},
+		{
+			// A pod whose victims could not be deleted is retried with backoff.
+			algo:              preemptingScheduler{mockScheduler{"", fitErr}, "machine1", victims},
+			deleteErr:         errors.New("forbidden"),
+			expectNominated:   map[string]string{"foo": "machine1"},
+			expectError:       fitErr,
+			expectEventCounts: map[string]int{"FailedScheduling": 1},
+		},
+		{
+			// Nothing is preempted if preemption does not help.
+			algo:              preemptingScheduler{mockScheduler{"", fitErr}, "", nil},
+			expectNominated:   map[string]string{},
+			expectError:       fitErr,
+			expectEventCounts: map[string]int{"FailedScheduling": 1},
+		},
+		{
+			// Only errors from predicates can be solved by preemption.
+			algo:              preemptingScheduler{mockScheduler{"", schedulerErr}, "machine1", victims},
+			expectNominated:   map[string]string{},
+			expectError:       schedulerErr,
+			expectEventCounts: map[string]int{"FailedScheduling": 1},
+		},
+	}
+
+	for i, item := range table {
+		var gotError error
+		preemptor := &fakePodPreemptor{nominated: map[string]string{}, deleteErr: item.deleteErr}
+		c := &Config{
+			NodeLister: algorithm.FakeNodeLister(
+				api.NodeList{Items: []api.Node{{ObjectMeta: api.ObjectMeta{Name: "machine1"}}}},
+			),
+			Algorithm:    item.algo,
+			PodPreemptor: preemptor,
+			Error: func(p *api.Pod, err error) {
+				gotError = err
+			},
+			NextPod: func() *api.Pod {
+				return podWithID("foo", "")
+			},
+			Recorder: eventBroadcaster.NewRecorder(api.EventSource{Component: "scheduler"}),
+		}
+		s := New(c)
+		expectedEvents := 0
+		for _, count := range item.expectEventCounts {
+			expectedEvents += count
+		}
+		gotEvents := make(chan string, expectedEvents)
+		events := eventBroadcaster.StartEventWatcher(func(e *api.Event) {
+			gotEvents <- e.Reason
+		})
+		s.scheduleOne()
+		if e, a := item.expectError, gotError; e != a {
+			t.Errorf("%v: error: wanted %v, got %v", i, e, a)
+		}
+		if e, a := item.expectDeleted, preemptor.deleted; !reflect.DeepEqual(e, a) {
+			t.Errorf("%v: deleted pods: wanted %v, got %v", i, e, a)
+		}
+		if e, a := item.expectNominated, preemptor.nominated; !reflect.DeepEqual(e, a) {
+			t.Errorf("%v: nominated nodes: wanted %v, got %v", i, e, a)
+		}
+		gotEventCounts := map[string]int{}
+		for j := 0; j < expectedEvents; j++ {
+			gotEventCounts[<-gotEvents]++
+		}
+		if e, a := item.expectEventCounts, gotEventCounts; !reflect.DeepEqual(e, a) {
+			t.Errorf("%v: events: wanted %v, got %v", i, e, a)
+		}
+		events.Stop()
+	}
+}
+
 func TestSchedulerForgetAssumedPodAfterDelete(t *testing.T) {
 	eventBroadcaster := record.NewBroadcaster()
 	defer eventBroadcaster.StartLogging(t.Logf).Stop()
@@ -190,7 +316,9 @@ func TestSchedulerForgetAssumedPodAfterDelete(t *testing.T) {
 	algo := NewGenericScheduler(
 		map[string]algorithm.FitPredicate{"PodFitsPorts": predicates.PodFitsPorts},
 		[]algorithm.PriorityConfig{},
+		[]algorithm.SchedulerExtender{},
 		modeler.PodLister(),
+		queuedPodLister,
 		rand.New(rand.NewSource(time.Now().UnixNano())))
 
 	var gotBinding *api.Binding
@@ -322,7 +450,9 @@ func TestSchedulerRateLimitsBinding(t *testing.T) {
 	algo := NewGenericScheduler(
 		map[string]algorithm.FitPredicate{},
 		[]algorithm.PriorityConfig{},
+		[]algorithm.SchedulerExtender{},
 		modeler.PodLister(),
+		queuedPodLister,
 		rand.New(rand.NewSource(time.Now().UnixNano())))
 
 	// Rate limit to 1 pod
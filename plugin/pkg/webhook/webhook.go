@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the client side of calls from the apiserver to
+// remote services which make authentication, authorization or admission decisions.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+)
+
+// defaultRequestTimeout bounds a single call to the remote service.
+const defaultRequestTimeout = 30 * time.Second
+
+// GenericWebhook POSTs JSON encoded objects to a remote service and decodes its JSON responses.
+type GenericWebhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewGenericWebhook creates a webhook from a kubeconfig-format file. The server of the current
+// context's cluster is the full URL requests are sent to, and its TLS settings and the current
+// user's credentials are used to reach it. As with other clients, credentials are only sent
+// to https servers.
+func NewGenericWebhook(kubeConfigFile string) (*GenericWebhook, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigFile}
+	clientConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load webhook config %q: %v", kubeConfigFile, err)
+	}
+	return newGenericWebhook(clientConfig)
+}
+
+func newGenericWebhook(config *client.Config) (*GenericWebhook, error) {
+	// clientcmd splits the path of the server into the prefix.
+	serverURL, err := url.Parse(config.Host + config.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook server %q: %v", config.Host, err)
+	}
+	if serverURL.Scheme != "http" && serverURL.Scheme != "https" || len(serverURL.Host) == 0 {
+		return nil, fmt.Errorf("webhook server %q must be an absolute http or https URL", config.Host)
+	}
+	transport, err := client.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return &GenericWebhook{
+		url:    serverURL.String(),
+		client: &http.Client{Transport: transport, Timeout: defaultRequestTimeout},
+	}, nil
+}
+
+// Post sends request to the remote service and decodes its reply into response. Any status
+// other than 200 OK is returned as an error.
+func (g *GenericWebhook) Post(request, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := g.client.Post(g.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook %s returned %d: %s", g.url, resp.StatusCode, string(data))
+	}
+	if err := json.Unmarshal(data, response); err != nil {
+		return fmt.Errorf("unable to decode response from webhook %s: %v", g.url, err)
+	}
+	return nil
+}